@@ -1,14 +1,34 @@
 package config
 
-import "github.com/kelseyhightower/envconfig"
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
 
 type Config struct {
-	Database      DatabaseConfig
-	Auth          AuthConfig
-	Server        ServerConfig
-	Redis         RedisConfig
-	RabbitMQ      RabbitMQConfig
-	ElasticSearch ElasticSearchConfig
+	Database       DatabaseConfig
+	Auth           AuthConfig
+	Server         ServerConfig
+	Redis          RedisConfig
+	RabbitMQ       RabbitMQConfig
+	ElasticSearch  ElasticSearchConfig
+	Cart           CartConfig
+	Wishlist       WishlistConfig
+	Import         ImportConfig
+	Export         ExportConfig
+	Trash          TrashConfig
+	Pricing        PricingConfig
+	Recommendation RecommendationConfig
+	Inventory      InventoryConfig
+	BackInStock    BackInStockConfig
+	Storage        StorageConfig
+	Media          MediaConfig
+	Publishing     PublishingConfig
+	Locale         LocaleConfig
+	Seo            SeoConfig
+	Bundle         BundleConfig
+	Digital        DigitalConfig
 }
 
 type DatabaseConfig struct {
@@ -48,8 +68,126 @@ type ElasticSearchConfig struct {
 	Port string `envconfig:"ELASTICSEARCH_PORT" default:"9200"`
 }
 
+type CartConfig struct {
+	AbandonAfter         string `envconfig:"CART_ABANDON_AFTER" default:"24h"`
+	AbandonCheckInterval string `envconfig:"CART_ABANDON_CHECK_INTERVAL" default:"15m"`
+	RecoverySecret       string `envconfig:"CART_RECOVERY_SECRET" required:"true"`
+	RecoveryTTL          string `envconfig:"CART_RECOVERY_TTL" default:"168h"`
+	RecoveryBaseUrl      string `envconfig:"CART_RECOVERY_BASE_URL" default:"http://localhost:4200/cart/recover"`
+	RedisTTL             string `envconfig:"CART_REDIS_TTL" default:"72h"`
+	FlushInterval        string `envconfig:"CART_FLUSH_INTERVAL" default:"5s"`
+}
+
+type WishlistConfig struct {
+	PriceDropCheckInterval string `envconfig:"WISHLIST_PRICE_DROP_CHECK_INTERVAL" default:"1h"`
+}
+
+type ImportConfig struct {
+	BatchSize     int `envconfig:"IMPORT_BATCH_SIZE" default:"500"`
+	MaxFileSizeMB int `envconfig:"IMPORT_MAX_FILE_SIZE_MB" default:"20"`
+}
+
+type ExportConfig struct {
+	BatchSize   int    `envconfig:"EXPORT_BATCH_SIZE" default:"500"`
+	SiteBaseUrl string `envconfig:"EXPORT_SITE_BASE_URL" default:"http://localhost:4200"`
+	Currency    string `envconfig:"EXPORT_CURRENCY" default:"TRY"`
+}
+
+type TrashConfig struct {
+	Retention     string `envconfig:"TRASH_RETENTION" default:"720h"`
+	PurgeInterval string `envconfig:"TRASH_PURGE_INTERVAL" default:"24h"`
+}
+
+type PricingConfig struct {
+	ScheduleInterval string `envconfig:"PRICING_SCHEDULE_INTERVAL" default:"1m"`
+}
+
+type RecommendationConfig struct {
+	TopN            int    `envconfig:"RECOMMENDATION_TOP_N" default:"10"`
+	ComputeInterval string `envconfig:"RECOMMENDATION_COMPUTE_INTERVAL" default:"6h"`
+	CacheTTL        string `envconfig:"RECOMMENDATION_CACHE_TTL" default:"1h"`
+}
+
+type InventoryConfig struct {
+	LowStockCheckInterval string `envconfig:"INVENTORY_LOW_STOCK_CHECK_INTERVAL" default:"5m"`
+	DefaultLeadTimeDays   int    `envconfig:"INVENTORY_DEFAULT_LEAD_TIME_DAYS" default:"7"`
+	ReorderCoverDays      int    `envconfig:"INVENTORY_REORDER_COVER_DAYS" default:"30"`
+}
+
+type BackInStockConfig struct {
+	CheckInterval      string `envconfig:"BACK_IN_STOCK_CHECK_INTERVAL" default:"1m"`
+	BatchSize          int    `envconfig:"BACK_IN_STOCK_BATCH_SIZE" default:"100"`
+	EmailCooldown      string `envconfig:"BACK_IN_STOCK_EMAIL_COOLDOWN" default:"1h"`
+	UnsubscribeSecret  string `envconfig:"BACK_IN_STOCK_UNSUBSCRIBE_SECRET" default:"back-in-stock-secret"`
+	UnsubscribeTTL     string `envconfig:"BACK_IN_STOCK_UNSUBSCRIBE_TTL" default:"720h"`
+	UnsubscribeBaseUrl string `envconfig:"BACK_IN_STOCK_UNSUBSCRIBE_BASE_URL" default:"http://localhost:4200/stock-subscriptions/unsubscribe"`
+}
+
+type StorageConfig struct {
+	Driver          string `envconfig:"STORAGE_DRIVER" default:"local"`
+	LocalDir        string `envconfig:"STORAGE_LOCAL_DIR" default:"./uploads"`
+	PublicBaseUrl   string `envconfig:"STORAGE_PUBLIC_BASE_URL" default:"http://localhost:8080/media"`
+	S3Endpoint      string `envconfig:"STORAGE_S3_ENDPOINT" default:"localhost:9000"`
+	S3Region        string `envconfig:"STORAGE_S3_REGION" default:"us-east-1"`
+	S3Bucket        string `envconfig:"STORAGE_S3_BUCKET" default:"ecommerce-media"`
+	S3AccessKey     string `envconfig:"STORAGE_S3_ACCESS_KEY"`
+	S3SecretKey     string `envconfig:"STORAGE_S3_SECRET_KEY"`
+	S3UseSSL        bool   `envconfig:"STORAGE_S3_USE_SSL" default:"false"`
+	S3PublicBaseUrl string `envconfig:"STORAGE_S3_PUBLIC_BASE_URL"`
+}
+
+type MediaConfig struct {
+	MaxFileSizeMB         int    `envconfig:"MEDIA_MAX_FILE_SIZE_MB" default:"10"`
+	MaxImagesPerProduct   int    `envconfig:"MEDIA_MAX_IMAGES_PER_PRODUCT" default:"20"`
+	ThumbnailWidths       string `envconfig:"MEDIA_THUMBNAIL_WIDTHS" default:"160,480,1024"`
+	OrphanCleanupInterval string `envconfig:"MEDIA_ORPHAN_CLEANUP_INTERVAL" default:"24h"`
+	OrphanGracePeriod     string `envconfig:"MEDIA_ORPHAN_GRACE_PERIOD" default:"1h"`
+}
+
+type PublishingConfig struct {
+	ScheduleInterval string `envconfig:"PUBLISHING_SCHEDULE_INTERVAL" default:"1m"`
+}
+
+type LocaleConfig struct {
+	Default   string `envconfig:"LOCALE_DEFAULT" default:"tr"`
+	Supported string `envconfig:"LOCALE_SUPPORTED" default:"tr,en"`
+}
+
+type SeoConfig struct {
+	SitemapBaseUrl         string `envconfig:"SEO_SITEMAP_BASE_URL" default:"http://localhost:8080"`
+	SitemapPageSize        int    `envconfig:"SEO_SITEMAP_PAGE_SIZE" default:"10000"`
+	SitemapRefreshInterval string `envconfig:"SEO_SITEMAP_REFRESH_INTERVAL" default:"5m"`
+}
+
+type BundleConfig struct {
+	RefreshInterval string `envconfig:"BUNDLE_REFRESH_INTERVAL" default:"1m"`
+}
+
+// DigitalConfig keeps the files of digital products apart from the public media: in their own local
+// directory, or their own bucket with the S3 driver.
+type DigitalConfig struct {
+	LocalDir            string `envconfig:"DIGITAL_STORAGE_LOCAL_DIR" default:"./downloads"`
+	S3Bucket            string `envconfig:"DIGITAL_STORAGE_S3_BUCKET" default:"ecommerce-downloads"`
+	MaxFileSizeMB       int    `envconfig:"DIGITAL_MAX_FILE_SIZE_MB" default:"500"`
+	DownloadBaseUrl     string `envconfig:"DIGITAL_DOWNLOAD_BASE_URL" default:"http://localhost:8080/api/v1/downloads"`
+	DownloadSecret      string `envconfig:"DIGITAL_DOWNLOAD_SECRET" default:"digital-download-secret"`
+	LinkTTL             string `envconfig:"DIGITAL_LINK_TTL" default:"15m"`
+	DownloadExpiry      string `envconfig:"DIGITAL_DOWNLOAD_EXPIRY" default:"720h"`
+	DownloadLimit       int    `envconfig:"DIGITAL_DOWNLOAD_LIMIT" default:"5"`
+	FulfillmentInterval string `envconfig:"DIGITAL_FULFILLMENT_INTERVAL" default:"1m"`
+}
+
 func Load() (*Config, error) {
 	var cfg Config
 	err := envconfig.Process("", &cfg)
 	return &cfg, err
 }
+
+// ParseDuration parses a duration setting and falls back to the given default when it is malformed.
+func ParseDuration(value string, fallback time.Duration) time.Duration {
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return fallback
+	}
+	return duration
+}
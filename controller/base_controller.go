@@ -2,6 +2,8 @@ package controller
 
 import (
 	"go-ecommerce-service/controller/response"
+	"go-ecommerce-service/internal/jwt"
+	_errors "go-ecommerce-service/pkg/errors"
 	"net/http"
 	"strconv"
 
@@ -15,6 +17,31 @@ func (bc *BaseController) ParseIdParam(c echo.Context, paramName string) (int64,
 	return strconv.ParseInt(param, 10, 64)
 }
 
+// CurrentUserId returns the id of the user authenticated by AuthMiddleware.
+func (bc *BaseController) CurrentUserId(c echo.Context) (int64, error) {
+	claim, ok := c.Get("userId").(*jwt.Claim)
+	if !ok || claim == nil {
+		return 0, _errors.NewUnauthorized("Authentication required")
+	}
+	return claim.UserId, nil
+}
+
+// CurrentUser returns the id and role of the user authenticated by AuthMiddleware, for services that
+// authorize the user themselves.
+func (bc *BaseController) CurrentUser(c echo.Context) (int64, string, error) {
+	claim, ok := c.Get("userId").(*jwt.Claim)
+	if !ok || claim == nil {
+		return 0, "", _errors.NewUnauthorized("Authentication required")
+	}
+	return claim.UserId, claim.Role, nil
+}
+
+// Locale returns the content locale resolved by LocaleMiddleware.
+func (bc *BaseController) Locale(c echo.Context) string {
+	locale, _ := c.Get("locale").(string)
+	return locale
+}
+
 func (bc *BaseController) StringQueryParam(c echo.Context, paramName string) string {
 	queryParam := c.QueryParam(paramName)
 	return queryParam
@@ -36,6 +63,25 @@ func (bc *BaseController) Created(c echo.Context, data interface{}, message stri
 	})
 }
 
+// Accepted answers with 202 for work that continues in the background.
+func (bc *BaseController) Accepted(c echo.Context, data interface{}, message string) error {
+	return c.JSON(http.StatusAccepted, response.ApiResponse{
+		Success: true,
+		Message: message,
+		Data:    data,
+	})
+}
+
+// MovedPermanently answers with 301 and a Location header pointing to the resource's current URL.
+func (bc *BaseController) MovedPermanently(c echo.Context, location string, data interface{}, message string) error {
+	c.Response().Header().Set(echo.HeaderLocation, location)
+	return c.JSON(http.StatusMovedPermanently, response.ApiResponse{
+		Success: true,
+		Message: message,
+		Data:    data,
+	})
+}
+
 func (bc *BaseController) BadRequest(c echo.Context, err error) error {
 	return c.JSON(http.StatusBadRequest, response.ApiResponse{
 		Success: false,
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type BundleController struct {
+	bundleService service.IBundleService
+	BaseController
+}
+
+func NewBundleController(bundleService service.IBundleService) *BundleController {
+	return &BundleController{bundleService: bundleService}
+}
+
+func (bundleController *BundleController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.GET("/api/v1/products/:id/bundle", bundleController.GetBundle)
+
+	api.PUT("/products/:id/bundle", bundleController.SaveBundle)
+	api.DELETE("/products/:id/bundle", bundleController.DeleteBundle)
+}
+
+func (bundleController *BundleController) GetBundle(c echo.Context) error {
+	productId, parseIdErr := bundleController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	bundle, serviceErr := bundleController.bundleService.GetBundle(productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return bundleController.Success(c, bundle, "Bundle retrieved")
+}
+
+func (bundleController *BundleController) SaveBundle(c echo.Context) error {
+	userId, role, authErr := bundleController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := bundleController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var saveBundleRequest request.SaveBundleRequest
+	if bindErr := c.Bind(&saveBundleRequest); bindErr != nil {
+		return bindErr
+	}
+
+	bundle, serviceErr := bundleController.bundleService.SaveBundle(userId, role, productId, saveBundleRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return bundleController.Success(c, bundle, "Bundle saved")
+}
+
+func (bundleController *BundleController) DeleteBundle(c echo.Context) error {
+	userId, role, authErr := bundleController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := bundleController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	if serviceErr := bundleController.bundleService.DeleteBundle(userId, role, productId); serviceErr != nil {
+		return serviceErr
+	}
+	return bundleController.Success(c, nil, "Bundle removed")
+}
@@ -2,7 +2,12 @@ package controller
 
 import (
 	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/domain"
+	_errors "go-ecommerce-service/pkg/errors"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
 	"go-ecommerce-service/service"
+	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -16,14 +21,33 @@ func NewCartController(cartService service.ICartService) *CartController {
 	return &CartController{cartService: cartService}
 }
 
-func (cartController *CartController) RegisterRoutes(e *echo.Echo) {
+// RegisterRoutes registers the cart endpoints. Recovery is public since the token is the credential; the
+// abandonment stats span every store and are left to admins.
+func (cartController *CartController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.POST("/api/v1/carts/recover", cartController.RecoverCart)
+	api.GET("/carts/abandonment-stats", cartController.GetAbandonmentStats, customMiddleware.RequireRole(domain.UserRoleAdmin))
 	e.GET("/api/v1/carts/:id", cartController.GetCartById)
+	e.GET("/api/v1/carts/:id/totals", cartController.GetCartTotals)
 	e.GET("/api/v1/carts", cartController.GetCartsByUserId)
 	e.POST("/api/v1/carts", cartController.CreateCart)
 	e.DELETE("/api/v1/carts/:id", cartController.DeleteCartById)
 	e.DELETE("/api/v1/carts/", cartController.ClearUserCarts)
 }
 
+// GetCartTotals prices the cart for its owner.
+func (cartController *CartController) GetCartTotals(c echo.Context) error {
+	id, parseIdErr := cartController.BaseController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	totals, serviceErr := cartController.cartService.GetCartTotals(id)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return cartController.Success(c, totals, "Cart totals calculated")
+}
+
 func (cartController *CartController) GetCartById(c echo.Context) error {
 	id, parseIdErr := cartController.BaseController.ParseIdParam(c, "id")
 	if parseIdErr != nil {
@@ -81,3 +105,44 @@ func (cartController *CartController) ClearUserCarts(c echo.Context) error {
 
 	return cartController.Created(c, nil, "User cart deleted")
 }
+
+// RecoverCart reactivates the abandoned cart of a recovery token.
+func (cartController *CartController) RecoverCart(c echo.Context) error {
+	var recoverCartRequest request.RecoverCartRequest
+	if bindErr := c.Bind(&recoverCartRequest); bindErr != nil {
+		return bindErr
+	}
+	recoveredCart, serviceErr := cartController.cartService.RecoverCart(recoverCartRequest.Token)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return cartController.Success(c, recoveredCart, "Cart recovered")
+}
+
+func (cartController *CartController) GetAbandonmentStats(c echo.Context) error {
+	days := 30
+	if daysParam := cartController.StringQueryParam(c, "days"); daysParam != "" {
+		parsedDays, parseErr := strconv.Atoi(daysParam)
+		if parseErr != nil || parsedDays <= 0 {
+			return _errors.NewBadRequest("days must be a positive number")
+		}
+		days = parsedDays
+	}
+
+	var storeId *uint
+	if storeIdParam := cartController.StringQueryParam(c, "store_id"); storeIdParam != "" {
+		parsedStoreId, parseErr := strconv.ParseUint(storeIdParam, 10, 64)
+		if parseErr != nil {
+			return _errors.NewBadRequest("store_id must be a store id")
+		}
+		id := uint(parsedStoreId)
+		storeId = &id
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	stats, serviceErr := cartController.cartService.GetAbandonmentStats(since, storeId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return cartController.Success(c, stats, "Cart abandonment stats")
+}
@@ -22,24 +22,50 @@ func NewCategoryController(categoryService service.ICategoryService) *CategoryCo
 
 func (categoryController *CategoryController) RegisterRoutes(e *echo.Echo) {
 	e.GET("/api/v1/categories", categoryController.GetAllCategories)
+	e.GET("/api/v1/categories/tree", categoryController.GetCategoryTree)
 	e.GET("/api/v1/categories/:id", categoryController.GetCategoryById)
-	e.GET("/api/v1/categories/:true", categoryController.GetCategoriesByIsActive)
+	e.GET("/api/v1/categories/:id/tree", categoryController.GetCategorySubtree)
 	e.POST("/api/v1/categories", categoryController.AddCategory)
 	e.PUT("/api/v1/categories/:id", categoryController.UpdateCategory)
+	e.PUT("/api/v1/categories/:id/move", categoryController.MoveCategory)
 	e.DELETE("/api/v1/categories/:id", categoryController.DeleteCategory)
 }
 
+// GetAllCategories lists the categories flat; ?is_active=true or false narrows the list down.
 func (categoryController *CategoryController) GetAllCategories(c echo.Context) error {
-	categories := categoryController.categoryService.GetAllCategories()
+	if c.QueryParam("is_active") != "" {
+		return categoryController.GetCategoriesByIsActive(c)
+	}
+	categories := categoryController.categoryService.GetAllCategories(categoryController.Locale(c))
 	return categoryController.Success(c, categories, "")
 }
 
+// GetCategoryTree returns the whole category tree; ?active_only=true leaves out inactive branches.
+func (categoryController *CategoryController) GetCategoryTree(c echo.Context) error {
+	activeOnly := parseBool(c.QueryParam("active_only"))
+	tree := categoryController.categoryService.GetCategoryTree(categoryController.Locale(c), activeOnly)
+	return categoryController.Success(c, tree, "")
+}
+
+func (categoryController *CategoryController) GetCategorySubtree(c echo.Context) error {
+	id, parseIdErr := strconv.Atoi(c.Param("id"))
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	activeOnly := parseBool(c.QueryParam("active_only"))
+	subtree, serviceErr := categoryController.categoryService.GetCategorySubtree(uint(id), categoryController.Locale(c), activeOnly)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return categoryController.Success(c, subtree, "")
+}
+
 func (categoryController *CategoryController) GetCategoryById(c echo.Context) error {
 	id, parseIdErr := strconv.Atoi(c.Param("id"))
 	if parseIdErr != nil {
 		return parseIdErr
 	}
-	category, serviceErr := categoryController.categoryService.GetCategoryById(id)
+	category, serviceErr := categoryController.categoryService.GetCategoryById(id, categoryController.Locale(c))
 	if serviceErr != nil {
 		return serviceErr
 	}
@@ -47,9 +73,9 @@ func (categoryController *CategoryController) GetCategoryById(c echo.Context) er
 }
 
 func (categoryController *CategoryController) GetCategoriesByIsActive(c echo.Context) error {
-	param := c.Param("true")
+	param := c.QueryParam("is_active")
 	b := parseBool(param)
-	categories, serviceErr := categoryController.categoryService.GetCategoriesByIsActive(b)
+	categories, serviceErr := categoryController.categoryService.GetCategoriesByIsActive(b, categoryController.Locale(c))
 	if serviceErr != nil {
 		return serviceErr
 	}
@@ -85,6 +111,22 @@ func (categoryController *CategoryController) UpdateCategory(c echo.Context) err
 	return categoryController.Success(c, category, "Category updated")
 }
 
+func (categoryController *CategoryController) MoveCategory(c echo.Context) error {
+	id, parseIdErr := strconv.Atoi(c.Param("id"))
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var moveCategoryRequest request.MoveCategoryRequest
+	if bindErr := c.Bind(&moveCategoryRequest); bindErr != nil {
+		return bindErr
+	}
+	category, serviceErr := categoryController.categoryService.MoveCategory(uint(id), moveCategoryRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return categoryController.Success(c, category, "Category moved")
+}
+
 func (categoryController *CategoryController) DeleteCategory(c echo.Context) error {
 	id, parseIdErr := strconv.Atoi(c.Param("id"))
 	if parseIdErr != nil {
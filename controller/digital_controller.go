@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"fmt"
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/internal/dto"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/service"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+type DigitalController struct {
+	digitalService service.IDigitalService
+	maxFileSize    int64
+	BaseController
+}
+
+func NewDigitalController(digitalService service.IDigitalService, maxFileSizeMB int) *DigitalController {
+	return &DigitalController{
+		digitalService: digitalService,
+		maxFileSize:    int64(maxFileSizeMB) << 20,
+	}
+}
+
+func (digitalController *DigitalController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.GET("/api/v1/downloads/:token", digitalController.Download)
+
+	api.GET("/products/:id/digital-files", digitalController.GetFiles)
+	// The body limit leaves room for the multipart envelope around the largest allowed file.
+	api.POST("/products/:id/digital-files", digitalController.UploadFile,
+		middleware.BodyLimit(fmt.Sprintf("%dM", digitalController.maxFileSize>>20+1)))
+	api.DELETE("/products/:id/digital-files/:fileId", digitalController.DeleteFile)
+	api.GET("/products/:id/license-keys", digitalController.GetLicenseKeyPool)
+	api.POST("/products/:id/license-keys", digitalController.AddLicenseKeys)
+	api.GET("/orders/:id/downloads", digitalController.GetOrderDownloads)
+}
+
+func (digitalController *DigitalController) GetFiles(c echo.Context) error {
+	userId, role, authErr := digitalController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := digitalController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	files, serviceErr := digitalController.digitalService.GetFiles(userId, role, productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return digitalController.Success(c, files, "Digital files listed")
+}
+
+// UploadFile accepts a multipart upload with the file in the file field and streams it to storage.
+func (digitalController *DigitalController) UploadFile(c echo.Context) error {
+	userId, role, authErr := digitalController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := digitalController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	fileHeader, fileErr := c.FormFile("file")
+	if fileErr != nil {
+		return _errors.NewBadRequest("File is required")
+	}
+	if fileHeader.Size > digitalController.maxFileSize {
+		return _errors.NewBadRequest(fmt.Sprintf("File cannot be larger than %d MB", digitalController.maxFileSize>>20))
+	}
+	file, openErr := fileHeader.Open()
+	if openErr != nil {
+		return openErr
+	}
+	defer file.Close()
+
+	digitalFile, serviceErr := digitalController.digitalService.UploadFile(userId, role, productId, dto.UploadDigitalFileRequest{
+		FileName:    fileHeader.Filename,
+		ContentType: fileHeader.Header.Get(echo.HeaderContentType),
+		Content:     file,
+		Size:        fileHeader.Size,
+	})
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return digitalController.Created(c, digitalFile, "Digital file uploaded")
+}
+
+func (digitalController *DigitalController) DeleteFile(c echo.Context) error {
+	userId, role, authErr := digitalController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := digitalController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	fileId, parseIdErr := digitalController.ParseIdParam(c, "fileId")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	if serviceErr := digitalController.digitalService.DeleteFile(userId, role, productId, fileId); serviceErr != nil {
+		return serviceErr
+	}
+	return digitalController.Success(c, nil, "Digital file deleted")
+}
+
+func (digitalController *DigitalController) GetLicenseKeyPool(c echo.Context) error {
+	userId, role, authErr := digitalController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := digitalController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	pool, serviceErr := digitalController.digitalService.GetLicenseKeyPool(userId, role, productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return digitalController.Success(c, pool, "Licence key pool retrieved")
+}
+
+func (digitalController *DigitalController) AddLicenseKeys(c echo.Context) error {
+	userId, role, authErr := digitalController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := digitalController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var addLicenseKeysRequest request.AddLicenseKeysRequest
+	if bindErr := c.Bind(&addLicenseKeysRequest); bindErr != nil {
+		return bindErr
+	}
+
+	pool, serviceErr := digitalController.digitalService.AddLicenseKeys(userId, role, productId, addLicenseKeysRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return digitalController.Created(c, pool, "Licence keys added")
+}
+
+func (digitalController *DigitalController) GetOrderDownloads(c echo.Context) error {
+	userId, authErr := digitalController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	orderId, parseIdErr := digitalController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	downloads, serviceErr := digitalController.digitalService.GetOrderDownloads(userId, orderId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return digitalController.Success(c, downloads, "Order downloads listed")
+}
+
+// Download serves the file of a signed download link as an attachment.
+func (digitalController *DigitalController) Download(c echo.Context) error {
+	file, serviceErr := digitalController.digitalService.Download(c.Param("token"))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	defer file.Content.Close()
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", file.FileName))
+	return c.Stream(http.StatusOK, file.ContentType, file.Content)
+}
@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/domain"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type InventoryController struct {
+	inventoryService service.IInventoryService
+	BaseController
+}
+
+func NewInventoryController(inventoryService service.IInventoryService) *InventoryController {
+	return &InventoryController{inventoryService: inventoryService}
+}
+
+// RegisterRoutes registers the inventory endpoints. Warehouses are managed by admins; the service lets the owners
+// of a product's store book its stock as well.
+func (inventoryController *InventoryController) RegisterRoutes(api *echo.Group) {
+	admin := customMiddleware.RequireRole(domain.UserRoleAdmin)
+	api.GET("/warehouses", inventoryController.GetWarehouses)
+	api.POST("/warehouses", inventoryController.AddWarehouse, admin)
+	api.PUT("/warehouses/:id", inventoryController.UpdateWarehouse, admin)
+	api.GET("/products/:id/stock", inventoryController.GetProductStock)
+	api.GET("/products/:id/stock-movements", inventoryController.GetMovements)
+	api.POST("/products/:id/stock-movements", inventoryController.RecordMovement)
+	api.POST("/products/:id/stock-transfers", inventoryController.TransferStock)
+}
+
+func (inventoryController *InventoryController) GetWarehouses(c echo.Context) error {
+	warehouses, serviceErr := inventoryController.inventoryService.GetWarehouses()
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return inventoryController.Success(c, warehouses, "Warehouses listed")
+}
+
+func (inventoryController *InventoryController) AddWarehouse(c echo.Context) error {
+	var addWarehouseRequest request.AddWarehouseRequest
+	if bindErr := c.Bind(&addWarehouseRequest); bindErr != nil {
+		return bindErr
+	}
+
+	warehouse, serviceErr := inventoryController.inventoryService.AddWarehouse(addWarehouseRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return inventoryController.Created(c, warehouse, "Warehouse created")
+}
+
+func (inventoryController *InventoryController) UpdateWarehouse(c echo.Context) error {
+	warehouseId, parseIdErr := inventoryController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var updateWarehouseRequest request.AddWarehouseRequest
+	if bindErr := c.Bind(&updateWarehouseRequest); bindErr != nil {
+		return bindErr
+	}
+
+	warehouse, serviceErr := inventoryController.inventoryService.UpdateWarehouse(warehouseId, updateWarehouseRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return inventoryController.Success(c, warehouse, "Warehouse updated")
+}
+
+func (inventoryController *InventoryController) GetProductStock(c echo.Context) error {
+	productId, parseIdErr := inventoryController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	stock, serviceErr := inventoryController.inventoryService.GetProductStock(productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return inventoryController.Success(c, stock, "Stock levels retrieved")
+}
+
+func (inventoryController *InventoryController) GetMovements(c echo.Context) error {
+	productId, parseIdErr := inventoryController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var listStockMovementsRequest request.ListStockMovementsRequest
+	if bindErr := c.Bind(&listStockMovementsRequest); bindErr != nil {
+		return bindErr
+	}
+
+	movements, serviceErr := inventoryController.inventoryService.GetMovements(productId, listStockMovementsRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return inventoryController.Success(c, movements, "Stock movements listed")
+}
+
+func (inventoryController *InventoryController) RecordMovement(c echo.Context) error {
+	userId, role, authErr := inventoryController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := inventoryController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var addStockMovementRequest request.AddStockMovementRequest
+	if bindErr := c.Bind(&addStockMovementRequest); bindErr != nil {
+		return bindErr
+	}
+
+	movement, serviceErr := inventoryController.inventoryService.RecordMovement(userId, role, productId, addStockMovementRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return inventoryController.Created(c, movement, "Stock movement recorded")
+}
+
+func (inventoryController *InventoryController) TransferStock(c echo.Context) error {
+	userId, role, authErr := inventoryController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := inventoryController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var stockTransferRequest request.StockTransferRequest
+	if bindErr := c.Bind(&stockTransferRequest); bindErr != nil {
+		return bindErr
+	}
+
+	movements, serviceErr := inventoryController.inventoryService.TransferStock(userId, role, productId, stockTransferRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return inventoryController.Created(c, movements, "Stock transferred")
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/domain"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PriceRuleController struct {
+	ruleService service.IPriceRuleService
+	BaseController
+}
+
+func NewPriceRuleController(ruleService service.IPriceRuleService) *PriceRuleController {
+	return &PriceRuleController{ruleService: ruleService}
+}
+
+// RegisterRoutes registers the price rule endpoints; rules and customer groups are changed by admins only.
+func (ruleController *PriceRuleController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.GET("/api/v1/products/:id/price-quote", ruleController.QuoteProduct)
+
+	admin := customMiddleware.RequireRole(domain.UserRoleAdmin)
+	api.GET("/price-rules", ruleController.GetPriceRules)
+	api.POST("/price-rules", ruleController.AddPriceRule, admin)
+	api.DELETE("/price-rules/:id", ruleController.DeletePriceRule, admin)
+	api.PUT("/users/:id/customer-group", ruleController.SetCustomerGroup, admin)
+}
+
+func (ruleController *PriceRuleController) QuoteProduct(c echo.Context) error {
+	productId, parseIdErr := ruleController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var priceQuoteRequest request.PriceQuoteRequest
+	if bindErr := c.Bind(&priceQuoteRequest); bindErr != nil {
+		return bindErr
+	}
+
+	quote, serviceErr := ruleController.ruleService.QuoteProduct(productId, priceQuoteRequest.VariantId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return ruleController.Success(c, quote, "Price quoted")
+}
+
+func (ruleController *PriceRuleController) GetPriceRules(c echo.Context) error {
+	priceRules, serviceErr := ruleController.ruleService.GetPriceRules()
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return ruleController.Success(c, priceRules, "Price rules listed")
+}
+
+func (ruleController *PriceRuleController) AddPriceRule(c echo.Context) error {
+	var addPriceRuleRequest request.AddPriceRuleRequest
+	if bindErr := c.Bind(&addPriceRuleRequest); bindErr != nil {
+		return bindErr
+	}
+
+	rule, serviceErr := ruleController.ruleService.AddPriceRule(addPriceRuleRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return ruleController.Created(c, rule, "Price rule created")
+}
+
+func (ruleController *PriceRuleController) DeletePriceRule(c echo.Context) error {
+	ruleId, parseIdErr := ruleController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	if serviceErr := ruleController.ruleService.DeletePriceRule(ruleId); serviceErr != nil {
+		return serviceErr
+	}
+	return ruleController.Success(c, nil, "Price rule deleted")
+}
+
+func (ruleController *PriceRuleController) SetCustomerGroup(c echo.Context) error {
+	userId, parseIdErr := ruleController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var customerGroupRequest request.CustomerGroupRequest
+	if bindErr := c.Bind(&customerGroupRequest); bindErr != nil {
+		return bindErr
+	}
+
+	if serviceErr := ruleController.ruleService.SetCustomerGroup(userId, customerGroupRequest.ToModel()); serviceErr != nil {
+		return serviceErr
+	}
+	return ruleController.Success(c, nil, "Customer group updated")
+}
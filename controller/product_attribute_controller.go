@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/domain"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProductAttributeController struct {
+	attributeService service.IProductAttributeService
+	BaseController
+}
+
+func NewProductAttributeController(attributeService service.IProductAttributeService) *ProductAttributeController {
+	return &ProductAttributeController{
+		attributeService: attributeService,
+	}
+}
+
+// RegisterRoutes registers the attribute endpoints. Attribute schemas belong to categories shared by every
+// store, so only admins change them.
+func (attributeController *ProductAttributeController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.GET("/api/v1/categories/:id/attributes", attributeController.GetCategoryAttributes)
+	e.GET("/api/v1/categories/:id/facets", attributeController.GetFacets)
+
+	admin := customMiddleware.RequireRole(domain.UserRoleAdmin)
+	api.POST("/categories/:id/attributes", attributeController.AddAttribute, admin)
+	api.PUT("/categories/:id/attributes/:attributeId", attributeController.UpdateAttribute, admin)
+	api.DELETE("/categories/:id/attributes/:attributeId", attributeController.DeleteAttribute, admin)
+}
+
+func (attributeController *ProductAttributeController) GetCategoryAttributes(c echo.Context) error {
+	categoryId, parseIdErr := attributeController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	attributes, serviceErr := attributeController.attributeService.GetCategoryAttributes(categoryId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return attributeController.Success(c, attributes, "Category attributes listed")
+}
+
+func (attributeController *ProductAttributeController) GetFacets(c echo.Context) error {
+	categoryId, parseIdErr := attributeController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	facets, serviceErr := attributeController.attributeService.GetFacets(categoryId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return attributeController.Success(c, facets, "Category facets listed")
+}
+
+func (attributeController *ProductAttributeController) AddAttribute(c echo.Context) error {
+	categoryId, parseIdErr := attributeController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var addCategoryAttributeRequest request.AddCategoryAttributeRequest
+	if bindErr := c.Bind(&addCategoryAttributeRequest); bindErr != nil {
+		return bindErr
+	}
+
+	attribute, serviceErr := attributeController.attributeService.AddAttribute(categoryId, addCategoryAttributeRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return attributeController.Created(c, attribute, "Category attribute added")
+}
+
+func (attributeController *ProductAttributeController) UpdateAttribute(c echo.Context) error {
+	categoryId, parseIdErr := attributeController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	attributeId, parseIdErr := attributeController.ParseIdParam(c, "attributeId")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var updateCategoryAttributeRequest request.UpdateCategoryAttributeRequest
+	if bindErr := c.Bind(&updateCategoryAttributeRequest); bindErr != nil {
+		return bindErr
+	}
+
+	attribute, serviceErr := attributeController.attributeService.UpdateAttribute(categoryId, attributeId, updateCategoryAttributeRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return attributeController.Success(c, attribute, "Category attribute updated")
+}
+
+func (attributeController *ProductAttributeController) DeleteAttribute(c echo.Context) error {
+	categoryId, parseIdErr := attributeController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	attributeId, parseIdErr := attributeController.ParseIdParam(c, "attributeId")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	if serviceErr := attributeController.attributeService.DeleteAttribute(categoryId, attributeId); serviceErr != nil {
+		return serviceErr
+	}
+	return attributeController.Success(c, nil, "Category attribute deleted")
+}
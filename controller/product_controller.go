@@ -2,7 +2,10 @@ package controller
 
 import (
 	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/internal/dto"
 	"go-ecommerce-service/service"
+	"net/url"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 )
@@ -18,18 +21,31 @@ func NewProductController(productService service.IProductService) *ProductContro
 	}
 }
 
-func (productController *ProductController) RegisterRoutes(e *echo.Echo) {
+func (productController *ProductController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
 	e.GET("/api/v1/products", productController.GetAllProducts)
 	e.GET("/api/v1/products/search", productController.SearchProducts)
+	e.GET("/api/v1/products/slug/:slug", productController.GetProductBySlug)
 	e.GET("/api/v1/products/:id", productController.GetProductById)
-	e.POST("/api/v1/products", productController.AddProduct)
-	e.PUT("/api/v1/products/:id", productController.UpdateProduct)
 	e.DELETE("/api/v1/products/:id", productController.DeleteProduct)
 	e.POST("/api/v1/products/sync", productController.SyncElasticsearch)
+
+	api.POST("/products", productController.AddProduct)
+	api.PUT("/products/:id", productController.UpdateProduct)
 }
 
 func (productController *ProductController) GetAllProducts(c echo.Context) error {
-	products := productController.productService.GetAllProducts()
+	var listProductsRequest request.ListProductsRequest
+	if bindErr := c.Bind(&listProductsRequest); bindErr != nil {
+		return bindErr
+	}
+
+	listRequest := listProductsRequest.ToModel()
+	listRequest.Attributes = attributeParams(c)
+	listRequest.Locale = productController.Locale(c)
+	products, serviceErr := productController.productService.ListProducts(listRequest)
+	if serviceErr != nil {
+		return serviceErr
+	}
 	return productController.Success(c, products, "All products listed")
 }
 
@@ -39,7 +55,7 @@ func (productController *ProductController) GetProductById(c echo.Context) error
 		return err
 	}
 
-	product, productByIdErr := productController.productService.GetProductById(productId)
+	product, productByIdErr := productController.productService.GetProductById(productId, productController.Locale(c))
 	if productByIdErr != nil {
 		return productByIdErr
 	}
@@ -47,13 +63,33 @@ func (productController *ProductController) GetProductById(c echo.Context) error
 	return productController.Success(c, product, "Product retrieved")
 }
 
+func (productController *ProductController) GetProductBySlug(c echo.Context) error {
+	product, currentSlug, serviceErr := productController.productService.GetProductBySlug(c.Param("slug"), productController.Locale(c))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if currentSlug != "" {
+		// An explicitly requested locale is kept, otherwise the redirect would resolve the slug of another locale.
+		location := "/api/v1/products/slug/" + currentSlug
+		if requested := c.QueryParam("locale"); requested != "" {
+			location += "?locale=" + url.QueryEscape(requested)
+		}
+		return productController.MovedPermanently(c, location, dto.SlugRedirectResponse{Slug: currentSlug, Location: location}, "Product slug moved")
+	}
+	return productController.Success(c, product, "Product retrieved")
+}
+
 func (productController *ProductController) AddProduct(c echo.Context) error {
+	userId, role, authErr := productController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
 	var addProductRequest request.AddProductRequest
 	if bindErr := c.Bind(&addProductRequest); bindErr != nil {
 		return bindErr
 	}
 
-	addedProduct, serviceErr := productController.productService.AddProduct(addProductRequest.ToModel())
+	addedProduct, serviceErr := productController.productService.AddProduct(userId, role, addProductRequest.ToModel())
 	if serviceErr != nil {
 		return serviceErr
 	}
@@ -62,6 +98,10 @@ func (productController *ProductController) AddProduct(c echo.Context) error {
 }
 
 func (productController *ProductController) UpdateProduct(c echo.Context) error {
+	userId, role, authErr := productController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
 	productId, parseIdErr := productController.ParseIdParam(c, "id")
 	if parseIdErr != nil {
 		return parseIdErr
@@ -71,7 +111,7 @@ func (productController *ProductController) UpdateProduct(c echo.Context) error
 		return bindErr
 	}
 
-	updatedProduct, serviceErr := productController.productService.UpdateProduct(uint(productId), updateProductRequest.ToModel())
+	updatedProduct, serviceErr := productController.productService.UpdateProduct(userId, role, uint(productId), updateProductRequest.ToModel())
 	if serviceErr != nil {
 		return serviceErr
 	}
@@ -98,6 +138,7 @@ func (productController *ProductController) DeleteProduct(c echo.Context) error
 // @Accept       json
 // @Produce      json
 // @Param        q    query     string  true  "Aranacak Kelime (Örn: 'laptop')"
+// @Param        sort query     string  false "Boş (alaka) veya 'rating'"
 // @Success      200  {object}  dto.ProductResponse
 // @Failure      400  {object}  map[string]string
 // @Failure      500  {object}  map[string]string
@@ -105,7 +146,7 @@ func (productController *ProductController) DeleteProduct(c echo.Context) error
 func (productController *ProductController) SearchProducts(c echo.Context) error {
 	query := c.QueryParam("q")
 
-	products, err := productController.productService.SearchProducts(query)
+	products, err := productController.productService.SearchProducts(query, c.QueryParam("sort"), productController.Locale(c))
 	if err != nil {
 		return productController.BadRequest(c, err)
 	}
@@ -120,3 +161,14 @@ func (productController *ProductController) SyncElasticsearch(ctx echo.Context)
 	}
 	return productController.Success(ctx, nil, "All products successfully synced to Elasticsearch! 🚀")
 }
+
+// attributeParams collects the attr.<code> query parameters keyed by what follows the prefix.
+func attributeParams(c echo.Context) map[string]string {
+	params := make(map[string]string)
+	for key, values := range c.QueryParams() {
+		if code, found := strings.CutPrefix(key, "attr."); found && len(values) > 0 {
+			params[code] = strings.Join(values, ",")
+		}
+	}
+	return params
+}
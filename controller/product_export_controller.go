@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/service"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type ProductExportController struct {
+	exportService service.IProductExportService
+	BaseController
+}
+
+func NewProductExportController(exportService service.IProductExportService) *ProductExportController {
+	return &ProductExportController{exportService: exportService}
+}
+
+func (exportController *ProductExportController) RegisterRoutes(e *echo.Echo) {
+	e.GET("/api/v1/products/export", exportController.ExportProducts)
+}
+
+// ExportProducts streams the catalog as an attachment. Once streaming has started the status can no
+// longer change, so a failure part way through is only logged and the download ends early.
+func (exportController *ProductExportController) ExportProducts(c echo.Context) error {
+	var exportProductsRequest request.ExportProductsRequest
+	if bindErr := c.Bind(&exportProductsRequest); bindErr != nil {
+		return bindErr
+	}
+	exportRequest := exportProductsRequest.ToModel()
+
+	file, serviceErr := exportController.exportService.ExportFile(exportRequest)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, file.ContentType)
+	c.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=\""+file.FileName+"\"")
+	c.Response().WriteHeader(http.StatusOK)
+	if exportErr := exportController.exportService.WriteExport(exportRequest, c.Response()); exportErr != nil {
+		log.Error().Err(exportErr).Str("format", exportRequest.Format).Msg("Product export stopped")
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"fmt"
+	"go-ecommerce-service/controller/request"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/service"
+	"io"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProductImageController struct {
+	imageService service.IProductImageService
+	maxFileSize  int64
+	BaseController
+}
+
+func NewProductImageController(imageService service.IProductImageService, maxFileSizeMB int) *ProductImageController {
+	return &ProductImageController{
+		imageService: imageService,
+		maxFileSize:  int64(maxFileSizeMB) << 20,
+	}
+}
+
+func (imageController *ProductImageController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.GET("/api/v1/products/:id/images", imageController.GetImages)
+
+	api.POST("/products/:id/images", imageController.UploadImage)
+	api.PUT("/products/:id/images/order", imageController.ReorderImages)
+	api.PUT("/products/:id/images/:imageId", imageController.UpdateImage)
+	api.DELETE("/products/:id/images/:imageId", imageController.DeleteImage)
+}
+
+func (imageController *ProductImageController) GetImages(c echo.Context) error {
+	productId, parseIdErr := imageController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	images, serviceErr := imageController.imageService.GetImages(productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return imageController.Success(c, images, "Product images listed")
+}
+
+// UploadImage accepts a multipart upload with the image in the file field and an optional alt_text field.
+func (imageController *ProductImageController) UploadImage(c echo.Context) error {
+	userId, role, authErr := imageController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := imageController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var uploadProductImageRequest request.UploadProductImageRequest
+	if bindErr := c.Bind(&uploadProductImageRequest); bindErr != nil {
+		return bindErr
+	}
+
+	fileHeader, fileErr := c.FormFile("file")
+	if fileErr != nil {
+		return _errors.NewBadRequest("Image file is required")
+	}
+	if fileHeader.Size > imageController.maxFileSize {
+		return _errors.NewBadRequest(fmt.Sprintf("Image file cannot be larger than %d MB", imageController.maxFileSize>>20))
+	}
+	file, openErr := fileHeader.Open()
+	if openErr != nil {
+		return openErr
+	}
+	defer file.Close()
+	content, readErr := io.ReadAll(file)
+	if readErr != nil {
+		return readErr
+	}
+
+	upload := uploadProductImageRequest.ToModel()
+	upload.FileName = fileHeader.Filename
+	upload.Content = content
+	image, serviceErr := imageController.imageService.UploadImage(userId, role, productId, upload)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return imageController.Created(c, image, "Product image uploaded")
+}
+
+func (imageController *ProductImageController) UpdateImage(c echo.Context) error {
+	userId, role, authErr := imageController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := imageController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	imageId, parseIdErr := imageController.ParseIdParam(c, "imageId")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var updateProductImageRequest request.UpdateProductImageRequest
+	if bindErr := c.Bind(&updateProductImageRequest); bindErr != nil {
+		return bindErr
+	}
+
+	image, serviceErr := imageController.imageService.UpdateImage(userId, role, productId, imageId, updateProductImageRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return imageController.Success(c, image, "Product image updated")
+}
+
+func (imageController *ProductImageController) ReorderImages(c echo.Context) error {
+	userId, role, authErr := imageController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := imageController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var reorderProductImagesRequest request.ReorderProductImagesRequest
+	if bindErr := c.Bind(&reorderProductImagesRequest); bindErr != nil {
+		return bindErr
+	}
+
+	images, serviceErr := imageController.imageService.ReorderImages(userId, role, productId, reorderProductImagesRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return imageController.Success(c, images, "Product images reordered")
+}
+
+func (imageController *ProductImageController) DeleteImage(c echo.Context) error {
+	userId, role, authErr := imageController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := imageController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	imageId, parseIdErr := imageController.ParseIdParam(c, "imageId")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	if serviceErr := imageController.imageService.DeleteImage(userId, role, productId, imageId); serviceErr != nil {
+		return serviceErr
+	}
+	return imageController.Success(c, nil, "Product image deleted")
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-ecommerce-service/controller/request"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/service"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProductImportController struct {
+	importService service.IProductImportService
+	maxFileSize   int64
+	BaseController
+}
+
+func NewProductImportController(importService service.IProductImportService, maxFileSizeMB int) *ProductImportController {
+	return &ProductImportController{
+		importService: importService,
+		maxFileSize:   int64(maxFileSizeMB) << 20,
+	}
+}
+
+// RegisterRoutes registers the import endpoints. The service limits imports to the stores the user owns, unless
+// the user is an admin, and a job to the user who started it.
+func (importController *ProductImportController) RegisterRoutes(api *echo.Group) {
+	api.POST("/products/imports", importController.StartImport)
+	api.GET("/products/imports/:id", importController.GetImportJob)
+	api.GET("/products/imports/:id/report", importController.GetImportReport)
+}
+
+// StartImport accepts a multipart upload with the file and its options; the mapping field is a JSON
+// object from product field to column name.
+func (importController *ProductImportController) StartImport(c echo.Context) error {
+	userId, role, authErr := importController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	var importProductsRequest request.ImportProductsRequest
+	if bindErr := c.Bind(&importProductsRequest); bindErr != nil {
+		return bindErr
+	}
+	options := importProductsRequest.ToModel()
+	if importProductsRequest.Mapping != "" {
+		if err := json.Unmarshal([]byte(importProductsRequest.Mapping), &options.Mapping); err != nil {
+			return _errors.NewBadRequest("Mapping must be a JSON object of field to column")
+		}
+	}
+
+	fileHeader, fileErr := c.FormFile("file")
+	if fileErr != nil {
+		return _errors.NewBadRequest("Import file is required")
+	}
+	if fileHeader.Size > importController.maxFileSize {
+		return _errors.NewBadRequest(fmt.Sprintf("Import file cannot be larger than %d MB", importController.maxFileSize>>20))
+	}
+	file, openErr := fileHeader.Open()
+	if openErr != nil {
+		return openErr
+	}
+	defer file.Close()
+	content, readErr := io.ReadAll(file)
+	if readErr != nil {
+		return readErr
+	}
+
+	job, serviceErr := importController.importService.StartImport(userId, role, fileHeader.Filename, content, options)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return importController.Accepted(c, job, "Product import started")
+}
+
+func (importController *ProductImportController) GetImportJob(c echo.Context) error {
+	userId, role, authErr := importController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	jobId, parseIdErr := importController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	job, serviceErr := importController.importService.GetImportJob(userId, role, jobId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return importController.Success(c, job, "Product import retrieved")
+}
+
+func (importController *ProductImportController) GetImportReport(c echo.Context) error {
+	userId, role, authErr := importController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	jobId, parseIdErr := importController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	if _, serviceErr := importController.importService.GetImportJob(userId, role, jobId); serviceErr != nil {
+		return serviceErr
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv")
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"import-%d-report.csv\"", jobId))
+	c.Response().WriteHeader(http.StatusOK)
+	return importController.importService.WriteImportReport(userId, role, jobId, c.Response())
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProductPriceController struct {
+	priceService service.IProductPriceService
+	BaseController
+}
+
+func NewProductPriceController(priceService service.IProductPriceService) *ProductPriceController {
+	return &ProductPriceController{priceService: priceService}
+}
+
+func (priceController *ProductPriceController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.GET("/api/v1/products/:id/price-history", priceController.GetPriceHistory)
+	e.GET("/api/v1/products/:id/lowest-price", priceController.GetLowestPrice)
+
+	api.GET("/products/:id/price-schedules", priceController.GetSchedules)
+	api.POST("/products/:id/price-schedules", priceController.AddSchedule)
+	api.DELETE("/price-schedules/:id", priceController.CancelSchedule)
+}
+
+func (priceController *ProductPriceController) GetPriceHistory(c echo.Context) error {
+	productId, parseIdErr := priceController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var priceHistoryRequest request.PriceHistoryRequest
+	if bindErr := c.Bind(&priceHistoryRequest); bindErr != nil {
+		return bindErr
+	}
+
+	history, serviceErr := priceController.priceService.GetPriceHistory(productId, priceHistoryRequest.Limit)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return priceController.Success(c, history, "Price history listed")
+}
+
+func (priceController *ProductPriceController) GetLowestPrice(c echo.Context) error {
+	productId, parseIdErr := priceController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	lowestPrice, serviceErr := priceController.priceService.GetLowestPrice(productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return priceController.Success(c, lowestPrice, "Lowest price retrieved")
+}
+
+func (priceController *ProductPriceController) GetSchedules(c echo.Context) error {
+	userId, role, authErr := priceController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := priceController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	schedules, serviceErr := priceController.priceService.GetSchedules(userId, role, productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return priceController.Success(c, schedules, "Price schedules listed")
+}
+
+func (priceController *ProductPriceController) AddSchedule(c echo.Context) error {
+	userId, role, authErr := priceController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := priceController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var priceScheduleRequest request.AddPriceScheduleRequest
+	if bindErr := c.Bind(&priceScheduleRequest); bindErr != nil {
+		return bindErr
+	}
+
+	schedule, serviceErr := priceController.priceService.AddSchedule(userId, role, productId, priceScheduleRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return priceController.Created(c, schedule, "Price change scheduled")
+}
+
+func (priceController *ProductPriceController) CancelSchedule(c echo.Context) error {
+	userId, role, authErr := priceController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	scheduleId, parseIdErr := priceController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	schedule, serviceErr := priceController.priceService.CancelSchedule(userId, role, scheduleId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return priceController.Success(c, schedule, "Price schedule cancelled")
+}
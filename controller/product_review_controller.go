@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/domain"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProductReviewController struct {
+	reviewService service.IProductReviewService
+	BaseController
+}
+
+func NewProductReviewController(reviewService service.IProductReviewService) *ProductReviewController {
+	return &ProductReviewController{reviewService: reviewService}
+}
+
+// RegisterRoutes registers the review endpoints. Moderation is left to reviewers.
+func (reviewController *ProductReviewController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.GET("/api/v1/products/:id/reviews", reviewController.GetProductReviews)
+
+	reviewer := customMiddleware.RequireRole(domain.ReviewerRoles...)
+	api.POST("/products/:id/reviews", reviewController.AddReview)
+	api.GET("/reviews/moderation", reviewController.GetModerationQueue, reviewer)
+	api.PUT("/reviews/:id/moderation", reviewController.ModerateReview, reviewer)
+	api.POST("/reviews/:id/votes", reviewController.VoteReview)
+}
+
+func (reviewController *ProductReviewController) GetProductReviews(c echo.Context) error {
+	productId, parseIdErr := reviewController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var listReviewsRequest request.ListReviewsRequest
+	if bindErr := c.Bind(&listReviewsRequest); bindErr != nil {
+		return bindErr
+	}
+
+	reviews, serviceErr := reviewController.reviewService.GetProductReviews(productId, listReviewsRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return reviewController.Success(c, reviews, "Product reviews listed")
+}
+
+func (reviewController *ProductReviewController) AddReview(c echo.Context) error {
+	userId, authErr := reviewController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := reviewController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var addReviewRequest request.AddReviewRequest
+	if bindErr := c.Bind(&addReviewRequest); bindErr != nil {
+		return bindErr
+	}
+
+	review, serviceErr := reviewController.reviewService.AddReview(userId, productId, addReviewRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return reviewController.Created(c, review, "Review submitted for moderation")
+}
+
+func (reviewController *ProductReviewController) GetModerationQueue(c echo.Context) error {
+	reviews, serviceErr := reviewController.reviewService.GetModerationQueue(reviewController.StringQueryParam(c, "status"))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return reviewController.Success(c, reviews, "Moderation queue listed")
+}
+
+func (reviewController *ProductReviewController) ModerateReview(c echo.Context) error {
+	reviewId, parseIdErr := reviewController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var moderateReviewRequest request.ModerateReviewRequest
+	if bindErr := c.Bind(&moderateReviewRequest); bindErr != nil {
+		return bindErr
+	}
+
+	review, serviceErr := reviewController.reviewService.ModerateReview(reviewId, moderateReviewRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return reviewController.Success(c, review, "Review moderated")
+}
+
+func (reviewController *ProductReviewController) VoteReview(c echo.Context) error {
+	userId, authErr := reviewController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	reviewId, parseIdErr := reviewController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var reviewVoteRequest request.ReviewVoteRequest
+	if bindErr := c.Bind(&reviewVoteRequest); bindErr != nil {
+		return bindErr
+	}
+
+	review, serviceErr := reviewController.reviewService.VoteReview(userId, reviewId, reviewVoteRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return reviewController.Success(c, review, "Review vote recorded")
+}
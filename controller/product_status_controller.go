@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/domain"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProductStatusController struct {
+	statusService service.IProductStatusService
+	BaseController
+}
+
+func NewProductStatusController(statusService service.IProductStatusService) *ProductStatusController {
+	return &ProductStatusController{statusService: statusService}
+}
+
+// RegisterRoutes registers the lifecycle endpoints. Reviewing and publishing are left to reviewers; the service
+// lets the owners of the product's store submit, unpublish and schedule it as well.
+func (statusController *ProductStatusController) RegisterRoutes(api *echo.Group) {
+	reviewer := customMiddleware.RequireRole(domain.ReviewerRoles...)
+	api.GET("/products/review-queue", statusController.GetReviewQueue, reviewer)
+	api.GET("/products/:id/status-history", statusController.GetStatusHistory)
+	api.POST("/products/:id/submit", statusController.Submit)
+	api.POST("/products/:id/approve", statusController.Approve, reviewer)
+	api.POST("/products/:id/reject", statusController.Reject, reviewer)
+	api.POST("/products/:id/publish", statusController.Publish, reviewer)
+	api.POST("/products/:id/unpublish", statusController.Unpublish)
+	api.PUT("/products/:id/schedule", statusController.SetSchedule)
+}
+
+func (statusController *ProductStatusController) GetReviewQueue(c echo.Context) error {
+	products, serviceErr := statusController.statusService.GetReviewQueue()
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return statusController.Success(c, products, "Review queue listed")
+}
+
+func (statusController *ProductStatusController) GetStatusHistory(c echo.Context) error {
+	userId, role, authErr := statusController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := statusController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	history, serviceErr := statusController.statusService.GetStatusHistory(userId, role, productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return statusController.Success(c, history, "Status history listed")
+}
+
+func (statusController *ProductStatusController) Submit(c echo.Context) error {
+	userId, role, authErr := statusController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := statusController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	product, serviceErr := statusController.statusService.Submit(userId, role, productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return statusController.Success(c, product, "Product submitted for review")
+}
+
+func (statusController *ProductStatusController) Approve(c echo.Context) error {
+	userId, authErr := statusController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := statusController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var reviewProductRequest request.ReviewProductRequest
+	if bindErr := c.Bind(&reviewProductRequest); bindErr != nil {
+		return bindErr
+	}
+
+	product, serviceErr := statusController.statusService.Approve(userId, productId, reviewProductRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return statusController.Success(c, product, "Product approved")
+}
+
+func (statusController *ProductStatusController) Reject(c echo.Context) error {
+	userId, authErr := statusController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := statusController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var reviewProductRequest request.ReviewProductRequest
+	if bindErr := c.Bind(&reviewProductRequest); bindErr != nil {
+		return bindErr
+	}
+
+	product, serviceErr := statusController.statusService.Reject(userId, productId, reviewProductRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return statusController.Success(c, product, "Product rejected")
+}
+
+func (statusController *ProductStatusController) Publish(c echo.Context) error {
+	userId, authErr := statusController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := statusController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	product, serviceErr := statusController.statusService.Publish(userId, productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return statusController.Success(c, product, "Product published")
+}
+
+func (statusController *ProductStatusController) Unpublish(c echo.Context) error {
+	userId, role, authErr := statusController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := statusController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	product, serviceErr := statusController.statusService.Unpublish(userId, role, productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return statusController.Success(c, product, "Product unpublished")
+}
+
+func (statusController *ProductStatusController) SetSchedule(c echo.Context) error {
+	userId, role, authErr := statusController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := statusController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var productScheduleRequest request.ProductScheduleRequest
+	if bindErr := c.Bind(&productScheduleRequest); bindErr != nil {
+		return bindErr
+	}
+
+	product, serviceErr := statusController.statusService.SetSchedule(userId, role, productId, productScheduleRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return statusController.Success(c, product, "Publish schedule saved")
+}
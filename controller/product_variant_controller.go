@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/domain"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProductVariantController struct {
+	variantService service.IProductVariantService
+	BaseController
+}
+
+func NewProductVariantController(variantService service.IProductVariantService) *ProductVariantController {
+	return &ProductVariantController{variantService: variantService}
+}
+
+// RegisterRoutes registers the variant endpoints. Option types are shared by every store, so only admins add
+// them; the service lets the owners of a product's store manage its variants.
+func (variantController *ProductVariantController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.GET("/api/v1/option-types", variantController.GetOptionTypes)
+	e.GET("/api/v1/products/:id/variants", variantController.GetVariantsByProductId)
+	e.GET("/api/v1/variants/sku/:sku", variantController.GetVariantBySku)
+
+	admin := customMiddleware.RequireRole(domain.UserRoleAdmin)
+	api.POST("/option-types", variantController.AddOptionType, admin)
+	api.POST("/option-types/:id/values", variantController.AddOptionValue, admin)
+	api.POST("/products/:id/variants", variantController.AddVariant)
+	api.PUT("/products/:id/variants/:variant_id", variantController.UpdateVariant)
+	api.DELETE("/products/:id/variants/:variant_id", variantController.DeleteVariant)
+}
+
+func (variantController *ProductVariantController) GetOptionTypes(c echo.Context) error {
+	optionTypes, serviceErr := variantController.variantService.GetOptionTypes()
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return variantController.Success(c, optionTypes, "Option types listed")
+}
+
+func (variantController *ProductVariantController) AddOptionType(c echo.Context) error {
+	var addOptionTypeRequest request.AddOptionTypeRequest
+	if bindErr := c.Bind(&addOptionTypeRequest); bindErr != nil {
+		return bindErr
+	}
+
+	optionType, serviceErr := variantController.variantService.AddOptionType(addOptionTypeRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return variantController.Created(c, optionType, "Option type added")
+}
+
+func (variantController *ProductVariantController) AddOptionValue(c echo.Context) error {
+	optionTypeId, parseIdErr := variantController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var addOptionValueRequest request.AddOptionValueRequest
+	if bindErr := c.Bind(&addOptionValueRequest); bindErr != nil {
+		return bindErr
+	}
+
+	optionValue, serviceErr := variantController.variantService.AddOptionValue(optionTypeId, addOptionValueRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return variantController.Created(c, optionValue, "Option value added")
+}
+
+func (variantController *ProductVariantController) GetVariantsByProductId(c echo.Context) error {
+	productId, parseIdErr := variantController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	variants, serviceErr := variantController.variantService.GetVariantsByProductId(productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return variantController.Success(c, variants, "Product variants listed")
+}
+
+func (variantController *ProductVariantController) GetVariantBySku(c echo.Context) error {
+	variant, serviceErr := variantController.variantService.GetVariantBySku(c.Param("sku"))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return variantController.Success(c, variant, "Product variant retrieved")
+}
+
+func (variantController *ProductVariantController) AddVariant(c echo.Context) error {
+	userId, role, authErr := variantController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := variantController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var addVariantRequest request.AddProductVariantRequest
+	if bindErr := c.Bind(&addVariantRequest); bindErr != nil {
+		return bindErr
+	}
+
+	variant, serviceErr := variantController.variantService.AddVariant(userId, role, productId, addVariantRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return variantController.Created(c, variant, "Product variant added")
+}
+
+func (variantController *ProductVariantController) UpdateVariant(c echo.Context) error {
+	userId, role, authErr := variantController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := variantController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	variantId, parseIdErr := variantController.ParseIdParam(c, "variant_id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var updateVariantRequest request.AddProductVariantRequest
+	if bindErr := c.Bind(&updateVariantRequest); bindErr != nil {
+		return bindErr
+	}
+
+	variant, serviceErr := variantController.variantService.UpdateVariant(userId, role, productId, variantId, updateVariantRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return variantController.Success(c, variant, "Product variant updated")
+}
+
+func (variantController *ProductVariantController) DeleteVariant(c echo.Context) error {
+	userId, role, authErr := variantController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := variantController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	variantId, parseIdErr := variantController.ParseIdParam(c, "variant_id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	if serviceErr := variantController.variantService.DeleteVariant(userId, role, productId, variantId); serviceErr != nil {
+		return serviceErr
+	}
+	return variantController.Success(c, nil, "Product variant deleted")
+}
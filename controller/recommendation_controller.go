@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RecommendationController struct {
+	recommendationService service.IRecommendationService
+	BaseController
+}
+
+func NewRecommendationController(recommendationService service.IRecommendationService) *RecommendationController {
+	return &RecommendationController{recommendationService: recommendationService}
+}
+
+func (recommendationController *RecommendationController) RegisterRoutes(e *echo.Echo) {
+	e.GET("/api/v1/products/:id/related", recommendationController.GetRelatedProducts)
+	e.GET("/api/v1/carts/:id/recommendations", recommendationController.GetCartRecommendations)
+}
+
+func (recommendationController *RecommendationController) GetRelatedProducts(c echo.Context) error {
+	productId, parseIdErr := recommendationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var recommendationRequest request.RecommendationRequest
+	if bindErr := c.Bind(&recommendationRequest); bindErr != nil {
+		return bindErr
+	}
+
+	related, serviceErr := recommendationController.recommendationService.GetRelatedProducts(productId, recommendationRequest.Limit)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return recommendationController.Success(c, related, "Related products listed")
+}
+
+// GetCartRecommendations returns the "you may also like" products of a cart.
+func (recommendationController *RecommendationController) GetCartRecommendations(c echo.Context) error {
+	cartId, parseIdErr := recommendationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var recommendationRequest request.RecommendationRequest
+	if bindErr := c.Bind(&recommendationRequest); bindErr != nil {
+		return bindErr
+	}
+
+	recommendations, serviceErr := recommendationController.recommendationService.GetCartRecommendations(cartId, recommendationRequest.Limit)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return recommendationController.Success(c, recommendations, "Recommendations listed")
+}
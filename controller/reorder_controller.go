@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ReorderController struct {
+	reorderService service.IReorderService
+	BaseController
+}
+
+func NewReorderController(reorderService service.IReorderService) *ReorderController {
+	return &ReorderController{reorderService: reorderService}
+}
+
+func (reorderController *ReorderController) RegisterRoutes(api *echo.Group) {
+	api.POST("/orders/:id/reorder", reorderController.Reorder)
+}
+
+func (reorderController *ReorderController) Reorder(c echo.Context) error {
+	userId, authErr := reorderController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	orderId, parseIdErr := reorderController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	report, serviceErr := reorderController.reorderService.Reorder(userId, orderId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return reorderController.Success(c, report, "Order lines added to cart")
+}
@@ -6,33 +6,38 @@ import (
 )
 
 type AddProductRequest struct {
-	Name            string  `json:"name"`
-	Description     string  `json:"description"`
-	Price           float64 `json:"price"`
-	BasePrice       float64 `json:"basePrice"`
-	Discount        float64 `json:"discount"`
-	ImageUrl        string  `json:"imageUrl"`
-	MetaDescription string  `json:"metaDescription"`
-	StockQuantity   int     `json:"stockQuantity"`
-	IsActive        bool    `json:"isActive"`
-	IsFeatured      bool    `json:"isFeatured"`
-	CategoryId      *uint   `json:"categoryId"`
-	StoreId         uint    `json:"storeId"`
+	Name            string                 `json:"name"`
+	Slug            string                 `json:"slug"`
+	Sku             *string                `json:"sku"`
+	Description     string                 `json:"description"`
+	Price           float64                `json:"price"`
+	BasePrice       float64                `json:"basePrice"`
+	Discount        float64                `json:"discount"`
+	ImageUrl        string                 `json:"imageUrl"`
+	MetaDescription string                 `json:"metaDescription"`
+	StockQuantity   int                    `json:"stockQuantity"`
+	IsFeatured      bool                   `json:"isFeatured"`
+	CategoryId      *uint                  `json:"categoryId"`
+	StoreId         uint                   `json:"storeId"`
+	Attributes      map[string]interface{} `json:"attributes"`
+	ProductType     string                 `json:"productType"`
 }
 
 type UpdateProductRequest struct {
-	Name            string  `json:"name"`
-	Description     string  `json:"description"`
-	Price           float64 `json:"price"`
-	BasePrice       float64 `json:"basePrice"`
-	Discount        float64 `json:"discount"`
-	ImageUrl        string  `json:"imageUrl"`
-	MetaDescription string  `json:"metaDescription"`
-	StockQuantity   int     `json:"stockQuantity"`
-	IsActive        bool    `json:"isActive"`
-	IsFeatured      bool    `json:"isFeatured"`
-	CategoryId      *uint   `json:"categoryId"`
-	StoreId         uint    `json:"storeId"`
+	Name            string                 `json:"name"`
+	Slug            string                 `json:"slug"`
+	Sku             *string                `json:"sku"`
+	Description     string                 `json:"description"`
+	Price           float64                `json:"price"`
+	BasePrice       float64                `json:"basePrice"`
+	Discount        float64                `json:"discount"`
+	ImageUrl        string                 `json:"imageUrl"`
+	MetaDescription string                 `json:"metaDescription"`
+	IsFeatured      bool                   `json:"isFeatured"`
+	CategoryId      *uint                  `json:"categoryId"`
+	StoreId         uint                   `json:"storeId"`
+	Attributes      map[string]interface{} `json:"attributes"`
+	ProductType     string                 `json:"productType"`
 }
 
 type RegisterRequest struct {
@@ -52,10 +57,15 @@ type AddCartRequest struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type RecoverCartRequest struct {
+	Token string `json:"token"`
+}
+
 type AddCartItemRequest struct {
-	CartId    int64 `json:"cart_id"`
-	ProductId int64 `json:"product_id"`
-	Quantity  int   `json:"quantity"`
+	CartId    int64  `json:"cart_id"`
+	ProductId int64  `json:"product_id"`
+	VariantId *int64 `json:"variant_id"`
+	Quantity  int    `json:"quantity"`
 }
 
 type AddOrderRequest struct {
@@ -66,23 +76,30 @@ type AddOrderRequest struct {
 }
 
 type AddOrderItemRequest struct {
-	OrderId   int64   `json:"order_id"`
-	ProductId int64   `json:"product_id"`
-	Quantity  int     `json:"quantity"`
-	Price     float32 `json:"price"`
+	OrderId   int64  `json:"order_id"`
+	ProductId int64  `json:"product_id"`
+	VariantId *int64 `json:"variant_id"`
+	Quantity  int    `json:"quantity"`
 }
 
 type UpdateOrderItemRequest struct {
-	OrderId   int64   `json:"order_id"`
-	ProductId int64   `json:"product_id"`
-	Quantity  int     `json:"quantity"`
-	Price     float32 `json:"price"`
+	OrderId   int64  `json:"order_id"`
+	ProductId int64  `json:"product_id"`
+	VariantId *int64 `json:"variant_id"`
+	Quantity  int    `json:"quantity"`
 }
 
 type AddCategoryRequest struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 	IsActive    bool   `json:"is_active"`
+	ParentId    *uint  `json:"parent_id"`
+	SortOrder   int    `json:"sort_order"`
+}
+
+type MoveCategoryRequest struct {
+	ParentId  *uint `json:"parent_id"`
+	SortOrder int   `json:"sort_order"`
 }
 
 type AddStoreRequest struct {
@@ -98,9 +115,270 @@ type AddStoreRequest struct {
 	UpdatedAt      time.Time `json:"updated_at"`
 }
 
+type AddWishlistRequest struct {
+	Name     string `json:"name"`
+	IsPublic bool   `json:"is_public"`
+}
+
+type AddWishlistItemRequest struct {
+	ProductId int64  `json:"product_id"`
+	VariantId *int64 `json:"variant_id"`
+}
+
+type ListProductsRequest struct {
+	StoreId    *uint    `query:"store_id"`
+	CategoryId *uint    `query:"category_id"`
+	IsFeatured *bool    `query:"featured"`
+	MinPrice   *float64 `query:"min_price"`
+	MaxPrice   *float64 `query:"max_price"`
+	InStock    bool     `query:"in_stock"`
+	Sort       string   `query:"sort"`
+	Cursor     string   `query:"cursor"`
+	Limit      int      `query:"limit"`
+}
+
+type AddOptionTypeRequest struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+type AddOptionValueRequest struct {
+	Value     string `json:"value"`
+	SortOrder int    `json:"sort_order"`
+}
+
+type AddProductVariantRequest struct {
+	Sku            string   `json:"sku"`
+	Price          *float64 `json:"price"`
+	StockQuantity  int      `json:"stock_quantity"`
+	Barcode        *string  `json:"barcode"`
+	ImageUrl       *string  `json:"image_url"`
+	IsActive       bool     `json:"is_active"`
+	OptionValueIds []int64  `json:"option_value_ids"`
+}
+
+type ImportProductsRequest struct {
+	Format  string `form:"format"`
+	MatchBy string `form:"match_by"`
+	StoreId *uint  `form:"store_id"`
+	Mapping string `form:"mapping"`
+}
+
+type ExportProductsRequest struct {
+	Format     string `query:"format"`
+	StoreId    *uint  `query:"store_id"`
+	CategoryId *uint  `query:"category_id"`
+	IsActive   *bool  `query:"active"`
+}
+
+type ListReviewsRequest struct {
+	Sort   string `query:"sort"`
+	Limit  int    `query:"limit"`
+	Offset int    `query:"offset"`
+}
+
+type AddReviewRequest struct {
+	Rating    int      `json:"rating"`
+	Title     string   `json:"title"`
+	Body      string   `json:"body"`
+	PhotoUrls []string `json:"photo_urls"`
+}
+
+type ModerateReviewRequest struct {
+	Status string  `json:"status"`
+	Note   *string `json:"note"`
+}
+
+type ReviewVoteRequest struct {
+	Helpful bool `json:"helpful"`
+}
+
+type PriceHistoryRequest struct {
+	Limit int `query:"limit"`
+}
+
+type AddPriceScheduleRequest struct {
+	Price     float64    `json:"price"`
+	BasePrice float64    `json:"base_price"`
+	Discount  float64    `json:"discount"`
+	StartsAt  time.Time  `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at"`
+}
+
+type RecommendationRequest struct {
+	Limit int `query:"limit"`
+}
+
+type PriceQuoteRequest struct {
+	VariantId *int64 `query:"variant_id"`
+}
+
+type AddPriceRuleRequest struct {
+	Name          string     `json:"name"`
+	Kind          string     `json:"kind"`
+	CustomerGroup *string    `json:"customer_group"`
+	ProductId     *int64     `json:"product_id"`
+	StoreId       *uint      `json:"store_id"`
+	CategoryId    *uint      `json:"category_id"`
+	PercentOff    float64    `json:"percent_off"`
+	StartsAt      *time.Time `json:"starts_at"`
+	EndsAt        *time.Time `json:"ends_at"`
+	IsActive      *bool      `json:"is_active"`
+}
+
+type CustomerGroupRequest struct {
+	CustomerGroup string `json:"customer_group"`
+}
+
+type AddWarehouseRequest struct {
+	Name      string  `json:"name"`
+	Code      string  `json:"code"`
+	Address   *string `json:"address"`
+	IsDefault bool    `json:"is_default"`
+	IsActive  *bool   `json:"is_active"`
+}
+
+type ListStockMovementsRequest struct {
+	WarehouseId *int64 `query:"warehouse_id"`
+	Before      *int64 `query:"before"`
+	Limit       int    `query:"limit"`
+}
+
+type AddStockMovementRequest struct {
+	WarehouseId int64   `json:"warehouse_id"`
+	VariantId   *int64  `json:"variant_id"`
+	Type        string  `json:"type"`
+	Quantity    int     `json:"quantity"`
+	Reason      *string `json:"reason"`
+	Reference   *string `json:"reference"`
+}
+
+type StockTransferRequest struct {
+	FromWarehouseId int64   `json:"from_warehouse_id"`
+	ToWarehouseId   int64   `json:"to_warehouse_id"`
+	VariantId       *int64  `json:"variant_id"`
+	Quantity        int     `json:"quantity"`
+	Reason          *string `json:"reason"`
+}
+
+type SetStockThresholdRequest struct {
+	VariantId    *int64 `json:"variant_id"`
+	Threshold    int    `json:"threshold"`
+	LeadTimeDays *int   `json:"lead_time_days"`
+}
+
+type StockThresholdQuery struct {
+	VariantId *int64 `query:"variant_id"`
+}
+
+type LowStockRequest struct {
+	StoreId *uint `query:"store_id"`
+}
+
+type ReorderReportRequest struct {
+	StoreId   *uint `query:"store_id"`
+	Days      int   `query:"days"`
+	CoverDays int   `query:"cover_days"`
+}
+
+type StockSubscriptionRequest struct {
+	ProductId int64  `json:"product_id"`
+	VariantId *int64 `json:"variant_id"`
+	Email     string `json:"email"`
+}
+
+type UnsubscribeStockRequest struct {
+	Token string `json:"token"`
+}
+
+type UploadProductImageRequest struct {
+	AltText string `form:"alt_text"`
+}
+
+type UpdateProductImageRequest struct {
+	AltText string `json:"alt_text"`
+}
+
+type ReorderProductImagesRequest struct {
+	ImageIds []int64 `json:"image_ids"`
+}
+
+type AddCategoryAttributeRequest struct {
+	Code         string   `json:"code"`
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Unit         string   `json:"unit"`
+	Options      []string `json:"options"`
+	IsRequired   bool     `json:"is_required"`
+	IsFilterable bool     `json:"is_filterable"`
+	Position     int      `json:"position"`
+}
+
+type UpdateCategoryAttributeRequest struct {
+	Name         string   `json:"name"`
+	Unit         string   `json:"unit"`
+	Options      []string `json:"options"`
+	IsRequired   bool     `json:"is_required"`
+	IsFilterable bool     `json:"is_filterable"`
+	Position     int      `json:"position"`
+}
+
+type ReviewProductRequest struct {
+	Note string `json:"note"`
+}
+
+type ProductScheduleRequest struct {
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+}
+
+type SaveProductTranslationRequest struct {
+	Name            string `json:"name"`
+	Slug            string `json:"slug"`
+	Description     string `json:"description"`
+	MetaDescription string `json:"meta_description"`
+}
+
+type SaveCategoryTranslationRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type SaveStoreTranslationRequest struct {
+	Description string `json:"description"`
+}
+
+type SaveBundleRequest struct {
+	PricingMode     string                   `json:"pricing_mode"`
+	FixedPrice      *float64                 `json:"fixed_price"`
+	DiscountPercent *float64                 `json:"discount_percent"`
+	Components      []BundleComponentRequest `json:"components"`
+}
+
+type BundleComponentRequest struct {
+	ProductId int64  `json:"product_id"`
+	VariantId *int64 `json:"variant_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+type AddLicenseKeysRequest struct {
+	Keys []string `json:"keys"`
+}
+
+type MoveCartItemToWishlistRequest struct {
+	CartItemId int64 `json:"cart_item_id"`
+}
+
+type MoveWishlistItemToCartRequest struct {
+	CartId   int64 `json:"cart_id"`
+	Quantity int   `json:"quantity"`
+}
+
 func (addProductRequest AddProductRequest) ToModel() dto.CreateProductRequest {
 	return dto.CreateProductRequest{
 		Name:            addProductRequest.Name,
+		Slug:            addProductRequest.Slug,
+		Sku:             addProductRequest.Sku,
 		Description:     addProductRequest.Description,
 		Price:           addProductRequest.Price,
 		BasePrice:       addProductRequest.BasePrice,
@@ -108,27 +386,30 @@ func (addProductRequest AddProductRequest) ToModel() dto.CreateProductRequest {
 		ImageUrl:        addProductRequest.ImageUrl,
 		MetaDescription: addProductRequest.MetaDescription,
 		StockQuantity:   addProductRequest.StockQuantity,
-		IsActive:        addProductRequest.IsActive,
 		IsFeatured:      addProductRequest.IsFeatured,
 		CategoryId:      addProductRequest.CategoryId,
 		StoreId:         addProductRequest.StoreId,
+		Attributes:      addProductRequest.Attributes,
+		ProductType:     addProductRequest.ProductType,
 	}
 }
 
 func (updateProductRequest UpdateProductRequest) ToModel() dto.CreateProductRequest {
 	return dto.CreateProductRequest{
 		Name:            updateProductRequest.Name,
+		Slug:            updateProductRequest.Slug,
+		Sku:             updateProductRequest.Sku,
 		Description:     updateProductRequest.Description,
 		Price:           updateProductRequest.Price,
 		BasePrice:       updateProductRequest.BasePrice,
 		Discount:        updateProductRequest.Discount,
 		ImageUrl:        updateProductRequest.ImageUrl,
 		MetaDescription: updateProductRequest.MetaDescription,
-		StockQuantity:   updateProductRequest.StockQuantity,
-		IsActive:        updateProductRequest.IsActive,
 		IsFeatured:      updateProductRequest.IsFeatured,
 		CategoryId:      updateProductRequest.CategoryId,
 		StoreId:         updateProductRequest.StoreId,
+		Attributes:      updateProductRequest.Attributes,
+		ProductType:     updateProductRequest.ProductType,
 	}
 }
 
@@ -142,6 +423,7 @@ func (addCartItemRequest AddCartItemRequest) ToModel() dto.CreateCartItemRequest
 	return dto.CreateCartItemRequest{
 		CartId:    addCartItemRequest.CartId,
 		ProductId: addCartItemRequest.ProductId,
+		VariantId: addCartItemRequest.VariantId,
 		Quantity:  addCartItemRequest.Quantity,
 	}
 }
@@ -158,8 +440,8 @@ func (addOrderItemRequest AddOrderItemRequest) ToModel() dto.CreateOrderItemRequ
 	return dto.CreateOrderItemRequest{
 		OrderId:   addOrderItemRequest.OrderId,
 		ProductId: addOrderItemRequest.ProductId,
+		VariantId: addOrderItemRequest.VariantId,
 		Quantity:  addOrderItemRequest.Quantity,
-		Price:     addOrderItemRequest.Price,
 	}
 }
 
@@ -167,8 +449,8 @@ func (updateOrderItemRequest UpdateOrderItemRequest) ToModel() dto.CreateOrderIt
 	return dto.CreateOrderItemRequest{
 		OrderId:   updateOrderItemRequest.OrderId,
 		ProductId: updateOrderItemRequest.ProductId,
+		VariantId: updateOrderItemRequest.VariantId,
 		Quantity:  updateOrderItemRequest.Quantity,
-		Price:     updateOrderItemRequest.Price,
 	}
 }
 
@@ -177,12 +459,22 @@ func (addCategoryRequest AddCategoryRequest) ToModel() dto.CreateCategoryRequest
 		Name:        addCategoryRequest.Name,
 		Description: addCategoryRequest.Description,
 		IsActive:    addCategoryRequest.IsActive,
+		ParentId:    addCategoryRequest.ParentId,
+		SortOrder:   addCategoryRequest.SortOrder,
+	}
+}
+
+func (moveCategoryRequest MoveCategoryRequest) ToModel() dto.MoveCategoryRequest {
+	return dto.MoveCategoryRequest{
+		ParentId:  moveCategoryRequest.ParentId,
+		SortOrder: moveCategoryRequest.SortOrder,
 	}
 }
 
 func (addStoreRequest AddStoreRequest) ToModel() dto.CreateStoreRequest {
 	return dto.CreateStoreRequest{
 		Name:         addStoreRequest.Name,
+		Slug:         addStoreRequest.Slug,
 		Description:  addStoreRequest.Description,
 		LogoUrl:      addStoreRequest.LogoUrl,
 		ContactEmail: addStoreRequest.ContactEmail,
@@ -190,3 +482,319 @@ func (addStoreRequest AddStoreRequest) ToModel() dto.CreateStoreRequest {
 		IsActive:     addStoreRequest.IsActive,
 	}
 }
+
+func (addWishlistRequest AddWishlistRequest) ToModel() dto.CreateWishlistRequest {
+	return dto.CreateWishlistRequest{
+		Name:     addWishlistRequest.Name,
+		IsPublic: addWishlistRequest.IsPublic,
+	}
+}
+
+func (addWishlistItemRequest AddWishlistItemRequest) ToModel() dto.AddWishlistItemRequest {
+	return dto.AddWishlistItemRequest{
+		ProductId: addWishlistItemRequest.ProductId,
+		VariantId: addWishlistItemRequest.VariantId,
+	}
+}
+
+func (moveRequest MoveCartItemToWishlistRequest) ToModel() dto.MoveCartItemToWishlistRequest {
+	return dto.MoveCartItemToWishlistRequest{
+		CartItemId: moveRequest.CartItemId,
+	}
+}
+
+func (moveRequest MoveWishlistItemToCartRequest) ToModel() dto.MoveWishlistItemToCartRequest {
+	return dto.MoveWishlistItemToCartRequest{
+		CartId:   moveRequest.CartId,
+		Quantity: moveRequest.Quantity,
+	}
+}
+
+func (addOptionTypeRequest AddOptionTypeRequest) ToModel() dto.CreateOptionTypeRequest {
+	return dto.CreateOptionTypeRequest{
+		Name:        addOptionTypeRequest.Name,
+		DisplayName: addOptionTypeRequest.DisplayName,
+	}
+}
+
+func (addOptionValueRequest AddOptionValueRequest) ToModel() dto.CreateOptionValueRequest {
+	return dto.CreateOptionValueRequest{
+		Value:     addOptionValueRequest.Value,
+		SortOrder: addOptionValueRequest.SortOrder,
+	}
+}
+
+func (addVariantRequest AddProductVariantRequest) ToModel() dto.CreateProductVariantRequest {
+	return dto.CreateProductVariantRequest{
+		Sku:            addVariantRequest.Sku,
+		Price:          addVariantRequest.Price,
+		StockQuantity:  addVariantRequest.StockQuantity,
+		Barcode:        addVariantRequest.Barcode,
+		ImageUrl:       addVariantRequest.ImageUrl,
+		IsActive:       addVariantRequest.IsActive,
+		OptionValueIds: addVariantRequest.OptionValueIds,
+	}
+}
+
+func (listProductsRequest ListProductsRequest) ToModel() dto.ProductListRequest {
+	return dto.ProductListRequest{
+		StoreId:    listProductsRequest.StoreId,
+		CategoryId: listProductsRequest.CategoryId,
+		IsFeatured: listProductsRequest.IsFeatured,
+		MinPrice:   listProductsRequest.MinPrice,
+		MaxPrice:   listProductsRequest.MaxPrice,
+		InStock:    listProductsRequest.InStock,
+		Sort:       listProductsRequest.Sort,
+		Cursor:     listProductsRequest.Cursor,
+		Limit:      listProductsRequest.Limit,
+	}
+}
+
+func (importProductsRequest ImportProductsRequest) ToModel() dto.ProductImportOptions {
+	return dto.ProductImportOptions{
+		Format:         importProductsRequest.Format,
+		MatchBy:        importProductsRequest.MatchBy,
+		DefaultStoreId: importProductsRequest.StoreId,
+	}
+}
+
+func (exportProductsRequest ExportProductsRequest) ToModel() dto.ProductExportRequest {
+	format := exportProductsRequest.Format
+	if format == "" {
+		format = "csv"
+	}
+	return dto.ProductExportRequest{
+		Format:     format,
+		StoreId:    exportProductsRequest.StoreId,
+		CategoryId: exportProductsRequest.CategoryId,
+		IsActive:   exportProductsRequest.IsActive,
+	}
+}
+
+func (listReviewsRequest ListReviewsRequest) ToModel() dto.ReviewListRequest {
+	return dto.ReviewListRequest{
+		Sort:   listReviewsRequest.Sort,
+		Limit:  listReviewsRequest.Limit,
+		Offset: listReviewsRequest.Offset,
+	}
+}
+
+func (addReviewRequest AddReviewRequest) ToModel() dto.CreateReviewRequest {
+	return dto.CreateReviewRequest{
+		Rating:    addReviewRequest.Rating,
+		Title:     addReviewRequest.Title,
+		Body:      addReviewRequest.Body,
+		PhotoUrls: addReviewRequest.PhotoUrls,
+	}
+}
+
+func (moderateReviewRequest ModerateReviewRequest) ToModel() dto.ModerateReviewRequest {
+	return dto.ModerateReviewRequest{
+		Status: moderateReviewRequest.Status,
+		Note:   moderateReviewRequest.Note,
+	}
+}
+
+func (reviewVoteRequest ReviewVoteRequest) ToModel() dto.ReviewVoteRequest {
+	return dto.ReviewVoteRequest{Helpful: reviewVoteRequest.Helpful}
+}
+
+func (addPriceScheduleRequest AddPriceScheduleRequest) ToModel() dto.CreatePriceScheduleRequest {
+	return dto.CreatePriceScheduleRequest{
+		Price:     addPriceScheduleRequest.Price,
+		BasePrice: addPriceScheduleRequest.BasePrice,
+		Discount:  addPriceScheduleRequest.Discount,
+		StartsAt:  addPriceScheduleRequest.StartsAt,
+		EndsAt:    addPriceScheduleRequest.EndsAt,
+	}
+}
+
+func (addPriceRuleRequest AddPriceRuleRequest) ToModel() dto.CreatePriceRuleRequest {
+	isActive := true
+	if addPriceRuleRequest.IsActive != nil {
+		isActive = *addPriceRuleRequest.IsActive
+	}
+	return dto.CreatePriceRuleRequest{
+		Name:          addPriceRuleRequest.Name,
+		Kind:          addPriceRuleRequest.Kind,
+		CustomerGroup: addPriceRuleRequest.CustomerGroup,
+		ProductId:     addPriceRuleRequest.ProductId,
+		StoreId:       addPriceRuleRequest.StoreId,
+		CategoryId:    addPriceRuleRequest.CategoryId,
+		PercentOff:    addPriceRuleRequest.PercentOff,
+		StartsAt:      addPriceRuleRequest.StartsAt,
+		EndsAt:        addPriceRuleRequest.EndsAt,
+		IsActive:      isActive,
+	}
+}
+
+func (customerGroupRequest CustomerGroupRequest) ToModel() dto.CustomerGroupRequest {
+	return dto.CustomerGroupRequest{CustomerGroup: customerGroupRequest.CustomerGroup}
+}
+
+func (addWarehouseRequest AddWarehouseRequest) ToModel() dto.CreateWarehouseRequest {
+	isActive := true
+	if addWarehouseRequest.IsActive != nil {
+		isActive = *addWarehouseRequest.IsActive
+	}
+	return dto.CreateWarehouseRequest{
+		Name:      addWarehouseRequest.Name,
+		Code:      addWarehouseRequest.Code,
+		Address:   addWarehouseRequest.Address,
+		IsDefault: addWarehouseRequest.IsDefault,
+		IsActive:  isActive,
+	}
+}
+
+func (listStockMovementsRequest ListStockMovementsRequest) ToModel() dto.StockMovementListRequest {
+	return dto.StockMovementListRequest{
+		WarehouseId: listStockMovementsRequest.WarehouseId,
+		Before:      listStockMovementsRequest.Before,
+		Limit:       listStockMovementsRequest.Limit,
+	}
+}
+
+func (addStockMovementRequest AddStockMovementRequest) ToModel() dto.CreateStockMovementRequest {
+	return dto.CreateStockMovementRequest{
+		WarehouseId: addStockMovementRequest.WarehouseId,
+		VariantId:   addStockMovementRequest.VariantId,
+		Type:        addStockMovementRequest.Type,
+		Quantity:    addStockMovementRequest.Quantity,
+		Reason:      addStockMovementRequest.Reason,
+		Reference:   addStockMovementRequest.Reference,
+	}
+}
+
+func (stockTransferRequest StockTransferRequest) ToModel() dto.StockTransferRequest {
+	return dto.StockTransferRequest{
+		FromWarehouseId: stockTransferRequest.FromWarehouseId,
+		ToWarehouseId:   stockTransferRequest.ToWarehouseId,
+		VariantId:       stockTransferRequest.VariantId,
+		Quantity:        stockTransferRequest.Quantity,
+		Reason:          stockTransferRequest.Reason,
+	}
+}
+
+func (setStockThresholdRequest SetStockThresholdRequest) ToModel() dto.SetStockThresholdRequest {
+	return dto.SetStockThresholdRequest{
+		VariantId:    setStockThresholdRequest.VariantId,
+		Threshold:    setStockThresholdRequest.Threshold,
+		LeadTimeDays: setStockThresholdRequest.LeadTimeDays,
+	}
+}
+
+func (reorderReportRequest ReorderReportRequest) ToModel() dto.ReorderReportRequest {
+	return dto.ReorderReportRequest{
+		StoreId:   reorderReportRequest.StoreId,
+		Days:      reorderReportRequest.Days,
+		CoverDays: reorderReportRequest.CoverDays,
+	}
+}
+
+func (stockSubscriptionRequest StockSubscriptionRequest) ToModel() dto.CreateStockSubscriptionRequest {
+	return dto.CreateStockSubscriptionRequest{
+		ProductId: stockSubscriptionRequest.ProductId,
+		VariantId: stockSubscriptionRequest.VariantId,
+		Email:     stockSubscriptionRequest.Email,
+	}
+}
+
+func (uploadProductImageRequest UploadProductImageRequest) ToModel() dto.UploadProductImageRequest {
+	return dto.UploadProductImageRequest{
+		AltText: uploadProductImageRequest.AltText,
+	}
+}
+
+func (updateProductImageRequest UpdateProductImageRequest) ToModel() dto.UpdateProductImageRequest {
+	return dto.UpdateProductImageRequest{
+		AltText: updateProductImageRequest.AltText,
+	}
+}
+
+func (reorderProductImagesRequest ReorderProductImagesRequest) ToModel() dto.ReorderProductImagesRequest {
+	return dto.ReorderProductImagesRequest{
+		ImageIds: reorderProductImagesRequest.ImageIds,
+	}
+}
+
+func (addCategoryAttributeRequest AddCategoryAttributeRequest) ToModel() dto.CreateCategoryAttributeRequest {
+	return dto.CreateCategoryAttributeRequest{
+		Code:         addCategoryAttributeRequest.Code,
+		Name:         addCategoryAttributeRequest.Name,
+		Type:         addCategoryAttributeRequest.Type,
+		Unit:         addCategoryAttributeRequest.Unit,
+		Options:      addCategoryAttributeRequest.Options,
+		IsRequired:   addCategoryAttributeRequest.IsRequired,
+		IsFilterable: addCategoryAttributeRequest.IsFilterable,
+		Position:     addCategoryAttributeRequest.Position,
+	}
+}
+
+func (updateCategoryAttributeRequest UpdateCategoryAttributeRequest) ToModel() dto.UpdateCategoryAttributeRequest {
+	return dto.UpdateCategoryAttributeRequest{
+		Name:         updateCategoryAttributeRequest.Name,
+		Unit:         updateCategoryAttributeRequest.Unit,
+		Options:      updateCategoryAttributeRequest.Options,
+		IsRequired:   updateCategoryAttributeRequest.IsRequired,
+		IsFilterable: updateCategoryAttributeRequest.IsFilterable,
+		Position:     updateCategoryAttributeRequest.Position,
+	}
+}
+
+func (reviewProductRequest ReviewProductRequest) ToModel() dto.ReviewProductRequest {
+	return dto.ReviewProductRequest{
+		Note: reviewProductRequest.Note,
+	}
+}
+
+func (productScheduleRequest ProductScheduleRequest) ToModel() dto.ProductScheduleRequest {
+	return dto.ProductScheduleRequest{
+		PublishAt:   productScheduleRequest.PublishAt,
+		UnpublishAt: productScheduleRequest.UnpublishAt,
+	}
+}
+
+func (saveProductTranslationRequest SaveProductTranslationRequest) ToModel() dto.SaveProductTranslationRequest {
+	return dto.SaveProductTranslationRequest{
+		Name:            saveProductTranslationRequest.Name,
+		Slug:            saveProductTranslationRequest.Slug,
+		Description:     saveProductTranslationRequest.Description,
+		MetaDescription: saveProductTranslationRequest.MetaDescription,
+	}
+}
+
+func (saveCategoryTranslationRequest SaveCategoryTranslationRequest) ToModel() dto.SaveCategoryTranslationRequest {
+	return dto.SaveCategoryTranslationRequest{
+		Name:        saveCategoryTranslationRequest.Name,
+		Description: saveCategoryTranslationRequest.Description,
+	}
+}
+
+func (saveStoreTranslationRequest SaveStoreTranslationRequest) ToModel() dto.SaveStoreTranslationRequest {
+	return dto.SaveStoreTranslationRequest{
+		Description: saveStoreTranslationRequest.Description,
+	}
+}
+
+func (saveBundleRequest SaveBundleRequest) ToModel() dto.SaveBundleRequest {
+	components := make([]dto.BundleComponentRequest, 0, len(saveBundleRequest.Components))
+	for _, component := range saveBundleRequest.Components {
+		components = append(components, dto.BundleComponentRequest{
+			ProductId: component.ProductId,
+			VariantId: component.VariantId,
+			Quantity:  component.Quantity,
+		})
+	}
+	return dto.SaveBundleRequest{
+		PricingMode:     saveBundleRequest.PricingMode,
+		FixedPrice:      saveBundleRequest.FixedPrice,
+		DiscountPercent: saveBundleRequest.DiscountPercent,
+		Components:      components,
+	}
+}
+
+func (addLicenseKeysRequest AddLicenseKeysRequest) ToModel() dto.AddLicenseKeysRequest {
+	return dto.AddLicenseKeysRequest{
+		Keys: addLicenseKeysRequest.Keys,
+	}
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/service"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const sitemapContentType = "application/xml; charset=utf-8"
+
+type SeoController struct {
+	seoService service.ISeoService
+	BaseController
+}
+
+func NewSeoController(seoService service.ISeoService) *SeoController {
+	return &SeoController{seoService: seoService}
+}
+
+func (seoController *SeoController) RegisterRoutes(e *echo.Echo) {
+	e.GET("/sitemap.xml", seoController.GetSitemapIndex)
+	e.GET("/sitemaps/:file", seoController.GetSitemap)
+	e.GET("/api/v1/products/:id/seo", seoController.GetProductSeo)
+}
+
+func (seoController *SeoController) GetSitemapIndex(c echo.Context) error {
+	content, serviceErr := seoController.seoService.GetSitemapIndex()
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return c.Blob(http.StatusOK, sitemapContentType, content)
+}
+
+// GetSitemap serves a sitemap file named <entity type>-<page>.xml, e.g. products-1.xml.
+func (seoController *SeoController) GetSitemap(c echo.Context) error {
+	name, isXml := strings.CutSuffix(c.Param("file"), ".xml")
+	separator := strings.LastIndex(name, "-")
+	if !isXml || separator < 0 {
+		return _errors.NewNotFound("Sitemap not found")
+	}
+	page, parseErr := strconv.Atoi(name[separator+1:])
+	if parseErr != nil {
+		return _errors.NewNotFound("Sitemap not found")
+	}
+
+	content, serviceErr := seoController.seoService.GetSitemap(name[:separator], page)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return c.Blob(http.StatusOK, sitemapContentType, content)
+}
+
+func (seoController *SeoController) GetProductSeo(c echo.Context) error {
+	productId, parseIdErr := seoController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	seo, serviceErr := seoController.seoService.GetProductSeo(productId, seoController.Locale(c))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return seoController.Success(c, seo, "Product SEO data retrieved")
+}
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type StockAlertController struct {
+	alertService service.IStockAlertService
+	BaseController
+}
+
+func NewStockAlertController(alertService service.IStockAlertService) *StockAlertController {
+	return &StockAlertController{alertService: alertService}
+}
+
+func (alertController *StockAlertController) RegisterRoutes(api *echo.Group) {
+	api.GET("/products/:id/stock-thresholds", alertController.GetThresholds)
+	api.PUT("/products/:id/stock-thresholds", alertController.SetThreshold)
+	api.DELETE("/products/:id/stock-thresholds", alertController.DeleteThreshold)
+	api.GET("/stock-alerts", alertController.GetLowStockItems)
+	api.GET("/stock-alerts/reorder-report", alertController.GetReorderReport)
+}
+
+func (alertController *StockAlertController) GetThresholds(c echo.Context) error {
+	userId, role, authErr := alertController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := alertController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	thresholds, serviceErr := alertController.alertService.GetThresholds(userId, role, productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return alertController.Success(c, thresholds, "Stock thresholds listed")
+}
+
+func (alertController *StockAlertController) SetThreshold(c echo.Context) error {
+	userId, role, authErr := alertController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := alertController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var setStockThresholdRequest request.SetStockThresholdRequest
+	if bindErr := c.Bind(&setStockThresholdRequest); bindErr != nil {
+		return bindErr
+	}
+
+	threshold, serviceErr := alertController.alertService.SetThreshold(userId, role, productId, setStockThresholdRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return alertController.Success(c, threshold, "Stock threshold saved")
+}
+
+func (alertController *StockAlertController) DeleteThreshold(c echo.Context) error {
+	userId, role, authErr := alertController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := alertController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var stockThresholdQuery request.StockThresholdQuery
+	if bindErr := c.Bind(&stockThresholdQuery); bindErr != nil {
+		return bindErr
+	}
+
+	if serviceErr := alertController.alertService.DeleteThreshold(userId, role, productId, stockThresholdQuery.VariantId); serviceErr != nil {
+		return serviceErr
+	}
+	return alertController.Success(c, nil, "Stock threshold deleted")
+}
+
+func (alertController *StockAlertController) GetLowStockItems(c echo.Context) error {
+	userId, role, authErr := alertController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	var lowStockRequest request.LowStockRequest
+	if bindErr := c.Bind(&lowStockRequest); bindErr != nil {
+		return bindErr
+	}
+
+	items, serviceErr := alertController.alertService.GetLowStockItems(userId, role, lowStockRequest.StoreId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return alertController.Success(c, items, "Low stock items listed")
+}
+
+func (alertController *StockAlertController) GetReorderReport(c echo.Context) error {
+	userId, role, authErr := alertController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	var reorderReportRequest request.ReorderReportRequest
+	if bindErr := c.Bind(&reorderReportRequest); bindErr != nil {
+		return bindErr
+	}
+
+	report, serviceErr := alertController.alertService.GetReorderReport(userId, role, reorderReportRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return alertController.Success(c, report, "Reorder report generated")
+}
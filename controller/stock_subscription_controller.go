@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type StockSubscriptionController struct {
+	subscriptionService service.IStockSubscriptionService
+	BaseController
+}
+
+func NewStockSubscriptionController(subscriptionService service.IStockSubscriptionService) *StockSubscriptionController {
+	return &StockSubscriptionController{subscriptionService: subscriptionService}
+}
+
+// RegisterRoutes registers the restock subscription endpoints. Unsubscribing is a public POST since the token is
+// the credential; the unsubscribe link opens a storefront page that confirms and posts the token.
+func (subscriptionController *StockSubscriptionController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.POST("/api/v1/products/:id/stock-subscriptions", subscriptionController.SubscribeGuest)
+	e.POST("/api/v1/stock-subscriptions/unsubscribe", subscriptionController.Unsubscribe)
+
+	api.POST("/stock-subscriptions", subscriptionController.Subscribe)
+	api.GET("/stock-subscriptions", subscriptionController.GetSubscriptions)
+	api.DELETE("/stock-subscriptions/:id", subscriptionController.CancelSubscription)
+}
+
+func (subscriptionController *StockSubscriptionController) SubscribeGuest(c echo.Context) error {
+	productId, parseIdErr := subscriptionController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var stockSubscriptionRequest request.StockSubscriptionRequest
+	if bindErr := c.Bind(&stockSubscriptionRequest); bindErr != nil {
+		return bindErr
+	}
+	stockSubscriptionRequest.ProductId = productId
+
+	subscription, serviceErr := subscriptionController.subscriptionService.Subscribe(nil, stockSubscriptionRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return subscriptionController.Created(c, subscription, "Subscribed to restock notification")
+}
+
+// Unsubscribe cancels the subscription of an unsubscribe token.
+func (subscriptionController *StockSubscriptionController) Unsubscribe(c echo.Context) error {
+	var unsubscribeRequest request.UnsubscribeStockRequest
+	if bindErr := c.Bind(&unsubscribeRequest); bindErr != nil {
+		return bindErr
+	}
+	subscription, serviceErr := subscriptionController.subscriptionService.Unsubscribe(unsubscribeRequest.Token)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return subscriptionController.Success(c, subscription, "Unsubscribed from restock notification")
+}
+
+func (subscriptionController *StockSubscriptionController) Subscribe(c echo.Context) error {
+	userId, authErr := subscriptionController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	var stockSubscriptionRequest request.StockSubscriptionRequest
+	if bindErr := c.Bind(&stockSubscriptionRequest); bindErr != nil {
+		return bindErr
+	}
+
+	subscription, serviceErr := subscriptionController.subscriptionService.Subscribe(&userId, stockSubscriptionRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return subscriptionController.Created(c, subscription, "Subscribed to restock notification")
+}
+
+func (subscriptionController *StockSubscriptionController) GetSubscriptions(c echo.Context) error {
+	userId, authErr := subscriptionController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+
+	subscriptions, serviceErr := subscriptionController.subscriptionService.GetUserSubscriptions(userId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return subscriptionController.Success(c, subscriptions, "Stock subscriptions listed")
+}
+
+func (subscriptionController *StockSubscriptionController) CancelSubscription(c echo.Context) error {
+	userId, authErr := subscriptionController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	id, parseIdErr := subscriptionController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	if serviceErr := subscriptionController.subscriptionService.CancelSubscription(userId, id); serviceErr != nil {
+		return serviceErr
+	}
+	return subscriptionController.Success(c, nil, "Stock subscription cancelled")
+}
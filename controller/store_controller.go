@@ -2,6 +2,9 @@ package controller
 
 import (
 	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
 	"go-ecommerce-service/service"
 	"strconv"
 
@@ -17,16 +20,21 @@ func NewStoreController(storeService service.IStoreService) *StoreController {
 	return &StoreController{storeService: storeService}
 }
 
-func (storeController *StoreController) RegisterRoutes(e *echo.Echo) {
+func (storeController *StoreController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
 	e.GET("/api/v1/stores", storeController.GetAllStores)
+	e.GET("/api/v1/stores/slug/:slug", storeController.GetStoreBySlug)
 	e.GET("/api/v1/stores/:id", storeController.GetStoreById)
 	e.POST("/api/v1/stores", storeController.AddStore)
 	e.DELETE("/api/v1/stores/:id", storeController.DeleteStore)
 	e.PUT("/api/v1/stores/:id", storeController.UpdateStore)
+
+	admin := customMiddleware.RequireRole(domain.UserRoleAdmin)
+	api.PUT("/stores/:id/owners/:userId", storeController.AddStoreOwner, admin)
+	api.DELETE("/stores/:id/owners/:userId", storeController.RemoveStoreOwner, admin)
 }
 
 func (storeController *StoreController) GetAllStores(c echo.Context) error {
-	stores := storeController.storeService.GetAllStores()
+	stores := storeController.storeService.GetAllStores(storeController.Locale(c))
 	return storeController.Success(c, stores, "All stores retrieved")
 }
 
@@ -35,10 +43,22 @@ func (storeController *StoreController) GetStoreById(c echo.Context) error {
 	if parseIdErr != nil {
 		return parseIdErr
 	}
-	store, serviceErr := storeController.storeService.GetStoreById(uint(id))
+	store, serviceErr := storeController.storeService.GetStoreById(uint(id), storeController.Locale(c))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return storeController.Success(c, store, "Store retrieved")
+}
+
+func (storeController *StoreController) GetStoreBySlug(c echo.Context) error {
+	store, currentSlug, serviceErr := storeController.storeService.GetStoreBySlug(c.Param("slug"), storeController.Locale(c))
 	if serviceErr != nil {
 		return serviceErr
 	}
+	if currentSlug != "" {
+		location := "/api/v1/stores/slug/" + currentSlug
+		return storeController.MovedPermanently(c, location, dto.SlugRedirectResponse{Slug: currentSlug, Location: location}, "Store slug moved")
+	}
 	return storeController.Success(c, store, "Store retrieved")
 }
 
@@ -81,3 +101,33 @@ func (storeController *StoreController) UpdateStore(c echo.Context) error {
 	}
 	return storeController.Success(c, updatedStore, "Store updated")
 }
+
+func (storeController *StoreController) AddStoreOwner(c echo.Context) error {
+	storeId, parseIdErr := storeController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	userId, parseIdErr := storeController.ParseIdParam(c, "userId")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	if serviceErr := storeController.storeService.AddStoreOwner(uint(storeId), userId); serviceErr != nil {
+		return serviceErr
+	}
+	return storeController.Success(c, nil, "Store owner added")
+}
+
+func (storeController *StoreController) RemoveStoreOwner(c echo.Context) error {
+	storeId, parseIdErr := storeController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	userId, parseIdErr := storeController.ParseIdParam(c, "userId")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	if serviceErr := storeController.storeService.RemoveStoreOwner(uint(storeId), userId); serviceErr != nil {
+		return serviceErr
+	}
+	return storeController.Success(c, nil, "Store owner removed")
+}
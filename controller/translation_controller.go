@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/domain"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TranslationController struct {
+	translationService service.ITranslationService
+	BaseController
+}
+
+func NewTranslationController(translationService service.ITranslationService) *TranslationController {
+	return &TranslationController{translationService: translationService}
+}
+
+// RegisterRoutes registers the translation endpoints. Category translations are changed by admins; the service
+// lets the owners of a store manage the translations of the store and its products.
+func (translationController *TranslationController) RegisterRoutes(api *echo.Group) {
+	admin := customMiddleware.RequireRole(domain.UserRoleAdmin)
+	api.GET("/products/:id/translations", translationController.GetProductTranslations)
+	api.PUT("/products/:id/translations/:locale", translationController.SaveProductTranslation)
+	api.DELETE("/products/:id/translations/:locale", translationController.DeleteProductTranslation)
+	api.GET("/categories/:id/translations", translationController.GetCategoryTranslations)
+	api.PUT("/categories/:id/translations/:locale", translationController.SaveCategoryTranslation, admin)
+	api.DELETE("/categories/:id/translations/:locale", translationController.DeleteCategoryTranslation, admin)
+	api.GET("/stores/:id/translations", translationController.GetStoreTranslations)
+	api.PUT("/stores/:id/translations/:locale", translationController.SaveStoreTranslation)
+	api.DELETE("/stores/:id/translations/:locale", translationController.DeleteStoreTranslation)
+}
+
+func (translationController *TranslationController) GetProductTranslations(c echo.Context) error {
+	userId, role, authErr := translationController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := translationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	translations, serviceErr := translationController.translationService.GetProductTranslations(userId, role, productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return translationController.Success(c, translations, "Product translations listed")
+}
+
+func (translationController *TranslationController) SaveProductTranslation(c echo.Context) error {
+	userId, role, authErr := translationController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := translationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var saveProductTranslationRequest request.SaveProductTranslationRequest
+	if bindErr := c.Bind(&saveProductTranslationRequest); bindErr != nil {
+		return bindErr
+	}
+
+	translation, serviceErr := translationController.translationService.SaveProductTranslation(userId, role, productId, c.Param("locale"),
+		saveProductTranslationRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return translationController.Success(c, translation, "Product translation saved")
+}
+
+func (translationController *TranslationController) DeleteProductTranslation(c echo.Context) error {
+	userId, role, authErr := translationController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	productId, parseIdErr := translationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	if serviceErr := translationController.translationService.DeleteProductTranslation(userId, role, productId, c.Param("locale")); serviceErr != nil {
+		return serviceErr
+	}
+	return translationController.Success(c, nil, "Product translation deleted")
+}
+
+func (translationController *TranslationController) GetCategoryTranslations(c echo.Context) error {
+	categoryId, parseIdErr := translationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	translations, serviceErr := translationController.translationService.GetCategoryTranslations(categoryId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return translationController.Success(c, translations, "Category translations listed")
+}
+
+func (translationController *TranslationController) SaveCategoryTranslation(c echo.Context) error {
+	categoryId, parseIdErr := translationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var saveCategoryTranslationRequest request.SaveCategoryTranslationRequest
+	if bindErr := c.Bind(&saveCategoryTranslationRequest); bindErr != nil {
+		return bindErr
+	}
+
+	translation, serviceErr := translationController.translationService.SaveCategoryTranslation(categoryId, c.Param("locale"),
+		saveCategoryTranslationRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return translationController.Success(c, translation, "Category translation saved")
+}
+
+func (translationController *TranslationController) DeleteCategoryTranslation(c echo.Context) error {
+	categoryId, parseIdErr := translationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	if serviceErr := translationController.translationService.DeleteCategoryTranslation(categoryId, c.Param("locale")); serviceErr != nil {
+		return serviceErr
+	}
+	return translationController.Success(c, nil, "Category translation deleted")
+}
+
+func (translationController *TranslationController) GetStoreTranslations(c echo.Context) error {
+	userId, role, authErr := translationController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	storeId, parseIdErr := translationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	translations, serviceErr := translationController.translationService.GetStoreTranslations(userId, role, storeId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return translationController.Success(c, translations, "Store translations listed")
+}
+
+func (translationController *TranslationController) SaveStoreTranslation(c echo.Context) error {
+	userId, role, authErr := translationController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	storeId, parseIdErr := translationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var saveStoreTranslationRequest request.SaveStoreTranslationRequest
+	if bindErr := c.Bind(&saveStoreTranslationRequest); bindErr != nil {
+		return bindErr
+	}
+
+	translation, serviceErr := translationController.translationService.SaveStoreTranslation(userId, role, storeId, c.Param("locale"),
+		saveStoreTranslationRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return translationController.Success(c, translation, "Store translation saved")
+}
+
+func (translationController *TranslationController) DeleteStoreTranslation(c echo.Context) error {
+	userId, role, authErr := translationController.CurrentUser(c)
+	if authErr != nil {
+		return authErr
+	}
+	storeId, parseIdErr := translationController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	if serviceErr := translationController.translationService.DeleteStoreTranslation(userId, role, storeId, c.Param("locale")); serviceErr != nil {
+		return serviceErr
+	}
+	return translationController.Success(c, nil, "Store translation deleted")
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"go-ecommerce-service/domain"
+	customMiddleware "go-ecommerce-service/pkg/middleware"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TrashController struct {
+	trashService service.ITrashService
+	BaseController
+}
+
+func NewTrashController(trashService service.ITrashService) *TrashController {
+	return &TrashController{trashService: trashService}
+}
+
+// RegisterRoutes registers the trash endpoints, which are left to admins.
+func (trashController *TrashController) RegisterRoutes(api *echo.Group) {
+	admin := customMiddleware.RequireRole(domain.UserRoleAdmin)
+	api.GET("/trash", trashController.GetTrash, admin)
+	api.POST("/trash/products/:id/restore", trashController.RestoreProduct, admin)
+	api.POST("/trash/stores/:id/restore", trashController.RestoreStore, admin)
+	api.POST("/trash/categories/:id/restore", trashController.RestoreCategory, admin)
+}
+
+func (trashController *TrashController) GetTrash(c echo.Context) error {
+	trash, serviceErr := trashController.trashService.GetTrash()
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return trashController.Success(c, trash, "Trash listed")
+}
+
+func (trashController *TrashController) RestoreProduct(c echo.Context) error {
+	productId, parseIdErr := trashController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	product, serviceErr := trashController.trashService.RestoreProduct(productId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return trashController.Success(c, product, "Product restored")
+}
+
+func (trashController *TrashController) RestoreStore(c echo.Context) error {
+	storeId, parseIdErr := trashController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	store, serviceErr := trashController.trashService.RestoreStore(uint(storeId))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return trashController.Success(c, store, "Store restored")
+}
+
+func (trashController *TrashController) RestoreCategory(c echo.Context) error {
+	categoryId, parseIdErr := trashController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+
+	category, serviceErr := trashController.trashService.RestoreCategory(uint(categoryId))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return trashController.Success(c, category, "Category restored")
+}
@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"go-ecommerce-service/controller/request"
+	"go-ecommerce-service/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type WishlistController struct {
+	wishlistService service.IWishlistService
+	BaseController
+}
+
+func NewWishlistController(wishlistService service.IWishlistService) *WishlistController {
+	return &WishlistController{wishlistService: wishlistService}
+}
+
+// RegisterRoutes registers the public share route on e and the owner routes on the authenticated api group.
+func (wishlistController *WishlistController) RegisterRoutes(e *echo.Echo, api *echo.Group) {
+	e.GET("/api/v1/wishlists/shared/:token", wishlistController.GetSharedWishlist)
+
+	api.GET("/wishlists", wishlistController.GetUserWishlists)
+	api.POST("/wishlists", wishlistController.CreateWishlist)
+	api.GET("/wishlists/:id", wishlistController.GetWishlist)
+	api.PUT("/wishlists/:id", wishlistController.UpdateWishlist)
+	api.DELETE("/wishlists/:id", wishlistController.DeleteWishlist)
+	api.POST("/wishlists/:id/items", wishlistController.AddItem)
+	api.DELETE("/wishlists/:id/items/:item_id", wishlistController.RemoveItem)
+	api.POST("/wishlists/:id/items/from-cart", wishlistController.MoveCartItemToWishlist)
+	api.POST("/wishlists/:id/items/:item_id/move-to-cart", wishlistController.MoveItemToCart)
+}
+
+func (wishlistController *WishlistController) GetSharedWishlist(c echo.Context) error {
+	wishlist, serviceErr := wishlistController.wishlistService.GetSharedWishlist(c.Param("token"))
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Success(c, wishlist, "Shared wishlist retrieved")
+}
+
+func (wishlistController *WishlistController) GetUserWishlists(c echo.Context) error {
+	userId, authErr := wishlistController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	wishlists, serviceErr := wishlistController.wishlistService.GetUserWishlists(userId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Success(c, wishlists, "Wishlists retrieved")
+}
+
+func (wishlistController *WishlistController) GetWishlist(c echo.Context) error {
+	userId, authErr := wishlistController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	id, parseIdErr := wishlistController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	wishlist, serviceErr := wishlistController.wishlistService.GetWishlist(userId, id)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Success(c, wishlist, "Wishlist retrieved")
+}
+
+func (wishlistController *WishlistController) CreateWishlist(c echo.Context) error {
+	userId, authErr := wishlistController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	var addWishlistRequest request.AddWishlistRequest
+	if bindErr := c.Bind(&addWishlistRequest); bindErr != nil {
+		return bindErr
+	}
+	wishlist, serviceErr := wishlistController.wishlistService.CreateWishlist(userId, addWishlistRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Created(c, wishlist, "Wishlist created")
+}
+
+func (wishlistController *WishlistController) UpdateWishlist(c echo.Context) error {
+	userId, authErr := wishlistController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	id, parseIdErr := wishlistController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var updateWishlistRequest request.AddWishlistRequest
+	if bindErr := c.Bind(&updateWishlistRequest); bindErr != nil {
+		return bindErr
+	}
+	wishlist, serviceErr := wishlistController.wishlistService.UpdateWishlist(userId, id, updateWishlistRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Success(c, wishlist, "Wishlist updated")
+}
+
+func (wishlistController *WishlistController) DeleteWishlist(c echo.Context) error {
+	userId, authErr := wishlistController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	id, parseIdErr := wishlistController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	if serviceErr := wishlistController.wishlistService.DeleteWishlist(userId, id); serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Success(c, nil, "Wishlist deleted")
+}
+
+func (wishlistController *WishlistController) AddItem(c echo.Context) error {
+	userId, authErr := wishlistController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	id, parseIdErr := wishlistController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var addItemRequest request.AddWishlistItemRequest
+	if bindErr := c.Bind(&addItemRequest); bindErr != nil {
+		return bindErr
+	}
+	item, serviceErr := wishlistController.wishlistService.AddItem(userId, id, addItemRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Created(c, item, "Product added to wishlist")
+}
+
+func (wishlistController *WishlistController) RemoveItem(c echo.Context) error {
+	userId, authErr := wishlistController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	id, parseIdErr := wishlistController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	itemId, parseItemIdErr := wishlistController.ParseIdParam(c, "item_id")
+	if parseItemIdErr != nil {
+		return parseItemIdErr
+	}
+	if serviceErr := wishlistController.wishlistService.RemoveItem(userId, id, itemId); serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Success(c, nil, "Product removed from wishlist")
+}
+
+func (wishlistController *WishlistController) MoveCartItemToWishlist(c echo.Context) error {
+	userId, authErr := wishlistController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	id, parseIdErr := wishlistController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	var moveRequest request.MoveCartItemToWishlistRequest
+	if bindErr := c.Bind(&moveRequest); bindErr != nil {
+		return bindErr
+	}
+	item, serviceErr := wishlistController.wishlistService.MoveCartItemToWishlist(userId, id, moveRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Success(c, item, "Cart item saved for later")
+}
+
+func (wishlistController *WishlistController) MoveItemToCart(c echo.Context) error {
+	userId, authErr := wishlistController.CurrentUserId(c)
+	if authErr != nil {
+		return authErr
+	}
+	id, parseIdErr := wishlistController.ParseIdParam(c, "id")
+	if parseIdErr != nil {
+		return parseIdErr
+	}
+	itemId, parseItemIdErr := wishlistController.ParseIdParam(c, "item_id")
+	if parseItemIdErr != nil {
+		return parseItemIdErr
+	}
+	var moveRequest request.MoveWishlistItemToCartRequest
+	if bindErr := c.Bind(&moveRequest); bindErr != nil {
+		return bindErr
+	}
+	cartItem, serviceErr := wishlistController.wishlistService.MoveItemToCart(userId, id, itemId, moveRequest.ToModel())
+	if serviceErr != nil {
+		return serviceErr
+	}
+	return wishlistController.Success(c, cartItem, "Wishlist item moved to cart")
+}
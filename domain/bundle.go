@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+const (
+	BundlePricingFixed    = "fixed"
+	BundlePricingDiscount = "discount"
+)
+
+// ProductBundle turns a product into a set of other products or variants. A fixed bundle sells at FixedPrice,
+// a discount bundle at DiscountPercent off the list price of its components.
+type ProductBundle struct {
+	ProductId       int64
+	PricingMode     string
+	FixedPrice      *float64
+	DiscountPercent *float64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Components      []BundleComponent
+}
+
+// BundleComponent is a product or variant in a bundle with the units one set contains. Name, Sku, ListPrice
+// and StockQuantity are the component's current values.
+type BundleComponent struct {
+	Id            int64
+	BundleId      int64
+	ProductId     int64
+	VariantId     *int64
+	Quantity      int
+	Position      int
+	Name          string
+	Sku           *string
+	ListPrice     float64
+	StockQuantity int
+}
+
+// OrderItemComponent is the part of an ordered bundle that ships as a component. Quantity counts the units
+// of the whole order line; AllocatedPrice is the component's share of the price of one bundle.
+type OrderItemComponent struct {
+	Id             int64
+	OrderItemId    int64
+	ProductId      int64
+	VariantId      *int64
+	Quantity       int
+	AllocatedPrice float64
+}
@@ -2,8 +2,24 @@ package domain
 
 import "time"
 
+const (
+	CartStatusActive    = "active"
+	CartStatusAbandoned = "abandoned"
+)
+
 type Cart struct {
-	Id        int64
-	UserId    int64
-	CreatedAt time.Time
+	Id          int64
+	UserId      int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Status      string
+	AbandonedAt *time.Time
+	RecoveredAt *time.Time
+}
+
+type CartAbandonmentStat struct {
+	StoreId        uint
+	TotalCarts     int
+	AbandonedCarts int
+	RecoveredCarts int
 }
@@ -5,4 +5,5 @@ type CartItem struct {
 	CartId    int64
 	ProductId int64
 	Quantity  int
+	VariantId *int64
 }
@@ -1,8 +1,37 @@
 package domain
 
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
 type Category struct {
 	Id          uint
 	Name        string
 	Description string
 	IsActive    bool
+	ParentId    *uint
+	Path        string
+	SortOrder   int
+	DeletedAt   *time.Time
+}
+
+// PathIds returns the ids on the category's path, from the root down to the category itself.
+func (category Category) PathIds() []uint {
+	segments := strings.Split(strings.TrimSuffix(category.Path, "/"), "/")
+	ids := make([]uint, 0, len(segments))
+	for _, segment := range segments {
+		id, err := strconv.ParseUint(segment, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids
+}
+
+// Depth is the number of ancestors of the category; roots are at depth 0.
+func (category Category) Depth() int {
+	return strings.Count(category.Path, "/") - 1
 }
@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+const (
+	ProductTypePhysical = "physical"
+	ProductTypeDigital  = "digital"
+)
+
+const (
+	OrderStatusShipped   = "Shipped"
+	OrderStatusDelivered = "Delivered"
+)
+
+// DigitalFile is a file buyers of a digital product can download. Files are kept in private storage.
+type DigitalFile struct {
+	Id          int64
+	ProductId   int64
+	FileName    string
+	StorageKey  string
+	ContentType string
+	SizeBytes   int64
+	CreatedAt   time.Time
+}
+
+// LicenseKey is a key of a digital product's pool. A key is assigned to at most one order line.
+type LicenseKey struct {
+	Id          int64
+	ProductId   int64
+	Key         string
+	OrderItemId *int64
+	AssignedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+type LicenseKeyPool struct {
+	Available int
+	Assigned  int
+}
+
+// DownloadGrant lets the buyer of an order line download a file DownloadLimit times until ExpiresAt.
+type DownloadGrant struct {
+	Id               int64
+	OrderItemId      int64
+	FileId           int64
+	UserId           int64
+	DownloadCount    int
+	DownloadLimit    int
+	ExpiresAt        time.Time
+	LastDownloadedAt *time.Time
+	CreatedAt        time.Time
+	File             DigitalFile
+}
+
+func (grant DownloadGrant) DownloadsLeft() int {
+	return grant.DownloadLimit - grant.DownloadCount
+}
+
+// Available tells whether the grant can still be downloaded at the given time.
+func (grant DownloadGrant) Available(now time.Time) bool {
+	return grant.DownloadsLeft() > 0 && now.Before(grant.ExpiresAt)
+}
+
+// FulfillmentRun counts what a fulfillment of paid digital order lines handed out. WaitingLines are lines
+// still missing licence keys because their product's pool ran out.
+type FulfillmentRun struct {
+	Grants       int
+	Keys         int
+	WaitingLines int
+}
@@ -0,0 +1,92 @@
+package domain
+
+import "time"
+
+const (
+	StockMovementReceipt    = "receipt"
+	StockMovementSale       = "sale"
+	StockMovementReturn     = "return"
+	StockMovementAdjustment = "adjustment"
+	StockMovementTransfer   = "transfer"
+)
+
+type Warehouse struct {
+	Id        int64
+	Name      string
+	Code      string
+	Address   *string
+	IsDefault bool
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// StockLevel is the on-hand quantity of a product, or of one of its variants, at a warehouse.
+type StockLevel struct {
+	WarehouseId int64
+	ProductId   int64
+	VariantId   *int64
+	Quantity    int
+	UpdatedAt   time.Time
+}
+
+// StockMovement is an entry of the append-only stock ledger. Quantity is signed: receipts and returns are
+// positive, sales negative, and adjustments and transfers either way. BalanceAfter is the stock level of
+// the location after the movement was booked.
+type StockMovement struct {
+	Id           int64
+	WarehouseId  int64
+	ProductId    int64
+	VariantId    *int64
+	Type         string
+	Quantity     int
+	BalanceAfter int
+	Reason       *string
+	Reference    *string
+	CreatedBy    *int64
+	CreatedAt    time.Time
+}
+
+type StockMovementFilter struct {
+	ProductId   int64
+	WarehouseId *int64
+	Before      *int64
+	Limit       int
+}
+
+// StockThreshold is the low-stock threshold of a product or variant. AlertedAt is set once a low-stock alert
+// went out and cleared when the stock recovers above the threshold, so every crossing alerts once.
+type StockThreshold struct {
+	Id           int64
+	ProductId    int64
+	VariantId    *int64
+	Threshold    int
+	LeadTimeDays int
+	AlertedAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type LowStockItem struct {
+	ThresholdId   int64
+	ProductId     int64
+	VariantId     *int64
+	ProductName   string
+	Sku           *string
+	StoreId       uint
+	StockQuantity int
+	Threshold     int
+}
+
+// StockVelocity is the stock of a product or variant next to the units sold in the report window.
+type StockVelocity struct {
+	ProductId     int64
+	VariantId     *int64
+	ProductName   string
+	Sku           *string
+	StoreId       uint
+	StockQuantity int
+	Threshold     *int
+	LeadTimeDays  *int
+	UnitsSold     int
+}
@@ -2,6 +2,10 @@ package domain
 
 import "time"
 
+// PaidOrderStatuses are the lower cased order statuses of orders that were paid for. Digital order lines are
+// fulfilled and products can be reviewed once their order reaches one of them.
+var PaidOrderStatuses = []string{"paid", "shipped", "delivered"}
+
 type Order struct {
 	Id         int64
 	UserId     int64
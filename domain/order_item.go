@@ -1,9 +1,13 @@
 package domain
 
+import "time"
+
 type OrderItem struct {
 	Id        int64
 	OrderId   int64
 	ProductId int64
 	Quantity  int
 	Price     float32
+	CreatedAt time.Time
+	VariantId *int64
 }
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+const (
+	PriceRuleKindSale          = "sale"
+	PriceRuleKindCustomerGroup = "customer_group"
+)
+
+// CustomerGroupRetail is the group of new users and anonymous shoppers.
+const CustomerGroupRetail = "retail"
+
+// PriceRule takes a percentage off the selling price. Sales apply to everyone, customer group rules only to
+// users of their group. A rule is limited to a product, store or category when those are set, and to its
+// time window when StartsAt or EndsAt is set.
+type PriceRule struct {
+	Id            int64
+	Name          string
+	Kind          string
+	CustomerGroup *string
+	ProductId     *int64
+	StoreId       *uint
+	CategoryId    *uint
+	PercentOff    float64
+	StartsAt      *time.Time
+	EndsAt        *time.Time
+	IsActive      bool
+	CreatedAt     time.Time
+}
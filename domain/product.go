@@ -19,4 +19,17 @@ type Product struct {
 	StoreId         uint
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	Sku             *string
+	DeletedAt       *time.Time
+	AverageRating   float64
+	ReviewCount     int
+	Status          string
+	PublishAt       *time.Time
+	UnpublishAt     *time.Time
+	SubmittedAt     *time.Time
+	ReviewNote      string
+	ProductType     string
+	SubmittedBy     *int64
+	Variants        []ProductVariant
+	Attributes      []ProductAttributeValue
 }
@@ -0,0 +1,74 @@
+package domain
+
+import "time"
+
+const (
+	AttributeTypeText    = "text"
+	AttributeTypeNumber  = "number"
+	AttributeTypeEnum    = "enum"
+	AttributeTypeBoolean = "boolean"
+)
+
+// CategoryAttribute is a typed attribute of a category's schema. Products of the category store a value for it;
+// enum attributes only accept one of their options and number attributes carry an optional unit, e.g. inch.
+type CategoryAttribute struct {
+	Id           int64
+	CategoryId   int64
+	Code         string
+	Name         string
+	Type         string
+	Unit         string
+	Options      []string
+	IsRequired   bool
+	IsFilterable bool
+	Position     int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// ProductAttributeValue is a product's value of an attribute joined with the attribute's definition.
+// Only the field of the attribute's type is set.
+type ProductAttributeValue struct {
+	ProductId   int64
+	AttributeId int64
+	Code        string
+	Name        string
+	Type        string
+	Unit        string
+	TextValue   *string
+	NumberValue *float64
+	BoolValue   *bool
+}
+
+// Value returns the typed value: a string for text and enum, a float64 for number and a bool for boolean attributes.
+func (value ProductAttributeValue) Value() interface{} {
+	switch {
+	case value.NumberValue != nil:
+		return *value.NumberValue
+	case value.BoolValue != nil:
+		return *value.BoolValue
+	case value.TextValue != nil:
+		return *value.TextValue
+	default:
+		return nil
+	}
+}
+
+// AttributeFilter narrows a product listing to products with a matching attribute value. Values match text,
+// enum and boolean attributes; the bounds match number attributes.
+type AttributeFilter struct {
+	Code   string
+	Values []string
+	Min    *float64
+	Max    *float64
+}
+
+// AttributeFacet counts the products of a category per value of a filterable attribute. Number attributes
+// are summarized in a single facet with their value range instead.
+type AttributeFacet struct {
+	AttributeId int64
+	Value       string
+	MinNumber   *float64
+	MaxNumber   *float64
+	Count       int
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+const (
+	ProductSortNewest    = "newest"
+	ProductSortPriceAsc  = "price_asc"
+	ProductSortPriceDesc = "price_desc"
+	ProductSortName      = "name"
+	ProductSortRating    = "rating"
+)
+
+// ProductFilter describes a page of the product listing. Nil and empty fields are not filtered on. IsActive
+// follows the product status, so it selects the published products or all others.
+type ProductFilter struct {
+	StoreId     *uint
+	CategoryIds []uint
+	IsFeatured  *bool
+	IsActive    *bool
+	MinPrice    *float64
+	MaxPrice    *float64
+	InStock     bool
+	Attributes  []AttributeFilter
+	Sort        string
+	Limit       int
+	After       *ProductCursor
+}
+
+// ProductCursor is the sort key of the last product on the previous page (keyset pagination).
+type ProductCursor struct {
+	Id        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	Price     float64   `json:"price,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Rating    float64   `json:"rating,omitempty"`
+	Reviews   int       `json:"reviews,omitempty"`
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// ProductImage is an uploaded gallery image. The first image by position is the product's main image.
+type ProductImage struct {
+	Id          int64
+	ProductId   int64
+	StorageKey  string
+	Url         string
+	AltText     string
+	Position    int
+	ContentType string
+	Width       int
+	Height      int
+	SizeBytes   int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Renditions  []ImageRendition
+}
+
+// ImageRendition is a resized or re-encoded copy of a product image, such as a thumbnail or a WebP version.
+type ImageRendition struct {
+	ImageId     int64
+	Name        string
+	ContentType string
+	Width       int
+	Height      int
+	StorageKey  string
+	Url         string
+}
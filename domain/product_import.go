@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+const (
+	ImportStatusPending   = "pending"
+	ImportStatusRunning   = "running"
+	ImportStatusCompleted = "completed"
+	ImportStatusFailed    = "failed"
+
+	ImportRowCreated = "created"
+	ImportRowUpdated = "updated"
+	ImportRowFailed  = "failed"
+
+	ImportMatchBySku  = "sku"
+	ImportMatchBySlug = "slug"
+)
+
+type ProductImportJob struct {
+	Id            int64
+	FileName      string
+	Format        string
+	MatchBy       string
+	Status        string
+	TotalRows     int
+	ProcessedRows int
+	CreatedCount  int
+	UpdatedCount  int
+	FailedCount   int
+	Error         *string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	CompletedAt   *time.Time
+	CreatedBy     *int64
+}
+
+// ProductImportResult is the outcome of one row of an import file.
+type ProductImportResult struct {
+	Id        int64
+	JobId     int64
+	RowNumber int
+	Status    string
+	MatchKey  *string
+	ProductId *int64
+	Message   *string
+}
+
+// ProductUpsert is the outcome of writing one imported product.
+type ProductUpsert struct {
+	Product Product
+	Created bool
+	Err     error
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+const (
+	PriceChangeSourceManual   = "manual"
+	PriceChangeSourceImport   = "import"
+	PriceChangeSourceSchedule = "schedule"
+	PriceChangeSourceBundle   = "bundle"
+)
+
+const (
+	PriceScheduleStatusScheduled = "scheduled"
+	PriceScheduleStatusActive    = "active"
+	PriceScheduleStatusCompleted = "completed"
+	PriceScheduleStatusCancelled = "cancelled"
+)
+
+// PriceChange is one entry of a product's price history. ChangedBy is empty for changes that were not
+// made by an authenticated user.
+type PriceChange struct {
+	Id        int64
+	ProductId int64
+	Price     float64
+	BasePrice float64
+	Discount  float64
+	Source    string
+	ChangedBy *int64
+	ChangedAt time.Time
+}
+
+// PriceSchedule is a planned price change. The product gets the scheduled prices at StartsAt and goes
+// back to the prices it had before when EndsAt is reached; a schedule without EndsAt is permanent.
+type PriceSchedule struct {
+	Id                int64
+	ProductId         int64
+	Price             float64
+	BasePrice         float64
+	Discount          float64
+	StartsAt          time.Time
+	EndsAt            *time.Time
+	Status            string
+	PreviousPrice     *float64
+	PreviousBasePrice *float64
+	PreviousDiscount  *float64
+	CreatedBy         int64
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// PriceScheduleRun counts the schedules one pass of the scheduler started and finished.
+type PriceScheduleRun struct {
+	Started  int
+	Finished int
+}
@@ -0,0 +1,19 @@
+package domain
+
+const (
+	RelationSourceBoughtTogether = "bought_together"
+	RelationSourceCategory       = "category"
+)
+
+// RelatedProduct is a product recommended next to another one. Score is the number of orders the two
+// products were bought together in; category fallbacks score zero.
+type RelatedProduct struct {
+	Product Product
+	Source  string
+	Score   float64
+}
+
+type RelationRun struct {
+	BoughtTogether int
+	Category       int
+}
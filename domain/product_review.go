@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+const (
+	ReviewStatusPending  = "pending"
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+
+	ReviewSortNewest     = "newest"
+	ReviewSortHelpful    = "helpful"
+	ReviewSortRatingDesc = "rating_desc"
+	ReviewSortRatingAsc  = "rating_asc"
+)
+
+type ProductReview struct {
+	Id              int64
+	ProductId       int64
+	UserId          int64
+	Rating          int
+	Title           string
+	Body            string
+	Status          string
+	ModerationNote  *string
+	HelpfulCount    int
+	NotHelpfulCount int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	ModeratedAt     *time.Time
+	Photos          []ReviewPhoto
+}
+
+type ReviewPhoto struct {
+	Id        int64
+	ReviewId  int64
+	Url       string
+	SortOrder int
+}
+
+// ReviewRatingSummary aggregates the approved reviews of a product. Distribution maps a star rating
+// to the number of reviews with that rating.
+type ReviewRatingSummary struct {
+	AverageRating float64
+	ReviewCount   int
+	Distribution  map[int]int
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+const (
+	ProductStatusDraft         = "draft"
+	ProductStatusPendingReview = "pending_review"
+	ProductStatusPublished     = "published"
+	ProductStatusUnpublished   = "unpublished"
+)
+
+// ProductStatusChange is one entry of a product's lifecycle history. ChangedBy is empty for changes made by
+// the publish scheduler.
+type ProductStatusChange struct {
+	Id         int64
+	ProductId  int64
+	FromStatus string
+	ToStatus   string
+	Note       string
+	ChangedBy  *int64
+	ChangedAt  time.Time
+}
+
+// PublishSchedule holds the times a product is published and unpublished at. An empty time is not scheduled.
+type PublishSchedule struct {
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+}
+
+// ProductPublishRun counts the products one pass of the publish scheduler published and unpublished.
+type ProductPublishRun struct {
+	Published   int
+	Unpublished int
+}
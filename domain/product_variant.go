@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+type OptionType struct {
+	Id          int64
+	Name        string
+	DisplayName string
+	Values      []OptionValue
+}
+
+type OptionValue struct {
+	Id           int64
+	OptionTypeId int64
+	Value        string
+	SortOrder    int
+}
+
+type ProductVariant struct {
+	Id            int64
+	ProductId     int64
+	Sku           string
+	Price         *float64
+	StockQuantity int
+	Barcode       *string
+	ImageUrl      *string
+	IsActive      bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Options       []VariantOption
+}
+
+// VariantOption is a single option value of a variant joined with its option type, e.g. size=M.
+type VariantOption struct {
+	VariantId     int64
+	OptionTypeId  int64
+	OptionType    string
+	OptionValueId int64
+	Value         string
+}
+
+// EffectivePrice returns the variant price override or the given product price when the variant has none.
+func (variant ProductVariant) EffectivePrice(productPrice float64) float64 {
+	if variant.Price != nil {
+		return *variant.Price
+	}
+	return productPrice
+}
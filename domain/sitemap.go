@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+const (
+	SitemapProducts   = "products"
+	SitemapCategories = "categories"
+	SitemapStores     = "stores"
+)
+
+// SitemapPage is a generated sitemap file. Entities are bucketed into pages by id, so a catalog change only
+// touches the page of the changed entity. The fingerprint summarizes the page's entries and tells whether
+// the page has to be generated again.
+type SitemapPage struct {
+	EntityType   string
+	Page         int
+	Fingerprint  string
+	EntryCount   int
+	LastModified *time.Time
+	GeneratedAt  time.Time
+}
+
+// SitemapEntry is a live, active entity listed in a sitemap. Alternates holds the entity's slugs in the
+// translated locales that have their own slug.
+type SitemapEntry struct {
+	EntityId     int64
+	Slug         string
+	LastModified *time.Time
+	Alternates   map[string]string
+}
+
+type SitemapRun struct {
+	Generated int
+	Removed   int
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+const (
+	SlugEntityProduct = "product"
+	SlugEntityStore   = "store"
+)
+
+// SlugHistory is a slug an entity used before; it keeps old public URLs resolvable.
+type SlugHistory struct {
+	Id         int64
+	EntityType string
+	EntityId   int64
+	Slug       string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+const (
+	StockSubscriptionActive       = "active"
+	StockSubscriptionNotified     = "notified"
+	StockSubscriptionUnsubscribed = "unsubscribed"
+)
+
+// StockSubscription asks to be told once when an out-of-stock product or variant is back in stock.
+// Guests subscribe with an email only; signed-in users are linked by UserId.
+type StockSubscription struct {
+	Id         int64
+	ProductId  int64
+	VariantId  *int64
+	UserId     *int64
+	Email      string
+	Status     string
+	NotifiedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// RestockNotification is an active subscription whose product or variant has stock again.
+type RestockNotification struct {
+	SubscriptionId int64
+	ProductId      int64
+	VariantId      *int64
+	UserId         *int64
+	Email          string
+	ProductName    string
+	Slug           string
+	Sku            *string
+	StockQuantity  int
+}
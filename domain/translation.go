@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+// ProductTranslation is the content of a product in a locale other than the default one. The slug is
+// specific to the locale.
+type ProductTranslation struct {
+	ProductId       int64
+	Locale          string
+	Name            string
+	Slug            string
+	Description     string
+	MetaDescription string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type CategoryTranslation struct {
+	CategoryId  int64
+	Locale      string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type StoreTranslation struct {
+	StoreId     int64
+	Locale      string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Apply returns the product with its texts and slug replaced by the translation.
+func (translation ProductTranslation) Apply(product Product) Product {
+	product.Name = translation.Name
+	product.Slug = translation.Slug
+	product.Description = translation.Description
+	product.MetaDescription = translation.MetaDescription
+	return product
+}
+
+func (translation CategoryTranslation) Apply(category Category) Category {
+	category.Name = translation.Name
+	category.Description = translation.Description
+	return category
+}
+
+func (translation StoreTranslation) Apply(store Store) Store {
+	store.Description = translation.Description
+	return store
+}
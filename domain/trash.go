@@ -0,0 +1,8 @@
+package domain
+
+// TrashPurge counts the trashed records a purge run removed for good.
+type TrashPurge struct {
+	Products   int64
+	Stores     int64
+	Categories int64
+}
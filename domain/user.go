@@ -3,10 +3,21 @@ package domain
 import "time"
 
 type User struct {
-	Id           int64
-	FirstName    string
-	LastName     string
-	Email        string
-	PasswordHash string
-	CreatedAt    time.Time
+	Id            int64
+	FirstName     string
+	LastName      string
+	Email         string
+	PasswordHash  string
+	CreatedAt     time.Time
+	CustomerGroup string
+	Role          string
 }
+
+const (
+	UserRoleCustomer  = "customer"
+	UserRoleModerator = "moderator"
+	UserRoleAdmin     = "admin"
+)
+
+// ReviewerRoles are the roles that may review products and moderate customer reviews.
+var ReviewerRoles = []string{UserRoleModerator, UserRoleAdmin}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+type Wishlist struct {
+	Id         int64
+	UserId     int64
+	Name       string
+	IsPublic   bool
+	ShareToken *string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type WishlistItem struct {
+	Id                int64
+	WishlistId        int64
+	ProductId         int64
+	VariantId         *int64
+	AddedPrice        float64
+	LastNotifiedPrice *float64
+	CreatedAt         time.Time
+}
+
+type WishlistPriceDrop struct {
+	WishlistItemId int64
+	WishlistId     int64
+	UserId         int64
+	ProductId      int64
+	ProductName    string
+	PreviousPrice  float64
+	CurrentPrice   float64
+}
@@ -1,12 +1,29 @@
 package rabbitmq
 
 import (
+	"encoding/json"
 	"fmt"
 	"go-ecommerce-service/config"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+const (
+	OrderCreatedQueue         = "order_created_queue"
+	CartAbandonedQueue        = "cart_abandoned_queue"
+	WishlistPriceDroppedQueue = "wishlist_price_dropped_queue"
+	StockLowQueue             = "stock_low_queue"
+	BackInStockQueue          = "back_in_stock_queue"
+)
+
+var declaredQueues = []string{
+	OrderCreatedQueue,
+	CartAbandonedQueue,
+	WishlistPriceDroppedQueue,
+	StockLowQueue,
+	BackInStockQueue,
+}
+
 type IRabbitMQClient interface {
 	Publish(exchange, routingKey string, mandatory, immediate bool, msg amqp.Publishing) error
 }
@@ -28,16 +45,18 @@ func NewRabbitMQClient(cfg config.RabbitMQConfig) (*RabbitMQClient, error) {
 		return nil, fmt.Errorf("Failed to open a channel: %v", err)
 	}
 
-	_, err = ch.QueueDeclare(
-		"order_created_queue",
-		true,
-		false,
-		false,
-		false,
-		nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("Failed to declare a queue: %v", err)
+	for _, queue := range declaredQueues {
+		_, err = ch.QueueDeclare(
+			queue,
+			true,
+			false,
+			false,
+			false,
+			nil,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to declare a queue: %v", err)
+		}
 	}
 	return &RabbitMQClient{conn, ch}, nil
 }
@@ -54,3 +73,15 @@ func (rc *RabbitMQClient) Close() {
 func (rc *RabbitMQClient) Publish(exchange, routingKey string, mandatory, immediate bool, msg amqp.Publishing) error {
 	return rc.Channel.Publish(exchange, routingKey, mandatory, immediate, msg)
 }
+
+// PublishJSON marshals the payload and publishes it to the given queue on the default exchange.
+func PublishJSON(client IRabbitMQClient, queue string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return client.Publish("", queue, false, false, amqp.Publishing{
+		ContentType: "application/json",
+		Body:        body,
+	})
+}
@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage keeps files on the local filesystem; the API serves the root directory as static files.
+type LocalStorage struct {
+	rootDir string
+	baseUrl string
+}
+
+func NewLocalStorage(rootDir string, baseUrl string) (*LocalStorage, error) {
+	if err := os.MkdirAll(rootDir, 0o755); err != nil {
+		return nil, fmt.Errorf("Failed to create storage directory: %v", err)
+	}
+	return &LocalStorage{rootDir: rootDir, baseUrl: baseUrl}, nil
+}
+
+func (ls *LocalStorage) Put(key string, content []byte, contentType string) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	// Write to a temporary file first so a half written file is never served.
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, content, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tempPath, path)
+}
+
+func (ls *LocalStorage) PutStream(key string, content io.Reader, size int64, contentType string) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tempPath := path + ".tmp"
+	tempFile, err := os.OpenFile(tempPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	written, copyErr := io.Copy(tempFile, content)
+	if closeErr := tempFile.Close(); copyErr == nil {
+		copyErr = closeErr
+	}
+	if copyErr == nil && written != size {
+		copyErr = fmt.Errorf("Stored %d of %d bytes of %s", written, size, key)
+	}
+	if copyErr != nil {
+		os.Remove(tempPath)
+		return copyErr
+	}
+	return os.Rename(tempPath, path)
+}
+
+func (ls *LocalStorage) Open(key string) (io.ReadCloser, error) {
+	path, err := ls.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (ls *LocalStorage) Delete(key string) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (ls *LocalStorage) List(prefix string) ([]StoredObject, error) {
+	objects := []StoredObject{}
+	err := filepath.WalkDir(ls.rootDir, func(path string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relative, err := filepath.Rel(ls.rootDir, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(relative)
+		if !strings.HasPrefix(key, prefix) || strings.HasSuffix(key, ".tmp") {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, StoredObject{Key: key, Size: info.Size(), ModifiedAt: info.ModTime()})
+		return nil
+	})
+	return objects, err
+}
+
+func (ls *LocalStorage) URL(key string) string {
+	return joinUrl(ls.baseUrl, key)
+}
+
+func (ls *LocalStorage) RootDir() string {
+	return ls.rootDir
+}
+
+// path resolves the key inside the root directory and rejects keys that would escape it.
+func (ls *LocalStorage) path(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if cleaned == "/" {
+		return "", fmt.Errorf("Invalid storage key: %s", key)
+	}
+	return filepath.Join(ls.rootDir, cleaned), nil
+}
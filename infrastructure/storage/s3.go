@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go-ecommerce-service/config"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Storage keeps files in a bucket of an S3 compatible object store.
+type S3Storage struct {
+	client  *minio.Client
+	bucket  string
+	baseUrl string
+}
+
+func NewS3Storage(cfg config.StorageConfig) (*S3Storage, error) {
+	client, err := minio.New(cfg.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.S3AccessKey, cfg.S3SecretKey, ""),
+		Secure: cfg.S3UseSSL,
+		Region: cfg.S3Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create S3 client: %v", err)
+	}
+
+	ctx := context.Background()
+	exists, err := client.BucketExists(ctx, cfg.S3Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to check S3 bucket: %v", err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, cfg.S3Bucket, minio.MakeBucketOptions{Region: cfg.S3Region}); err != nil {
+			return nil, fmt.Errorf("Failed to create S3 bucket: %v", err)
+		}
+	}
+
+	baseUrl := cfg.S3PublicBaseUrl
+	if baseUrl == "" {
+		baseUrl = joinUrl(client.EndpointURL().String(), cfg.S3Bucket)
+	}
+	return &S3Storage{client: client, bucket: cfg.S3Bucket, baseUrl: baseUrl}, nil
+}
+
+func (ss *S3Storage) Put(key string, content []byte, contentType string) error {
+	_, err := ss.client.PutObject(context.Background(), ss.bucket, key, bytes.NewReader(content), int64(len(content)),
+		minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (ss *S3Storage) PutStream(key string, content io.Reader, size int64, contentType string) error {
+	_, err := ss.client.PutObject(context.Background(), ss.bucket, key, content, size,
+		minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (ss *S3Storage) Open(key string) (io.ReadCloser, error) {
+	object, err := ss.client.GetObject(context.Background(), ss.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject is lazy; reading the object info surfaces a missing object before the caller starts streaming.
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, err
+	}
+	return object, nil
+}
+
+func (ss *S3Storage) Delete(key string) error {
+	return ss.client.RemoveObject(context.Background(), ss.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (ss *S3Storage) List(prefix string) ([]StoredObject, error) {
+	objects := []StoredObject{}
+	for object := range ss.client.ListObjects(context.Background(), ss.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		objects = append(objects, StoredObject{Key: object.Key, Size: object.Size, ModifiedAt: object.LastModified})
+	}
+	return objects, nil
+}
+
+func (ss *S3Storage) URL(key string) string {
+	return joinUrl(ss.baseUrl, key)
+}
@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"fmt"
+	"go-ecommerce-service/config"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+type StoredObject struct {
+	Key        string
+	Size       int64
+	ModifiedAt time.Time
+}
+
+// IObjectStorage stores media files under slash separated keys and tells the public URL they are served from.
+type IObjectStorage interface {
+	Put(key string, content []byte, contentType string) error
+	// PutStream stores size bytes read from content without holding the file in memory.
+	PutStream(key string, content io.Reader, size int64, contentType string) error
+	// Open returns a reader of the stored file; the caller closes it.
+	Open(key string) (io.ReadCloser, error)
+	Delete(key string) error
+	List(prefix string) ([]StoredObject, error)
+	URL(key string) string
+}
+
+func NewObjectStorage(cfg config.StorageConfig) (IObjectStorage, error) {
+	switch strings.ToLower(cfg.Driver) {
+	case "", DriverLocal:
+		return NewLocalStorage(cfg.LocalDir, cfg.PublicBaseUrl)
+	case DriverS3:
+		return NewS3Storage(cfg)
+	default:
+		return nil, fmt.Errorf("Unknown storage driver: %s", cfg.Driver)
+	}
+}
+
+func joinUrl(baseUrl string, key string) string {
+	return strings.TrimRight(baseUrl, "/") + "/" + strings.TrimLeft(key, "/")
+}
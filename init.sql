@@ -1,6 +1,43 @@
+DROP TABLE IF EXISTS download_grants;
+DROP TABLE IF EXISTS store_owners;
+DROP TABLE IF EXISTS license_keys;
+DROP TABLE IF EXISTS digital_files;
+DROP TABLE IF EXISTS order_item_components;
+DROP TABLE IF EXISTS bundle_components;
+DROP TABLE IF EXISTS product_bundles;
+DROP TABLE IF EXISTS sitemap_pages;
+DROP TABLE IF EXISTS store_translations;
+DROP TABLE IF EXISTS category_translations;
+DROP TABLE IF EXISTS product_translations;
+DROP TABLE IF EXISTS product_status_changes;
+DROP TABLE IF EXISTS product_attribute_values;
+DROP TABLE IF EXISTS category_attributes;
+DROP TABLE IF EXISTS product_image_renditions;
+DROP TABLE IF EXISTS product_images;
+DROP TABLE IF EXISTS stock_subscriptions;
+DROP TABLE IF EXISTS stock_thresholds;
+DROP TABLE IF EXISTS stock_movements;
+DROP TABLE IF EXISTS stock_levels;
+DROP TABLE IF EXISTS warehouses;
+DROP TABLE IF EXISTS product_relations;
+DROP TABLE IF EXISTS price_rules;
+DROP TABLE IF EXISTS product_price_schedules;
+DROP TABLE IF EXISTS product_price_history;
+DROP TABLE IF EXISTS review_votes;
+DROP TABLE IF EXISTS review_photos;
+DROP TABLE IF EXISTS product_reviews;
+DROP TABLE IF EXISTS product_import_results;
+DROP TABLE IF EXISTS product_import_jobs;
+DROP TABLE IF EXISTS slug_history;
+DROP TABLE IF EXISTS wishlist_items;
+DROP TABLE IF EXISTS wishlists;
 DROP TABLE IF EXISTS order_items;
 DROP TABLE IF EXISTS orders;
 DROP TABLE IF EXISTS cart_items;
+DROP TABLE IF EXISTS product_variant_options;
+DROP TABLE IF EXISTS product_variants;
+DROP TABLE IF EXISTS option_values;
+DROP TABLE IF EXISTS option_types;
 DROP TABLE IF EXISTS carts;
 DROP TABLE IF EXISTS products;
 DROP TABLE IF EXISTS stores;
@@ -8,17 +45,29 @@ DROP TABLE IF EXISTS categories;
 DROP TABLE IF EXISTS users;
 
 
+-- Categories form a tree. path holds the ids from the root down to the category itself, e.g. '1/4/9/',
+-- so a subtree is every category whose path starts with the path of its root.
 CREATE TABLE IF NOT EXISTS categories(
     id BIGSERIAL NOT NULL PRIMARY KEY,
-    name VARCHAR(255) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
     description TEXT,
-    is_active BOOLEAN DEFAULT true
+    is_active BOOLEAN DEFAULT true,
+    parent_id BIGINT,
+    path TEXT NOT NULL,
+    sort_order INT NOT NULL DEFAULT 0,
+    deleted_at TIMESTAMP,
+    FOREIGN KEY (parent_id) REFERENCES categories(id)
     );
 
+-- Names are only unique among live rows so a trashed category does not block its name.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_name_live ON categories(name) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories(parent_id);
+CREATE INDEX IF NOT EXISTS idx_categories_path ON categories(path text_pattern_ops);
+
 
 CREATE TABLE IF NOT EXISTS stores(
     id BIGSERIAL NOT NULL PRIMARY KEY,
-    name VARCHAR(255) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
     slug VARCHAR(255) NOT NULL UNIQUE,
     description TEXT,
     logo_url VARCHAR(500),
@@ -27,9 +76,12 @@ CREATE TABLE IF NOT EXISTS stores(
     contact_address TEXT,
     is_active BOOLEAN DEFAULT true,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
     );
 
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stores_name_live ON stores(name) WHERE deleted_at IS NULL;
+
 
 CREATE TABLE IF NOT EXISTS users (
     id BIGSERIAL NOT NULL PRIMARY KEY,
@@ -37,7 +89,22 @@ CREATE TABLE IF NOT EXISTS users (
     last_name VARCHAR(255) NOT NULL,
     email VARCHAR(255) NOT NULL UNIQUE,
     password_hash VARCHAR(255) NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    customer_group VARCHAR(30) DEFAULT 'retail' NOT NULL,
+    -- Moderators review products and customer reviews; admins can also manage the trash. Roles are granted in the
+    -- database and take effect at the user's next login.
+    role VARCHAR(20) DEFAULT 'customer' NOT NULL CHECK (role IN ('customer', 'moderator', 'admin'))
+    );
+
+-- Users that manage a store's catalog, e.g. the files and licence keys of its digital products. Owners are
+-- assigned by admins.
+CREATE TABLE IF NOT EXISTS store_owners (
+    store_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (store_id, user_id),
+    FOREIGN KEY (store_id) REFERENCES stores(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
     );
 
 
@@ -58,25 +125,98 @@ CREATE TABLE IF NOT EXISTS products (
     store_id BIGINT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    sku VARCHAR(100) UNIQUE,
+    deleted_at TIMESTAMP,
+    average_rating DECIMAL(3,2) DEFAULT 0 NOT NULL,
+    review_count INTEGER DEFAULT 0 NOT NULL,
+    status VARCHAR(20) DEFAULT 'published' NOT NULL CHECK (status IN ('draft', 'pending_review', 'published', 'unpublished')),
+    publish_at TIMESTAMP,
+    unpublish_at TIMESTAMP,
+    submitted_at TIMESTAMP,
+    review_note VARCHAR(1000) DEFAULT '' NOT NULL,
+    product_type VARCHAR(20) DEFAULT 'physical' NOT NULL CHECK (product_type IN ('physical', 'digital')),
+    submitted_by BIGINT,
+    CHECK (price >= 0 AND base_price >= 0 AND discount >= 0 AND discount <= base_price),
+    -- is_active is kept as the visibility flag the rest of the schema filters on; only published products are visible.
+    CHECK (is_active = (status = 'published')),
     FOREIGN KEY (category_id) REFERENCES categories(id),
-    FOREIGN KEY (store_id) REFERENCES stores(id)
+    FOREIGN KEY (store_id) REFERENCES stores(id),
+    FOREIGN KEY (submitted_by) REFERENCES users(id)
     );
 
+CREATE INDEX IF NOT EXISTS idx_products_created_at_id ON products(created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_products_price_id ON products(price, id);
+CREATE INDEX IF NOT EXISTS idx_products_name_id ON products(name, id);
+CREATE INDEX IF NOT EXISTS idx_products_store_id ON products(store_id);
+CREATE INDEX IF NOT EXISTS idx_products_category_id ON products(category_id);
+CREATE INDEX IF NOT EXISTS idx_products_rating_id ON products(average_rating DESC, review_count DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_products_deleted_at ON products(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_products_review_queue ON products(submitted_at, id) WHERE status = 'pending_review';
+CREATE INDEX IF NOT EXISTS idx_products_publish_at ON products(publish_at) WHERE publish_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_products_unpublish_at ON products(unpublish_at) WHERE unpublish_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS option_types (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    display_name VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS option_values (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    option_type_id BIGINT NOT NULL,
+    value VARCHAR(100) NOT NULL,
+    sort_order INT DEFAULT 0 NOT NULL,
+    UNIQUE (option_type_id, value),
+    FOREIGN KEY (option_type_id) REFERENCES option_types(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS product_variants (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    sku VARCHAR(100) NOT NULL UNIQUE,
+    price DECIMAL(10,2),
+    stock_quantity INTEGER DEFAULT 0 NOT NULL,
+    barcode VARCHAR(100),
+    image_url VARCHAR(500),
+    is_active BOOLEAN DEFAULT true NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_variants_product_id ON product_variants(product_id);
+
+CREATE TABLE IF NOT EXISTS product_variant_options (
+    variant_id BIGINT NOT NULL,
+    option_value_id BIGINT NOT NULL,
+    PRIMARY KEY (variant_id, option_value_id),
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id) ON DELETE CASCADE,
+    FOREIGN KEY (option_value_id) REFERENCES option_values(id)
+);
+
 
 CREATE TABLE IF NOT EXISTS carts (
     id BIGSERIAL NOT NULL PRIMARY KEY,
     user_id BIGINT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    status VARCHAR(20) DEFAULT 'active' NOT NULL,
+    abandoned_at TIMESTAMP,
+    recovered_at TIMESTAMP,
     FOREIGN KEY (user_id) REFERENCES users(id)
 );
 
+CREATE INDEX IF NOT EXISTS idx_carts_status_updated_at ON carts(status, updated_at);
+
 CREATE TABLE IF NOT EXISTS cart_items (
     id BIGSERIAL NOT NULL PRIMARY KEY,
     cart_id BIGINT NOT NULL,
     product_id BIGINT NOT NULL,
     quantity INT NOT NULL,
+    variant_id BIGINT,
     FOREIGN KEY (cart_id) REFERENCES carts(id),
-    FOREIGN KEY (product_id) REFERENCES products(id)
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id)
     );
 
 
@@ -96,13 +236,525 @@ CREATE TABLE IF NOT EXISTS order_items (
     product_id BIGINT NOT NULL,
     quantity INT NOT NULL,
     price DECIMAL(10,2) NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    variant_id BIGINT,
     FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE,
-    FOREIGN KEY (product_id) REFERENCES products(id)
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id)
     );
 
+CREATE TABLE IF NOT EXISTS wishlists (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    is_public BOOLEAN DEFAULT false NOT NULL,
+    share_token VARCHAR(64) UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS wishlist_items (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    wishlist_id BIGINT NOT NULL,
+    product_id BIGINT NOT NULL,
+    added_price DECIMAL(10,2) NOT NULL,
+    last_notified_price DECIMAL(10,2),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    variant_id BIGINT,
+    FOREIGN KEY (wishlist_id) REFERENCES wishlists(id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wishlist_items_item ON wishlist_items(wishlist_id, product_id, (COALESCE(variant_id, 0)));
+
+CREATE TABLE IF NOT EXISTS slug_history (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id BIGINT NOT NULL,
+    slug VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (entity_type, slug)
+);
+
+CREATE TABLE IF NOT EXISTS product_import_jobs (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    file_name VARCHAR(255) NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    match_by VARCHAR(10) NOT NULL,
+    status VARCHAR(20) DEFAULT 'pending' NOT NULL,
+    total_rows INT DEFAULT 0 NOT NULL,
+    processed_rows INT DEFAULT 0 NOT NULL,
+    created_count INT DEFAULT 0 NOT NULL,
+    updated_count INT DEFAULT 0 NOT NULL,
+    failed_count INT DEFAULT 0 NOT NULL,
+    error TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP,
+    created_by BIGINT,
+    FOREIGN KEY (created_by) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS product_import_results (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    job_id BIGINT NOT NULL,
+    row_number INT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    match_key VARCHAR(255),
+    product_id BIGINT,
+    message TEXT,
+    FOREIGN KEY (job_id) REFERENCES product_import_jobs(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_import_results_job_id ON product_import_results(job_id, row_number);
+
+CREATE TABLE IF NOT EXISTS product_reviews (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(20) DEFAULT 'pending' NOT NULL,
+    moderation_note TEXT,
+    helpful_count INT DEFAULT 0 NOT NULL,
+    not_helpful_count INT DEFAULT 0 NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    moderated_at TIMESTAMP,
+    UNIQUE (product_id, user_id),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_reviews_product_status ON product_reviews(product_id, status, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_product_reviews_status_created_at ON product_reviews(status, created_at);
+
+CREATE TABLE IF NOT EXISTS review_photos (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    review_id BIGINT NOT NULL,
+    url VARCHAR(500) NOT NULL,
+    sort_order INT DEFAULT 0 NOT NULL,
+    FOREIGN KEY (review_id) REFERENCES product_reviews(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS review_votes (
+    review_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    is_helpful BOOLEAN NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (review_id, user_id),
+    FOREIGN KEY (review_id) REFERENCES product_reviews(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS product_price_history (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    price DECIMAL(10,2) NOT NULL,
+    base_price DECIMAL(10,2) NOT NULL,
+    discount DECIMAL(10,2) NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    changed_by BIGINT,
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_price_history_product_changed_at ON product_price_history(product_id, changed_at DESC);
+
+CREATE TABLE IF NOT EXISTS product_price_schedules (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    price DECIMAL(10,2) NOT NULL,
+    base_price DECIMAL(10,2) NOT NULL,
+    discount DECIMAL(10,2) NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP,
+    status VARCHAR(20) DEFAULT 'scheduled' NOT NULL,
+    previous_price DECIMAL(10,2),
+    previous_base_price DECIMAL(10,2),
+    previous_discount DECIMAL(10,2),
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CHECK (ends_at IS NULL OR ends_at > starts_at),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_price_schedules_status_starts_at ON product_price_schedules(status, starts_at);
+CREATE INDEX IF NOT EXISTS idx_product_price_schedules_product_id ON product_price_schedules(product_id);
+
+CREATE TABLE IF NOT EXISTS price_rules (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    customer_group VARCHAR(30),
+    product_id BIGINT,
+    store_id BIGINT,
+    category_id BIGINT,
+    percent_off DECIMAL(5,2) NOT NULL CHECK (percent_off > 0 AND percent_off <= 100),
+    starts_at TIMESTAMP,
+    ends_at TIMESTAMP,
+    is_active BOOLEAN DEFAULT TRUE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CHECK (kind <> 'customer_group' OR customer_group IS NOT NULL),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (store_id) REFERENCES stores(id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_rules_active ON price_rules(is_active, kind);
+
+CREATE TABLE IF NOT EXISTS product_relations (
+    product_id BIGINT NOT NULL,
+    related_product_id BIGINT NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    score DECIMAL(10,2) DEFAULT 0 NOT NULL,
+    position INT NOT NULL,
+    computed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (product_id, related_product_id),
+    CHECK (product_id <> related_product_id),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (related_product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_relations_product_position ON product_relations(product_id, position);
+CREATE INDEX IF NOT EXISTS idx_order_items_order_product ON order_items(order_id, product_id);
+
+CREATE TABLE IF NOT EXISTS warehouses (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    code VARCHAR(20) NOT NULL UNIQUE,
+    address TEXT,
+    is_default BOOLEAN DEFAULT FALSE NOT NULL,
+    is_active BOOLEAN DEFAULT TRUE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CHECK (NOT is_default OR is_active)
+);
+
+-- Exactly one warehouse takes the stock given on product creation and imports.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_warehouses_default ON warehouses(is_default) WHERE is_default;
+
+CREATE TABLE IF NOT EXISTS stock_levels (
+    warehouse_id BIGINT NOT NULL,
+    product_id BIGINT NOT NULL,
+    variant_id BIGINT,
+    quantity INT DEFAULT 0 NOT NULL CHECK (quantity >= 0),
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (warehouse_id) REFERENCES warehouses(id),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_levels_location ON stock_levels(warehouse_id, product_id, (COALESCE(variant_id, 0)));
+CREATE INDEX IF NOT EXISTS idx_stock_levels_product_id ON stock_levels(product_id);
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    warehouse_id BIGINT NOT NULL,
+    product_id BIGINT NOT NULL,
+    variant_id BIGINT,
+    movement_type VARCHAR(20) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity <> 0),
+    balance_after INT NOT NULL,
+    reason VARCHAR(255),
+    reference VARCHAR(100),
+    created_by BIGINT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (warehouse_id) REFERENCES warehouses(id),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_product_id ON stock_movements(product_id, id DESC);
+
+CREATE TABLE IF NOT EXISTS stock_thresholds (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    variant_id BIGINT,
+    threshold INT NOT NULL CHECK (threshold >= 0),
+    lead_time_days INT DEFAULT 7 NOT NULL CHECK (lead_time_days >= 0),
+    alerted_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_thresholds_item ON stock_thresholds(product_id, (COALESCE(variant_id, 0)));
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders(created_at);
+
+CREATE TABLE IF NOT EXISTS stock_subscriptions (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    variant_id BIGINT,
+    user_id BIGINT,
+    email VARCHAR(255) NOT NULL,
+    status VARCHAR(20) DEFAULT 'active' NOT NULL CHECK (status IN ('active', 'notified', 'unsubscribed')),
+    notified_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_subscriptions_item_email ON stock_subscriptions(product_id, (COALESCE(variant_id, 0)), (LOWER(email)));
+CREATE INDEX IF NOT EXISTS idx_stock_subscriptions_active ON stock_subscriptions(product_id) WHERE status = 'active';
+CREATE INDEX IF NOT EXISTS idx_stock_subscriptions_user_id ON stock_subscriptions(user_id);
+CREATE INDEX IF NOT EXISTS idx_stock_subscriptions_notified ON stock_subscriptions((LOWER(email)), notified_at);
+
+CREATE TABLE IF NOT EXISTS product_images (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    storage_key VARCHAR(500) NOT NULL UNIQUE,
+    url VARCHAR(1000) NOT NULL,
+    alt_text VARCHAR(255) DEFAULT '' NOT NULL,
+    position INT DEFAULT 0 NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_images_product_id ON product_images(product_id, position);
+
+CREATE TABLE IF NOT EXISTS product_image_renditions (
+    image_id BIGINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    storage_key VARCHAR(500) NOT NULL UNIQUE,
+    url VARCHAR(1000) NOT NULL,
+    PRIMARY KEY (image_id, name),
+    FOREIGN KEY (image_id) REFERENCES product_images(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS category_attributes (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    category_id BIGINT NOT NULL,
+    code VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('text', 'number', 'enum', 'boolean')),
+    unit VARCHAR(20) DEFAULT '' NOT NULL,
+    options TEXT[] DEFAULT '{}' NOT NULL,
+    is_required BOOLEAN DEFAULT false NOT NULL,
+    is_filterable BOOLEAN DEFAULT false NOT NULL,
+    position INT DEFAULT 0 NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (category_id, code),
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+-- Exactly one value column is set, matching the attribute's type: enum values are stored as text.
+CREATE TABLE IF NOT EXISTS product_attribute_values (
+    product_id BIGINT NOT NULL,
+    attribute_id BIGINT NOT NULL,
+    text_value VARCHAR(500),
+    number_value DECIMAL(14,4),
+    bool_value BOOLEAN,
+    PRIMARY KEY (product_id, attribute_id),
+    CHECK (num_nonnulls(text_value, number_value, bool_value) = 1),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (attribute_id) REFERENCES category_attributes(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_attribute_values_text ON product_attribute_values(attribute_id, text_value) WHERE text_value IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_product_attribute_values_number ON product_attribute_values(attribute_id, number_value) WHERE number_value IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_product_attribute_values_bool ON product_attribute_values(attribute_id, bool_value) WHERE bool_value IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS product_status_changes (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    note VARCHAR(1000) DEFAULT '' NOT NULL,
+    changed_by BIGINT,
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_status_changes_product_changed_at ON product_status_changes(product_id, changed_at DESC);
+
+-- Content in the default locale lives on the entities; these tables hold the other locales.
+CREATE TABLE IF NOT EXISTS product_translations (
+    product_id BIGINT NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) NOT NULL,
+    description TEXT DEFAULT '' NOT NULL,
+    meta_description VARCHAR(300) DEFAULT '' NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (product_id, locale),
+    UNIQUE (locale, slug),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_translations_slug ON product_translations(slug);
+
+CREATE TABLE IF NOT EXISTS category_translations (
+    category_id BIGINT NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT DEFAULT '' NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (category_id, locale),
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS store_translations (
+    store_id BIGINT NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    description TEXT DEFAULT '' NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (store_id, locale),
+    FOREIGN KEY (store_id) REFERENCES stores(id) ON DELETE CASCADE
+);
+
+-- Generated sitemap files, regenerated page by page when the fingerprint of a page's entries changes.
+CREATE TABLE IF NOT EXISTS sitemap_pages (
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('products', 'categories', 'stores')),
+    page INT NOT NULL CHECK (page > 0),
+    fingerprint VARCHAR(32) NOT NULL,
+    entry_count INT NOT NULL,
+    last_modified TIMESTAMP,
+    generated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    content TEXT NOT NULL,
+    PRIMARY KEY (entity_type, page)
+);
+
+-- A bundle is a product sold as a set of other products or variants. It is priced from its components and
+-- has no stock of its own: its stock quantity is the number of complete sets the component stock allows.
+CREATE TABLE IF NOT EXISTS product_bundles (
+    product_id BIGINT NOT NULL PRIMARY KEY,
+    pricing_mode VARCHAR(20) NOT NULL CHECK (pricing_mode IN ('fixed', 'discount')),
+    fixed_price DECIMAL(10,2) CHECK (fixed_price >= 0),
+    discount_percent DECIMAL(5,2) CHECK (discount_percent >= 0 AND discount_percent < 100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS bundle_components (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    bundle_id BIGINT NOT NULL,
+    product_id BIGINT NOT NULL,
+    variant_id BIGINT,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    position INT NOT NULL DEFAULT 0,
+    FOREIGN KEY (bundle_id) REFERENCES product_bundles(product_id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bundle_components_item ON bundle_components(bundle_id, product_id, (COALESCE(variant_id, 0)));
+CREATE INDEX IF NOT EXISTS idx_bundle_components_product ON bundle_components(product_id, variant_id);
+
+-- Breakdown of an ordered bundle: the component units shipped and the share of the bundle price each carries.
+CREATE TABLE IF NOT EXISTS order_item_components (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    order_item_id BIGINT NOT NULL,
+    product_id BIGINT NOT NULL,
+    variant_id BIGINT,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    allocated_price DECIMAL(10,2) NOT NULL,
+    FOREIGN KEY (order_item_id) REFERENCES order_items(id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (variant_id) REFERENCES product_variants(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_item_components_order_item ON order_item_components(order_item_id);
+
+-- Files of digital products live in private storage and are only handed out through signed download links.
+CREATE TABLE IF NOT EXISTS digital_files (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    storage_key VARCHAR(500) NOT NULL UNIQUE,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_digital_files_product ON digital_files(product_id);
+
+-- A key handed out stays assigned even when its order line is removed, so it is never sold twice.
+CREATE TABLE IF NOT EXISTS license_keys (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    license_key VARCHAR(255) NOT NULL,
+    order_item_id BIGINT,
+    assigned_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (product_id, license_key),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (order_item_id) REFERENCES order_items(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_license_keys_available ON license_keys(product_id, id) WHERE assigned_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_license_keys_order_item ON license_keys(order_item_id);
+
+-- A grant lets the buyer of an order line download one file of the product a limited number of times until it expires.
+CREATE TABLE IF NOT EXISTS download_grants (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    order_item_id BIGINT NOT NULL,
+    file_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    download_count INT DEFAULT 0 NOT NULL,
+    download_limit INT NOT NULL CHECK (download_limit > 0),
+    expires_at TIMESTAMP NOT NULL,
+    last_downloaded_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (order_item_id, file_id),
+    CHECK (download_count <= download_limit),
+    FOREIGN KEY (order_item_id) REFERENCES order_items(id) ON DELETE CASCADE,
+    FOREIGN KEY (file_id) REFERENCES digital_files(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_download_grants_user ON download_grants(user_id);
+
+-- The ledger is append-only: corrections are booked as new adjustment movements.
+CREATE OR REPLACE FUNCTION reject_stock_movement_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'stock movements are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS stock_movements_append_only ON stock_movements;
+CREATE TRIGGER stock_movements_append_only BEFORE UPDATE OF warehouse_id, product_id, variant_id, movement_type, quantity, balance_after, reason, reference
+    ON stock_movements FOR EACH ROW EXECUTE FUNCTION reject_stock_movement_update();
+
 -- Test Data
 INSERT INTO users (first_name, last_name, email, password_hash) VALUES ('Test', 'User', 'test@user.com', 'hash');
 INSERT INTO stores (name, slug, description) VALUES ('TeknoStore', 'tekno-store', 'Teknoloji Mağazası');
-INSERT INTO categories (name, description) VALUES ('Elektronik', 'Elektronik Eşyalar');
-INSERT INTO products (name, slug, price, base_price, stock_quantity, store_id, category_id) VALUES ('Laptop', 'laptop-001', 15000.00, 15000.00, 100, 1, 1);
\ No newline at end of file
+INSERT INTO categories (name, description, path) VALUES ('Elektronik', 'Elektronik Eşyalar', '1/');
+INSERT INTO products (name, slug, price, base_price, stock_quantity, store_id, category_id) VALUES ('Laptop', 'laptop-001', 15000.00, 15000.00, 100, 1, 1);
+INSERT INTO product_price_history (product_id, price, base_price, discount, source) VALUES (1, 15000.00, 15000.00, 0, 'manual');
+INSERT INTO category_attributes (category_id, code, name, type, unit, is_filterable, position) VALUES (1, 'screen_size', 'Ekran Boyutu', 'number', 'inch', true, 0);
+INSERT INTO category_attributes (category_id, code, name, type, options, is_filterable, position) VALUES (1, 'color', 'Renk', 'enum', '{Siyah,Gri,Beyaz}', true, 1);
+INSERT INTO product_attribute_values (product_id, attribute_id, number_value) VALUES (1, 1, 15.6);
+INSERT INTO product_attribute_values (product_id, attribute_id, text_value) VALUES (1, 2, 'Gri');
+INSERT INTO product_translations (product_id, locale, name, slug, description) VALUES (1, 'en', 'Laptop', 'laptop-001-en', '');
+INSERT INTO category_translations (category_id, locale, name, description) VALUES (1, 'en', 'Electronics', 'Electronic devices');
+INSERT INTO store_translations (store_id, locale, description) VALUES (1, 'en', 'Technology store');
+INSERT INTO warehouses (name, code, address, is_default) VALUES ('İstanbul Depo', 'IST', 'Tuzla, İstanbul', true);
+INSERT INTO warehouses (name, code, address) VALUES ('Ankara Depo', 'ANK', 'Sincan, Ankara');
+INSERT INTO stock_levels (warehouse_id, product_id, quantity) VALUES (1, 1, 100);
+INSERT INTO stock_movements (warehouse_id, product_id, movement_type, quantity, balance_after, reason) VALUES (1, 1, 'receipt', 100, 100, 'Initial stock');
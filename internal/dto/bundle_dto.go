@@ -0,0 +1,43 @@
+package dto
+
+import "time"
+
+// BundleResponse shows a bundle with the price it sells at, the list price of its components and the number
+// of complete sets in stock.
+type BundleResponse struct {
+	ProductId       int64                     `json:"product_id"`
+	PricingMode     string                    `json:"pricing_mode"`
+	FixedPrice      *float64                  `json:"fixed_price,omitempty"`
+	DiscountPercent *float64                  `json:"discount_percent,omitempty"`
+	Price           float64                   `json:"price"`
+	ListPrice       float64                   `json:"list_price"`
+	Savings         float64                   `json:"savings"`
+	StockQuantity   int                       `json:"stock_quantity"`
+	Components      []BundleComponentResponse `json:"components"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+}
+
+type BundleComponentResponse struct {
+	ProductId     int64   `json:"product_id"`
+	VariantId     *int64  `json:"variant_id,omitempty"`
+	Name          string  `json:"name"`
+	Sku           *string `json:"sku,omitempty"`
+	Quantity      int     `json:"quantity"`
+	UnitPrice     float64 `json:"unit_price"`
+	StockQuantity int     `json:"stock_quantity"`
+}
+
+// SaveBundleRequest makes the product a bundle of the components or replaces its contents. Fixed bundles need
+// a fixed price, discount bundles a discount percent off the components' list price.
+type SaveBundleRequest struct {
+	PricingMode     string                   `json:"pricing_mode" validate:"required,oneof=fixed discount"`
+	FixedPrice      *float64                 `json:"fixed_price" validate:"omitempty,gte=0"`
+	DiscountPercent *float64                 `json:"discount_percent" validate:"omitempty,gte=0,lt=100"`
+	Components      []BundleComponentRequest `json:"components" validate:"required,min=1,max=20,dive"`
+}
+
+type BundleComponentRequest struct {
+	ProductId int64  `json:"product_id" validate:"required,gt=0"`
+	VariantId *int64 `json:"variant_id"`
+	Quantity  int    `json:"quantity" validate:"required,min=1,max=100"`
+}
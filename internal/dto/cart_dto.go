@@ -5,9 +5,43 @@ import "time"
 type CartResponse struct {
 	Id        int64     `json:"id"`
 	UserId    int64     `json:"user_id"`
+	Status    string    `json:"status"`
 	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type CreateCartRequest struct {
 	UserId int64 `json:"user_id"`
 }
+
+type RecoveredCartResponse struct {
+	Cart  CartResponse       `json:"cart"`
+	Items []CartItemResponse `json:"items"`
+}
+
+type CartAbandonmentStatResponse struct {
+	StoreId         uint    `json:"store_id"`
+	TotalCarts      int     `json:"total_carts"`
+	AbandonedCarts  int     `json:"abandoned_carts"`
+	RecoveredCarts  int     `json:"recovered_carts"`
+	AbandonmentRate float64 `json:"abandonment_rate"`
+	RecoveryRate    float64 `json:"recovery_rate"`
+}
+
+type CartLineResponse struct {
+	CartItemId int64   `json:"cart_item_id"`
+	ProductId  int64   `json:"product_id"`
+	VariantId  *int64  `json:"variant_id,omitempty"`
+	Quantity   int     `json:"quantity"`
+	BasePrice  float64 `json:"base_price"`
+	UnitPrice  float64 `json:"unit_price"`
+	LineTotal  float64 `json:"line_total"`
+}
+
+// CartTotalsResponse prices the cart for its owner. Lines of products that are no longer sold are left out.
+type CartTotalsResponse struct {
+	CartId   int64              `json:"cart_id"`
+	Lines    []CartLineResponse `json:"lines"`
+	Subtotal float64            `json:"subtotal"`
+	Savings  float64            `json:"savings"`
+}
@@ -1,14 +1,16 @@
 package dto
 
 type CartItemResponse struct {
-	Id        int64 `json:"id"`
-	CartId    int64 `json:"cart_id"`
-	ProductId int64 `json:"product_id"`
-	Quantity  int   `json:"quantity"`
+	Id        int64  `json:"id"`
+	CartId    int64  `json:"cart_id"`
+	ProductId int64  `json:"product_id"`
+	VariantId *int64 `json:"variant_id,omitempty"`
+	Quantity  int    `json:"quantity"`
 }
 
 type CreateCartItemRequest struct {
-	CartId    int64 `json:"cart_id"`
-	ProductId int64 `json:"product_id"`
-	Quantity  int   `json:"quantity"`
+	CartId    int64  `json:"cart_id"`
+	ProductId int64  `json:"product_id"`
+	VariantId *int64 `json:"variant_id,omitempty"`
+	Quantity  int    `json:"quantity"`
 }
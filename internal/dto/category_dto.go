@@ -1,13 +1,45 @@
 package dto
 
+import "time"
+
 type CategoryResponse struct {
-	Id          uint   `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	Id          uint       `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	ParentId    *uint      `json:"parent_id"`
+	SortOrder   int        `json:"sort_order"`
+	Depth       int        `json:"depth"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// CategoryTreeResponse is a category with its subcategories nested below it, in sort order.
+type CategoryTreeResponse struct {
+	Id          uint                   `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	IsActive    bool                   `json:"is_active"`
+	SortOrder   int                    `json:"sort_order"`
+	Children    []CategoryTreeResponse `json:"children"`
 }
 
+// CategoryBreadcrumb is one step on the path from a root category down to a product's category.
+type CategoryBreadcrumb struct {
+	Id   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// CreateCategoryRequest carries the fields of a category. ParentId is only read when the category is created;
+// existing categories change parents through a move.
 type CreateCategoryRequest struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 	IsActive    bool   `json:"is_active"`
+	ParentId    *uint  `json:"parent_id"`
+	SortOrder   int    `json:"sort_order" validate:"gte=0"`
+}
+
+// MoveCategoryRequest puts a category under ParentId, or at the root when ParentId is empty.
+type MoveCategoryRequest struct {
+	ParentId  *uint `json:"parent_id"`
+	SortOrder int   `json:"sort_order" validate:"gte=0"`
 }
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"io"
+	"time"
+)
+
+type DigitalFileResponse struct {
+	Id          int64     `json:"id"`
+	ProductId   int64     `json:"product_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	SizeBytes   int64     `json:"size_bytes"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type UploadDigitalFileRequest struct {
+	FileName    string `validate:"required,max=255"`
+	ContentType string
+	Content     io.Reader `validate:"required"`
+	Size        int64     `validate:"gt=0"`
+}
+
+type AddLicenseKeysRequest struct {
+	Keys []string `json:"keys" validate:"required,min=1,max=1000,dive,max=255"`
+}
+
+// LicenseKeyPoolResponse counts the keys of a product's pool. Added is the number of new keys of an upload;
+// keys already in the pool are skipped.
+type LicenseKeyPoolResponse struct {
+	ProductId int64 `json:"product_id"`
+	Added     int   `json:"added,omitempty"`
+	Available int   `json:"available"`
+	Assigned  int   `json:"assigned"`
+}
+
+// OrderDownloadsResponse lists what the buyer of an order gets for its digital lines. Download URLs are signed
+// and short lived; downloads without downloads left or past their expiry come without a URL.
+type OrderDownloadsResponse struct {
+	OrderId     int64                `json:"order_id"`
+	Downloads   []DownloadResponse   `json:"downloads"`
+	LicenseKeys []LicenseKeyResponse `json:"license_keys"`
+}
+
+type DownloadResponse struct {
+	OrderItemId   int64      `json:"order_item_id"`
+	ProductId     int64      `json:"product_id"`
+	FileId        int64      `json:"file_id"`
+	FileName      string     `json:"file_name"`
+	SizeBytes     int64      `json:"size_bytes"`
+	DownloadsLeft int        `json:"downloads_left"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	Url           string     `json:"url,omitempty"`
+	UrlExpiresAt  *time.Time `json:"url_expires_at,omitempty"`
+}
+
+type LicenseKeyResponse struct {
+	OrderItemId int64      `json:"order_item_id"`
+	ProductId   int64      `json:"product_id"`
+	LicenseKey  string     `json:"license_key"`
+	AssignedAt  *time.Time `json:"assigned_at"`
+}
+
+// DownloadFile streams the content of a downloaded file; the reader is closed once the file is served.
+type DownloadFile struct {
+	FileName    string
+	ContentType string
+	Content     io.ReadCloser
+}
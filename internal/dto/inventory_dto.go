@@ -0,0 +1,142 @@
+package dto
+
+import "time"
+
+type WarehouseResponse struct {
+	Id        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Code      string    `json:"code"`
+	Address   *string   `json:"address,omitempty"`
+	IsDefault bool      `json:"is_default"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CreateWarehouseRequest struct {
+	Name      string  `json:"name" validate:"required,max=255"`
+	Code      string  `json:"code" validate:"required,alphanum,max=20"`
+	Address   *string `json:"address"`
+	IsDefault bool    `json:"is_default"`
+	IsActive  bool    `json:"is_active"`
+}
+
+type StockLevelResponse struct {
+	WarehouseId   int64     `json:"warehouse_id"`
+	WarehouseCode string    `json:"warehouse_code"`
+	VariantId     *int64    `json:"variant_id,omitempty"`
+	Quantity      int       `json:"quantity"`
+	IsSellable    bool      `json:"is_sellable"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type VariantStockResponse struct {
+	VariantId       int64 `json:"variant_id"`
+	AvailableToSell int   `json:"available_to_sell"`
+}
+
+// ProductStockResponse shows the stock of a product per location. AvailableToSell counts the active
+// warehouses only; the product's own stock and every variant's stock are reported separately.
+type ProductStockResponse struct {
+	ProductId       int64                  `json:"product_id"`
+	AvailableToSell int                    `json:"available_to_sell"`
+	Variants        []VariantStockResponse `json:"variants"`
+	Locations       []StockLevelResponse   `json:"locations"`
+}
+
+type StockMovementResponse struct {
+	Id           int64     `json:"id"`
+	WarehouseId  int64     `json:"warehouse_id"`
+	ProductId    int64     `json:"product_id"`
+	VariantId    *int64    `json:"variant_id,omitempty"`
+	Type         string    `json:"type"`
+	Quantity     int       `json:"quantity"`
+	BalanceAfter int       `json:"balance_after"`
+	Reason       *string   `json:"reason,omitempty"`
+	Reference    *string   `json:"reference,omitempty"`
+	CreatedBy    *int64    `json:"created_by,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type StockMovementListRequest struct {
+	WarehouseId *int64 `json:"warehouse_id"`
+	Before      *int64 `json:"before"`
+	Limit       int    `json:"limit"`
+}
+
+// CreateStockMovementRequest books a movement at one warehouse. Receipts, returns and sales take a positive
+// quantity, adjustments a signed one.
+type CreateStockMovementRequest struct {
+	WarehouseId int64   `json:"warehouse_id" validate:"required"`
+	VariantId   *int64  `json:"variant_id"`
+	Type        string  `json:"type" validate:"required"`
+	Quantity    int     `json:"quantity"`
+	Reason      *string `json:"reason" validate:"omitempty,max=255"`
+	Reference   *string `json:"reference" validate:"omitempty,max=100"`
+}
+
+type StockTransferRequest struct {
+	FromWarehouseId int64   `json:"from_warehouse_id" validate:"required"`
+	ToWarehouseId   int64   `json:"to_warehouse_id" validate:"required"`
+	VariantId       *int64  `json:"variant_id"`
+	Quantity        int     `json:"quantity" validate:"gt=0"`
+	Reason          *string `json:"reason" validate:"omitempty,max=255"`
+}
+
+type StockThresholdResponse struct {
+	Id           int64      `json:"id"`
+	ProductId    int64      `json:"product_id"`
+	VariantId    *int64     `json:"variant_id,omitempty"`
+	Threshold    int        `json:"threshold"`
+	LeadTimeDays int        `json:"lead_time_days"`
+	AlertedAt    *time.Time `json:"alerted_at,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type SetStockThresholdRequest struct {
+	VariantId    *int64 `json:"variant_id"`
+	Threshold    int    `json:"threshold" validate:"gte=0"`
+	LeadTimeDays *int   `json:"lead_time_days" validate:"omitempty,gte=0,lte=365"`
+}
+
+type LowStockItemResponse struct {
+	ProductId     int64   `json:"product_id"`
+	VariantId     *int64  `json:"variant_id,omitempty"`
+	ProductName   string  `json:"product_name"`
+	Sku           *string `json:"sku,omitempty"`
+	StoreId       uint    `json:"store_id"`
+	StockQuantity int     `json:"stock_quantity"`
+	Threshold     int     `json:"threshold"`
+}
+
+type ReorderReportRequest struct {
+	StoreId   *uint `json:"store_id"`
+	Days      int   `json:"days"`
+	CoverDays int   `json:"cover_days"`
+}
+
+// ReorderSuggestionResponse estimates how long the stock lasts at the recent sales velocity. Once the stock is
+// at or below the reorder point, the suggested quantity covers the lead time and the target cover days on top
+// of the threshold.
+type ReorderSuggestionResponse struct {
+	ProductId         int64    `json:"product_id"`
+	VariantId         *int64   `json:"variant_id,omitempty"`
+	ProductName       string   `json:"product_name"`
+	Sku               *string  `json:"sku,omitempty"`
+	StoreId           uint     `json:"store_id"`
+	StockQuantity     int      `json:"stock_quantity"`
+	Threshold         int      `json:"threshold"`
+	LeadTimeDays      int      `json:"lead_time_days"`
+	UnitsSold         int      `json:"units_sold"`
+	DailySales        float64  `json:"daily_sales"`
+	DaysOfCover       *float64 `json:"days_of_cover"`
+	ReorderPoint      int      `json:"reorder_point"`
+	SuggestedQuantity int      `json:"suggested_quantity"`
+}
+
+type ReorderReportResponse struct {
+	Days        int                         `json:"days"`
+	CoverDays   int                         `json:"cover_days"`
+	GeneratedAt time.Time                   `json:"generated_at"`
+	Items       []ReorderSuggestionResponse `json:"items"`
+}
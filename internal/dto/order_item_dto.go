@@ -4,13 +4,27 @@ type OrderItemResponse struct {
 	Id        int64   `json:"id"`
 	OrderId   int64   `json:"order_id"`
 	ProductId int64   `json:"product_id"`
+	VariantId *int64  `json:"variant_id,omitempty"`
 	Quantity  int     `json:"quantity"`
 	Price     float32 `json:"price"`
+	// Components breaks an ordered bundle down into the units that ship.
+	Components []OrderItemComponentResponse `json:"components,omitempty"`
 }
 
+// OrderItemComponentResponse is a component of an ordered bundle. Quantity counts the units of the whole line;
+// AllocatedPrice is the component's share of the price of one bundle.
+type OrderItemComponentResponse struct {
+	ProductId      int64   `json:"product_id"`
+	VariantId      *int64  `json:"variant_id,omitempty"`
+	Quantity       int     `json:"quantity"`
+	AllocatedPrice float64 `json:"allocated_price"`
+}
+
+// CreateOrderItemRequest adds a product to an order. The unit price is not taken from the client; it is
+// priced for the order's customer.
 type CreateOrderItemRequest struct {
-	OrderId   int64   `json:"order_id"`
-	ProductId int64   `json:"product_id"`
-	Quantity  int     `json:"quantity"`
-	Price     float32 `json:"price"`
+	OrderId   int64  `json:"order_id"`
+	ProductId int64  `json:"product_id"`
+	VariantId *int64 `json:"variant_id,omitempty"`
+	Quantity  int    `json:"quantity"`
 }
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+type PriceRuleResponse struct {
+	Id            int64      `json:"id"`
+	Name          string     `json:"name"`
+	Kind          string     `json:"kind"`
+	CustomerGroup *string    `json:"customer_group,omitempty"`
+	ProductId     *int64     `json:"product_id,omitempty"`
+	StoreId       *uint      `json:"store_id,omitempty"`
+	CategoryId    *uint      `json:"category_id,omitempty"`
+	PercentOff    float64    `json:"percent_off"`
+	StartsAt      *time.Time `json:"starts_at,omitempty"`
+	EndsAt        *time.Time `json:"ends_at,omitempty"`
+	IsActive      bool       `json:"is_active"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type CreatePriceRuleRequest struct {
+	Name          string     `json:"name" validate:"required,max=255"`
+	Kind          string     `json:"kind"`
+	CustomerGroup *string    `json:"customer_group" validate:"omitempty,max=30"`
+	ProductId     *int64     `json:"product_id"`
+	StoreId       *uint      `json:"store_id"`
+	CategoryId    *uint      `json:"category_id"`
+	PercentOff    float64    `json:"percent_off"`
+	StartsAt      *time.Time `json:"starts_at"`
+	EndsAt        *time.Time `json:"ends_at"`
+	IsActive      bool       `json:"is_active"`
+}
+
+type CustomerGroupRequest struct {
+	CustomerGroup string `json:"customer_group" validate:"required,max=30"`
+}
+
+// PriceQuoteResponse breaks the price of one unit down into the discounts that make it up.
+type PriceQuoteResponse struct {
+	ProductId       int64   `json:"product_id"`
+	VariantId       *int64  `json:"variant_id,omitempty"`
+	CustomerGroup   string  `json:"customer_group"`
+	BasePrice       float64 `json:"base_price"`
+	ProductDiscount float64 `json:"product_discount"`
+	SaleDiscount    float64 `json:"sale_discount"`
+	GroupDiscount   float64 `json:"group_discount"`
+	Price           float64 `json:"price"`
+	AppliedRuleIds  []int64 `json:"applied_rule_ids"`
+}
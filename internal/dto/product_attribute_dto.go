@@ -0,0 +1,65 @@
+package dto
+
+import "time"
+
+type CategoryAttributeResponse struct {
+	Id           int64     `json:"id"`
+	CategoryId   int64     `json:"category_id"`
+	Code         string    `json:"code"`
+	Name         string    `json:"name"`
+	Type         string    `json:"type"`
+	Unit         string    `json:"unit,omitempty"`
+	Options      []string  `json:"options,omitempty"`
+	IsRequired   bool      `json:"is_required"`
+	IsFilterable bool      `json:"is_filterable"`
+	Position     int       `json:"position"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type CreateCategoryAttributeRequest struct {
+	Code         string   `json:"code" validate:"required,max=100"`
+	Name         string   `json:"name" validate:"required,max=255"`
+	Type         string   `json:"type" validate:"required,oneof=text number enum boolean"`
+	Unit         string   `json:"unit" validate:"max=20"`
+	Options      []string `json:"options"`
+	IsRequired   bool     `json:"is_required"`
+	IsFilterable bool     `json:"is_filterable"`
+	Position     int      `json:"position" validate:"gte=0"`
+}
+
+type UpdateCategoryAttributeRequest struct {
+	Name         string   `json:"name" validate:"required,max=255"`
+	Unit         string   `json:"unit" validate:"max=20"`
+	Options      []string `json:"options"`
+	IsRequired   bool     `json:"is_required"`
+	IsFilterable bool     `json:"is_filterable"`
+	Position     int      `json:"position" validate:"gte=0"`
+}
+
+// ProductAttributeResponse is a product's attribute value. Value is a string, number or boolean by type.
+type ProductAttributeResponse struct {
+	Code  string      `json:"code"`
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Unit  string      `json:"unit,omitempty"`
+	Value interface{} `json:"value"`
+}
+
+type AttributeFacetValueResponse struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// AttributeFacetResponse lists the values of a filterable attribute with their product counts. Number
+// attributes report their value range instead.
+type AttributeFacetResponse struct {
+	Code   string                        `json:"code"`
+	Name   string                        `json:"name"`
+	Type   string                        `json:"type"`
+	Unit   string                        `json:"unit,omitempty"`
+	Values []AttributeFacetValueResponse `json:"values,omitempty"`
+	Min    *float64                      `json:"min,omitempty"`
+	Max    *float64                      `json:"max,omitempty"`
+	Count  int                           `json:"count"`
+}
@@ -3,35 +3,80 @@ package dto
 import "time"
 
 type ProductResponse struct {
-	Id              uint      `json:"id"`
-	Name            string    `json:"name"`
-	Slug            string    `json:"slug"`
-	Description     string    `json:"description"`
-	Price           float64   `json:"price"`
-	BasePrice       float64   `json:"base_price"`
-	Discount        float64   `json:"discount"`
-	ImageUrl        string    `json:"image_url"`
-	MetaDescription string    `json:"meta_description"`
-	StockQuantity   int       `json:"stock_quantity"`
-	IsActive        bool      `json:"is_active"`
-	IsFeatured      bool      `json:"is_featured"`
-	CategoryId      *uint     `json:"category_id"`
-	StoreId         uint      `json:"store_id"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	Id              uint                       `json:"id"`
+	Name            string                     `json:"name"`
+	Slug            string                     `json:"slug"`
+	Sku             *string                    `json:"sku,omitempty"`
+	Description     string                     `json:"description"`
+	Price           float64                    `json:"price"`
+	BasePrice       float64                    `json:"base_price"`
+	Discount        float64                    `json:"discount"`
+	ImageUrl        string                     `json:"image_url"`
+	MetaDescription string                     `json:"meta_description"`
+	StockQuantity   int                        `json:"stock_quantity"`
+	IsActive        bool                       `json:"is_active"`
+	IsFeatured      bool                       `json:"is_featured"`
+	CategoryId      *uint                      `json:"category_id"`
+	Breadcrumbs     []CategoryBreadcrumb       `json:"breadcrumbs,omitempty"`
+	StoreId         uint                       `json:"store_id"`
+	CreatedAt       time.Time                  `json:"created_at"`
+	UpdatedAt       time.Time                  `json:"updated_at"`
+	Variants        []ProductVariantResponse   `json:"variants,omitempty"`
+	DeletedAt       *time.Time                 `json:"deleted_at,omitempty"`
+	AverageRating   float64                    `json:"average_rating"`
+	ReviewCount     int                        `json:"review_count"`
+	Attributes      []ProductAttributeResponse `json:"attributes,omitempty"`
+	Status          string                     `json:"status"`
+	PublishAt       *time.Time                 `json:"publish_at,omitempty"`
+	UnpublishAt     *time.Time                 `json:"unpublish_at,omitempty"`
+	SubmittedAt     *time.Time                 `json:"submitted_at,omitempty"`
+	ReviewNote      string                     `json:"review_note,omitempty"`
+	ProductType     string                     `json:"product_type"`
 }
 
+// CreateProductRequest carries the fields of a product. IsActive is only read by imports; products saved
+// through the product endpoints change visibility through the publishing workflow.
 type CreateProductRequest struct {
-	Name            string  `json:"name" validate:"required"`
-	Description     string  `json:"description" validate:"required"`
-	Price           float64 `json:"price"`
-	BasePrice       float64 `json:"base_price"`
-	Discount        float64 `json:"discount"`
-	ImageUrl        string  `json:"image_url"`
-	MetaDescription string  `json:"meta_description"`
-	StockQuantity   int     `json:"stock_quantity"`
-	IsActive        bool    `json:"is_active"`
-	IsFeatured      bool    `json:"is_featured"`
-	CategoryId      *uint   `json:"category_id"`
-	StoreId         uint    `json:"store_id"`
+	Name            string                 `json:"name" validate:"required"`
+	Slug            string                 `json:"slug"`
+	Sku             *string                `json:"sku"`
+	Description     string                 `json:"description" validate:"required"`
+	Price           float64                `json:"price"`
+	BasePrice       float64                `json:"base_price"`
+	Discount        float64                `json:"discount"`
+	ImageUrl        string                 `json:"image_url"`
+	MetaDescription string                 `json:"meta_description"`
+	StockQuantity   int                    `json:"stock_quantity" validate:"gte=0"`
+	IsActive        bool                   `json:"is_active"`
+	IsFeatured      bool                   `json:"is_featured"`
+	CategoryId      *uint                  `json:"category_id"`
+	StoreId         uint                   `json:"store_id"`
+	Attributes      map[string]interface{} `json:"attributes"`
+	ProductType     string                 `json:"product_type" validate:"omitempty,oneof=physical digital"`
+}
+
+type ProductListRequest struct {
+	StoreId    *uint             `json:"store_id"`
+	CategoryId *uint             `json:"category_id"`
+	IsFeatured *bool             `json:"is_featured"`
+	MinPrice   *float64          `json:"min_price"`
+	MaxPrice   *float64          `json:"max_price"`
+	InStock    bool              `json:"in_stock"`
+	Attributes map[string]string `json:"attributes"`
+	Sort       string            `json:"sort"`
+	Cursor     string            `json:"cursor"`
+	Limit      int               `json:"limit"`
+	Locale     string            `json:"locale"`
+}
+
+type PageResponse struct {
+	Limit      int    `json:"limit"`
+	Total      int    `json:"total"`
+	HasMore    bool   `json:"has_more"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type ProductPageResponse struct {
+	Items []ProductResponse `json:"items"`
+	Page  PageResponse      `json:"page"`
 }
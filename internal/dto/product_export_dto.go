@@ -0,0 +1,13 @@
+package dto
+
+type ProductExportRequest struct {
+	Format     string `json:"format"`
+	StoreId    *uint  `json:"store_id"`
+	CategoryId *uint  `json:"category_id"`
+	IsActive   *bool  `json:"is_active"`
+}
+
+type ProductExportFile struct {
+	ContentType string `json:"content_type"`
+	FileName    string `json:"file_name"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+type ProductImageResponse struct {
+	Id          int64                    `json:"id"`
+	ProductId   int64                    `json:"product_id"`
+	Url         string                   `json:"url"`
+	AltText     string                   `json:"alt_text"`
+	Position    int                      `json:"position"`
+	ContentType string                   `json:"content_type"`
+	Width       int                      `json:"width"`
+	Height      int                      `json:"height"`
+	SizeBytes   int64                    `json:"size_bytes"`
+	Renditions  []ImageRenditionResponse `json:"renditions"`
+	CreatedAt   time.Time                `json:"created_at"`
+}
+
+type ImageRenditionResponse struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Url         string `json:"url"`
+}
+
+type UploadProductImageRequest struct {
+	FileName string
+	Content  []byte `validate:"required"`
+	AltText  string `validate:"max=255"`
+}
+
+type UpdateProductImageRequest struct {
+	AltText string `json:"alt_text" validate:"max=255"`
+}
+
+type ReorderProductImagesRequest struct {
+	ImageIds []int64 `json:"image_ids" validate:"required,min=1"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+type ProductImportOptions struct {
+	Format         string            `json:"format"`
+	MatchBy        string            `json:"match_by"`
+	DefaultStoreId *uint             `json:"default_store_id"`
+	Mapping        map[string]string `json:"mapping"`
+}
+
+type ProductImportJobResponse struct {
+	Id            int64      `json:"id"`
+	FileName      string     `json:"file_name"`
+	Format        string     `json:"format"`
+	MatchBy       string     `json:"match_by"`
+	Status        string     `json:"status"`
+	TotalRows     int        `json:"total_rows"`
+	ProcessedRows int        `json:"processed_rows"`
+	Progress      float64    `json:"progress"`
+	CreatedCount  int        `json:"created_count"`
+	UpdatedCount  int        `json:"updated_count"`
+	FailedCount   int        `json:"failed_count"`
+	Error         *string    `json:"error,omitempty"`
+	ReportUrl     string     `json:"report_url"`
+	CreatedAt     time.Time  `json:"created_at"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+}
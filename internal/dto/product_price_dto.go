@@ -0,0 +1,49 @@
+package dto
+
+import "time"
+
+type PriceChangeResponse struct {
+	Id        int64     `json:"id"`
+	ProductId int64     `json:"product_id"`
+	Price     float64   `json:"price"`
+	BasePrice float64   `json:"base_price"`
+	Discount  float64   `json:"discount"`
+	Source    string    `json:"source"`
+	ChangedBy *int64    `json:"changed_by,omitempty"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// LowestPriceResponse carries the lowest price of the disclosure window next to the current price.
+type LowestPriceResponse struct {
+	ProductId    int64     `json:"product_id"`
+	CurrentPrice float64   `json:"current_price"`
+	LowestPrice  float64   `json:"lowest_price"`
+	Days         int       `json:"days"`
+	Since        time.Time `json:"since"`
+}
+
+type PriceScheduleResponse struct {
+	Id                int64      `json:"id"`
+	ProductId         int64      `json:"product_id"`
+	Price             float64    `json:"price"`
+	BasePrice         float64    `json:"base_price"`
+	Discount          float64    `json:"discount"`
+	StartsAt          time.Time  `json:"starts_at"`
+	EndsAt            *time.Time `json:"ends_at,omitempty"`
+	Status            string     `json:"status"`
+	PreviousPrice     *float64   `json:"previous_price,omitempty"`
+	PreviousBasePrice *float64   `json:"previous_base_price,omitempty"`
+	PreviousDiscount  *float64   `json:"previous_discount,omitempty"`
+	CreatedBy         int64      `json:"created_by"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// CreatePriceScheduleRequest plans a price change. A zero base price keeps the product's current base price;
+// the price fields are resolved against each other like the ones of a product.
+type CreatePriceScheduleRequest struct {
+	Price     float64    `json:"price" validate:"gte=0"`
+	BasePrice float64    `json:"base_price" validate:"gte=0"`
+	Discount  float64    `json:"discount" validate:"gte=0"`
+	StartsAt  time.Time  `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at"`
+}
@@ -0,0 +1,55 @@
+package dto
+
+import "time"
+
+type ReviewResponse struct {
+	Id              int64      `json:"id"`
+	ProductId       int64      `json:"product_id"`
+	UserId          int64      `json:"user_id"`
+	Rating          int        `json:"rating"`
+	Title           string     `json:"title"`
+	Body            string     `json:"body"`
+	Status          string     `json:"status"`
+	ModerationNote  *string    `json:"moderation_note,omitempty"`
+	HelpfulCount    int        `json:"helpful_count"`
+	NotHelpfulCount int        `json:"not_helpful_count"`
+	PhotoUrls       []string   `json:"photo_urls"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ModeratedAt     *time.Time `json:"moderated_at,omitempty"`
+}
+
+type RatingSummaryResponse struct {
+	AverageRating float64     `json:"average_rating"`
+	ReviewCount   int         `json:"review_count"`
+	Distribution  map[int]int `json:"distribution"`
+}
+
+type ProductReviewsResponse struct {
+	Summary RatingSummaryResponse `json:"summary"`
+	Reviews []ReviewResponse      `json:"reviews"`
+	Limit   int                   `json:"limit"`
+	Offset  int                   `json:"offset"`
+	HasMore bool                  `json:"has_more"`
+}
+
+type ReviewListRequest struct {
+	Sort   string `json:"sort"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+}
+
+type CreateReviewRequest struct {
+	Rating    int      `json:"rating" validate:"required,min=1,max=5"`
+	Title     string   `json:"title" validate:"required,max=255"`
+	Body      string   `json:"body" validate:"required,max=5000"`
+	PhotoUrls []string `json:"photo_urls" validate:"max=5,dive,url"`
+}
+
+type ModerateReviewRequest struct {
+	Status string  `json:"status"`
+	Note   *string `json:"note"`
+}
+
+type ReviewVoteRequest struct {
+	Helpful bool `json:"helpful"`
+}
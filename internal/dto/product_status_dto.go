@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+type ProductStatusChangeResponse struct {
+	Id         int64     `json:"id"`
+	ProductId  int64     `json:"product_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Note       string    `json:"note,omitempty"`
+	ChangedBy  *int64    `json:"changed_by,omitempty"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+// ReviewProductRequest carries the reviewer's note. A rejection needs a note so the vendor knows what to fix.
+type ReviewProductRequest struct {
+	Note string `json:"note" validate:"max=1000"`
+}
+
+// ProductScheduleRequest sets when the product goes live and when it is taken down again. An empty time
+// clears that part of the schedule.
+type ProductScheduleRequest struct {
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+}
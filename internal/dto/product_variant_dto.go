@@ -0,0 +1,58 @@
+package dto
+
+import "time"
+
+type OptionValueResponse struct {
+	Id        int64  `json:"id"`
+	Value     string `json:"value"`
+	SortOrder int    `json:"sort_order"`
+}
+
+type OptionTypeResponse struct {
+	Id          int64                 `json:"id"`
+	Name        string                `json:"name"`
+	DisplayName string                `json:"display_name"`
+	Values      []OptionValueResponse `json:"values"`
+}
+
+type VariantOptionResponse struct {
+	OptionTypeId  int64  `json:"option_type_id"`
+	OptionType    string `json:"option_type"`
+	OptionValueId int64  `json:"option_value_id"`
+	Value         string `json:"value"`
+}
+
+type ProductVariantResponse struct {
+	Id             int64                   `json:"id"`
+	ProductId      int64                   `json:"product_id"`
+	Sku            string                  `json:"sku"`
+	Price          *float64                `json:"price"`
+	EffectivePrice float64                 `json:"effective_price"`
+	StockQuantity  int                     `json:"stock_quantity"`
+	Barcode        *string                 `json:"barcode"`
+	ImageUrl       *string                 `json:"image_url"`
+	IsActive       bool                    `json:"is_active"`
+	Options        []VariantOptionResponse `json:"options"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+}
+
+type CreateOptionTypeRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	DisplayName string `json:"display_name" validate:"required,max=255"`
+}
+
+type CreateOptionValueRequest struct {
+	Value     string `json:"value" validate:"required,max=100"`
+	SortOrder int    `json:"sort_order"`
+}
+
+type CreateProductVariantRequest struct {
+	Sku            string   `json:"sku" validate:"required,max=100"`
+	Price          *float64 `json:"price"`
+	StockQuantity  int      `json:"stock_quantity"`
+	Barcode        *string  `json:"barcode"`
+	ImageUrl       *string  `json:"image_url"`
+	IsActive       bool     `json:"is_active"`
+	OptionValueIds []int64  `json:"option_value_ids"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type RelatedProductResponse struct {
+	Product ProductResponse `json:"product"`
+	Source  string          `json:"source"`
+	Score   float64         `json:"score"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+const (
+	ReorderReasonUnavailable   = "product_unavailable"
+	ReorderReasonVariantGone   = "variant_unavailable"
+	ReorderReasonOutOfStock    = "out_of_stock"
+	ReorderReasonCappedToStock = "capped_to_stock"
+	ReorderReasonPriceChanged  = "price_changed"
+)
+
+type ReorderLineResponse struct {
+	ProductId         int64    `json:"product_id"`
+	ProductName       string   `json:"product_name,omitempty"`
+	VariantId         *int64   `json:"variant_id,omitempty"`
+	Sku               string   `json:"sku,omitempty"`
+	RequestedQuantity int      `json:"requested_quantity"`
+	AddedQuantity     int      `json:"added_quantity"`
+	PreviousPrice     float32  `json:"previous_price"`
+	CurrentPrice      float64  `json:"current_price,omitempty"`
+	Reasons           []string `json:"reasons,omitempty"`
+}
+
+type ReorderResponse struct {
+	OrderId  int64                 `json:"order_id"`
+	CartId   int64                 `json:"cart_id"`
+	Added    []ReorderLineResponse `json:"added"`
+	Adjusted []ReorderLineResponse `json:"adjusted"`
+	Dropped  []ReorderLineResponse `json:"dropped"`
+}
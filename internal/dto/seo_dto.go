@@ -0,0 +1,61 @@
+package dto
+
+// ProductSeoResponse carries what a storefront renders in a product page's head: the canonical URL, the
+// URLs of the page in the other locales and the schema.org structured data.
+type ProductSeoResponse struct {
+	ProductId    int64          `json:"product_id"`
+	Locale       string         `json:"locale"`
+	CanonicalUrl string         `json:"canonical_url"`
+	Alternates   []AlternateUrl `json:"alternates"`
+	JsonLd       ProductJsonLd  `json:"json_ld"`
+}
+
+type AlternateUrl struct {
+	Locale string `json:"locale"`
+	Url    string `json:"url"`
+}
+
+// ProductJsonLd is a schema.org Product. Offers is an Offer, or an AggregateOffer for a product with variants.
+type ProductJsonLd struct {
+	Context         string           `json:"@context"`
+	Type            string           `json:"@type"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description,omitempty"`
+	Sku             string           `json:"sku,omitempty"`
+	Image           string           `json:"image,omitempty"`
+	Url             string           `json:"url"`
+	Offers          any              `json:"offers"`
+	AggregateRating *AggregateRating `json:"aggregateRating,omitempty"`
+}
+
+type Offer struct {
+	Type          string        `json:"@type"`
+	Sku           string        `json:"sku,omitempty"`
+	Url           string        `json:"url"`
+	Price         string        `json:"price"`
+	PriceCurrency string        `json:"priceCurrency"`
+	Availability  string        `json:"availability"`
+	ItemCondition string        `json:"itemCondition"`
+	Seller        *Organization `json:"seller,omitempty"`
+}
+
+type AggregateOffer struct {
+	Type          string        `json:"@type"`
+	LowPrice      string        `json:"lowPrice"`
+	HighPrice     string        `json:"highPrice"`
+	PriceCurrency string        `json:"priceCurrency"`
+	OfferCount    int           `json:"offerCount"`
+	Offers        []Offer       `json:"offers"`
+	Seller        *Organization `json:"seller,omitempty"`
+}
+
+type Organization struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+type AggregateRating struct {
+	Type        string  `json:"@type"`
+	RatingValue float64 `json:"ratingValue"`
+	ReviewCount int     `json:"reviewCount"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+type StockSubscriptionResponse struct {
+	Id         int64      `json:"id"`
+	ProductId  int64      `json:"product_id"`
+	VariantId  *int64     `json:"variant_id,omitempty"`
+	Email      string     `json:"email"`
+	Status     string     `json:"status"`
+	NotifiedAt *time.Time `json:"notified_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateStockSubscriptionRequest subscribes to the restock of a product or one of its variants. Email is
+// required for guests; signed-in users are notified at their account email.
+type CreateStockSubscriptionRequest struct {
+	ProductId int64  `json:"product_id" validate:"required"`
+	VariantId *int64 `json:"variant_id"`
+	Email     string `json:"email" validate:"omitempty,email,max=255"`
+}
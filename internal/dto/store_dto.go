@@ -3,21 +3,23 @@ package dto
 import "time"
 
 type StoreResponse struct {
-	Id             uint      `json:"id"`
-	Name           string    `json:"name"`
-	Slug           string    `json:"slug"`
-	Description    string    `json:"description"`
-	LogoUrl        string    `json:"logo_url"`
-	ContactEmail   string    `json:"contact_email"`
-	ContactPhone   string    `json:"contact_phone"`
-	ContactAddress string    `json:"contact_address"`
-	IsActive       bool      `json:"is_active"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	Id             uint       `json:"id"`
+	Name           string     `json:"name"`
+	Slug           string     `json:"slug"`
+	Description    string     `json:"description"`
+	LogoUrl        string     `json:"logo_url"`
+	ContactEmail   string     `json:"contact_email"`
+	ContactPhone   string     `json:"contact_phone"`
+	ContactAddress string     `json:"contact_address"`
+	IsActive       bool       `json:"is_active"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
 }
 
 type CreateStoreRequest struct {
 	Name           string `json:"name"`
+	Slug           string `json:"slug"`
 	Description    string `json:"description"`
 	LogoUrl        string `json:"logo_url"`
 	ContactEmail   string `json:"contact_email"`
@@ -25,3 +27,9 @@ type CreateStoreRequest struct {
 	ContactAddress string `json:"contact_address"`
 	IsActive       bool   `json:"is_active"`
 }
+
+// SlugRedirectResponse points a request for a previous slug to the entity's current slug.
+type SlugRedirectResponse struct {
+	Slug     string `json:"slug"`
+	Location string `json:"location"`
+}
@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+type ProductTranslationResponse struct {
+	ProductId       int64     `json:"product_id"`
+	Locale          string    `json:"locale"`
+	Name            string    `json:"name"`
+	Slug            string    `json:"slug"`
+	Description     string    `json:"description"`
+	MetaDescription string    `json:"meta_description"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// SaveProductTranslationRequest replaces the product's content in a locale. Without a slug the translation
+// keeps its current slug, or a new one is generated from the name.
+type SaveProductTranslationRequest struct {
+	Name            string `json:"name" validate:"required,max=255"`
+	Slug            string `json:"slug" validate:"max=255"`
+	Description     string `json:"description"`
+	MetaDescription string `json:"meta_description" validate:"max=300"`
+}
+
+type CategoryTranslationResponse struct {
+	CategoryId  int64     `json:"category_id"`
+	Locale      string    `json:"locale"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type SaveCategoryTranslationRequest struct {
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description"`
+}
+
+type StoreTranslationResponse struct {
+	StoreId     int64     `json:"store_id"`
+	Locale      string    `json:"locale"`
+	Description string    `json:"description"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type SaveStoreTranslationRequest struct {
+	Description string `json:"description" validate:"required"`
+}
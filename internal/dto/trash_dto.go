@@ -0,0 +1,7 @@
+package dto
+
+type TrashResponse struct {
+	Products   []ProductResponse  `json:"products"`
+	Stores     []StoreResponse    `json:"stores"`
+	Categories []CategoryResponse `json:"categories"`
+}
@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+type WishlistResponse struct {
+	Id         int64                  `json:"id"`
+	UserId     int64                  `json:"user_id"`
+	Name       string                 `json:"name"`
+	IsPublic   bool                   `json:"is_public"`
+	ShareToken *string                `json:"share_token,omitempty"`
+	Items      []WishlistItemResponse `json:"items,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+}
+
+type WishlistItemResponse struct {
+	Id           int64     `json:"id"`
+	WishlistId   int64     `json:"wishlist_id"`
+	ProductId    int64     `json:"product_id"`
+	VariantId    *int64    `json:"variant_id,omitempty"`
+	ProductName  string    `json:"product_name"`
+	AddedPrice   float64   `json:"added_price"`
+	CurrentPrice float64   `json:"current_price"`
+	PriceDropped bool      `json:"price_dropped"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type CreateWishlistRequest struct {
+	Name     string `json:"name" validate:"required,max=255"`
+	IsPublic bool   `json:"is_public"`
+}
+
+type AddWishlistItemRequest struct {
+	ProductId int64  `json:"product_id" validate:"required"`
+	VariantId *int64 `json:"variant_id"`
+}
+
+type MoveCartItemToWishlistRequest struct {
+	CartItemId int64 `json:"cart_item_id" validate:"required"`
+}
+
+type MoveWishlistItemToCartRequest struct {
+	CartId   int64 `json:"cart_id" validate:"required"`
+	Quantity int   `json:"quantity"`
+}
@@ -15,13 +15,15 @@ func Initialize(secret string) {
 type Claim struct {
 	UserId int64  `json:"user_id"`
 	Email  string `json:"email"`
+	Role   string `json:"role"`
 	jwt.RegisteredClaims
 }
 
-func GenerateToken(userId int64, email string) (string, error) {
+func GenerateToken(userId int64, email string, role string) (string, error) {
 	claim := &Claim{
 		UserId: userId,
 		Email:  email,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
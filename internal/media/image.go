@@ -0,0 +1,165 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// MaxPixels guards against images that are small on disk but huge once decoded.
+const MaxPixels = 40_000_000
+
+const jpegQuality = 85
+
+var ErrUnsupportedImage = errors.New("Image must be a JPEG, PNG, GIF or WebP file")
+
+type Rendition struct {
+	Name        string
+	ContentType string
+	Extension   string
+	Width       int
+	Height      int
+	Content     []byte
+}
+
+// Image is a decoded upload with the renditions derived from it.
+type Image struct {
+	ContentType string
+	Extension   string
+	Width       int
+	Height      int
+	Renditions  []Rendition
+}
+
+// Process decodes an uploaded image and renders a thumbnail and a WebP copy for every width smaller than the
+// original, plus a full size WebP copy. PNG and GIF thumbnails stay PNG to keep transparency, others are JPEG.
+func Process(content []byte, widths []int) (Image, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return Image{}, ErrUnsupportedImage
+	}
+	if config.Width*config.Height > MaxPixels {
+		return Image{}, fmt.Errorf("Image cannot have more than %d pixels", MaxPixels)
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return Image{}, ErrUnsupportedImage
+	}
+
+	processed := Image{Width: config.Width, Height: config.Height}
+	switch format {
+	case "jpeg":
+		processed.ContentType, processed.Extension = "image/jpeg", "jpg"
+	case "png":
+		processed.ContentType, processed.Extension = "image/png", "png"
+	case "gif":
+		processed.ContentType, processed.Extension = "image/gif", "gif"
+	case "webp":
+		processed.ContentType, processed.Extension = "image/webp", "webp"
+	default:
+		return Image{}, ErrUnsupportedImage
+	}
+	keepsTransparency := format == "png" || format == "gif"
+
+	for _, width := range widths {
+		if width >= config.Width {
+			continue
+		}
+		resized := resize(decoded, width)
+		name := fmt.Sprintf("w%d", width)
+		thumbnail, err := encodeThumbnail(resized, name, keepsTransparency)
+		if err != nil {
+			return Image{}, err
+		}
+		webp, err := encodeWebp(resized, name+"_webp")
+		if err != nil {
+			return Image{}, err
+		}
+		processed.Renditions = append(processed.Renditions, thumbnail, webp)
+	}
+	if format != "webp" {
+		webp, err := encodeWebp(decoded, "webp")
+		if err != nil {
+			return Image{}, err
+		}
+		processed.Renditions = append(processed.Renditions, webp)
+	}
+	return processed, nil
+}
+
+// ParseWidths reads a comma separated list of thumbnail widths, ignoring blanks and duplicates.
+func ParseWidths(value string) ([]int, error) {
+	seen := make(map[int]bool)
+	widths := []int{}
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		width, err := strconv.Atoi(part)
+		if err != nil || width <= 0 {
+			return nil, fmt.Errorf("Invalid thumbnail width: %s", part)
+		}
+		if !seen[width] {
+			seen[width] = true
+			widths = append(widths, width)
+		}
+	}
+	sort.Ints(widths)
+	return widths, nil
+}
+
+func resize(source image.Image, width int) image.Image {
+	bounds := source.Bounds()
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+	target := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(target, target.Bounds(), source, bounds, draw.Over, nil)
+	return target
+}
+
+func encodeThumbnail(img image.Image, name string, keepsTransparency bool) (Rendition, error) {
+	var buffer bytes.Buffer
+	rendition := Rendition{Name: name, Width: img.Bounds().Dx(), Height: img.Bounds().Dy()}
+	if keepsTransparency {
+		if err := png.Encode(&buffer, img); err != nil {
+			return Rendition{}, err
+		}
+		rendition.ContentType, rendition.Extension = "image/png", "png"
+	} else {
+		if err := jpeg.Encode(&buffer, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return Rendition{}, err
+		}
+		rendition.ContentType, rendition.Extension = "image/jpeg", "jpg"
+	}
+	rendition.Content = buffer.Bytes()
+	return rendition, nil
+}
+
+func encodeWebp(img image.Image, name string) (Rendition, error) {
+	var buffer bytes.Buffer
+	if err := nativewebp.Encode(&buffer, img, nil); err != nil {
+		return Rendition{}, err
+	}
+	return Rendition{
+		Name:        name,
+		ContentType: "image/webp",
+		Extension:   "webp",
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+		Content:     buffer.Bytes(),
+	}, nil
+}
@@ -0,0 +1,148 @@
+package pricing
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"math"
+	"strings"
+)
+
+// Prices is the consistent set of price fields stored on a product: Price is always BasePrice minus Discount.
+type Prices struct {
+	Price     float64
+	BasePrice float64
+	Discount  float64
+}
+
+// Resolve checks the price fields a client sent against each other and derives the selling price.
+// Clients that only send a price get it as base price, and clients that send a lower price next to the
+// base price without a discount get the difference as discount.
+func Resolve(price float64, basePrice float64, discount float64) (Prices, error) {
+	if price < 0 || basePrice < 0 || discount < 0 {
+		return Prices{}, errors.New("Prices cannot be negative")
+	}
+	if basePrice == 0 {
+		basePrice = price
+	}
+	if discount > basePrice {
+		return Prices{}, errors.New("Discount cannot be greater than the base price")
+	}
+	if discount == 0 && price > 0 && price < basePrice {
+		discount = Round(basePrice - price)
+	}
+
+	derived := Round(basePrice - discount)
+	if price > 0 && Round(price) != derived {
+		return Prices{}, errors.New("Price must equal the base price minus the discount")
+	}
+	return Prices{Price: derived, BasePrice: Round(basePrice), Discount: Round(discount)}, nil
+}
+
+// Quote is the breakdown of the price a customer pays for one unit.
+type Quote struct {
+	BasePrice       float64
+	ProductDiscount float64
+	SaleDiscount    float64
+	GroupDiscount   float64
+	Price           float64
+	AppliedRuleIds  []int64
+}
+
+// Calculator prices products for one customer group with the rules that are active at the moment. The
+// category paths cover the categories of the rules and everything below them, so a category rule also
+// prices the products of its subcategories.
+type Calculator struct {
+	rules         []domain.PriceRule
+	customerGroup string
+	categoryPaths map[uint]string
+}
+
+func NewCalculator(rules []domain.PriceRule, customerGroup string, categoryPaths map[uint]string) Calculator {
+	if customerGroup == "" {
+		customerGroup = domain.CustomerGroupRetail
+	}
+	return Calculator{rules: rules, customerGroup: customerGroup, categoryPaths: categoryPaths}
+}
+
+// Quote derives the unit price of the product, or of the variant when one is given. The product discount
+// comes first, then the best matching sale and then the best matching customer group rule, each taken
+// from the price left by the previous step.
+func (calculator Calculator) Quote(product domain.Product, variant *domain.ProductVariant) Quote {
+	quote := Quote{BasePrice: product.BasePrice, AppliedRuleIds: []int64{}}
+	price := product.Price
+	if variant != nil && variant.Price != nil {
+		quote.BasePrice = *variant.Price
+		price = *variant.Price
+	}
+	if quote.BasePrice < price {
+		quote.BasePrice = price
+	}
+	quote.ProductDiscount = Round(quote.BasePrice - price)
+
+	if sale := calculator.bestRule(product, domain.PriceRuleKindSale); sale != nil {
+		quote.SaleDiscount = Round(price * sale.PercentOff / 100)
+		price -= quote.SaleDiscount
+		quote.AppliedRuleIds = append(quote.AppliedRuleIds, sale.Id)
+	}
+	if groupRule := calculator.bestRule(product, domain.PriceRuleKindCustomerGroup); groupRule != nil {
+		quote.GroupDiscount = Round(price * groupRule.PercentOff / 100)
+		price -= quote.GroupDiscount
+		quote.AppliedRuleIds = append(quote.AppliedRuleIds, groupRule.Id)
+	}
+
+	quote.Price = Round(math.Max(price, 0))
+	return quote
+}
+
+// UnitPrice is the price the customer pays for one unit.
+func (calculator Calculator) UnitPrice(product domain.Product, variant *domain.ProductVariant) float64 {
+	return calculator.Quote(product, variant).Price
+}
+
+func (calculator Calculator) bestRule(product domain.Product, kind string) *domain.PriceRule {
+	var best *domain.PriceRule
+	for i := range calculator.rules {
+		rule := &calculator.rules[i]
+		if rule.Kind != kind || !calculator.matches(rule, product) {
+			continue
+		}
+		if best == nil || rule.PercentOff > best.PercentOff {
+			best = rule
+		}
+	}
+	return best
+}
+
+func (calculator Calculator) matches(rule *domain.PriceRule, product domain.Product) bool {
+	if rule.CustomerGroup != nil && *rule.CustomerGroup != calculator.customerGroup {
+		return false
+	}
+	if rule.ProductId != nil && *rule.ProductId != int64(product.Id) {
+		return false
+	}
+	if rule.StoreId != nil && *rule.StoreId != product.StoreId {
+		return false
+	}
+	if rule.CategoryId != nil && !calculator.inCategory(product, *rule.CategoryId) {
+		return false
+	}
+	return true
+}
+
+// inCategory tells whether the product sits in the category or one of its subcategories.
+func (calculator Calculator) inCategory(product domain.Product, categoryId uint) bool {
+	if product.CategoryId == nil {
+		return false
+	}
+	if *product.CategoryId == categoryId {
+		return true
+	}
+	categoryPath, found := calculator.categoryPaths[categoryId]
+	productPath, productFound := calculator.categoryPaths[*product.CategoryId]
+	return found && productFound && strings.HasPrefix(productPath, categoryPath)
+}
+
+// Round rounds an amount to cents.
+func Round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
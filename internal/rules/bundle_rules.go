@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+)
+
+type BundleRules struct {
+	BaseRules[dto.SaveBundleRequest]
+}
+
+func NewBundleRules() *BundleRules {
+	return &BundleRules{}
+}
+
+func (r *BundleRules) ValidateSave(req dto.SaveBundleRequest) error {
+	if err := r.ValidateStructure(req); err != nil {
+		return err
+	}
+
+	switch req.PricingMode {
+	case domain.BundlePricingFixed:
+		if req.FixedPrice == nil || req.DiscountPercent != nil {
+			return errors.New("Fixed bundles need a fixed price and no discount percent")
+		}
+	case domain.BundlePricingDiscount:
+		if req.DiscountPercent == nil || req.FixedPrice != nil {
+			return errors.New("Discount bundles need a discount percent and no fixed price")
+		}
+	}
+
+	type item struct {
+		productId int64
+		variantId int64
+	}
+	seen := make(map[item]bool, len(req.Components))
+	units := 0
+	for _, component := range req.Components {
+		key := item{productId: component.ProductId}
+		if component.VariantId != nil {
+			key.variantId = *component.VariantId
+		}
+		if seen[key] {
+			return errors.New("A product or variant can only be listed once in a bundle")
+		}
+		seen[key] = true
+		units += component.Quantity
+	}
+	if units < 2 {
+		return errors.New("A bundle must contain at least two units")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/validation"
 )
 
 type CategoryRules struct {
@@ -13,3 +14,7 @@ func NewCategoryRules() *CategoryRules {
 }
 
 // Business Rules
+
+func (r *CategoryRules) ValidateMove(req dto.MoveCategoryRequest) error {
+	return validation.ValidateStruct(req)
+}
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/validation"
+	"strings"
+)
+
+type DigitalRules struct {
+	BaseRules[dto.UploadDigitalFileRequest]
+}
+
+func NewDigitalRules() *DigitalRules {
+	return &DigitalRules{}
+}
+
+func (r *DigitalRules) ValidateUpload(req dto.UploadDigitalFileRequest) error {
+	return r.ValidateStructure(req)
+}
+
+// ValidateLicenseKeys validates the upload and returns its keys trimmed, without blanks and repeats.
+func (r *DigitalRules) ValidateLicenseKeys(req dto.AddLicenseKeysRequest) ([]string, error) {
+	if err := validation.ValidateStruct(req); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(req.Keys))
+	seen := make(map[string]bool, len(req.Keys))
+	for _, key := range req.Keys {
+		key = strings.TrimSpace(key)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("At least one licence key is required")
+	}
+	return keys, nil
+}
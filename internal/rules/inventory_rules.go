@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/validation"
+	"strings"
+)
+
+const MaxStockMovementPageSize = 200
+
+type InventoryRules struct {
+	BaseRules[dto.CreateWarehouseRequest]
+}
+
+func NewInventoryRules() *InventoryRules {
+	return &InventoryRules{}
+}
+
+func (r *InventoryRules) ValidateWarehouse(req dto.CreateWarehouseRequest) error {
+	if err := r.ValidateStructure(req); err != nil {
+		return err
+	}
+	if req.IsDefault && !req.IsActive {
+		return errors.New("The default warehouse must be active")
+	}
+	return nil
+}
+
+// ValidateWarehouseUpdate keeps a default warehouse in place: the default moves by making another warehouse the default.
+func (r *InventoryRules) ValidateWarehouseUpdate(existing domain.Warehouse, req dto.CreateWarehouseRequest) error {
+	if err := r.ValidateWarehouse(req); err != nil {
+		return err
+	}
+	if existing.IsDefault && !req.IsDefault {
+		return errors.New("Make another warehouse the default instead")
+	}
+	return nil
+}
+
+func (r *InventoryRules) ValidateMovement(req dto.CreateStockMovementRequest) error {
+	if err := validation.ValidateStruct(req); err != nil {
+		return err
+	}
+	switch req.Type {
+	case domain.StockMovementReceipt, domain.StockMovementSale, domain.StockMovementReturn:
+		if req.Quantity <= 0 {
+			return errors.New("Quantity must be greater than 0")
+		}
+	case domain.StockMovementAdjustment:
+		if req.Quantity == 0 {
+			return errors.New("Quantity cannot be 0")
+		}
+		if req.Reason == nil || strings.TrimSpace(*req.Reason) == "" {
+			return errors.New("Adjustments need a reason")
+		}
+	case domain.StockMovementTransfer:
+		return errors.New("Transfers are booked through the stock transfer endpoint")
+	default:
+		return errors.New("Type must be one of receipt, sale, return, adjustment")
+	}
+	return nil
+}
+
+func (r *InventoryRules) ValidateTransfer(req dto.StockTransferRequest) error {
+	if err := validation.ValidateStruct(req); err != nil {
+		return err
+	}
+	if req.FromWarehouseId == req.ToWarehouseId {
+		return errors.New("Source and destination warehouses must differ")
+	}
+	return nil
+}
+
+func (r *InventoryRules) ValidateMovementList(req dto.StockMovementListRequest) error {
+	if req.Limit < 0 || req.Limit > MaxStockMovementPageSize {
+		return errors.New("Limit must be between 1 and 200")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/validation"
+)
+
+type PriceRuleRules struct {
+	BaseRules[dto.CreatePriceRuleRequest]
+}
+
+func NewPriceRuleRules() *PriceRuleRules {
+	return &PriceRuleRules{}
+}
+
+func (r *PriceRuleRules) ValidateCreate(req dto.CreatePriceRuleRequest) error {
+	if err := r.ValidateStructure(req); err != nil {
+		return err
+	}
+
+	switch req.Kind {
+	case domain.PriceRuleKindSale:
+	case domain.PriceRuleKindCustomerGroup:
+		if req.CustomerGroup == nil || *req.CustomerGroup == "" {
+			return errors.New("Customer group rules need a customer group")
+		}
+	default:
+		return errors.New("Kind must be sale or customer_group")
+	}
+	if req.PercentOff <= 0 || req.PercentOff > 100 {
+		return errors.New("Percent off must be greater than 0 and at most 100")
+	}
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		return errors.New("End time must be after the start time")
+	}
+	return nil
+}
+
+func (r *PriceRuleRules) ValidateCustomerGroup(req dto.CustomerGroupRequest) error {
+	return validation.ValidateStruct(req)
+}
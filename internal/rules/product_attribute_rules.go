@@ -0,0 +1,203 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/validation"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const maxAttributeTextLength = 500
+
+var attributeCodePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type ProductAttributeRules struct {
+	BaseRules[dto.CreateCategoryAttributeRequest]
+}
+
+func NewProductAttributeRules() *ProductAttributeRules {
+	return &ProductAttributeRules{}
+}
+
+func (r *ProductAttributeRules) ValidateCreate(req dto.CreateCategoryAttributeRequest) error {
+	if err := r.ValidateStructure(req); err != nil {
+		return err
+	}
+
+	if !attributeCodePattern.MatchString(req.Code) {
+		return errors.New("Attribute code can only contain lowercase letters, digits and underscores")
+	}
+	if req.Unit != "" && req.Type != domain.AttributeTypeNumber {
+		return errors.New("Only number attributes can have a unit")
+	}
+	return validateAttributeOptions(req.Type, req.Options)
+}
+
+// ValidateUpdate checks the new definition of the attribute. Enum options that products still use cannot be removed.
+func (r *ProductAttributeRules) ValidateUpdate(req dto.UpdateCategoryAttributeRequest, attribute domain.CategoryAttribute, usedValues []string) error {
+	if err := validation.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if req.Unit != "" && attribute.Type != domain.AttributeTypeNumber {
+		return errors.New("Only number attributes can have a unit")
+	}
+	if err := validateAttributeOptions(attribute.Type, req.Options); err != nil {
+		return err
+	}
+	if attribute.Type == domain.AttributeTypeEnum {
+		for _, value := range usedValues {
+			if !slices.Contains(req.Options, value) {
+				return fmt.Errorf("Option %s is still used by products", value)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateValues checks the values of a product against its category's schema and returns them typed.
+// Every code must belong to the schema and every required attribute must have a value.
+func (r *ProductAttributeRules) ValidateValues(schema []domain.CategoryAttribute, values map[string]interface{}) ([]domain.ProductAttributeValue, error) {
+	attributesByCode := make(map[string]domain.CategoryAttribute, len(schema))
+	for _, attribute := range schema {
+		attributesByCode[attribute.Code] = attribute
+	}
+	for code := range values {
+		if _, ok := attributesByCode[code]; !ok {
+			return nil, fmt.Errorf("Unknown attribute for the category: %s", code)
+		}
+	}
+
+	validated := make([]domain.ProductAttributeValue, 0, len(values))
+	for _, attribute := range schema {
+		raw, ok := values[attribute.Code]
+		if text, isText := raw.(string); isText && strings.TrimSpace(text) == "" {
+			ok = false
+		}
+		if !ok || raw == nil {
+			if attribute.IsRequired {
+				return nil, fmt.Errorf("Attribute %s is required", attribute.Code)
+			}
+			continue
+		}
+
+		value := domain.ProductAttributeValue{
+			AttributeId: attribute.Id,
+			Code:        attribute.Code,
+			Name:        attribute.Name,
+			Type:        attribute.Type,
+			Unit:        attribute.Unit,
+		}
+		switch attribute.Type {
+		case domain.AttributeTypeNumber:
+			number, isNumber := raw.(float64)
+			if !isNumber {
+				return nil, fmt.Errorf("Attribute %s must be a number", attribute.Code)
+			}
+			value.NumberValue = &number
+		case domain.AttributeTypeBoolean:
+			flag, isBool := raw.(bool)
+			if !isBool {
+				return nil, fmt.Errorf("Attribute %s must be true or false", attribute.Code)
+			}
+			value.BoolValue = &flag
+		default:
+			text, isText := raw.(string)
+			if !isText {
+				return nil, fmt.Errorf("Attribute %s must be a text", attribute.Code)
+			}
+			text = strings.TrimSpace(text)
+			if len(text) > maxAttributeTextLength {
+				return nil, fmt.Errorf("Attribute %s cannot be longer than %d characters", attribute.Code, maxAttributeTextLength)
+			}
+			if attribute.Type == domain.AttributeTypeEnum && !slices.Contains(attribute.Options, text) {
+				return nil, fmt.Errorf("Attribute %s must be one of %s", attribute.Code, strings.Join(attribute.Options, ", "))
+			}
+			value.TextValue = &text
+		}
+		validated = append(validated, value)
+	}
+	return validated, nil
+}
+
+// ParseFilters reads the attr.<code> listing parameters. A plain code matches any of its comma separated
+// values, code.min and code.max bound number attributes.
+func (r *ProductAttributeRules) ParseFilters(params map[string]string) ([]domain.AttributeFilter, error) {
+	filtersByCode := make(map[string]*domain.AttributeFilter)
+	codes := []string{}
+	for key, raw := range params {
+		code, bound, _ := strings.Cut(key, ".")
+		if !attributeCodePattern.MatchString(code) {
+			return nil, fmt.Errorf("Invalid attribute filter: attr.%s", key)
+		}
+		filter, ok := filtersByCode[code]
+		if !ok {
+			filter = &domain.AttributeFilter{Code: code}
+			filtersByCode[code] = filter
+			codes = append(codes, code)
+		}
+
+		switch bound {
+		case "":
+			for _, value := range strings.Split(raw, ",") {
+				if value = strings.TrimSpace(value); value != "" {
+					filter.Values = append(filter.Values, value)
+				}
+			}
+		case "min", "max":
+			number, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return nil, fmt.Errorf("attr.%s must be a number", key)
+			}
+			if bound == "min" {
+				filter.Min = &number
+			} else {
+				filter.Max = &number
+			}
+		default:
+			return nil, fmt.Errorf("Invalid attribute filter: attr.%s", key)
+		}
+	}
+
+	slices.Sort(codes)
+	filters := make([]domain.AttributeFilter, 0, len(codes))
+	for _, code := range codes {
+		filter := *filtersByCode[code]
+		if len(filter.Values) == 0 && filter.Min == nil && filter.Max == nil {
+			continue
+		}
+		if filter.Min != nil && filter.Max != nil && *filter.Min > *filter.Max {
+			return nil, fmt.Errorf("attr.%s.min cannot be greater than attr.%s.max", code, code)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func validateAttributeOptions(attributeType string, options []string) error {
+	if attributeType != domain.AttributeTypeEnum {
+		if len(options) > 0 {
+			return errors.New("Only enum attributes can have options")
+		}
+		return nil
+	}
+	if len(options) == 0 {
+		return errors.New("Enum attributes need at least one option")
+	}
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		if strings.TrimSpace(option) != option || option == "" {
+			return errors.New("Options cannot be blank or start or end with spaces")
+		}
+		if seen[option] {
+			return fmt.Errorf("Option %s is listed twice", option)
+		}
+		seen[option] = true
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/validation"
+)
+
+type ProductImageRules struct {
+	BaseRules[dto.UploadProductImageRequest]
+}
+
+func NewProductImageRules() *ProductImageRules {
+	return &ProductImageRules{}
+}
+
+func (r *ProductImageRules) ValidateUpload(req dto.UploadProductImageRequest, imageCount int, maxImages int) error {
+	if err := r.ValidateStructure(req); err != nil {
+		return err
+	}
+	if imageCount >= maxImages {
+		return errors.New("Product gallery is full")
+	}
+	return nil
+}
+
+func (r *ProductImageRules) ValidateUpdate(req dto.UpdateProductImageRequest) error {
+	return validation.ValidateStruct(req)
+}
+
+// ValidateReorder requires the new order to list every image of the gallery exactly once.
+func (r *ProductImageRules) ValidateReorder(req dto.ReorderProductImagesRequest, images []domain.ProductImage) error {
+	if err := validation.ValidateStruct(req); err != nil {
+		return err
+	}
+	if len(req.ImageIds) != len(images) {
+		return errors.New("Image order must list every image of the product once")
+	}
+	remaining := make(map[int64]bool, len(images))
+	for _, image := range images {
+		remaining[image.Id] = true
+	}
+	for _, imageId := range req.ImageIds {
+		if !remaining[imageId] {
+			return errors.New("Image order must list every image of the product once")
+		}
+		delete(remaining, imageId)
+	}
+	return nil
+}
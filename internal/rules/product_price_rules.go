@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/internal/dto"
+	"time"
+)
+
+const MaxPriceHistoryPageSize = 200
+
+type ProductPriceRules struct {
+	BaseRules[dto.CreatePriceScheduleRequest]
+}
+
+func NewProductPriceRules() *ProductPriceRules {
+	return &ProductPriceRules{}
+}
+
+func (r *ProductPriceRules) ValidateSchedule(req dto.CreatePriceScheduleRequest, now time.Time) error {
+	if err := r.ValidateStructure(req); err != nil {
+		return err
+	}
+	if req.StartsAt.IsZero() {
+		return errors.New("Start time is required")
+	}
+	if req.EndsAt != nil {
+		if !req.EndsAt.After(req.StartsAt) {
+			return errors.New("End time must be after the start time")
+		}
+		if !req.EndsAt.After(now) {
+			return errors.New("End time must be in the future")
+		}
+	}
+	return nil
+}
+
+func (r *ProductPriceRules) ValidateHistoryLimit(limit int) error {
+	if limit < 0 || limit > MaxPriceHistoryPageSize {
+		return errors.New("Limit must be between 1 and 200")
+	}
+	return nil
+}
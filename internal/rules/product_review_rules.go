@@ -0,0 +1,39 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+)
+
+const MaxReviewPageSize = 50
+
+type ProductReviewRules struct {
+	BaseRules[dto.CreateReviewRequest]
+}
+
+func NewProductReviewRules() *ProductReviewRules {
+	return &ProductReviewRules{}
+}
+
+func (r *ProductReviewRules) ValidateList(req dto.ReviewListRequest) error {
+	switch req.Sort {
+	case "", domain.ReviewSortNewest, domain.ReviewSortHelpful, domain.ReviewSortRatingDesc, domain.ReviewSortRatingAsc:
+	default:
+		return errors.New("Sort must be one of newest, helpful, rating_desc, rating_asc")
+	}
+	if req.Limit < 0 || req.Limit > MaxReviewPageSize {
+		return errors.New("Limit must be between 1 and 50")
+	}
+	if req.Offset < 0 {
+		return errors.New("Offset cannot be negative")
+	}
+	return nil
+}
+
+func (r *ProductReviewRules) ValidateModeration(req dto.ModerateReviewRequest) error {
+	if req.Status != domain.ReviewStatusApproved && req.Status != domain.ReviewStatusRejected {
+		return errors.New("Status must be approved or rejected")
+	}
+	return nil
+}
@@ -2,9 +2,20 @@ package rules
 
 import (
 	"errors"
+	"go-ecommerce-service/domain"
 	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/pricing"
+	"slices"
 )
 
+const MaxProductPageSize = 100
+
+// ImportFields are the product fields a column of an import file can be mapped to.
+var ImportFields = []string{
+	"name", "slug", "sku", "description", "price", "base_price", "discount", "image_url",
+	"meta_description", "stock_quantity", "is_active", "is_featured", "category_id", "store_id",
+}
+
 type ProductRules struct {
 	BaseRules[dto.CreateProductRequest]
 }
@@ -13,19 +24,42 @@ func NewProductRules() *ProductRules {
 	return &ProductRules{}
 }
 
-func (r *ProductRules) ValidateCreate(req dto.CreateProductRequest) error {
+// ValidateCreate validates the request and returns the consistent prices derived from its price fields.
+func (r *ProductRules) ValidateCreate(req dto.CreateProductRequest) (pricing.Prices, error) {
 	// Validation Struct
 	if err := r.ValidateStructure(req); err != nil {
-		return err
+		return pricing.Prices{}, err
 	}
 
 	// Business Rules
-	if req.Price < 0 {
-		return errors.New("Product price cannot be less than 0")
+	return pricing.Resolve(req.Price, req.BasePrice, req.Discount)
+}
+
+func (r *ProductRules) ValidateList(req dto.ProductListRequest) error {
+	switch req.Sort {
+	case "", domain.ProductSortNewest, domain.ProductSortPriceAsc, domain.ProductSortPriceDesc, domain.ProductSortName, domain.ProductSortRating:
+	default:
+		return errors.New("Sort must be one of newest, price_asc, price_desc, name, rating")
 	}
-	if req.Discount < 0 {
-		return errors.New("Discount rate cannot be less than 0")
+	if req.Limit < 0 || req.Limit > MaxProductPageSize {
+		return errors.New("Limit must be between 1 and 100")
 	}
+	if req.MinPrice != nil && req.MaxPrice != nil && *req.MinPrice > *req.MaxPrice {
+		return errors.New("Minimum price cannot be greater than maximum price")
+	}
+	return nil
+}
 
+func (r *ProductRules) ValidateImport(options dto.ProductImportOptions) error {
+	switch options.MatchBy {
+	case "", domain.ImportMatchBySku, domain.ImportMatchBySlug:
+	default:
+		return errors.New("Match by must be sku or slug")
+	}
+	for field := range options.Mapping {
+		if !slices.Contains(ImportFields, field) {
+			return errors.New("Unknown import field in mapping: " + field)
+		}
+	}
 	return nil
 }
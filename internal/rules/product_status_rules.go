@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/validation"
+	"slices"
+	"strings"
+	"time"
+)
+
+// productTransitions lists the statuses a product can move to from each status. Drafts are submitted for
+// review, a rejected review sends the product back to draft, and approved products are published and
+// unpublished freely. Approval is the only way out of review towards going live, see ValidateApproval.
+var productTransitions = map[string][]string{
+	domain.ProductStatusDraft:         {domain.ProductStatusPendingReview},
+	domain.ProductStatusPendingReview: {domain.ProductStatusDraft},
+	domain.ProductStatusPublished:     {domain.ProductStatusUnpublished},
+	domain.ProductStatusUnpublished:   {domain.ProductStatusPublished},
+}
+
+type ProductStatusRules struct {
+	BaseRules[dto.ProductScheduleRequest]
+}
+
+func NewProductStatusRules() *ProductStatusRules {
+	return &ProductStatusRules{}
+}
+
+func (r *ProductStatusRules) ValidateTransition(from string, to string) error {
+	if !slices.Contains(productTransitions[from], to) {
+		return fmt.Errorf("Product cannot move from %s to %s", from, to)
+	}
+	return nil
+}
+
+// ValidateApproval checks that the product waits for review.
+func (r *ProductStatusRules) ValidateApproval(status string) error {
+	if status != domain.ProductStatusPendingReview {
+		return fmt.Errorf("Product cannot be approved from %s", status)
+	}
+	return nil
+}
+
+// ValidateReviewer checks that the reviewer is not the user who submitted the product.
+func (r *ProductStatusRules) ValidateReviewer(product domain.Product, reviewerId int64) error {
+	if product.SubmittedBy != nil && *product.SubmittedBy == reviewerId {
+		return errors.New("Products cannot be reviewed by the user who submitted them")
+	}
+	return nil
+}
+
+func (r *ProductStatusRules) ValidateReview(req dto.ReviewProductRequest, rejected bool) error {
+	if err := validation.ValidateStruct(req); err != nil {
+		return err
+	}
+	if rejected && strings.TrimSpace(req.Note) == "" {
+		return errors.New("A rejection needs a note")
+	}
+	return nil
+}
+
+// ValidateSchedule checks a publish schedule for a product in the given status. Both times must lie in the
+// future and a published product can only be scheduled to be unpublished.
+func (r *ProductStatusRules) ValidateSchedule(req dto.ProductScheduleRequest, status string, now time.Time) error {
+	if err := r.ValidateStructure(req); err != nil {
+		return err
+	}
+	if req.PublishAt != nil {
+		if status == domain.ProductStatusPublished {
+			return errors.New("Product is already published")
+		}
+		if !req.PublishAt.After(now) {
+			return errors.New("Publish time must be in the future")
+		}
+	}
+	if req.UnpublishAt != nil {
+		if !req.UnpublishAt.After(now) {
+			return errors.New("Unpublish time must be in the future")
+		}
+		if req.PublishAt != nil && !req.UnpublishAt.After(*req.PublishAt) {
+			return errors.New("Unpublish time must be after the publish time")
+		}
+	}
+	return nil
+}
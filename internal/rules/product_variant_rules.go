@@ -0,0 +1,40 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/validation"
+)
+
+type ProductVariantRules struct {
+	BaseRules[dto.CreateProductVariantRequest]
+}
+
+func NewProductVariantRules() *ProductVariantRules {
+	return &ProductVariantRules{}
+}
+
+func (r *ProductVariantRules) ValidateCreate(req dto.CreateProductVariantRequest) error {
+	if err := r.ValidateStructure(req); err != nil {
+		return err
+	}
+
+	if req.Price != nil && *req.Price < 0 {
+		return errors.New("Variant price cannot be less than 0")
+	}
+	if req.StockQuantity < 0 {
+		return errors.New("Variant stock quantity cannot be less than 0")
+	}
+	if len(req.OptionValueIds) == 0 {
+		return errors.New("Variant must have at least one option value")
+	}
+	return nil
+}
+
+func (r *ProductVariantRules) ValidateOptionType(req dto.CreateOptionTypeRequest) error {
+	return validation.ValidateStruct(req)
+}
+
+func (r *ProductVariantRules) ValidateOptionValue(req dto.CreateOptionValueRequest) error {
+	return validation.ValidateStruct(req)
+}
@@ -0,0 +1,19 @@
+package rules
+
+import "fmt"
+
+type RecommendationRules struct {
+	maxLimit int
+}
+
+func NewRecommendationRules(maxLimit int) *RecommendationRules {
+	return &RecommendationRules{maxLimit: maxLimit}
+}
+
+// ValidateLimit accepts limits up to the number of relations stored per product; zero means all of them.
+func (r *RecommendationRules) ValidateLimit(limit int) error {
+	if limit < 0 || limit > r.maxLimit {
+		return fmt.Errorf("Limit must be between 1 and %d", r.maxLimit)
+	}
+	return nil
+}
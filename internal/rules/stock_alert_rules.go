@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/internal/dto"
+)
+
+const MaxReorderReportDays = 365
+
+type StockAlertRules struct {
+	BaseRules[dto.SetStockThresholdRequest]
+}
+
+func NewStockAlertRules() *StockAlertRules {
+	return &StockAlertRules{}
+}
+
+func (r *StockAlertRules) ValidateThreshold(req dto.SetStockThresholdRequest) error {
+	return r.ValidateStructure(req)
+}
+
+func (r *StockAlertRules) ValidateReport(req dto.ReorderReportRequest) error {
+	if req.Days < 0 || req.Days > MaxReorderReportDays {
+		return errors.New("Days must be between 1 and 365")
+	}
+	if req.CoverDays < 0 || req.CoverDays > MaxReorderReportDays {
+		return errors.New("Cover days must be between 1 and 365")
+	}
+	return nil
+}
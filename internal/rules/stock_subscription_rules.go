@@ -0,0 +1,26 @@
+package rules
+
+import (
+	"errors"
+	"go-ecommerce-service/internal/dto"
+	"strings"
+)
+
+type StockSubscriptionRules struct {
+	BaseRules[dto.CreateStockSubscriptionRequest]
+}
+
+func NewStockSubscriptionRules() *StockSubscriptionRules {
+	return &StockSubscriptionRules{}
+}
+
+func (r *StockSubscriptionRules) ValidateCreate(req dto.CreateStockSubscriptionRequest) error {
+	return r.ValidateStructure(req)
+}
+
+func (r *StockSubscriptionRules) ValidateGuest(req dto.CreateStockSubscriptionRequest) error {
+	if strings.TrimSpace(req.Email) == "" {
+		return errors.New("Email is required to subscribe without an account")
+	}
+	return r.ValidateCreate(req)
+}
@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"fmt"
+	"go-ecommerce-service/pkg/locale"
+	"go-ecommerce-service/pkg/validation"
+)
+
+type TranslationRules struct {
+	locales locale.Locales
+}
+
+func NewTranslationRules(locales locale.Locales) *TranslationRules {
+	return &TranslationRules{locales: locales}
+}
+
+// ValidateLocale accepts the supported locales other than the default one, whose content is stored on the
+// entity itself.
+func (r *TranslationRules) ValidateLocale(requested string) error {
+	if requested == r.locales.Default() {
+		return fmt.Errorf("Content in %s is edited on the entity itself", requested)
+	}
+	if !r.locales.IsTranslated(requested) {
+		return fmt.Errorf("Locale %s is not supported", requested)
+	}
+	return nil
+}
+
+func (r *TranslationRules) ValidateSave(requested string, req interface{}) error {
+	if err := r.ValidateLocale(requested); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(req)
+}
@@ -0,0 +1,11 @@
+package rules
+
+import "go-ecommerce-service/internal/dto"
+
+type WishlistRules struct {
+	BaseRules[dto.CreateWishlistRequest]
+}
+
+func NewWishlistRules() *WishlistRules {
+	return &WishlistRules{}
+}
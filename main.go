@@ -7,8 +7,10 @@ import (
 	"go-ecommerce-service/controller"
 	"go-ecommerce-service/infrastructure/elasticsearch"
 	"go-ecommerce-service/infrastructure/rabbitmq"
+	"go-ecommerce-service/infrastructure/storage"
 	"go-ecommerce-service/internal/jwt"
 	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/pkg/locale"
 	"go-ecommerce-service/pkg/logger"
 	customMiddleware "go-ecommerce-service/pkg/middleware"
 	"go-ecommerce-service/service"
@@ -109,26 +111,98 @@ func main() {
 	}
 	log.Info().Msg("ElasticSearch connection successful! 🚀")
 
+	// Object Storage
+	objectStorage, storageErr := storage.NewObjectStorage(cfg.Storage)
+	if storageErr != nil {
+		log.Fatal().Err(storageErr).Msg("Could not initialize object storage")
+	}
+
+	// Digital files are kept apart from the public media and only served through signed download links.
+	digitalStorageConfig := cfg.Storage
+	digitalStorageConfig.LocalDir = cfg.Digital.LocalDir
+	digitalStorageConfig.S3Bucket = cfg.Digital.S3Bucket
+	digitalStorage, storageErr := storage.NewObjectStorage(digitalStorageConfig)
+	if storageErr != nil {
+		log.Fatal().Err(storageErr).Msg("Could not initialize digital file storage")
+	}
+
+	// Content locales
+	locales := locale.New(cfg.Locale.Default, cfg.Locale.Supported)
+
 	// Dependency Injection
-	productRepository := persistence.NewProductRepository(dbPool, esClient)
+	productRepository := persistence.NewProductRepository(dbPool, esClient, locales.Default(), locales.Translated())
+	if err := productRepository.EnsureIndex(); err != nil {
+		log.Warn().Err(err).Msg("Product search index mapping could not be applied")
+	}
 	userRepository := persistence.NewUserRepository(dbPool)
 	cartRepository := persistence.NewCartRepository(dbPool)
-	carItemRepository := persistence.NewCartItemRepository(dbPool)
+	carItemRepository := persistence.NewRedisCartItemRepository(rdb, persistence.NewCartItemRepository(dbPool),
+		config.ParseDuration(cfg.Cart.RedisTTL, 72*time.Hour))
 	orderRepository := persistence.NewOrderRepository(dbPool)
 	orderItemRepository := persistence.NewOrderItemRepository(dbPool)
 	categoryRepository := persistence.NewCategoryRepository(dbPool)
 	storeRepository := persistence.NewStoreRepository(dbPool)
-
-	productService := service.NewProductService(productRepository, rdb)
+	wishlistRepository := persistence.NewWishlistRepository(dbPool)
+	productVariantRepository := persistence.NewProductVariantRepository(dbPool)
+	slugHistoryRepository := persistence.NewSlugHistoryRepository(dbPool)
+	productImportRepository := persistence.NewProductImportRepository(dbPool)
+	productReviewRepository := persistence.NewProductReviewRepository(dbPool)
+	productPriceRepository := persistence.NewProductPriceRepository(dbPool)
+	priceRuleRepository := persistence.NewPriceRuleRepository(dbPool)
+	recommendationRepository := persistence.NewRecommendationRepository(dbPool)
+	inventoryRepository := persistence.NewInventoryRepository(dbPool)
+	stockAlertRepository := persistence.NewStockAlertRepository(dbPool)
+	stockSubscriptionRepository := persistence.NewStockSubscriptionRepository(dbPool)
+	productImageRepository := persistence.NewProductImageRepository(dbPool)
+	productAttributeRepository := persistence.NewProductAttributeRepository(dbPool)
+	productStatusRepository := persistence.NewProductStatusRepository(dbPool)
+	translationRepository := persistence.NewTranslationRepository(dbPool)
+	sitemapRepository := persistence.NewSitemapRepository(dbPool)
+	bundleRepository := persistence.NewBundleRepository(dbPool)
+	digitalRepository := persistence.NewDigitalRepository(dbPool)
+
+	productService := service.NewProductService(productRepository, productVariantRepository, productAttributeRepository, categoryRepository,
+		slugHistoryRepository, translationRepository, locales, storeRepository, rdb)
 	userService := service.NewUserService(userRepository)
-	cartService := service.NewCartService(cartRepository)
-	carItemService := service.NewCartItemService(carItemRepository)
+	cartService := service.NewCartService(cartRepository, carItemRepository, productRepository, productVariantRepository,
+		priceRuleRepository, rabbitClient, cfg.Cart)
+	carItemService := service.NewCartItemService(carItemRepository, productVariantRepository)
 	orderService := service.NewOrderService(orderRepository, rabbitClient)
-	orderItemService := service.NewOrderItemService(orderItemRepository)
+	orderItemService := service.NewOrderItemService(orderItemRepository, orderRepository, productRepository, productVariantRepository,
+		priceRuleRepository, bundleRepository, rdb)
 	jwtManager := service.NewJWTService()
 	authService := service.NewAuthService(userRepository, jwtManager)
-	categoryService := service.NewCategoryService(categoryRepository)
-	storeService := service.NewStoreService(storeRepository)
+	categoryService := service.NewCategoryService(categoryRepository, translationRepository, locales)
+	storeService := service.NewStoreService(storeRepository, productRepository, slugHistoryRepository, translationRepository, locales, rdb)
+	reorderService := service.NewReorderService(orderRepository, orderItemRepository, productRepository, cartRepository, carItemRepository,
+		productVariantRepository, priceRuleRepository)
+	productVariantService := service.NewProductVariantService(productVariantRepository, productRepository, storeRepository, rdb)
+	productImportService := service.NewProductImportService(productImportRepository, productRepository, productVariantRepository, storeRepository, rdb, cfg.Import)
+	productExportService := service.NewProductExportService(productRepository, productVariantRepository, cfg.Export)
+	trashService := service.NewTrashService(productRepository, productVariantRepository, storeRepository, categoryRepository, rdb,
+		config.ParseDuration(cfg.Trash.Retention, 30*24*time.Hour))
+	productReviewService := service.NewProductReviewService(productReviewRepository, productRepository, productVariantRepository, rdb)
+	productPriceService := service.NewProductPriceService(productPriceRepository, productRepository, productVariantRepository, storeRepository, rdb)
+	priceRuleService := service.NewPriceRuleService(priceRuleRepository, productRepository, productVariantRepository)
+	recommendationService := service.NewRecommendationService(recommendationRepository, productRepository, cartRepository, carItemRepository,
+		rdb, cfg.Recommendation)
+	inventoryService := service.NewInventoryService(inventoryRepository, productRepository, productVariantRepository, storeRepository, rdb)
+	stockAlertService := service.NewStockAlertService(stockAlertRepository, productRepository, productVariantRepository, storeRepository, rabbitClient,
+		cfg.Inventory)
+	stockSubscriptionService := service.NewStockSubscriptionService(stockSubscriptionRepository, productRepository,
+		productVariantRepository, userRepository, rabbitClient, cfg.BackInStock)
+	productImageService := service.NewProductImageService(productImageRepository, productRepository, productVariantRepository, storeRepository, rdb,
+		objectStorage, cfg.Media)
+	productAttributeService := service.NewProductAttributeService(productAttributeRepository, categoryRepository, productRepository,
+		productVariantRepository, rdb)
+	productStatusService := service.NewProductStatusService(productStatusRepository, productRepository, productVariantRepository, storeRepository, rdb)
+	translationService := service.NewTranslationService(translationRepository, productRepository, productVariantRepository,
+		categoryRepository, storeRepository, slugHistoryRepository, locales, rdb)
+	seoService := service.NewSeoService(sitemapRepository, productRepository, productVariantRepository, storeRepository,
+		translationRepository, priceRuleRepository, locales, cfg.Seo, cfg.Export)
+	bundleService := service.NewBundleService(bundleRepository, productRepository, productVariantRepository, storeRepository, rdb)
+	digitalService := service.NewDigitalService(digitalRepository, productRepository, storeRepository, orderRepository, digitalStorage, cfg.Digital)
+	wishlistService := service.NewWishlistService(wishlistRepository, productRepository, productVariantRepository, cartRepository, carItemRepository, rabbitClient)
 
 	productController := controller.NewProductController(productService)
 	userController := controller.NewUserController(userService)
@@ -139,32 +213,106 @@ func main() {
 	authController := controller.NewAuthController(authService)
 	categoryController := controller.NewCategoryController(categoryService)
 	storeController := controller.NewStoreController(storeService)
+	wishlistController := controller.NewWishlistController(wishlistService)
+	reorderController := controller.NewReorderController(reorderService)
+	productVariantController := controller.NewProductVariantController(productVariantService)
+	productImportController := controller.NewProductImportController(productImportService, cfg.Import.MaxFileSizeMB)
+	productExportController := controller.NewProductExportController(productExportService)
+	trashController := controller.NewTrashController(trashService)
+	productReviewController := controller.NewProductReviewController(productReviewService)
+	productPriceController := controller.NewProductPriceController(productPriceService)
+	priceRuleController := controller.NewPriceRuleController(priceRuleService)
+	recommendationController := controller.NewRecommendationController(recommendationService)
+	inventoryController := controller.NewInventoryController(inventoryService)
+	stockAlertController := controller.NewStockAlertController(stockAlertService)
+	stockSubscriptionController := controller.NewStockSubscriptionController(stockSubscriptionService)
+	productImageController := controller.NewProductImageController(productImageService, cfg.Media.MaxFileSizeMB)
+	productAttributeController := controller.NewProductAttributeController(productAttributeService)
+	productStatusController := controller.NewProductStatusController(productStatusService)
+	translationController := controller.NewTranslationController(translationService)
+	seoController := controller.NewSeoController(seoService)
+	bundleController := controller.NewBundleController(bundleService)
+	digitalController := controller.NewDigitalController(digitalService, cfg.Digital.MaxFileSizeMB)
 
 	// Worker
-	orderWorker := worker.NewOrderWorker(rabbitClient, orderRepository)
+	orderWorker := worker.NewOrderWorker(rabbitClient, orderRepository, digitalRepository)
 	orderWorker.Start()
+	cartPersistenceWorker := worker.NewCartPersistenceWorker(carItemRepository, config.ParseDuration(cfg.Cart.FlushInterval, 5*time.Second))
+	cartPersistenceWorker.Start()
+	cartAbandonmentWorker := worker.NewCartAbandonmentWorker(cartService, config.ParseDuration(cfg.Cart.AbandonCheckInterval, 15*time.Minute))
+	cartAbandonmentWorker.Start()
+	wishlistPriceDropWorker := worker.NewWishlistPriceDropWorker(wishlistService, config.ParseDuration(cfg.Wishlist.PriceDropCheckInterval, time.Hour))
+	wishlistPriceDropWorker.Start()
+	trashPurgeWorker := worker.NewTrashPurgeWorker(trashService, config.ParseDuration(cfg.Trash.PurgeInterval, 24*time.Hour))
+	trashPurgeWorker.Start()
+	priceScheduleWorker := worker.NewPriceScheduleWorker(productPriceService, config.ParseDuration(cfg.Pricing.ScheduleInterval, time.Minute))
+	priceScheduleWorker.Start()
+	recommendationWorker := worker.NewRecommendationWorker(recommendationService,
+		config.ParseDuration(cfg.Recommendation.ComputeInterval, 6*time.Hour))
+	recommendationWorker.Start()
+	lowStockWorker := worker.NewLowStockWorker(stockAlertService, config.ParseDuration(cfg.Inventory.LowStockCheckInterval, 5*time.Minute))
+	lowStockWorker.Start()
+	backInStockWorker := worker.NewBackInStockWorker(stockSubscriptionService, config.ParseDuration(cfg.BackInStock.CheckInterval, time.Minute))
+	backInStockWorker.Start()
+	orphanMediaWorker := worker.NewOrphanMediaWorker(productImageService, config.ParseDuration(cfg.Media.OrphanCleanupInterval, 24*time.Hour))
+	orphanMediaWorker.Start()
+	productPublishWorker := worker.NewProductPublishWorker(productStatusService,
+		config.ParseDuration(cfg.Publishing.ScheduleInterval, time.Minute))
+	productPublishWorker.Start()
+	sitemapWorker := worker.NewSitemapWorker(seoService, config.ParseDuration(cfg.Seo.SitemapRefreshInterval, 5*time.Minute))
+	sitemapWorker.Start()
+	bundleWorker := worker.NewBundleWorker(bundleService, config.ParseDuration(cfg.Bundle.RefreshInterval, time.Minute))
+	bundleWorker.Start()
+	digitalFulfillmentWorker := worker.NewDigitalFulfillmentWorker(digitalService,
+		config.ParseDuration(cfg.Digital.FulfillmentInterval, time.Minute))
+	digitalFulfillmentWorker.Start()
 
 	e := echo.New()
 
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
+	if localStorage, ok := objectStorage.(*storage.LocalStorage); ok {
+		e.Static("/media", localStorage.RootDir())
+	}
 
 	authMiddleware := customMiddleware.AuthMiddleware(authService)
 
 	authController.RegisterRoutes(e)
-	productController.RegisterRoutes(e)
+	productExportController.RegisterRoutes(e)
 	userController.RegisterRoutes(e)
 	categoryController.RegisterRoutes(e)
-	storeController.RegisterRoutes(e)
+	recommendationController.RegisterRoutes(e)
+	seoController.RegisterRoutes(e)
 
 	api := e.Group("/api/v1")
 	api.Use(authMiddleware)
-	cartController.RegisterRoutes(e)
+	productController.RegisterRoutes(e, api)
+	productVariantController.RegisterRoutes(e, api)
+	cartController.RegisterRoutes(e, api)
 	cartItemController.RegiesterRoutes(e)
 	orderController.RegisterRoutes(e)
 	orderItemController.RegisterRoutes(e)
+	storeController.RegisterRoutes(e, api)
+	wishlistController.RegisterRoutes(e, api)
+	reorderController.RegisterRoutes(api)
+	trashController.RegisterRoutes(api)
+	productReviewController.RegisterRoutes(e, api)
+	productPriceController.RegisterRoutes(e, api)
+	priceRuleController.RegisterRoutes(e, api)
+	inventoryController.RegisterRoutes(api)
+	stockAlertController.RegisterRoutes(api)
+	stockSubscriptionController.RegisterRoutes(e, api)
+	productImageController.RegisterRoutes(e, api)
+	productAttributeController.RegisterRoutes(e, api)
+	productStatusController.RegisterRoutes(api)
+	productImportController.RegisterRoutes(api)
+	translationController.RegisterRoutes(api)
+	bundleController.RegisterRoutes(e, api)
+	digitalController.RegisterRoutes(e, api)
 
 	e.HTTPErrorHandler = customMiddleware.CustomHTTPErrorHandler
 
+	e.Use(customMiddleware.LocaleMiddleware(locales))
+
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"http://localhost:4200"},
 		AllowMethods: []string{echo.GET, echo.PUT, echo.POST, echo.DELETE},
@@ -189,6 +337,8 @@ func main() {
 		log.Fatal().Err(err).Msg("Server forced to shutdown")
 	}
 
+	cartPersistenceWorker.Flush()
+
 	log.Info().Msg("🔌 Closing connections...")
 	log.Info().Msg("👋 Goodbye! System shut down successfully.")
 }
@@ -0,0 +1,296 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const bundleComponentsQuery = `SELECT bc.id, bc.bundle_id, bc.product_id, bc.variant_id, bc.quantity, bc.position,
+		p.name, COALESCE(v.sku, p.sku), COALESCE(v.price, p.price), COALESCE(v.stock_quantity, p.stock_quantity)
+	FROM bundle_components bc
+	JOIN products p ON p.id = bc.product_id
+	LEFT JOIN product_variants v ON v.id = bc.variant_id
+	WHERE bc.bundle_id = $1
+	ORDER BY bc.position, bc.id`
+
+// bundlePriceQuery prices bundles from the list price of their components. The list total is the bundle's
+// base price, so the saving shows as its discount; a fixed price above the list total is sold without one.
+// Only bundles whose price changed are updated.
+const bundlePriceQuery = `WITH totals AS (
+		SELECT pb.product_id, pb.pricing_mode, pb.fixed_price, pb.discount_percent,
+			ROUND(SUM(COALESCE(v.price, p.price) * bc.quantity), 2) AS list_total
+		FROM product_bundles pb
+		JOIN bundle_components bc ON bc.bundle_id = pb.product_id
+		JOIN products p ON p.id = bc.product_id
+		LEFT JOIN product_variants v ON v.id = bc.variant_id
+		WHERE $1::BIGINT IS NULL OR pb.product_id = $1
+		GROUP BY pb.product_id
+	), priced AS (
+		SELECT product_id, GREATEST(list_total, price) AS base_price, price FROM (
+			SELECT product_id, list_total, CASE WHEN pricing_mode = 'fixed' THEN fixed_price
+				ELSE ROUND(list_total * (100 - discount_percent) / 100, 2) END AS price
+			FROM totals) t
+	)
+	UPDATE products p SET price = priced.price, base_price = priced.base_price, discount = priced.base_price - priced.price,
+		updated_at = CURRENT_TIMESTAMP
+	FROM priced
+	WHERE p.id = priced.product_id AND (p.price, p.base_price) IS DISTINCT FROM (priced.price, priced.base_price)
+	RETURNING p.id`
+
+// bundleStockQuery sets the stock of bundles to the number of complete sets their components allow. A component
+// that is not for sale, being inactive or deleted, leaves its bundles without stock.
+const bundleStockQuery = `UPDATE products b SET stock_quantity = s.quantity FROM (
+		SELECT bc.bundle_id, MIN(CASE WHEN p.is_active AND p.deleted_at IS NULL AND COALESCE(v.is_active, true)
+			THEN COALESCE(v.stock_quantity, p.stock_quantity) ELSE 0 END / bc.quantity) AS quantity
+		FROM bundle_components bc
+		JOIN products p ON p.id = bc.product_id
+		LEFT JOIN product_variants v ON v.id = bc.variant_id
+		WHERE $1::BIGINT IS NULL OR bc.bundle_id = $1
+		GROUP BY bc.bundle_id) s
+	WHERE b.id = s.bundle_id AND b.stock_quantity <> s.quantity
+	RETURNING b.id`
+
+type IBundleRepository interface {
+	GetBundle(productId int64) (domain.ProductBundle, error)
+	IsBundleComponent(productId int64) (bool, error)
+	SaveBundle(bundle domain.ProductBundle, userId int64) (domain.ProductBundle, error)
+	DeleteBundle(productId int64) error
+	RefreshBundles() ([]int64, error)
+	AddBundleOrderItem(orderItem domain.OrderItem, components []domain.OrderItemComponent) (domain.OrderItem, []domain.OrderItemComponent, error)
+	GetOrderItemComponents(orderItemIds []int64) (map[int64][]domain.OrderItemComponent, error)
+	DeleteBundleOrderItems(orderItemIds []int64) error
+}
+
+type BundleRepository struct {
+	dbPool                *pgxpool.Pool
+	scanner               *helper.GenericScanner[domain.ProductBundle]
+	componentScanner      *helper.GenericScanner[domain.BundleComponent]
+	orderComponentScanner *helper.GenericScanner[domain.OrderItemComponent]
+}
+
+func NewBundleRepository(dbPool *pgxpool.Pool) IBundleRepository {
+	return &BundleRepository{
+		dbPool:                dbPool,
+		scanner:               helper.NewGenericScanner(dbPool, helper.ScanProductBundle),
+		componentScanner:      helper.NewGenericScanner(dbPool, helper.ScanBundleComponent),
+		orderComponentScanner: helper.NewGenericScanner(dbPool, helper.ScanOrderItemComponent),
+	}
+}
+
+func (bundleRepository *BundleRepository) GetBundle(productId int64) (domain.ProductBundle, error) {
+	ctx := context.Background()
+	bundle, err := bundleRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM product_bundles WHERE product_id = $1", productId)
+	if err != nil {
+		return domain.ProductBundle{}, err
+	}
+	if bundle.Components, err = bundleRepository.componentScanner.QueryAndScan(ctx, bundleComponentsQuery, productId); err != nil {
+		return domain.ProductBundle{}, err
+	}
+	return bundle, nil
+}
+
+func (bundleRepository *BundleRepository) IsBundleComponent(productId int64) (bool, error) {
+	ctx := context.Background()
+	var isComponent bool
+	err := bundleRepository.dbPool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM bundle_components WHERE product_id = $1)",
+		productId).Scan(&isComponent)
+	if err != nil {
+		return false, common.WrapError("query bundle component", err)
+	}
+	return isComponent, nil
+}
+
+// SaveBundle creates the bundle or replaces its pricing and components, then prices the bundle product and
+// derives its stock from the new components. A price change is recorded for the user who saved the bundle.
+func (bundleRepository *BundleRepository) SaveBundle(bundle domain.ProductBundle, userId int64) (domain.ProductBundle, error) {
+	ctx := context.Background()
+	tx, err := bundleRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.ProductBundle{}, common.WrapError("begin save bundle", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO product_bundles (product_id, pricing_mode, fixed_price, discount_percent) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (product_id) DO UPDATE SET pricing_mode = EXCLUDED.pricing_mode, fixed_price = EXCLUDED.fixed_price,
+			discount_percent = EXCLUDED.discount_percent, updated_at = CURRENT_TIMESTAMP`
+	if _, err := tx.Exec(ctx, query, bundle.ProductId, bundle.PricingMode, bundle.FixedPrice, bundle.DiscountPercent); err != nil {
+		return domain.ProductBundle{}, common.WrapError("save bundle", err)
+	}
+	if _, err := tx.Exec(ctx, "DELETE FROM bundle_components WHERE bundle_id = $1", bundle.ProductId); err != nil {
+		return domain.ProductBundle{}, common.WrapError("delete bundle components", err)
+	}
+	for position, component := range bundle.Components {
+		query := `INSERT INTO bundle_components (bundle_id, product_id, variant_id, quantity, position) VALUES ($1, $2, $3, $4, $5)`
+		if _, err := tx.Exec(ctx, query, bundle.ProductId, component.ProductId, component.VariantId, component.Quantity, position); err != nil {
+			return domain.ProductBundle{}, common.WrapError("add bundle component", err)
+		}
+	}
+	if _, err := refreshBundles(ctx, tx, &bundle.ProductId, &userId); err != nil {
+		return domain.ProductBundle{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.ProductBundle{}, common.WrapError("commit save bundle", err)
+	}
+	return bundleRepository.GetBundle(bundle.ProductId)
+}
+
+// DeleteBundle turns the bundle back into a plain product, whose stock comes from its own stock levels again.
+func (bundleRepository *BundleRepository) DeleteBundle(productId int64) error {
+	ctx := context.Background()
+	tx, err := bundleRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return common.WrapError("begin delete bundle", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, "DELETE FROM product_bundles WHERE product_id = $1", productId)
+	if err != nil {
+		return common.WrapError("delete bundle", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return common.ErrBundleNotFound
+	}
+	if err := refreshStockQuantity(ctx, tx, productId, nil); err != nil {
+		return err
+	}
+	return common.WrapError("commit delete bundle", tx.Commit(ctx))
+}
+
+// RefreshBundles reprices every bundle and derives its stock again, and returns the bundles that changed.
+func (bundleRepository *BundleRepository) RefreshBundles() ([]int64, error) {
+	ctx := context.Background()
+	tx, err := bundleRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, common.WrapError("begin refresh bundles", err)
+	}
+	defer tx.Rollback(ctx)
+
+	changed, err := refreshBundles(ctx, tx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, common.WrapError("commit refresh bundles", err)
+	}
+	return changed, nil
+}
+
+// AddBundleOrderItem adds an ordered bundle with its breakdown and books the sale of the component units. The
+// sales carry the order line as reference, so removing the line can return the units where they came from.
+func (bundleRepository *BundleRepository) AddBundleOrderItem(orderItem domain.OrderItem,
+	components []domain.OrderItemComponent) (domain.OrderItem, []domain.OrderItemComponent, error) {
+	ctx := context.Background()
+	tx, err := bundleRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.OrderItem{}, nil, common.WrapError("begin add bundle order item", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO order_items (order_id, product_id, quantity, price, variant_id) VALUES ($1, $2, $3, $4, $5) RETURNING *`
+	added, err := helper.ScanOrderItem(tx.QueryRow(ctx, query,
+		orderItem.OrderId, orderItem.ProductId, orderItem.Quantity, orderItem.Price, orderItem.VariantId))
+	if err != nil {
+		return domain.OrderItem{}, nil, err
+	}
+
+	reference := orderItemReference(added.Id)
+	reason := fmt.Sprintf("Bundle %d ordered", orderItem.ProductId)
+	saved := make([]domain.OrderItemComponent, 0, len(components))
+	for _, component := range components {
+		query := `INSERT INTO order_item_components (order_item_id, product_id, variant_id, quantity, allocated_price)
+			VALUES ($1, $2, $3, $4, $5) RETURNING *`
+		savedComponent, err := helper.ScanOrderItemComponent(tx.QueryRow(ctx, query,
+			added.Id, component.ProductId, component.VariantId, component.Quantity, component.AllocatedPrice))
+		if err != nil {
+			return domain.OrderItem{}, nil, err
+		}
+		sale := domain.StockMovement{ProductId: savedComponent.ProductId, VariantId: savedComponent.VariantId, Quantity: savedComponent.Quantity}
+		if err := bookSale(ctx, tx, sale, reference, reason); err != nil {
+			return domain.OrderItem{}, nil, err
+		}
+		saved = append(saved, savedComponent)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return domain.OrderItem{}, nil, common.WrapError("commit add bundle order item", err)
+	}
+	return added, saved, nil
+}
+
+func (bundleRepository *BundleRepository) GetOrderItemComponents(orderItemIds []int64) (map[int64][]domain.OrderItemComponent, error) {
+	ctx := context.Background()
+	components, err := bundleRepository.orderComponentScanner.QueryAndScan(ctx,
+		"SELECT * FROM order_item_components WHERE order_item_id = ANY($1) ORDER BY order_item_id, id", orderItemIds)
+	if err != nil {
+		return nil, err
+	}
+	byOrderItem := make(map[int64][]domain.OrderItemComponent)
+	for _, component := range components {
+		byOrderItem[component.OrderItemId] = append(byOrderItem[component.OrderItemId], component)
+	}
+	return byOrderItem, nil
+}
+
+// DeleteBundleOrderItems removes ordered bundles and returns their component units to the warehouses they
+// were sold from.
+func (bundleRepository *BundleRepository) DeleteBundleOrderItems(orderItemIds []int64) error {
+	ctx := context.Background()
+	tx, err := bundleRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return common.WrapError("begin delete bundle order items", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := returnSales(ctx, tx, orderItemIds, "Bundle order line removed"); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, "DELETE FROM order_items WHERE id = ANY($1)", orderItemIds); err != nil {
+		return common.WrapError("delete bundle order items", err)
+	}
+	return common.WrapError("commit delete bundle order items", tx.Commit(ctx))
+}
+
+// refreshBundles prices a bundle, or all of them, and derives its stock inside the caller's transaction. It
+// returns the bundles whose price or stock changed. Price changes are recorded for the user, if a user made them.
+func refreshBundles(ctx context.Context, tx pgx.Tx, productId *int64, userId *int64) ([]int64, error) {
+	changed := make([]int64, 0)
+	seen := make(map[int64]bool)
+	for _, query := range []string{bundlePriceQuery, bundleStockQuery} {
+		rows, err := tx.Query(ctx, query, productId)
+		if err != nil {
+			return nil, common.WrapError("refresh bundles", err)
+		}
+		ids := make([]int64, 0)
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, common.WrapError("scan refreshed bundle", err)
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, common.WrapError("refresh bundles", err)
+		}
+
+		for _, id := range ids {
+			if query == bundlePriceQuery {
+				if _, err := tx.Exec(ctx, recordPriceChangeQuery, id, domain.PriceChangeSourceBundle, userId); err != nil {
+					return nil, common.WrapError("record bundle price change", err)
+				}
+			}
+			if !seen[id] {
+				seen[id] = true
+				changed = append(changed, id)
+			}
+		}
+	}
+	return changed, nil
+}
@@ -3,7 +3,9 @@ package persistence
 import (
 	"context"
 	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
 	"go-ecommerce-service/persistence/helper"
+	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/labstack/gommon/log"
@@ -14,31 +16,49 @@ type ICartItemRepository interface {
 	UpdateItemQuantity(cartItemId int64, newQuantity int) (domain.CartItem, error)
 	RemoveItemFromCart(cartItemId int64) error
 	GetItemsByCartId(cartId int64) []domain.CartItem
+	GetItemById(cartItemId int64) (domain.CartItem, error)
 	ClearCartItems(cartId int64) error
 	IncreaseItemQuantity(cartItemId int64, amount int) error
 	DecreaseItemQuantity(cartItemId int64, amount int) error
 }
 
+// ICartItemSnapshotRepository is the durable cart item store that the Redis cart store persists into.
+type ICartItemSnapshotRepository interface {
+	ICartItemRepository
+	SaveCartSnapshot(cartId int64, items []domain.CartItem) error
+	GetLastItemId() (int64, error)
+	GetCartIdsUpdatedSince(since time.Time) ([]int64, error)
+	ValidateItemReferences(cartId int64, productId int64) error
+}
+
 type CartItemRepository struct {
 	dbPool  *pgxpool.Pool
 	scanner *helper.GenericScanner[domain.CartItem]
 }
 
-func NewCartItemRepository(dbPool *pgxpool.Pool) ICartItemRepository {
+func NewCartItemRepository(dbPool *pgxpool.Pool) ICartItemSnapshotRepository {
 	return &CartItemRepository{
 		dbPool:  dbPool,
 		scanner: helper.NewGenericScanner(dbPool, helper.ScanCartItem),
 	}
 }
 
+func (cartItemRepository *CartItemRepository) touchCart(ctx context.Context, cartId int64) {
+	query := `UPDATE carts SET updated_at = CURRENT_TIMESTAMP, status = 'active' WHERE id = $1`
+	if err := cartItemRepository.scanner.ExecuteExec(ctx, query, cartId); err != nil {
+		log.Error(err)
+	}
+}
+
 func (cartItemRepository *CartItemRepository) AddItemToCart(cartItem domain.CartItem) (domain.CartItem, error) {
 	ctx := context.Background()
-	query := `INSERT INTO cart_items (cart_id,product_id,quantity) VALUES ($1,$2,$3) RETURNING *`
+	query := `INSERT INTO cart_items (cart_id,product_id,quantity,variant_id) VALUES ($1,$2,$3,$4) RETURNING *`
 	cartItem, err := cartItemRepository.scanner.QueryRowAndScan(ctx, query,
-		cartItem.CartId, cartItem.ProductId, cartItem.Quantity)
+		cartItem.CartId, cartItem.ProductId, cartItem.Quantity, cartItem.VariantId)
 	if err != nil {
 		return domain.CartItem{}, err
 	}
+	cartItemRepository.touchCart(ctx, cartItem.CartId)
 	return cartItem, nil
 }
 
@@ -49,16 +69,18 @@ func (cartItemRepository *CartItemRepository) UpdateItemQuantity(cartItemId int6
 	if err != nil {
 		return domain.CartItem{}, err
 	}
+	cartItemRepository.touchCart(ctx, cartItem.CartId)
 	return cartItem, nil
 }
 
 func (cartItemRepository *CartItemRepository) RemoveItemFromCart(cartItemId int64) error {
 	ctx := context.Background()
-	query := `SELECT from cart_items where id=$1`
-	err := cartItemRepository.scanner.ExecuteExec(ctx, query, cartItemId)
+	query := `DELETE from cart_items where id=$1 RETURNING *`
+	item, err := cartItemRepository.scanner.QueryRowAndScan(ctx, query, cartItemId)
 	if err != nil {
 		return err
 	}
+	cartItemRepository.touchCart(ctx, item.CartId)
 	return nil
 }
 
@@ -73,6 +95,12 @@ func (cartItemRepository *CartItemRepository) GetItemsByCartId(cartId int64) []d
 	return items
 }
 
+func (cartItemRepository *CartItemRepository) GetItemById(cartItemId int64) (domain.CartItem, error) {
+	ctx := context.Background()
+	query := `SELECT * from cart_items where id = $1`
+	return cartItemRepository.scanner.QueryRowAndScan(ctx, query, cartItemId)
+}
+
 func (cartItemRepository *CartItemRepository) ClearCartItems(cartId int64) error {
 	ctx := context.Background()
 	query := `DELETE from cart_items where cart_id=$1`
@@ -97,6 +125,7 @@ func (cartItemRepository *CartItemRepository) IncreaseItemQuantity(cartItemId in
 	if executeExecErr != nil {
 		return executeExecErr
 	}
+	cartItemRepository.touchCart(ctx, item.CartId)
 	return nil
 }
 
@@ -113,5 +142,94 @@ func (cartItemRepository *CartItemRepository) DecreaseItemQuantity(cartItemId in
 	if executeExecErr != nil {
 		return executeExecErr
 	}
+	cartItemRepository.touchCart(ctx, item.CartId)
+	return nil
+}
+
+// SaveCartSnapshot replaces the stored lines of a cart with the given items in a single transaction.
+func (cartItemRepository *CartItemRepository) SaveCartSnapshot(cartId int64, items []domain.CartItem) error {
+	ctx := context.Background()
+	tx, err := cartItemRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return common.WrapError("begin cart snapshot", err)
+	}
+	defer tx.Rollback(ctx)
+
+	itemIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		itemIds = append(itemIds, item.Id)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM cart_items WHERE cart_id = $1 AND NOT (id = ANY($2))`, cartId, itemIds); err != nil {
+		return common.WrapError("delete cart snapshot items", err)
+	}
+
+	for _, item := range items {
+		query := `INSERT INTO cart_items (id, cart_id, product_id, quantity, variant_id) VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (id) DO UPDATE SET quantity = EXCLUDED.quantity`
+		if _, err := tx.Exec(ctx, query, item.Id, cartId, item.ProductId, item.Quantity, item.VariantId); err != nil {
+			return common.WrapError("upsert cart snapshot item", err)
+		}
+	}
+
+	if len(items) > 0 {
+		// Ids are allocated by Redis, keep the serial sequence ahead of them for direct inserts.
+		query := `SELECT setval(pg_get_serial_sequence('cart_items', 'id'), (SELECT MAX(id) FROM cart_items))`
+		if _, err := tx.Exec(ctx, query); err != nil {
+			return common.WrapError("advance cart item sequence", err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE carts SET updated_at = CURRENT_TIMESTAMP, status = 'active' WHERE id = $1`, cartId); err != nil {
+		return common.WrapError("touch cart", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// ValidateItemReferences checks that a cart line can be persisted: the cart exists and the product exists
+// and is not in the trash.
+func (cartItemRepository *CartItemRepository) ValidateItemReferences(cartId int64, productId int64) error {
+	ctx := context.Background()
+	var cartExists, productExists bool
+	query := `SELECT EXISTS (SELECT 1 FROM carts WHERE id = $1),
+		EXISTS (SELECT 1 FROM products WHERE id = $2 AND deleted_at IS NULL)`
+	if err := cartItemRepository.dbPool.QueryRow(ctx, query, cartId, productId).Scan(&cartExists, &productExists); err != nil {
+		return common.WrapError("validate cart item references", err)
+	}
+	if !cartExists {
+		return common.ErrCartNotFound
+	}
+	if !productExists {
+		return common.ErrProductNotFound
+	}
 	return nil
 }
+
+func (cartItemRepository *CartItemRepository) GetLastItemId() (int64, error) {
+	ctx := context.Background()
+	var lastId int64
+	err := cartItemRepository.dbPool.QueryRow(ctx, `SELECT COALESCE(MAX(id), 0) FROM cart_items`).Scan(&lastId)
+	if err != nil {
+		return 0, common.WrapError("get last cart item id", err)
+	}
+	return lastId, nil
+}
+
+func (cartItemRepository *CartItemRepository) GetCartIdsUpdatedSince(since time.Time) ([]int64, error) {
+	ctx := context.Background()
+	rows, err := cartItemRepository.scanner.ExecuteQuery(ctx,
+		`SELECT id FROM carts WHERE status = 'active' AND updated_at >= $1`, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cartIds := make([]int64, 0)
+	for rows.Next() {
+		var cartId int64
+		if err := rows.Scan(&cartId); err != nil {
+			return nil, common.WrapError("scan cart id", err)
+		}
+		cartIds = append(cartIds, cartId)
+	}
+	return cartIds, rows.Err()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"go-ecommerce-service/domain"
 	"go-ecommerce-service/persistence/helper"
+	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/labstack/gommon/log"
@@ -15,17 +16,24 @@ type ICartRepository interface {
 	CreateCart(cart domain.Cart) (domain.Cart, error)
 	DeleteCartById(cartId int64) error
 	ClearUserCart(userId int64) error
+	TouchCart(cartId int64) error
+	GetInactiveCarts(inactiveSince time.Time) ([]domain.Cart, error)
+	MarkCartAbandoned(cartId int64) (domain.Cart, error)
+	RestoreCart(cartId int64, abandonedAt int64) (domain.Cart, error)
+	GetAbandonmentStats(since time.Time, storeId *uint) ([]domain.CartAbandonmentStat, error)
 }
 
 type CartRepository struct {
-	dbPool  *pgxpool.Pool
-	scanner *helper.GenericScanner[domain.Cart]
+	dbPool      *pgxpool.Pool
+	scanner     *helper.GenericScanner[domain.Cart]
+	statScanner *helper.GenericScanner[domain.CartAbandonmentStat]
 }
 
 func NewCartRepository(dbPool *pgxpool.Pool) ICartRepository {
 	return &CartRepository{
-		dbPool:  dbPool,
-		scanner: helper.NewGenericScanner(dbPool, helper.ScanCart),
+		dbPool:      dbPool,
+		scanner:     helper.NewGenericScanner(dbPool, helper.ScanCart),
+		statScanner: helper.NewGenericScanner(dbPool, helper.ScanCartAbandonmentStat),
 	}
 }
 
@@ -78,3 +86,57 @@ func (cartRepository *CartRepository) ClearUserCart(userId int64) error {
 	}
 	return nil
 }
+
+func (cartRepository *CartRepository) TouchCart(cartId int64) error {
+	ctx := context.Background()
+	query := `UPDATE carts SET updated_at = CURRENT_TIMESTAMP, status = 'active' WHERE id = $1`
+	return cartRepository.scanner.ExecuteExec(ctx, query, cartId)
+}
+
+func (cartRepository *CartRepository) GetInactiveCarts(inactiveSince time.Time) ([]domain.Cart, error) {
+	ctx := context.Background()
+	query := `SELECT * FROM carts c
+		WHERE c.status = 'active' AND c.updated_at < $1
+		AND EXISTS (SELECT 1 FROM cart_items ci WHERE ci.cart_id = c.id)`
+	carts, err := cartRepository.scanner.QueryAndScan(ctx, query, inactiveSince)
+	if err != nil {
+		return []domain.Cart{}, err
+	}
+	return carts, nil
+}
+
+func (cartRepository *CartRepository) MarkCartAbandoned(cartId int64) (domain.Cart, error) {
+	ctx := context.Background()
+	query := `UPDATE carts SET status = 'abandoned', abandoned_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'active' RETURNING *`
+	return cartRepository.scanner.QueryRowAndScan(ctx, query, cartId)
+}
+
+// RestoreCart reactivates the cart only while it is still abandoned since the given unix time, so a
+// recovery link works once and stops working when the cart is abandoned again.
+func (cartRepository *CartRepository) RestoreCart(cartId int64, abandonedAt int64) (domain.Cart, error) {
+	ctx := context.Background()
+	query := `UPDATE carts SET status = 'active', updated_at = CURRENT_TIMESTAMP, recovered_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'abandoned' AND EXTRACT(EPOCH FROM date_trunc('second', abandoned_at))::BIGINT = $2
+		RETURNING *`
+	return cartRepository.scanner.QueryRowAndScan(ctx, query, cartId, abandonedAt)
+}
+
+func (cartRepository *CartRepository) GetAbandonmentStats(since time.Time, storeId *uint) ([]domain.CartAbandonmentStat, error) {
+	ctx := context.Background()
+	query := `SELECT p.store_id,
+			COUNT(DISTINCT c.id),
+			COUNT(DISTINCT c.id) FILTER (WHERE c.abandoned_at IS NOT NULL),
+			COUNT(DISTINCT c.id) FILTER (WHERE c.recovered_at IS NOT NULL)
+		FROM carts c
+		JOIN cart_items ci ON ci.cart_id = c.id
+		JOIN products p ON p.id = ci.product_id
+		WHERE c.created_at >= $1 AND ($2::BIGINT IS NULL OR p.store_id = $2)
+		GROUP BY p.store_id
+		ORDER BY p.store_id`
+	stats, err := cartRepository.statScanner.QueryAndScan(ctx, query, since, storeId)
+	if err != nil {
+		return []domain.CartAbandonmentStat{}, err
+	}
+	return stats, nil
+}
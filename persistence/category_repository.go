@@ -3,7 +3,11 @@ package persistence
 import (
 	"context"
 	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
 	"go-ecommerce-service/persistence/helper"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
 )
@@ -12,9 +16,15 @@ type ICategoryRepository interface {
 	GetAllCategories() []domain.Category
 	GetCategoryById(id int) (domain.Category, error)
 	GetCategoriesByIsActive(isActive bool) ([]domain.Category, error)
+	GetSubtree(id uint) ([]domain.Category, error)
+	GetCategoriesWithAncestors(ids []uint) ([]domain.Category, error)
 	AddCategory(category domain.Category) (domain.Category, error)
 	UpdateCategory(categoryId uint, category domain.Category) (domain.Category, error)
+	MoveCategory(id uint, parentId *uint, sortOrder int) (domain.Category, error)
 	DeleteCategory(id uint) error
+	GetDeletedCategories() ([]domain.Category, error)
+	RestoreCategoryById(id uint) (domain.Category, error)
+	PurgeDeletedCategories(before time.Time) (int64, error)
 }
 
 type CategoryRepository struct {
@@ -32,7 +42,7 @@ func NewCategoryRepository(dbPool *pgxpool.Pool) ICategoryRepository {
 func (categoryRepository *CategoryRepository) GetAllCategories() []domain.Category {
 	ctx := context.Background()
 
-	categories, err := categoryRepository.scanner.QueryAndScan(ctx, "SELECT * FROM categories")
+	categories, err := categoryRepository.scanner.QueryAndScan(ctx, "SELECT * FROM categories WHERE deleted_at IS NULL ORDER BY sort_order, name, id")
 
 	if err != nil {
 		return []domain.Category{}
@@ -42,7 +52,7 @@ func (categoryRepository *CategoryRepository) GetAllCategories() []domain.Catego
 
 func (categoryRepository *CategoryRepository) GetCategoryById(id int) (domain.Category, error) {
 	ctx := context.Background()
-	category, err := categoryRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM categories WHERE id = $1", id)
+	category, err := categoryRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM categories WHERE id = $1 AND deleted_at IS NULL", id)
 	if err != nil {
 		return domain.Category{}, err
 	}
@@ -51,20 +61,56 @@ func (categoryRepository *CategoryRepository) GetCategoryById(id int) (domain.Ca
 
 func (categoryRepository *CategoryRepository) GetCategoriesByIsActive(isActive bool) ([]domain.Category, error) {
 	ctx := context.Background()
-	categories, err := categoryRepository.scanner.QueryAndScan(ctx, "SELECT * FROM categories WHERE is_active = $1", isActive)
+	categories, err := categoryRepository.scanner.QueryAndScan(ctx, "SELECT * FROM categories WHERE is_active = $1 AND deleted_at IS NULL ORDER BY sort_order, name, id", isActive)
 	if err != nil {
 		return []domain.Category{}, err
 	}
 	return categories, nil
 }
 
+// GetSubtree returns the category followed by all of its live descendants, parents before their children.
+func (categoryRepository *CategoryRepository) GetSubtree(id uint) ([]domain.Category, error) {
+	ctx := context.Background()
+	query := `SELECT c.* FROM categories c
+		JOIN categories r ON c.path LIKE r.path || '%'
+		WHERE r.id = $1 AND r.deleted_at IS NULL AND c.deleted_at IS NULL
+		ORDER BY length(c.path), c.sort_order, c.name, c.id`
+	categories, err := categoryRepository.scanner.QueryAndScan(ctx, query, id)
+	if err != nil {
+		return []domain.Category{}, err
+	}
+	if len(categories) == 0 {
+		return []domain.Category{}, common.ErrCategoryNotFound
+	}
+	return categories, nil
+}
+
+// GetCategoriesWithAncestors returns the live categories with the given ids together with every category above them.
+func (categoryRepository *CategoryRepository) GetCategoriesWithAncestors(ids []uint) ([]domain.Category, error) {
+	ctx := context.Background()
+	query := `SELECT a.* FROM categories a
+		WHERE a.deleted_at IS NULL
+			AND EXISTS (SELECT 1 FROM categories c WHERE c.id = ANY($1) AND c.deleted_at IS NULL AND c.path LIKE a.path || '%')`
+	categories, err := categoryRepository.scanner.QueryAndScan(ctx, query, ids)
+	if err != nil {
+		return []domain.Category{}, err
+	}
+	return categories, nil
+}
+
+// AddCategory inserts the category under its parent. The id is drawn up front so the path can be written in the
+// same statement; a parent that is missing or trashed inserts nothing.
 func (categoryRepository *CategoryRepository) AddCategory(category domain.Category) (domain.Category, error) {
 	ctx := context.Background()
-	query := `INSERT INTO categories (name, description, is_active) 
-              VALUES ($1, $2, $3) 
-              RETURNING *`
+	query := `INSERT INTO categories (id, name, description, is_active, parent_id, sort_order, path)
+		SELECT n.id, $1, $2, $3, $4, $5, COALESCE(p.path, '') || n.id || '/'
+		FROM (SELECT nextval('categories_id_seq') AS id) n
+		LEFT JOIN categories p ON p.id = $4 AND p.deleted_at IS NULL
+		WHERE $4::bigint IS NULL OR p.id IS NOT NULL
+		RETURNING *`
 
-	category, err := categoryRepository.scanner.QueryRowAndScan(ctx, query, category.Name, category.Description, category.IsActive)
+	category, err := categoryRepository.scanner.QueryRowAndScan(ctx, query, category.Name, category.Description, category.IsActive,
+		category.ParentId, category.SortOrder)
 	if err != nil {
 		return domain.Category{}, err
 	}
@@ -72,7 +118,7 @@ func (categoryRepository *CategoryRepository) AddCategory(category domain.Catego
 }
 func (categoryRepository *CategoryRepository) UpdateCategory(categoryId uint, category domain.Category) (domain.Category, error) {
 	ctx := context.Background()
-	query := `UPDATE categories set name = $1, description = $2, is_active = $3 WHERE id = $4 RETURNING *`
+	query := `UPDATE categories set name = $1, description = $2, is_active = $3 WHERE id = $4 AND deleted_at IS NULL RETURNING *`
 
 	category, err := categoryRepository.scanner.QueryRowAndScan(ctx, query, category.Name, category.Description, category.IsActive, categoryId)
 	if err != nil {
@@ -80,12 +126,95 @@ func (categoryRepository *CategoryRepository) UpdateCategory(categoryId uint, ca
 	}
 	return category, nil
 }
+
+// MoveCategory puts the category and its subtree under a new parent, or makes it a root when parentId is nil.
+// Moves take a table lock so two concurrent moves cannot build a cycle between them.
+func (categoryRepository *CategoryRepository) MoveCategory(id uint, parentId *uint, sortOrder int) (domain.Category, error) {
+	ctx := context.Background()
+	tx, err := categoryRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Category{}, common.WrapError("begin category move", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		return domain.Category{}, common.WrapError("lock categories", err)
+	}
+	var oldPath string
+	if err := tx.QueryRow(ctx, "SELECT path FROM categories WHERE id = $1 AND deleted_at IS NULL", id).Scan(&oldPath); err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.Category{}, common.ErrCategoryNotFound
+		}
+		return domain.Category{}, common.WrapError("select category path", err)
+	}
+	parentPath := ""
+	if parentId != nil {
+		if err := tx.QueryRow(ctx, "SELECT path FROM categories WHERE id = $1 AND deleted_at IS NULL", *parentId).Scan(&parentPath); err != nil {
+			if err.Error() == common.NOT_FOUND {
+				return domain.Category{}, common.ErrCategoryNotFound
+			}
+			return domain.Category{}, common.WrapError("select parent category path", err)
+		}
+		if strings.HasPrefix(parentPath, oldPath) {
+			return domain.Category{}, common.ErrCategoryCycle
+		}
+	}
+
+	newPath := parentPath + strconv.FormatUint(uint64(id), 10) + "/"
+	if _, err := tx.Exec(ctx, `UPDATE categories SET path = $1 || substr(path, length($2) + 1) WHERE path LIKE $2 || '%'`,
+		newPath, oldPath); err != nil {
+		return domain.Category{}, common.WrapError("update subtree paths", err)
+	}
+	category, err := helper.ScanCategory(tx.QueryRow(ctx,
+		"UPDATE categories SET parent_id = $2, sort_order = $3 WHERE id = $1 RETURNING *", id, parentId, sortOrder))
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Category{}, common.WrapError("commit category move", err)
+	}
+	return category, nil
+}
+
 func (categoryRepository *CategoryRepository) DeleteCategory(id uint) error {
 	ctx := context.Background()
-	query := `DELETE FROM categories WHERE id = $1`
-	err := categoryRepository.scanner.ExecuteExec(ctx, query, id)
+	query := `UPDATE categories SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL RETURNING *`
+	_, err := categoryRepository.scanner.QueryRowAndScan(ctx, query, id)
+	return err
+}
+
+func (categoryRepository *CategoryRepository) GetDeletedCategories() ([]domain.Category, error) {
+	ctx := context.Background()
+	categories, err := categoryRepository.scanner.QueryAndScan(ctx,
+		"SELECT * FROM categories WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC")
+	if err != nil {
+		return []domain.Category{}, err
+	}
+	return categories, nil
+}
+
+// RestoreCategoryById takes the category out of the trash. A category whose parent is still in the trash has to
+// wait for its parent.
+func (categoryRepository *CategoryRepository) RestoreCategoryById(id uint) (domain.Category, error) {
+	ctx := context.Background()
+	query := `UPDATE categories SET deleted_at = NULL
+		WHERE id = $1 AND deleted_at IS NOT NULL
+			AND (parent_id IS NULL OR EXISTS (SELECT 1 FROM categories p WHERE p.id = categories.parent_id AND p.deleted_at IS NULL))
+		RETURNING *`
+	return categoryRepository.scanner.QueryRowAndScan(ctx, query, id)
+}
+
+// PurgeDeletedCategories removes categories trashed before the given time that no product or subcategory points
+// to anymore. Trashed subtrees are therefore removed leaf first, one level per run.
+func (categoryRepository *CategoryRepository) PurgeDeletedCategories(before time.Time) (int64, error) {
+	ctx := context.Background()
+	query := `DELETE FROM categories c
+		WHERE c.deleted_at < $1 AND NOT EXISTS (SELECT 1 FROM products p WHERE p.category_id = c.id)
+			AND NOT EXISTS (SELECT 1 FROM categories s WHERE s.parent_id = c.id)`
+	tag, err := categoryRepository.dbPool.Exec(ctx, query, before)
 	if err != nil {
-		return err
+		return 0, common.WrapError("purge categories", err)
 	}
-	return nil
+	return tag.RowsAffected(), nil
 }
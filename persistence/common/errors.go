@@ -3,19 +3,50 @@ package common
 import (
 	"errors"
 	"fmt"
+
+	"github.com/jackc/pgconn"
 )
 
 var (
-	ErrProductNotFound   = errors.New("Product not found")
-	ErrUserNotFound      = errors.New("User not found")
-	ErrOrderNotFound     = errors.New("Order not found")
-	ErrOrderItemNotFound = errors.New("Order item not found")
-	ErrCartNotFound      = errors.New("Cart not found")
-	ErrCartItemNotFound  = errors.New("Cart item not found")
-	ErrCategoryNotFound  = errors.New("Category not found")
-	ErrStoreNotFound     = errors.New("Store not found")
-	ErrDatabaseQuery     = errors.New("Database query error")
-	ErrDatabaseExecute   = errors.New("Database execution error")
+	ErrProductNotFound           = errors.New("Product not found")
+	ErrUserNotFound              = errors.New("User not found")
+	ErrOrderNotFound             = errors.New("Order not found")
+	ErrOrderItemNotFound         = errors.New("Order item not found")
+	ErrCartNotFound              = errors.New("Cart not found")
+	ErrCartItemNotFound          = errors.New("Cart item not found")
+	ErrCartConflict              = errors.New("Cart was changed concurrently, please retry")
+	ErrCartNotRecoverable        = errors.New("Cart recovery link was already used or has been replaced")
+	ErrCategoryNotFound          = errors.New("Category not found")
+	ErrCategoryCycle             = errors.New("A category cannot be moved under itself or one of its subcategories")
+	ErrStoreNotFound             = errors.New("Store not found")
+	ErrStoreOwnerNotFound        = errors.New("Store owner not found")
+	ErrWishlistNotFound          = errors.New("Wishlist not found")
+	ErrWishlistItemNotFound      = errors.New("Wishlist item not found")
+	ErrOptionTypeNotFound        = errors.New("Option type not found")
+	ErrOptionValueNotFound       = errors.New("Option value not found")
+	ErrProductVariantNotFound    = errors.New("Product variant not found")
+	ErrSlugNotFound              = errors.New("Slug not found")
+	ErrImportJobNotFound         = errors.New("Import job not found")
+	ErrImportOtherStore          = errors.New("Matches a product of another store")
+	ErrReviewNotFound            = errors.New("Review not found")
+	ErrPriceScheduleNotFound     = errors.New("Price schedule not found")
+	ErrPriceRuleNotFound         = errors.New("Price rule not found")
+	ErrWarehouseNotFound         = errors.New("Warehouse not found")
+	ErrNoDefaultWarehouse        = errors.New("No default warehouse is configured")
+	ErrInsufficientStock         = errors.New("Insufficient stock at the warehouse")
+	ErrStockThresholdNotFound    = errors.New("Stock threshold not found")
+	ErrStockSubscriptionNotFound = errors.New("Stock subscription not found")
+	ErrProductImageNotFound      = errors.New("Product image not found")
+	ErrAttributeNotFound         = errors.New("Attribute not found")
+	ErrTranslationNotFound       = errors.New("Translation not found")
+	ErrSitemapNotFound           = errors.New("Sitemap not found")
+	ErrBundleNotFound            = errors.New("Bundle not found")
+	ErrBundleStock               = errors.New("Bundle stock is derived from its components")
+	ErrDigitalFileNotFound       = errors.New("Digital file not found")
+	ErrDownloadNotFound          = errors.New("Download not found")
+	ErrDownloadUnavailable       = errors.New("Download limit reached or download expired")
+	ErrDatabaseQuery             = errors.New("Database query error")
+	ErrDatabaseExecute           = errors.New("Database execution error")
 )
 
 func WrapError(operation string, err error) error {
@@ -24,3 +55,13 @@ func WrapError(operation string, err error) error {
 	}
 	return fmt.Errorf("%s: %w", operation, err)
 }
+
+// foreignKeyViolation is the Postgres SQLSTATE of a row referencing a missing row.
+const foreignKeyViolation = "23503"
+
+// IsForeignKeyViolation reports whether the statement failed because a referenced row does not exist, an
+// error that retrying the same statement cannot fix.
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation
+}
@@ -0,0 +1,211 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const downloadGrantsQuery = `SELECT g.*, f.* FROM download_grants g JOIN digital_files f ON f.id = g.file_id`
+
+// grantDownloadsQuery grants every paid digital order line the files of its product it has no grant for yet,
+// so files added to a product later reach earlier buyers as well.
+const grantDownloadsQuery = `
+	INSERT INTO download_grants (order_item_id, file_id, user_id, download_limit, expires_at)
+	SELECT oi.id, f.id, o.user_id, $1, $2
+	FROM order_items oi
+	JOIN orders o ON o.id = oi.order_id
+	JOIN products p ON p.id = oi.product_id AND p.product_type = 'digital'
+	JOIN digital_files f ON f.product_id = oi.product_id
+	WHERE LOWER(o.status) = ANY($3)
+		AND NOT EXISTS (SELECT 1 FROM download_grants g WHERE g.order_item_id = oi.id AND g.file_id = f.id)
+	ON CONFLICT (order_item_id, file_id) DO NOTHING`
+
+// missingLicenseKeysQuery lists the paid order lines of products sold with licence keys that hold fewer keys
+// than units ordered.
+const missingLicenseKeysQuery = `
+	SELECT oi.id, oi.product_id, oi.quantity - COUNT(k.id) AS missing
+	FROM order_items oi
+	JOIN orders o ON o.id = oi.order_id
+	JOIN products p ON p.id = oi.product_id AND p.product_type = 'digital'
+	LEFT JOIN license_keys k ON k.order_item_id = oi.id
+	WHERE LOWER(o.status) = ANY($1)
+		AND EXISTS (SELECT 1 FROM license_keys pool WHERE pool.product_id = oi.product_id)
+	GROUP BY oi.id, oi.product_id, oi.quantity
+	HAVING oi.quantity > COUNT(k.id)
+	ORDER BY oi.id`
+
+const assignLicenseKeysQuery = `
+	UPDATE license_keys SET order_item_id = $1, assigned_at = CURRENT_TIMESTAMP
+	WHERE id IN (
+		SELECT id FROM license_keys WHERE product_id = $2 AND assigned_at IS NULL
+		ORDER BY id LIMIT $3 FOR UPDATE SKIP LOCKED)`
+
+type IDigitalRepository interface {
+	GetFiles(productId int64) ([]domain.DigitalFile, error)
+	AddFile(file domain.DigitalFile) (domain.DigitalFile, error)
+	DeleteFile(productId int64, fileId int64) (domain.DigitalFile, error)
+	AddLicenseKeys(productId int64, keys []string) (int, error)
+	GetLicenseKeyPool(productId int64) (domain.LicenseKeyPool, error)
+	GrantDownloads(downloadLimit int, expiresAt time.Time) (int, error)
+	AssignLicenseKeys() (int, int, error)
+	GetOrderDownloads(orderId int64) ([]domain.DownloadGrant, error)
+	GetOrderLicenseKeys(orderId int64) ([]domain.LicenseKey, error)
+	GetDownloadGrant(grantId int64) (domain.DownloadGrant, error)
+	RecordDownload(grantId int64) (domain.DownloadGrant, error)
+	IsDigitalOrder(orderId int64) (bool, error)
+}
+
+type DigitalRepository struct {
+	dbPool       *pgxpool.Pool
+	fileScanner  *helper.GenericScanner[domain.DigitalFile]
+	keyScanner   *helper.GenericScanner[domain.LicenseKey]
+	grantScanner *helper.GenericScanner[domain.DownloadGrant]
+}
+
+func NewDigitalRepository(dbPool *pgxpool.Pool) IDigitalRepository {
+	return &DigitalRepository{
+		dbPool:       dbPool,
+		fileScanner:  helper.NewGenericScanner(dbPool, helper.ScanDigitalFile),
+		keyScanner:   helper.NewGenericScanner(dbPool, helper.ScanLicenseKey),
+		grantScanner: helper.NewGenericScanner(dbPool, helper.ScanDownloadGrant),
+	}
+}
+
+func (digitalRepository *DigitalRepository) GetFiles(productId int64) ([]domain.DigitalFile, error) {
+	ctx := context.Background()
+	return digitalRepository.fileScanner.QueryAndScan(ctx, "SELECT * FROM digital_files WHERE product_id = $1 ORDER BY id", productId)
+}
+
+func (digitalRepository *DigitalRepository) AddFile(file domain.DigitalFile) (domain.DigitalFile, error) {
+	ctx := context.Background()
+	query := `INSERT INTO digital_files (product_id, file_name, storage_key, content_type, size_bytes)
+		VALUES ($1, $2, $3, $4, $5) RETURNING *`
+	return digitalRepository.fileScanner.QueryRowAndScan(ctx, query,
+		file.ProductId, file.FileName, file.StorageKey, file.ContentType, file.SizeBytes)
+}
+
+// DeleteFile removes the file and the download grants issued for it, and returns it so its content can be deleted.
+func (digitalRepository *DigitalRepository) DeleteFile(productId int64, fileId int64) (domain.DigitalFile, error) {
+	ctx := context.Background()
+	return digitalRepository.fileScanner.QueryRowAndScan(ctx,
+		"DELETE FROM digital_files WHERE id = $1 AND product_id = $2 RETURNING *", fileId, productId)
+}
+
+// AddLicenseKeys adds keys to the product's pool and returns how many were new.
+func (digitalRepository *DigitalRepository) AddLicenseKeys(productId int64, keys []string) (int, error) {
+	ctx := context.Background()
+	tag, err := digitalRepository.dbPool.Exec(ctx, `INSERT INTO license_keys (product_id, license_key)
+		SELECT $1, UNNEST($2::TEXT[]) ON CONFLICT (product_id, license_key) DO NOTHING`, productId, keys)
+	if err != nil {
+		return 0, common.WrapError("add license keys", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (digitalRepository *DigitalRepository) GetLicenseKeyPool(productId int64) (domain.LicenseKeyPool, error) {
+	ctx := context.Background()
+	var pool domain.LicenseKeyPool
+	err := digitalRepository.dbPool.QueryRow(ctx, `SELECT COUNT(*) FILTER (WHERE assigned_at IS NULL), COUNT(*) FILTER (WHERE assigned_at IS NOT NULL)
+		FROM license_keys WHERE product_id = $1`, productId).Scan(&pool.Available, &pool.Assigned)
+	if err != nil {
+		return pool, common.WrapError("query license key pool", err)
+	}
+	return pool, nil
+}
+
+func (digitalRepository *DigitalRepository) GrantDownloads(downloadLimit int, expiresAt time.Time) (int, error) {
+	ctx := context.Background()
+	tag, err := digitalRepository.dbPool.Exec(ctx, grantDownloadsQuery, downloadLimit, expiresAt, domain.PaidOrderStatuses)
+	if err != nil {
+		return 0, common.WrapError("grant downloads", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// AssignLicenseKeys gives paid order lines the keys they are missing, oldest lines first. Lines the pool
+// cannot fill are counted as waiting and get their keys once keys are added.
+func (digitalRepository *DigitalRepository) AssignLicenseKeys() (int, int, error) {
+	ctx := context.Background()
+	rows, err := digitalRepository.dbPool.Query(ctx, missingLicenseKeysQuery, domain.PaidOrderStatuses)
+	if err != nil {
+		return 0, 0, common.WrapError("query missing license keys", err)
+	}
+	type line struct {
+		orderItemId int64
+		productId   int64
+		missing     int
+	}
+	lines := make([]line, 0)
+	for rows.Next() {
+		var missing line
+		if err := rows.Scan(&missing.orderItemId, &missing.productId, &missing.missing); err != nil {
+			rows.Close()
+			return 0, 0, common.WrapError("scan missing license keys", err)
+		}
+		lines = append(lines, missing)
+	}
+	rows.Close()
+
+	assigned, waiting := 0, 0
+	for _, missing := range lines {
+		tag, err := digitalRepository.dbPool.Exec(ctx, assignLicenseKeysQuery, missing.orderItemId, missing.productId, missing.missing)
+		if err != nil {
+			return assigned, waiting, common.WrapError("assign license keys", err)
+		}
+		assigned += int(tag.RowsAffected())
+		if int(tag.RowsAffected()) < missing.missing {
+			waiting++
+		}
+	}
+	return assigned, waiting, nil
+}
+
+func (digitalRepository *DigitalRepository) GetOrderDownloads(orderId int64) ([]domain.DownloadGrant, error) {
+	ctx := context.Background()
+	return digitalRepository.grantScanner.QueryAndScan(ctx, downloadGrantsQuery+
+		" JOIN order_items oi ON oi.id = g.order_item_id WHERE oi.order_id = $1 ORDER BY g.order_item_id, f.id", orderId)
+}
+
+func (digitalRepository *DigitalRepository) GetOrderLicenseKeys(orderId int64) ([]domain.LicenseKey, error) {
+	ctx := context.Background()
+	return digitalRepository.keyScanner.QueryAndScan(ctx, `SELECT k.* FROM license_keys k
+		JOIN order_items oi ON oi.id = k.order_item_id WHERE oi.order_id = $1 ORDER BY k.order_item_id, k.id`, orderId)
+}
+
+func (digitalRepository *DigitalRepository) GetDownloadGrant(grantId int64) (domain.DownloadGrant, error) {
+	ctx := context.Background()
+	return digitalRepository.grantScanner.QueryRowAndScan(ctx, downloadGrantsQuery+" WHERE g.id = $1", grantId)
+}
+
+// RecordDownload counts a download of the grant, as long as it has downloads left and has not expired.
+func (digitalRepository *DigitalRepository) RecordDownload(grantId int64) (domain.DownloadGrant, error) {
+	ctx := context.Background()
+	query := `WITH recorded AS (
+			UPDATE download_grants SET download_count = download_count + 1, last_downloaded_at = CURRENT_TIMESTAMP
+			WHERE id = $1 AND download_count < download_limit AND expires_at > CURRENT_TIMESTAMP
+			RETURNING *)
+		SELECT g.*, f.* FROM recorded g JOIN digital_files f ON f.id = g.file_id`
+	grant, err := digitalRepository.grantScanner.QueryRowAndScan(ctx, query, grantId)
+	if errors.Is(err, common.ErrDownloadNotFound) {
+		return domain.DownloadGrant{}, common.ErrDownloadUnavailable
+	}
+	return grant, err
+}
+
+// IsDigitalOrder tells whether the order has lines and all of them are digital products.
+func (digitalRepository *DigitalRepository) IsDigitalOrder(orderId int64) (bool, error) {
+	ctx := context.Background()
+	var digital bool
+	err := digitalRepository.dbPool.QueryRow(ctx, `SELECT COUNT(*) > 0 AND COALESCE(BOOL_AND(p.product_type = 'digital'), false)
+		FROM order_items oi JOIN products p ON p.id = oi.product_id WHERE oi.order_id = $1`, orderId).Scan(&digital)
+	if err != nil {
+		return false, common.WrapError("query digital order", err)
+	}
+	return digital, nil
+}
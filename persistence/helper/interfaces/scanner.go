@@ -7,7 +7,17 @@ import (
 )
 
 type Scannable interface {
-	domain.Product | domain.User | domain.Cart | domain.CartItem | domain.Order | domain.OrderItem | domain.Category | domain.Store
+	domain.Product | domain.User | domain.Cart | domain.CartItem | domain.Order | domain.OrderItem | domain.Category | domain.Store |
+		domain.CartAbandonmentStat | domain.Wishlist | domain.WishlistItem | domain.WishlistPriceDrop |
+		domain.OptionType | domain.OptionValue | domain.ProductVariant | domain.VariantOption | domain.SlugHistory |
+		domain.ProductImportJob | domain.ProductImportResult | domain.ProductReview | domain.ReviewPhoto |
+		domain.PriceChange | domain.PriceSchedule | domain.PriceRule | domain.RelatedProduct |
+		domain.Warehouse | domain.StockLevel | domain.StockMovement | domain.StockThreshold | domain.LowStockItem | domain.StockVelocity |
+		domain.StockSubscription | domain.RestockNotification | domain.ProductImage | domain.ImageRendition |
+		domain.CategoryAttribute | domain.ProductAttributeValue | domain.AttributeFacet | domain.ProductStatusChange |
+		domain.ProductTranslation | domain.CategoryTranslation | domain.StoreTranslation | domain.SitemapPage | domain.SitemapEntry |
+		domain.ProductBundle | domain.BundleComponent | domain.OrderItemComponent |
+		domain.DigitalFile | domain.LicenseKey | domain.DownloadGrant
 }
 type Scanner[T Scannable] interface {
 	Scan(row pgx.Row) (T, error)
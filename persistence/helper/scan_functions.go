@@ -9,7 +9,19 @@ import (
 
 func ScanProduct(row pgx.Row) (domain.Product, error) {
 	var product domain.Product
-	err := row.Scan(
+	err := row.Scan(productFields(&product)...)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.Product{}, common.ErrProductNotFound
+		}
+		return product, common.WrapError("scan product", err)
+	}
+	return product, nil
+}
+
+// productFields returns the scan targets of a products row in column order.
+func productFields(product *domain.Product) []interface{} {
+	return []interface{}{
 		&product.Id,
 		&product.Name,
 		&product.Slug,
@@ -26,14 +38,18 @@ func ScanProduct(row pgx.Row) (domain.Product, error) {
 		&product.StoreId,
 		&product.CreatedAt,
 		&product.UpdatedAt,
-	)
-	if err != nil {
-		if err.Error() == common.NOT_FOUND {
-			return domain.Product{}, common.ErrProductNotFound
-		}
-		return product, common.WrapError("scan product", err)
+		&product.Sku,
+		&product.DeletedAt,
+		&product.AverageRating,
+		&product.ReviewCount,
+		&product.Status,
+		&product.PublishAt,
+		&product.UnpublishAt,
+		&product.SubmittedAt,
+		&product.ReviewNote,
+		&product.ProductType,
+		&product.SubmittedBy,
 	}
-	return product, nil
 }
 
 func ScanStore(row pgx.Row) (domain.Store, error) {
@@ -49,7 +65,8 @@ func ScanStore(row pgx.Row) (domain.Store, error) {
 		&store.ContactAddress,
 		&store.IsActive,
 		&store.CreatedAt,
-		&store.UpdatedAt)
+		&store.UpdatedAt,
+		&store.DeletedAt)
 	if err != nil {
 		if err.Error() == common.NOT_FOUND {
 			return domain.Store{}, common.ErrStoreNotFound
@@ -61,7 +78,7 @@ func ScanStore(row pgx.Row) (domain.Store, error) {
 
 func ScanUser(row pgx.Row) (domain.User, error) {
 	var user domain.User
-	err := row.Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	err := row.Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt, &user.CustomerGroup, &user.Role)
 	if err != nil {
 		if err.Error() == common.NOT_FOUND {
 			return domain.User{}, common.ErrUserNotFound
@@ -73,7 +90,15 @@ func ScanUser(row pgx.Row) (domain.User, error) {
 
 func ScanCart(row pgx.Row) (domain.Cart, error) {
 	var cart domain.Cart
-	err := row.Scan(&cart.Id, &cart.UserId, &cart.CreatedAt)
+	err := row.Scan(
+		&cart.Id,
+		&cart.UserId,
+		&cart.CreatedAt,
+		&cart.UpdatedAt,
+		&cart.Status,
+		&cart.AbandonedAt,
+		&cart.RecoveredAt,
+	)
 	if err != nil {
 		if err.Error() == common.NOT_FOUND {
 			return domain.Cart{}, common.ErrCartNotFound
@@ -83,9 +108,18 @@ func ScanCart(row pgx.Row) (domain.Cart, error) {
 	return cart, nil
 }
 
+func ScanCartAbandonmentStat(row pgx.Row) (domain.CartAbandonmentStat, error) {
+	var stat domain.CartAbandonmentStat
+	err := row.Scan(&stat.StoreId, &stat.TotalCarts, &stat.AbandonedCarts, &stat.RecoveredCarts)
+	if err != nil {
+		return stat, common.WrapError("scan cart abandonment stat", err)
+	}
+	return stat, nil
+}
+
 func ScanCartItem(row pgx.Row) (domain.CartItem, error) {
 	var cartItem domain.CartItem
-	err := row.Scan(&cartItem.Id, &cartItem.CartId, &cartItem.ProductId, &cartItem.Quantity)
+	err := row.Scan(&cartItem.Id, &cartItem.CartId, &cartItem.ProductId, &cartItem.Quantity, &cartItem.VariantId)
 	if err != nil {
 		if err.Error() == common.NOT_FOUND {
 			return domain.CartItem{}, common.ErrCartItemNotFound
@@ -116,7 +150,7 @@ func ScanOrder(row pgx.Row) (domain.Order, error) {
 
 func ScanOrderItem(row pgx.Row) (domain.OrderItem, error) {
 	var orderItem domain.OrderItem
-	err := row.Scan(&orderItem.Id, &orderItem.OrderId, &orderItem.ProductId, &orderItem.Quantity, &orderItem.Price)
+	err := row.Scan(&orderItem.Id, &orderItem.OrderId, &orderItem.ProductId, &orderItem.Quantity, &orderItem.Price, &orderItem.CreatedAt, &orderItem.VariantId)
 	if err != nil {
 		if err.Error() == common.NOT_FOUND {
 			return domain.OrderItem{}, common.ErrOrderItemNotFound
@@ -128,7 +162,8 @@ func ScanOrderItem(row pgx.Row) (domain.OrderItem, error) {
 
 func ScanCategory(row pgx.Row) (domain.Category, error) {
 	var category domain.Category
-	err := row.Scan(&category.Id, &category.Name, &category.Description, &category.IsActive)
+	err := row.Scan(&category.Id, &category.Name, &category.Description, &category.IsActive, &category.ParentId, &category.Path,
+		&category.SortOrder, &category.DeletedAt)
 	if err != nil {
 		if err.Error() == common.NOT_FOUND {
 			return domain.Category{}, common.ErrCategoryNotFound
@@ -137,3 +172,671 @@ func ScanCategory(row pgx.Row) (domain.Category, error) {
 	}
 	return category, nil
 }
+
+func ScanWishlist(row pgx.Row) (domain.Wishlist, error) {
+	var wishlist domain.Wishlist
+	err := row.Scan(
+		&wishlist.Id,
+		&wishlist.UserId,
+		&wishlist.Name,
+		&wishlist.IsPublic,
+		&wishlist.ShareToken,
+		&wishlist.CreatedAt,
+		&wishlist.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.Wishlist{}, common.ErrWishlistNotFound
+		}
+		return wishlist, common.WrapError("scan wishlist", err)
+	}
+	return wishlist, nil
+}
+
+func ScanWishlistItem(row pgx.Row) (domain.WishlistItem, error) {
+	var item domain.WishlistItem
+	err := row.Scan(
+		&item.Id,
+		&item.WishlistId,
+		&item.ProductId,
+		&item.AddedPrice,
+		&item.LastNotifiedPrice,
+		&item.CreatedAt,
+		&item.VariantId,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.WishlistItem{}, common.ErrWishlistItemNotFound
+		}
+		return item, common.WrapError("scan wishlist item", err)
+	}
+	return item, nil
+}
+
+func ScanWishlistPriceDrop(row pgx.Row) (domain.WishlistPriceDrop, error) {
+	var drop domain.WishlistPriceDrop
+	err := row.Scan(
+		&drop.WishlistItemId,
+		&drop.WishlistId,
+		&drop.UserId,
+		&drop.ProductId,
+		&drop.ProductName,
+		&drop.PreviousPrice,
+		&drop.CurrentPrice,
+	)
+	if err != nil {
+		return drop, common.WrapError("scan wishlist price drop", err)
+	}
+	return drop, nil
+}
+
+func ScanOptionType(row pgx.Row) (domain.OptionType, error) {
+	var optionType domain.OptionType
+	err := row.Scan(&optionType.Id, &optionType.Name, &optionType.DisplayName)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.OptionType{}, common.ErrOptionTypeNotFound
+		}
+		return optionType, common.WrapError("scan option type", err)
+	}
+	return optionType, nil
+}
+
+func ScanOptionValue(row pgx.Row) (domain.OptionValue, error) {
+	var optionValue domain.OptionValue
+	err := row.Scan(&optionValue.Id, &optionValue.OptionTypeId, &optionValue.Value, &optionValue.SortOrder)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.OptionValue{}, common.ErrOptionValueNotFound
+		}
+		return optionValue, common.WrapError("scan option value", err)
+	}
+	return optionValue, nil
+}
+
+func ScanProductVariant(row pgx.Row) (domain.ProductVariant, error) {
+	var variant domain.ProductVariant
+	err := row.Scan(
+		&variant.Id,
+		&variant.ProductId,
+		&variant.Sku,
+		&variant.Price,
+		&variant.StockQuantity,
+		&variant.Barcode,
+		&variant.ImageUrl,
+		&variant.IsActive,
+		&variant.CreatedAt,
+		&variant.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.ProductVariant{}, common.ErrProductVariantNotFound
+		}
+		return variant, common.WrapError("scan product variant", err)
+	}
+	return variant, nil
+}
+
+func ScanVariantOption(row pgx.Row) (domain.VariantOption, error) {
+	var option domain.VariantOption
+	err := row.Scan(&option.VariantId, &option.OptionTypeId, &option.OptionType, &option.OptionValueId, &option.Value)
+	if err != nil {
+		return option, common.WrapError("scan variant option", err)
+	}
+	return option, nil
+}
+
+func ScanSlugHistory(row pgx.Row) (domain.SlugHistory, error) {
+	var history domain.SlugHistory
+	err := row.Scan(&history.Id, &history.EntityType, &history.EntityId, &history.Slug, &history.CreatedAt)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.SlugHistory{}, common.ErrSlugNotFound
+		}
+		return history, common.WrapError("scan slug history", err)
+	}
+	return history, nil
+}
+
+func ScanProductImportJob(row pgx.Row) (domain.ProductImportJob, error) {
+	var job domain.ProductImportJob
+	err := row.Scan(
+		&job.Id,
+		&job.FileName,
+		&job.Format,
+		&job.MatchBy,
+		&job.Status,
+		&job.TotalRows,
+		&job.ProcessedRows,
+		&job.CreatedCount,
+		&job.UpdatedCount,
+		&job.FailedCount,
+		&job.Error,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+		&job.CompletedAt,
+		&job.CreatedBy,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.ProductImportJob{}, common.ErrImportJobNotFound
+		}
+		return job, common.WrapError("scan product import job", err)
+	}
+	return job, nil
+}
+
+func ScanProductImportResult(row pgx.Row) (domain.ProductImportResult, error) {
+	var result domain.ProductImportResult
+	err := row.Scan(&result.Id, &result.JobId, &result.RowNumber, &result.Status, &result.MatchKey, &result.ProductId, &result.Message)
+	if err != nil {
+		return result, common.WrapError("scan product import result", err)
+	}
+	return result, nil
+}
+
+func ScanProductReview(row pgx.Row) (domain.ProductReview, error) {
+	var review domain.ProductReview
+	err := row.Scan(
+		&review.Id,
+		&review.ProductId,
+		&review.UserId,
+		&review.Rating,
+		&review.Title,
+		&review.Body,
+		&review.Status,
+		&review.ModerationNote,
+		&review.HelpfulCount,
+		&review.NotHelpfulCount,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+		&review.ModeratedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.ProductReview{}, common.ErrReviewNotFound
+		}
+		return review, common.WrapError("scan product review", err)
+	}
+	return review, nil
+}
+
+func ScanReviewPhoto(row pgx.Row) (domain.ReviewPhoto, error) {
+	var photo domain.ReviewPhoto
+	err := row.Scan(&photo.Id, &photo.ReviewId, &photo.Url, &photo.SortOrder)
+	if err != nil {
+		return photo, common.WrapError("scan review photo", err)
+	}
+	return photo, nil
+}
+
+func ScanPriceChange(row pgx.Row) (domain.PriceChange, error) {
+	var change domain.PriceChange
+	err := row.Scan(
+		&change.Id,
+		&change.ProductId,
+		&change.Price,
+		&change.BasePrice,
+		&change.Discount,
+		&change.Source,
+		&change.ChangedBy,
+		&change.ChangedAt,
+	)
+	if err != nil {
+		return change, common.WrapError("scan price change", err)
+	}
+	return change, nil
+}
+
+func ScanProductStatusChange(row pgx.Row) (domain.ProductStatusChange, error) {
+	var change domain.ProductStatusChange
+	err := row.Scan(
+		&change.Id,
+		&change.ProductId,
+		&change.FromStatus,
+		&change.ToStatus,
+		&change.Note,
+		&change.ChangedBy,
+		&change.ChangedAt,
+	)
+	if err != nil {
+		return change, common.WrapError("scan product status change", err)
+	}
+	return change, nil
+}
+
+func ScanProductTranslation(row pgx.Row) (domain.ProductTranslation, error) {
+	var translation domain.ProductTranslation
+	err := row.Scan(
+		&translation.ProductId,
+		&translation.Locale,
+		&translation.Name,
+		&translation.Slug,
+		&translation.Description,
+		&translation.MetaDescription,
+		&translation.CreatedAt,
+		&translation.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.ProductTranslation{}, common.ErrTranslationNotFound
+		}
+		return translation, common.WrapError("scan product translation", err)
+	}
+	return translation, nil
+}
+
+func ScanCategoryTranslation(row pgx.Row) (domain.CategoryTranslation, error) {
+	var translation domain.CategoryTranslation
+	err := row.Scan(
+		&translation.CategoryId,
+		&translation.Locale,
+		&translation.Name,
+		&translation.Description,
+		&translation.CreatedAt,
+		&translation.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.CategoryTranslation{}, common.ErrTranslationNotFound
+		}
+		return translation, common.WrapError("scan category translation", err)
+	}
+	return translation, nil
+}
+
+func ScanStoreTranslation(row pgx.Row) (domain.StoreTranslation, error) {
+	var translation domain.StoreTranslation
+	err := row.Scan(
+		&translation.StoreId,
+		&translation.Locale,
+		&translation.Description,
+		&translation.CreatedAt,
+		&translation.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.StoreTranslation{}, common.ErrTranslationNotFound
+		}
+		return translation, common.WrapError("scan store translation", err)
+	}
+	return translation, nil
+}
+
+func ScanPriceSchedule(row pgx.Row) (domain.PriceSchedule, error) {
+	var schedule domain.PriceSchedule
+	err := row.Scan(
+		&schedule.Id,
+		&schedule.ProductId,
+		&schedule.Price,
+		&schedule.BasePrice,
+		&schedule.Discount,
+		&schedule.StartsAt,
+		&schedule.EndsAt,
+		&schedule.Status,
+		&schedule.PreviousPrice,
+		&schedule.PreviousBasePrice,
+		&schedule.PreviousDiscount,
+		&schedule.CreatedBy,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.PriceSchedule{}, common.ErrPriceScheduleNotFound
+		}
+		return schedule, common.WrapError("scan price schedule", err)
+	}
+	return schedule, nil
+}
+
+func ScanPriceRule(row pgx.Row) (domain.PriceRule, error) {
+	var rule domain.PriceRule
+	err := row.Scan(
+		&rule.Id,
+		&rule.Name,
+		&rule.Kind,
+		&rule.CustomerGroup,
+		&rule.ProductId,
+		&rule.StoreId,
+		&rule.CategoryId,
+		&rule.PercentOff,
+		&rule.StartsAt,
+		&rule.EndsAt,
+		&rule.IsActive,
+		&rule.CreatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.PriceRule{}, common.ErrPriceRuleNotFound
+		}
+		return rule, common.WrapError("scan price rule", err)
+	}
+	return rule, nil
+}
+
+// ScanRelatedProduct scans a products row followed by the source and score of the relation.
+func ScanRelatedProduct(row pgx.Row) (domain.RelatedProduct, error) {
+	var related domain.RelatedProduct
+	fields := append(productFields(&related.Product), &related.Source, &related.Score)
+	if err := row.Scan(fields...); err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.RelatedProduct{}, common.ErrProductNotFound
+		}
+		return related, common.WrapError("scan related product", err)
+	}
+	return related, nil
+}
+
+func ScanWarehouse(row pgx.Row) (domain.Warehouse, error) {
+	var warehouse domain.Warehouse
+	err := row.Scan(
+		&warehouse.Id,
+		&warehouse.Name,
+		&warehouse.Code,
+		&warehouse.Address,
+		&warehouse.IsDefault,
+		&warehouse.IsActive,
+		&warehouse.CreatedAt,
+		&warehouse.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.Warehouse{}, common.ErrWarehouseNotFound
+		}
+		return warehouse, common.WrapError("scan warehouse", err)
+	}
+	return warehouse, nil
+}
+
+func ScanStockLevel(row pgx.Row) (domain.StockLevel, error) {
+	var level domain.StockLevel
+	err := row.Scan(&level.WarehouseId, &level.ProductId, &level.VariantId, &level.Quantity, &level.UpdatedAt)
+	if err != nil {
+		return level, common.WrapError("scan stock level", err)
+	}
+	return level, nil
+}
+
+func ScanStockMovement(row pgx.Row) (domain.StockMovement, error) {
+	var movement domain.StockMovement
+	err := row.Scan(
+		&movement.Id,
+		&movement.WarehouseId,
+		&movement.ProductId,
+		&movement.VariantId,
+		&movement.Type,
+		&movement.Quantity,
+		&movement.BalanceAfter,
+		&movement.Reason,
+		&movement.Reference,
+		&movement.CreatedBy,
+		&movement.CreatedAt,
+	)
+	if err != nil {
+		return movement, common.WrapError("scan stock movement", err)
+	}
+	return movement, nil
+}
+
+func ScanStockThreshold(row pgx.Row) (domain.StockThreshold, error) {
+	var threshold domain.StockThreshold
+	err := row.Scan(
+		&threshold.Id,
+		&threshold.ProductId,
+		&threshold.VariantId,
+		&threshold.Threshold,
+		&threshold.LeadTimeDays,
+		&threshold.AlertedAt,
+		&threshold.CreatedAt,
+		&threshold.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.StockThreshold{}, common.ErrStockThresholdNotFound
+		}
+		return threshold, common.WrapError("scan stock threshold", err)
+	}
+	return threshold, nil
+}
+
+func ScanLowStockItem(row pgx.Row) (domain.LowStockItem, error) {
+	var item domain.LowStockItem
+	err := row.Scan(&item.ThresholdId, &item.ProductId, &item.VariantId, &item.ProductName, &item.Sku, &item.StoreId,
+		&item.StockQuantity, &item.Threshold)
+	if err != nil {
+		return item, common.WrapError("scan low stock item", err)
+	}
+	return item, nil
+}
+
+func ScanStockVelocity(row pgx.Row) (domain.StockVelocity, error) {
+	var velocity domain.StockVelocity
+	err := row.Scan(&velocity.ProductId, &velocity.VariantId, &velocity.ProductName, &velocity.Sku, &velocity.StoreId,
+		&velocity.StockQuantity, &velocity.Threshold, &velocity.LeadTimeDays, &velocity.UnitsSold)
+	if err != nil {
+		return velocity, common.WrapError("scan stock velocity", err)
+	}
+	return velocity, nil
+}
+
+func ScanStockSubscription(row pgx.Row) (domain.StockSubscription, error) {
+	var subscription domain.StockSubscription
+	err := row.Scan(
+		&subscription.Id,
+		&subscription.ProductId,
+		&subscription.VariantId,
+		&subscription.UserId,
+		&subscription.Email,
+		&subscription.Status,
+		&subscription.NotifiedAt,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.StockSubscription{}, common.ErrStockSubscriptionNotFound
+		}
+		return subscription, common.WrapError("scan stock subscription", err)
+	}
+	return subscription, nil
+}
+
+func ScanRestockNotification(row pgx.Row) (domain.RestockNotification, error) {
+	var notification domain.RestockNotification
+	err := row.Scan(&notification.SubscriptionId, &notification.ProductId, &notification.VariantId, &notification.UserId,
+		&notification.Email, &notification.ProductName, &notification.Slug, &notification.Sku, &notification.StockQuantity)
+	if err != nil {
+		return notification, common.WrapError("scan restock notification", err)
+	}
+	return notification, nil
+}
+
+func ScanProductImage(row pgx.Row) (domain.ProductImage, error) {
+	var image domain.ProductImage
+	err := row.Scan(
+		&image.Id,
+		&image.ProductId,
+		&image.StorageKey,
+		&image.Url,
+		&image.AltText,
+		&image.Position,
+		&image.ContentType,
+		&image.Width,
+		&image.Height,
+		&image.SizeBytes,
+		&image.CreatedAt,
+		&image.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.ProductImage{}, common.ErrProductImageNotFound
+		}
+		return image, common.WrapError("scan product image", err)
+	}
+	return image, nil
+}
+
+func ScanImageRendition(row pgx.Row) (domain.ImageRendition, error) {
+	var rendition domain.ImageRendition
+	err := row.Scan(&rendition.ImageId, &rendition.Name, &rendition.ContentType, &rendition.Width, &rendition.Height,
+		&rendition.StorageKey, &rendition.Url)
+	if err != nil {
+		return rendition, common.WrapError("scan image rendition", err)
+	}
+	return rendition, nil
+}
+
+func ScanCategoryAttribute(row pgx.Row) (domain.CategoryAttribute, error) {
+	var attribute domain.CategoryAttribute
+	err := row.Scan(
+		&attribute.Id,
+		&attribute.CategoryId,
+		&attribute.Code,
+		&attribute.Name,
+		&attribute.Type,
+		&attribute.Unit,
+		&attribute.Options,
+		&attribute.IsRequired,
+		&attribute.IsFilterable,
+		&attribute.Position,
+		&attribute.CreatedAt,
+		&attribute.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.CategoryAttribute{}, common.ErrAttributeNotFound
+		}
+		return attribute, common.WrapError("scan category attribute", err)
+	}
+	return attribute, nil
+}
+
+func ScanProductAttributeValue(row pgx.Row) (domain.ProductAttributeValue, error) {
+	var value domain.ProductAttributeValue
+	err := row.Scan(&value.ProductId, &value.AttributeId, &value.Code, &value.Name, &value.Type, &value.Unit,
+		&value.TextValue, &value.NumberValue, &value.BoolValue)
+	if err != nil {
+		return value, common.WrapError("scan product attribute value", err)
+	}
+	return value, nil
+}
+
+func ScanAttributeFacet(row pgx.Row) (domain.AttributeFacet, error) {
+	var facet domain.AttributeFacet
+	err := row.Scan(&facet.AttributeId, &facet.Value, &facet.MinNumber, &facet.MaxNumber, &facet.Count)
+	if err != nil {
+		return facet, common.WrapError("scan attribute facet", err)
+	}
+	return facet, nil
+}
+
+func ScanSitemapPage(row pgx.Row) (domain.SitemapPage, error) {
+	var page domain.SitemapPage
+	err := row.Scan(&page.EntityType, &page.Page, &page.Fingerprint, &page.EntryCount, &page.LastModified, &page.GeneratedAt)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.SitemapPage{}, common.ErrSitemapNotFound
+		}
+		return page, common.WrapError("scan sitemap page", err)
+	}
+	return page, nil
+}
+
+// ScanSitemapEntry reads an entry whose alternate slugs come as two parallel arrays of locales and slugs.
+func ScanSitemapEntry(row pgx.Row) (domain.SitemapEntry, error) {
+	var entry domain.SitemapEntry
+	var locales, slugs []string
+	err := row.Scan(&entry.EntityId, &entry.Slug, &entry.LastModified, &locales, &slugs)
+	if err != nil {
+		return entry, common.WrapError("scan sitemap entry", err)
+	}
+	entry.Alternates = make(map[string]string, len(locales))
+	for i, locale := range locales {
+		entry.Alternates[locale] = slugs[i]
+	}
+	return entry, nil
+}
+
+func ScanProductBundle(row pgx.Row) (domain.ProductBundle, error) {
+	var bundle domain.ProductBundle
+	err := row.Scan(&bundle.ProductId, &bundle.PricingMode, &bundle.FixedPrice, &bundle.DiscountPercent, &bundle.CreatedAt, &bundle.UpdatedAt)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.ProductBundle{}, common.ErrBundleNotFound
+		}
+		return bundle, common.WrapError("scan product bundle", err)
+	}
+	return bundle, nil
+}
+
+// ScanBundleComponent reads a component joined with the current name, SKU, price and stock of its product or variant.
+func ScanBundleComponent(row pgx.Row) (domain.BundleComponent, error) {
+	var component domain.BundleComponent
+	err := row.Scan(
+		&component.Id,
+		&component.BundleId,
+		&component.ProductId,
+		&component.VariantId,
+		&component.Quantity,
+		&component.Position,
+		&component.Name,
+		&component.Sku,
+		&component.ListPrice,
+		&component.StockQuantity,
+	)
+	if err != nil {
+		return component, common.WrapError("scan bundle component", err)
+	}
+	return component, nil
+}
+
+func ScanOrderItemComponent(row pgx.Row) (domain.OrderItemComponent, error) {
+	var component domain.OrderItemComponent
+	err := row.Scan(&component.Id, &component.OrderItemId, &component.ProductId, &component.VariantId, &component.Quantity,
+		&component.AllocatedPrice)
+	if err != nil {
+		return component, common.WrapError("scan order item component", err)
+	}
+	return component, nil
+}
+
+func ScanDigitalFile(row pgx.Row) (domain.DigitalFile, error) {
+	var file domain.DigitalFile
+	err := row.Scan(digitalFileFields(&file)...)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.DigitalFile{}, common.ErrDigitalFileNotFound
+		}
+		return file, common.WrapError("scan digital file", err)
+	}
+	return file, nil
+}
+
+func digitalFileFields(file *domain.DigitalFile) []interface{} {
+	return []interface{}{&file.Id, &file.ProductId, &file.FileName, &file.StorageKey, &file.ContentType, &file.SizeBytes, &file.CreatedAt}
+}
+
+func ScanLicenseKey(row pgx.Row) (domain.LicenseKey, error) {
+	var key domain.LicenseKey
+	err := row.Scan(&key.Id, &key.ProductId, &key.Key, &key.OrderItemId, &key.AssignedAt, &key.CreatedAt)
+	if err != nil {
+		return key, common.WrapError("scan license key", err)
+	}
+	return key, nil
+}
+
+// ScanDownloadGrant scans a download_grants row followed by the digital_files row of its file.
+func ScanDownloadGrant(row pgx.Row) (domain.DownloadGrant, error) {
+	var grant domain.DownloadGrant
+	fields := []interface{}{&grant.Id, &grant.OrderItemId, &grant.FileId, &grant.UserId, &grant.DownloadCount, &grant.DownloadLimit,
+		&grant.ExpiresAt, &grant.LastDownloadedAt, &grant.CreatedAt}
+	err := row.Scan(append(fields, digitalFileFields(&grant.File)...)...)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.DownloadGrant{}, common.ErrDownloadNotFound
+		}
+		return grant, common.WrapError("scan download grant", err)
+	}
+	return grant, nil
+}
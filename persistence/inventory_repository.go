@@ -0,0 +1,387 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// isBundleQuery tells whether a product is a bundle, whose stock is derived from its components.
+const isBundleQuery = "SELECT EXISTS (SELECT 1 FROM product_bundles WHERE product_id = $1)"
+
+// sellableStockQuery sums the stock of a product or variant over the active warehouses.
+const sellableStockQuery = `SELECT COALESCE(SUM(sl.quantity), 0) FROM stock_levels sl
+	JOIN warehouses w ON w.id = sl.warehouse_id AND w.is_active
+	WHERE sl.product_id = $1 AND sl.variant_id IS NOT DISTINCT FROM $2`
+
+type IInventoryRepository interface {
+	GetWarehouses() ([]domain.Warehouse, error)
+	GetWarehouseById(warehouseId int64) (domain.Warehouse, error)
+	AddWarehouse(warehouse domain.Warehouse) (domain.Warehouse, error)
+	UpdateWarehouse(warehouseId int64, warehouse domain.Warehouse) (domain.Warehouse, error)
+	GetStockLevels(productId int64) ([]domain.StockLevel, error)
+	GetMovements(filter domain.StockMovementFilter) ([]domain.StockMovement, error)
+	RecordMovements(movements []domain.StockMovement) ([]domain.StockMovement, error)
+}
+
+type InventoryRepository struct {
+	dbPool          *pgxpool.Pool
+	scanner         *helper.GenericScanner[domain.Warehouse]
+	levelScanner    *helper.GenericScanner[domain.StockLevel]
+	movementScanner *helper.GenericScanner[domain.StockMovement]
+}
+
+func NewInventoryRepository(dbPool *pgxpool.Pool) IInventoryRepository {
+	return &InventoryRepository{
+		dbPool:          dbPool,
+		scanner:         helper.NewGenericScanner(dbPool, helper.ScanWarehouse),
+		levelScanner:    helper.NewGenericScanner(dbPool, helper.ScanStockLevel),
+		movementScanner: helper.NewGenericScanner(dbPool, helper.ScanStockMovement),
+	}
+}
+
+func (inventoryRepository *InventoryRepository) GetWarehouses() ([]domain.Warehouse, error) {
+	ctx := context.Background()
+	warehouses, err := inventoryRepository.scanner.QueryAndScan(ctx, "SELECT * FROM warehouses ORDER BY id")
+	if err != nil {
+		return []domain.Warehouse{}, err
+	}
+	return warehouses, nil
+}
+
+func (inventoryRepository *InventoryRepository) GetWarehouseById(warehouseId int64) (domain.Warehouse, error) {
+	ctx := context.Background()
+	return inventoryRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM warehouses WHERE id = $1", warehouseId)
+}
+
+func (inventoryRepository *InventoryRepository) AddWarehouse(warehouse domain.Warehouse) (domain.Warehouse, error) {
+	ctx := context.Background()
+	tx, err := inventoryRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Warehouse{}, common.WrapError("begin add warehouse", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if warehouse.IsDefault {
+		if _, err := tx.Exec(ctx, "UPDATE warehouses SET is_default = false, updated_at = CURRENT_TIMESTAMP WHERE is_default"); err != nil {
+			return domain.Warehouse{}, common.WrapError("clear default warehouse", err)
+		}
+	}
+	query := `INSERT INTO warehouses (name, code, address, is_default, is_active) VALUES ($1, $2, $3, $4, $5) RETURNING *`
+	created, err := helper.ScanWarehouse(tx.QueryRow(ctx, query,
+		warehouse.Name, warehouse.Code, warehouse.Address, warehouse.IsDefault, warehouse.IsActive))
+	if err != nil {
+		return domain.Warehouse{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Warehouse{}, common.WrapError("commit add warehouse", err)
+	}
+	return created, nil
+}
+
+// UpdateWarehouse updates the warehouse and refreshes the stock quantities of everything stored there,
+// since stock at an inactive warehouse is not available to sell.
+func (inventoryRepository *InventoryRepository) UpdateWarehouse(warehouseId int64, warehouse domain.Warehouse) (domain.Warehouse, error) {
+	ctx := context.Background()
+	tx, err := inventoryRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Warehouse{}, common.WrapError("begin update warehouse", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if warehouse.IsDefault {
+		query := "UPDATE warehouses SET is_default = false, updated_at = CURRENT_TIMESTAMP WHERE is_default AND id <> $1"
+		if _, err := tx.Exec(ctx, query, warehouseId); err != nil {
+			return domain.Warehouse{}, common.WrapError("clear default warehouse", err)
+		}
+	}
+	query := `UPDATE warehouses SET name = $1, code = $2, address = $3, is_default = $4, is_active = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6 RETURNING *`
+	updated, err := helper.ScanWarehouse(tx.QueryRow(ctx, query,
+		warehouse.Name, warehouse.Code, warehouse.Address, warehouse.IsDefault, warehouse.IsActive, warehouseId))
+	if err != nil {
+		return domain.Warehouse{}, err
+	}
+
+	rows, err := tx.Query(ctx, "SELECT product_id, variant_id FROM stock_levels WHERE warehouse_id = $1", warehouseId)
+	if err != nil {
+		return domain.Warehouse{}, common.WrapError("query warehouse stock", err)
+	}
+	type location struct {
+		productId int64
+		variantId *int64
+	}
+	locations := make([]location, 0)
+	for rows.Next() {
+		var stocked location
+		if err := rows.Scan(&stocked.productId, &stocked.variantId); err != nil {
+			rows.Close()
+			return domain.Warehouse{}, common.WrapError("scan warehouse stock", err)
+		}
+		locations = append(locations, stocked)
+	}
+	rows.Close()
+	for _, stocked := range locations {
+		if err := refreshStockQuantity(ctx, tx, stocked.productId, stocked.variantId); err != nil {
+			return domain.Warehouse{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Warehouse{}, common.WrapError("commit update warehouse", err)
+	}
+	return updated, nil
+}
+
+func (inventoryRepository *InventoryRepository) GetStockLevels(productId int64) ([]domain.StockLevel, error) {
+	ctx := context.Background()
+	query := `SELECT * FROM stock_levels WHERE product_id = $1 ORDER BY variant_id NULLS FIRST, warehouse_id`
+	levels, err := inventoryRepository.levelScanner.QueryAndScan(ctx, query, productId)
+	if err != nil {
+		return []domain.StockLevel{}, err
+	}
+	return levels, nil
+}
+
+// GetMovements returns the ledger of a product, newest first, continuing before the filter's movement id.
+func (inventoryRepository *InventoryRepository) GetMovements(filter domain.StockMovementFilter) ([]domain.StockMovement, error) {
+	ctx := context.Background()
+	args := []interface{}{filter.ProductId}
+	query := "SELECT * FROM stock_movements WHERE product_id = $1"
+	if filter.WarehouseId != nil {
+		args = append(args, *filter.WarehouseId)
+		query += fmt.Sprintf(" AND warehouse_id = $%d", len(args))
+	}
+	if filter.Before != nil {
+		args = append(args, *filter.Before)
+		query += fmt.Sprintf(" AND id < $%d", len(args))
+	}
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY id DESC LIMIT $%d", len(args))
+
+	movements, err := inventoryRepository.movementScanner.QueryAndScan(ctx, query, args...)
+	if err != nil {
+		return []domain.StockMovement{}, err
+	}
+	return movements, nil
+}
+
+// RecordMovements books the movements in one transaction, so both legs of a transfer land or neither does.
+func (inventoryRepository *InventoryRepository) RecordMovements(movements []domain.StockMovement) ([]domain.StockMovement, error) {
+	ctx := context.Background()
+	tx, err := inventoryRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, common.WrapError("begin record movements", err)
+	}
+	defer tx.Rollback(ctx)
+
+	recorded := make([]domain.StockMovement, 0, len(movements))
+	for _, movement := range movements {
+		booked, err := applyStockMovement(ctx, tx, movement)
+		if err != nil {
+			return nil, err
+		}
+		recorded = append(recorded, booked)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, common.WrapError("commit record movements", err)
+	}
+	return recorded, nil
+}
+
+// applyStockMovement books a movement against the stock level of its location, appends it to the ledger
+// and refreshes the derived stock quantity. A movement that would take the level below zero fails with
+// ErrInsufficientStock. Bundles hold no stock of their own and fail with ErrBundleStock.
+func applyStockMovement(ctx context.Context, tx pgx.Tx, movement domain.StockMovement) (domain.StockMovement, error) {
+	var isBundle bool
+	if err := tx.QueryRow(ctx, isBundleQuery, movement.ProductId).Scan(&isBundle); err != nil {
+		return domain.StockMovement{}, common.WrapError("query bundle", err)
+	}
+	if isBundle {
+		return domain.StockMovement{}, common.ErrBundleStock
+	}
+
+	var balance int
+	if movement.Quantity > 0 {
+		query := `INSERT INTO stock_levels (warehouse_id, product_id, variant_id, quantity) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (warehouse_id, product_id, (COALESCE(variant_id, 0)))
+			DO UPDATE SET quantity = stock_levels.quantity + EXCLUDED.quantity, updated_at = CURRENT_TIMESTAMP
+			RETURNING quantity`
+		err := tx.QueryRow(ctx, query, movement.WarehouseId, movement.ProductId, movement.VariantId, movement.Quantity).Scan(&balance)
+		if err != nil {
+			return domain.StockMovement{}, common.WrapError("increase stock level", err)
+		}
+	} else {
+		query := `UPDATE stock_levels SET quantity = quantity + $4, updated_at = CURRENT_TIMESTAMP
+			WHERE warehouse_id = $1 AND product_id = $2 AND variant_id IS NOT DISTINCT FROM $3 AND quantity + $4 >= 0
+			RETURNING quantity`
+		err := tx.QueryRow(ctx, query, movement.WarehouseId, movement.ProductId, movement.VariantId, movement.Quantity).Scan(&balance)
+		if err != nil {
+			if err.Error() == common.NOT_FOUND {
+				return domain.StockMovement{}, common.ErrInsufficientStock
+			}
+			return domain.StockMovement{}, common.WrapError("decrease stock level", err)
+		}
+	}
+
+	query := `INSERT INTO stock_movements (warehouse_id, product_id, variant_id, movement_type, quantity, balance_after, reason, reference, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING *`
+	booked, err := helper.ScanStockMovement(tx.QueryRow(ctx, query, movement.WarehouseId, movement.ProductId, movement.VariantId,
+		movement.Type, movement.Quantity, balance, movement.Reason, movement.Reference, movement.CreatedBy))
+	if err != nil {
+		return domain.StockMovement{}, err
+	}
+	if err := refreshStockQuantity(ctx, tx, movement.ProductId, movement.VariantId); err != nil {
+		return domain.StockMovement{}, err
+	}
+	return booked, nil
+}
+
+// refreshStockQuantity recomputes the stock_quantity column of a product or variant, which is derived from
+// the stock levels at the active warehouses. Bundles keep the stock derived from their components.
+func refreshStockQuantity(ctx context.Context, tx pgx.Tx, productId int64, variantId *int64) error {
+	query := "UPDATE products SET stock_quantity = (" + sellableStockQuery + ") WHERE id = $1 AND NOT EXISTS (" +
+		"SELECT 1 FROM product_bundles WHERE product_id = $1)"
+	if variantId != nil {
+		query = "UPDATE product_variants SET stock_quantity = (" + sellableStockQuery + ") WHERE id = $2"
+	}
+	if _, err := tx.Exec(ctx, query, productId, variantId); err != nil {
+		return common.WrapError("refresh stock quantity", err)
+	}
+	return nil
+}
+
+// setStockQuantity brings the sellable stock of a product or variant to the given quantity by booking the
+// difference at the default warehouse. It serves the places that still accept a plain stock quantity,
+// product and variant creation and imports. The quantity given for a bundle is ignored.
+func setStockQuantity(ctx context.Context, tx pgx.Tx, productId int64, variantId *int64, quantity int,
+	movementType string, reason string) error {
+	var isBundle bool
+	if err := tx.QueryRow(ctx, isBundleQuery, productId).Scan(&isBundle); err != nil {
+		return common.WrapError("query bundle", err)
+	}
+	if isBundle {
+		return nil
+	}
+
+	var current int
+	if err := tx.QueryRow(ctx, sellableStockQuery, productId, variantId).Scan(&current); err != nil {
+		return common.WrapError("query sellable stock", err)
+	}
+	if current == quantity {
+		return nil
+	}
+
+	var warehouseId int64
+	if err := tx.QueryRow(ctx, "SELECT id FROM warehouses WHERE is_default").Scan(&warehouseId); err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return common.ErrNoDefaultWarehouse
+		}
+		return common.WrapError("query default warehouse", err)
+	}
+	_, err := applyStockMovement(ctx, tx, domain.StockMovement{
+		WarehouseId: warehouseId,
+		ProductId:   productId,
+		VariantId:   variantId,
+		Type:        movementType,
+		Quantity:    quantity - current,
+		Reason:      &reason,
+	})
+	return err
+}
+
+// bookSale takes the units of the sale from the active warehouses, the default warehouse first and then the
+// ones holding the most, and fails with ErrInsufficientStock when they do not hold enough together. The sale
+// gives ProductId, VariantId and the positive Quantity sold.
+func bookSale(ctx context.Context, tx pgx.Tx, sale domain.StockMovement, reference string, reason string) error {
+	rows, err := tx.Query(ctx, `SELECT sl.warehouse_id, sl.quantity FROM stock_levels sl
+		JOIN warehouses w ON w.id = sl.warehouse_id AND w.is_active
+		WHERE sl.product_id = $1 AND sl.variant_id IS NOT DISTINCT FROM $2 AND sl.quantity > 0
+		ORDER BY w.is_default DESC, sl.quantity DESC, sl.warehouse_id`, sale.ProductId, sale.VariantId)
+	if err != nil {
+		return common.WrapError("query sale stock", err)
+	}
+	type level struct {
+		warehouseId int64
+		quantity    int
+	}
+	levels := make([]level, 0)
+	for rows.Next() {
+		var stocked level
+		if err := rows.Scan(&stocked.warehouseId, &stocked.quantity); err != nil {
+			rows.Close()
+			return common.WrapError("scan sale stock", err)
+		}
+		levels = append(levels, stocked)
+	}
+	rows.Close()
+
+	remaining := sale.Quantity
+	for _, stocked := range levels {
+		if remaining == 0 {
+			break
+		}
+		quantity := min(remaining, stocked.quantity)
+		_, err := applyStockMovement(ctx, tx, domain.StockMovement{
+			WarehouseId: stocked.warehouseId,
+			ProductId:   sale.ProductId,
+			VariantId:   sale.VariantId,
+			Type:        domain.StockMovementSale,
+			Quantity:    -quantity,
+			Reason:      &reason,
+			Reference:   &reference,
+		})
+		if err != nil {
+			return err
+		}
+		remaining -= quantity
+	}
+	if remaining > 0 {
+		return common.ErrInsufficientStock
+	}
+	return nil
+}
+
+// returnSales returns the units sold for the order lines to the warehouses they were sold from.
+func returnSales(ctx context.Context, tx pgx.Tx, orderItemIds []int64, reason string) error {
+	references := make([]string, 0, len(orderItemIds))
+	for _, orderItemId := range orderItemIds {
+		references = append(references, orderItemReference(orderItemId))
+	}
+	rows, err := tx.Query(ctx, `SELECT warehouse_id, product_id, variant_id, quantity, reference FROM stock_movements
+		WHERE reference = ANY($1) AND movement_type = $2 ORDER BY id`, references, domain.StockMovementSale)
+	if err != nil {
+		return common.WrapError("query order line sales", err)
+	}
+	returns := make([]domain.StockMovement, 0)
+	for rows.Next() {
+		var sale domain.StockMovement
+		if err := rows.Scan(&sale.WarehouseId, &sale.ProductId, &sale.VariantId, &sale.Quantity, &sale.Reference); err != nil {
+			rows.Close()
+			return common.WrapError("scan order line sale", err)
+		}
+		sale.Type = domain.StockMovementReturn
+		sale.Quantity = -sale.Quantity
+		sale.Reason = &reason
+		returns = append(returns, sale)
+	}
+	rows.Close()
+
+	for _, movement := range returns {
+		if _, err := applyStockMovement(ctx, tx, movement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func orderItemReference(orderItemId int64) string {
+	return fmt.Sprintf("order_item:%d", orderItemId)
+}
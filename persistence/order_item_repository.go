@@ -2,9 +2,12 @@ package persistence
 
 import (
 	"context"
+	"fmt"
 	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
 	"go-ecommerce-service/persistence/helper"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -31,14 +34,27 @@ func NewOrderItemRepository(dbPool *pgxpool.Pool) IOrderItemRepository {
 	}
 }
 
+// AddOrderItem adds the line and books the sale of its units, which carries the line as reference.
 func (orderItemRepository *OrderItemRepository) AddOrderItem(orderItem domain.OrderItem) (domain.OrderItem, error) {
 	ctx := context.Background()
-	query := `insert into order_items (order_id, product_id, quantity, price) values($1,$2,$3,$4) RETURNING *`
-	addedOrderItem, err := orderItemRepository.scanner.QueryRowAndScan(ctx, query,
-		orderItem.Id, orderItem.ProductId, orderItem.Quantity, orderItem.Price)
+	tx, err := orderItemRepository.dbPool.Begin(ctx)
 	if err != nil {
+		return domain.OrderItem{}, common.WrapError("begin add order item", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `insert into order_items (order_id, product_id, quantity, price, variant_id) values($1,$2,$3,$4,$5) RETURNING *`
+	addedOrderItem, err := helper.ScanOrderItem(tx.QueryRow(ctx, query,
+		orderItem.OrderId, orderItem.ProductId, orderItem.Quantity, orderItem.Price, orderItem.VariantId))
+	if err != nil {
+		return domain.OrderItem{}, err
+	}
+	if err := bookOrderItemSale(ctx, tx, addedOrderItem); err != nil {
 		return domain.OrderItem{}, err
 	}
+	if err := tx.Commit(ctx); err != nil {
+		return domain.OrderItem{}, common.WrapError("commit add order item", err)
+	}
 	return addedOrderItem, nil
 }
 
@@ -69,41 +85,88 @@ func (orderItemRepository *OrderItemRepository) GetOrderItemsByProductId(product
 	return orderItems, nil
 }
 
+// UpdateOrderItem replaces the line. The units sold for it go back to stock and the changed line is sold again.
 func (orderItemRepository *OrderItemRepository) UpdateOrderItem(orderItemId int64, orderItem domain.OrderItem) (domain.OrderItem, error) {
-	ctx := context.Background()
-	query := `update order_items set order_id=$1,product_id=$2,quantity=$3,price=$4 where id=$5 RETURNING *`
-	updatedOrderItem, err := orderItemRepository.scanner.QueryRowAndScan(ctx, query,
-		orderItem.OrderId, orderItem.ProductId, orderItem.Quantity, orderItem.Price, orderItem.Id)
+	query := `update order_items set order_id=$1,product_id=$2,quantity=$3,price=$4,variant_id=$5 where id=$6 RETURNING *`
+	return orderItemRepository.resell(orderItemId, query,
+		orderItem.OrderId, orderItem.ProductId, orderItem.Quantity, orderItem.Price, orderItem.VariantId, orderItem.Id)
+}
+
+func (orderItemRepository *OrderItemRepository) UpdateOrderItemQuantity(orderItemId int64, quantity int) (domain.OrderItem, error) {
+	query := `update order_items set quantity=$1 where id=$2 RETURNING *`
+	return orderItemRepository.resell(orderItemId, query, quantity, orderItemId)
+}
+
+// DeleteOrderItemById removes the line and returns its units to the warehouses they were sold from.
+func (orderItemRepository *OrderItemRepository) DeleteOrderItemById(orderItemId int64) error {
+	return orderItemRepository.deleteOrderItems([]int64{orderItemId})
+}
+
+func (orderItemRepository *OrderItemRepository) DeleteAllOrderItemsByOrderId(orderId int64) error {
+	orderItems, err := orderItemRepository.GetOrderItemsByOrderId(orderId)
 	if err != nil {
-		return domain.OrderItem{}, err
+		return err
 	}
-	return updatedOrderItem, nil
+	orderItemIds := make([]int64, 0, len(orderItems))
+	for _, orderItem := range orderItems {
+		orderItemIds = append(orderItemIds, orderItem.Id)
+	}
+	return orderItemRepository.deleteOrderItems(orderItemIds)
 }
 
-func (orderItemRepository *OrderItemRepository) UpdateOrderItemQuantity(orderItemId int64, quantity int) (domain.OrderItem, error) {
+// resell returns the units sold for the line to stock, changes the line with the update query and books the
+// sale of the changed line, all in one transaction.
+func (orderItemRepository *OrderItemRepository) resell(orderItemId int64, query string, args ...interface{}) (domain.OrderItem, error) {
 	ctx := context.Background()
-	query := `update order_items set quantity=$1 where id=$2 RETURNING *`
-	updatedOrderItem, err := orderItemRepository.scanner.QueryRowAndScan(ctx, query, quantity, orderItemId)
+	tx, err := orderItemRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.OrderItem{}, common.WrapError("begin update order item", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := returnSales(ctx, tx, []int64{orderItemId}, "Order line changed"); err != nil {
+		return domain.OrderItem{}, err
+	}
+	updatedOrderItem, err := helper.ScanOrderItem(tx.QueryRow(ctx, query, args...))
 	if err != nil {
 		return domain.OrderItem{}, err
 	}
+	if err := bookOrderItemSale(ctx, tx, updatedOrderItem); err != nil {
+		return domain.OrderItem{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return domain.OrderItem{}, common.WrapError("commit update order item", err)
+	}
 	return updatedOrderItem, nil
 }
 
-func (orderItemRepository *OrderItemRepository) DeleteOrderItemById(orderItem_id int64) error {
+func (orderItemRepository *OrderItemRepository) deleteOrderItems(orderItemIds []int64) error {
 	ctx := context.Background()
-	err := orderItemRepository.scanner.ExecuteExec(ctx, "delete from order_items where id = $1", orderItem_id)
+	tx, err := orderItemRepository.dbPool.Begin(ctx)
 	if err != nil {
+		return common.WrapError("begin delete order items", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := returnSales(ctx, tx, orderItemIds, "Order line removed"); err != nil {
 		return err
 	}
-	return nil
+	if _, err := tx.Exec(ctx, "delete from order_items where id = ANY($1)", orderItemIds); err != nil {
+		return common.WrapError("delete order items", err)
+	}
+	return common.WrapError("commit delete order items", tx.Commit(ctx))
 }
 
-func (orderItemRepository *OrderItemRepository) DeleteAllOrderItemsByOrderId(orderId int64) error {
-	ctx := context.Background()
-	err := orderItemRepository.scanner.ExecuteExec(ctx, "delete from order_items where order_id = $1", orderId)
-	if err != nil {
-		return err
+// bookOrderItemSale takes the units of the line from stock. Digital products are delivered, not taken from stock.
+func bookOrderItemSale(ctx context.Context, tx pgx.Tx, orderItem domain.OrderItem) error {
+	var digital bool
+	if err := tx.QueryRow(ctx, "SELECT product_type = $2 FROM products WHERE id = $1",
+		orderItem.ProductId, domain.ProductTypeDigital).Scan(&digital); err != nil {
+		return common.WrapError("query product type", err)
+	}
+	if digital {
+		return nil
 	}
-	return nil
+	sale := domain.StockMovement{ProductId: orderItem.ProductId, VariantId: orderItem.VariantId, Quantity: orderItem.Quantity}
+	return bookSale(ctx, tx, sale, orderItemReference(orderItem.Id), fmt.Sprintf("Order %d", orderItem.OrderId))
 }
@@ -0,0 +1,117 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type IPriceRuleRepository interface {
+	GetPriceRules() ([]domain.PriceRule, error)
+	GetActiveRules(now time.Time) ([]domain.PriceRule, error)
+	GetCategoryPaths(categoryIds []uint) (map[uint]string, error)
+	AddPriceRule(rule domain.PriceRule) (domain.PriceRule, error)
+	DeletePriceRule(ruleId int64) error
+	GetCustomerGroup(userId int64) (string, error)
+	SetCustomerGroup(userId int64, customerGroup string) error
+}
+
+type PriceRuleRepository struct {
+	dbPool  *pgxpool.Pool
+	scanner *helper.GenericScanner[domain.PriceRule]
+}
+
+func NewPriceRuleRepository(dbPool *pgxpool.Pool) IPriceRuleRepository {
+	return &PriceRuleRepository{
+		dbPool:  dbPool,
+		scanner: helper.NewGenericScanner(dbPool, helper.ScanPriceRule),
+	}
+}
+
+func (ruleRepository *PriceRuleRepository) GetPriceRules() ([]domain.PriceRule, error) {
+	ctx := context.Background()
+	rules, err := ruleRepository.scanner.QueryAndScan(ctx, "SELECT * FROM price_rules ORDER BY id DESC")
+	if err != nil {
+		return []domain.PriceRule{}, err
+	}
+	return rules, nil
+}
+
+// GetActiveRules returns the enabled rules whose time window contains the given moment.
+func (ruleRepository *PriceRuleRepository) GetActiveRules(now time.Time) ([]domain.PriceRule, error) {
+	ctx := context.Background()
+	query := `SELECT * FROM price_rules
+		WHERE is_active AND (starts_at IS NULL OR starts_at <= $1) AND (ends_at IS NULL OR ends_at > $1)`
+	rules, err := ruleRepository.scanner.QueryAndScan(ctx, query, now)
+	if err != nil {
+		return []domain.PriceRule{}, err
+	}
+	return rules, nil
+}
+
+// GetCategoryPaths returns the paths of the given categories and of every category below them, keyed by id.
+func (ruleRepository *PriceRuleRepository) GetCategoryPaths(categoryIds []uint) (map[uint]string, error) {
+	ctx := context.Background()
+	rows, err := ruleRepository.dbPool.Query(ctx, `SELECT c.id, c.path FROM categories c
+		WHERE c.deleted_at IS NULL
+			AND EXISTS (SELECT 1 FROM categories r WHERE r.id = ANY($1) AND r.deleted_at IS NULL AND c.path LIKE r.path || '%')`,
+		categoryIds)
+	if err != nil {
+		return nil, common.WrapError("query category paths", err)
+	}
+	defer rows.Close()
+
+	paths := make(map[uint]string)
+	for rows.Next() {
+		var id uint
+		var path string
+		if err := rows.Scan(&id, &path); err != nil {
+			return nil, common.WrapError("scan category path", err)
+		}
+		paths[id] = path
+	}
+	return paths, rows.Err()
+}
+
+func (ruleRepository *PriceRuleRepository) AddPriceRule(rule domain.PriceRule) (domain.PriceRule, error) {
+	ctx := context.Background()
+	query := `INSERT INTO price_rules (name, kind, customer_group, product_id, store_id, category_id, percent_off, starts_at, ends_at, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *`
+	return ruleRepository.scanner.QueryRowAndScan(ctx, query, rule.Name, rule.Kind, rule.CustomerGroup, rule.ProductId,
+		rule.StoreId, rule.CategoryId, rule.PercentOff, rule.StartsAt, rule.EndsAt, rule.IsActive)
+}
+
+func (ruleRepository *PriceRuleRepository) DeletePriceRule(ruleId int64) error {
+	ctx := context.Background()
+	_, err := ruleRepository.scanner.QueryRowAndScan(ctx, "DELETE FROM price_rules WHERE id = $1 RETURNING *", ruleId)
+	return err
+}
+
+func (ruleRepository *PriceRuleRepository) GetCustomerGroup(userId int64) (string, error) {
+	ctx := context.Background()
+	var customerGroup string
+	err := ruleRepository.dbPool.QueryRow(ctx, "SELECT customer_group FROM users WHERE id = $1", userId).Scan(&customerGroup)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return "", common.ErrUserNotFound
+		}
+		return "", common.WrapError("get customer group", err)
+	}
+	return customerGroup, nil
+}
+
+func (ruleRepository *PriceRuleRepository) SetCustomerGroup(userId int64, customerGroup string) error {
+	ctx := context.Background()
+	result, err := ruleRepository.dbPool.Exec(ctx, "UPDATE users SET customer_group = $1 WHERE id = $2", customerGroup, userId)
+	if err != nil {
+		return common.WrapError("set customer group", err)
+	}
+	if result.RowsAffected() == 0 {
+		return common.ErrUserNotFound
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const attributeValuesQuery = `SELECT pav.product_id, ca.id, ca.code, ca.name, ca.type, ca.unit, pav.text_value, pav.number_value, pav.bool_value
+	FROM product_attribute_values pav
+	JOIN category_attributes ca ON ca.id = pav.attribute_id
+	WHERE pav.product_id = ANY($1)
+	ORDER BY pav.product_id, ca.position, ca.id`
+
+type IProductAttributeRepository interface {
+	GetCategoryAttributes(categoryId int64) ([]domain.CategoryAttribute, error)
+	GetAttributeById(attributeId int64) (domain.CategoryAttribute, error)
+	AddAttribute(attribute domain.CategoryAttribute) (domain.CategoryAttribute, error)
+	UpdateAttribute(attributeId int64, attribute domain.CategoryAttribute) (domain.CategoryAttribute, error)
+	DeleteAttribute(attributeId int64) ([]int64, error)
+	GetUsedValues(attributeId int64) ([]string, error)
+	GetAttributeValues(productIds []int64) (map[int64][]domain.ProductAttributeValue, error)
+	GetFacets(categoryId int64) ([]domain.AttributeFacet, error)
+}
+
+type ProductAttributeRepository struct {
+	dbPool       *pgxpool.Pool
+	scanner      *helper.GenericScanner[domain.CategoryAttribute]
+	valueScanner *helper.GenericScanner[domain.ProductAttributeValue]
+	facetScanner *helper.GenericScanner[domain.AttributeFacet]
+}
+
+func NewProductAttributeRepository(dbPool *pgxpool.Pool) IProductAttributeRepository {
+	return &ProductAttributeRepository{
+		dbPool:       dbPool,
+		scanner:      helper.NewGenericScanner(dbPool, helper.ScanCategoryAttribute),
+		valueScanner: helper.NewGenericScanner(dbPool, helper.ScanProductAttributeValue),
+		facetScanner: helper.NewGenericScanner(dbPool, helper.ScanAttributeFacet),
+	}
+}
+
+func (attributeRepository *ProductAttributeRepository) GetCategoryAttributes(categoryId int64) ([]domain.CategoryAttribute, error) {
+	ctx := context.Background()
+	attributes, err := attributeRepository.scanner.QueryAndScan(ctx,
+		"SELECT * FROM category_attributes WHERE category_id = $1 ORDER BY position, id", categoryId)
+	if err != nil {
+		return []domain.CategoryAttribute{}, err
+	}
+	return attributes, nil
+}
+
+func (attributeRepository *ProductAttributeRepository) GetAttributeById(attributeId int64) (domain.CategoryAttribute, error) {
+	ctx := context.Background()
+	return attributeRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM category_attributes WHERE id = $1", attributeId)
+}
+
+func (attributeRepository *ProductAttributeRepository) AddAttribute(attribute domain.CategoryAttribute) (domain.CategoryAttribute, error) {
+	ctx := context.Background()
+	query := `INSERT INTO category_attributes (category_id, code, name, type, unit, options, is_required, is_filterable, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING *`
+	return attributeRepository.scanner.QueryRowAndScan(ctx, query, attribute.CategoryId, attribute.Code, attribute.Name,
+		attribute.Type, attribute.Unit, attribute.Options, attribute.IsRequired, attribute.IsFilterable, attribute.Position)
+}
+
+// UpdateAttribute changes the attribute's definition. The code and type are kept so stored values stay valid.
+func (attributeRepository *ProductAttributeRepository) UpdateAttribute(attributeId int64, attribute domain.CategoryAttribute) (domain.CategoryAttribute, error) {
+	ctx := context.Background()
+	query := `UPDATE category_attributes SET name = $1, unit = $2, options = $3, is_required = $4, is_filterable = $5,
+		position = $6, updated_at = CURRENT_TIMESTAMP WHERE id = $7 RETURNING *`
+	return attributeRepository.scanner.QueryRowAndScan(ctx, query, attribute.Name, attribute.Unit, attribute.Options,
+		attribute.IsRequired, attribute.IsFilterable, attribute.Position, attributeId)
+}
+
+// DeleteAttribute removes the attribute with its values and returns the products that had a value, so their
+// search documents can be refreshed.
+func (attributeRepository *ProductAttributeRepository) DeleteAttribute(attributeId int64) ([]int64, error) {
+	ctx := context.Background()
+	tx, err := attributeRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, common.WrapError("begin delete attribute", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var productIds []int64
+	query := `WITH deleted AS (DELETE FROM product_attribute_values WHERE attribute_id = $1 RETURNING product_id)
+		SELECT COALESCE(array_agg(product_id), '{}') FROM deleted`
+	if err := tx.QueryRow(ctx, query, attributeId).Scan(&productIds); err != nil {
+		return nil, common.WrapError("delete attribute values", err)
+	}
+	if _, err := helper.ScanCategoryAttribute(tx.QueryRow(ctx, "DELETE FROM category_attributes WHERE id = $1 RETURNING *", attributeId)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, common.WrapError("commit delete attribute", err)
+	}
+	return productIds, nil
+}
+
+// GetUsedValues returns the distinct text values products store for the attribute.
+func (attributeRepository *ProductAttributeRepository) GetUsedValues(attributeId int64) ([]string, error) {
+	ctx := context.Background()
+	var values []string
+	query := `SELECT COALESCE(array_agg(DISTINCT text_value), '{}') FROM product_attribute_values
+		WHERE attribute_id = $1 AND text_value IS NOT NULL`
+	if err := attributeRepository.dbPool.QueryRow(ctx, query, attributeId).Scan(&values); err != nil {
+		return nil, common.WrapError("query used attribute values", err)
+	}
+	return values, nil
+}
+
+func (attributeRepository *ProductAttributeRepository) GetAttributeValues(productIds []int64) (map[int64][]domain.ProductAttributeValue, error) {
+	return attributeValuesByProduct(context.Background(), attributeRepository.valueScanner, productIds)
+}
+
+// GetFacets counts the live, active products of the category per value of its filterable attributes.
+// Number attributes are reported as one row with their value range.
+func (attributeRepository *ProductAttributeRepository) GetFacets(categoryId int64) ([]domain.AttributeFacet, error) {
+	ctx := context.Background()
+	query := `SELECT ca.id,
+			CASE WHEN ca.type = 'number' THEN '' ELSE COALESCE(pav.text_value, pav.bool_value::text) END AS value,
+			MIN(pav.number_value), MAX(pav.number_value), COUNT(*)
+		FROM product_attribute_values pav
+		JOIN category_attributes ca ON ca.id = pav.attribute_id
+		JOIN products p ON p.id = pav.product_id
+		WHERE ca.category_id = $1 AND ca.is_filterable AND p.category_id = ca.category_id
+			AND p.deleted_at IS NULL AND p.is_active
+		GROUP BY ca.id, value
+		ORDER BY ca.id, COUNT(*) DESC, value`
+	facets, err := attributeRepository.facetScanner.QueryAndScan(ctx, query, categoryId)
+	if err != nil {
+		return []domain.AttributeFacet{}, err
+	}
+	return facets, nil
+}
+
+func attributeValuesByProduct(ctx context.Context, scanner *helper.GenericScanner[domain.ProductAttributeValue],
+	productIds []int64) (map[int64][]domain.ProductAttributeValue, error) {
+	valuesByProduct := make(map[int64][]domain.ProductAttributeValue)
+	if len(productIds) == 0 {
+		return valuesByProduct, nil
+	}
+	values, err := scanner.QueryAndScan(ctx, attributeValuesQuery, productIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		valuesByProduct[value.ProductId] = append(valuesByProduct[value.ProductId], value)
+	}
+	return valuesByProduct, nil
+}
+
+// replaceAttributeValues stores the given values as the product's complete set of attribute values.
+func replaceAttributeValues(ctx context.Context, tx pgx.Tx, productId int64, values []domain.ProductAttributeValue) error {
+	if _, err := tx.Exec(ctx, "DELETE FROM product_attribute_values WHERE product_id = $1", productId); err != nil {
+		return common.WrapError("delete product attribute values", err)
+	}
+	for _, value := range values {
+		query := `INSERT INTO product_attribute_values (product_id, attribute_id, text_value, number_value, bool_value)
+			VALUES ($1, $2, $3, $4, $5)`
+		if _, err := tx.Exec(ctx, query, productId, value.AttributeId, value.TextValue, value.NumberValue, value.BoolValue); err != nil {
+			return common.WrapError("insert product attribute value", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type IProductImageRepository interface {
+	GetImages(productId int64) ([]domain.ProductImage, error)
+	GetImageById(imageId int64) (domain.ProductImage, error)
+	AddImage(image domain.ProductImage) (domain.ProductImage, error)
+	UpdateAltText(imageId int64, altText string) (domain.ProductImage, error)
+	ReorderImages(productId int64, imageIds []int64) error
+	DeleteImage(imageId int64) (domain.ProductImage, error)
+	GetStorageKeys() (map[string]bool, error)
+}
+
+type ProductImageRepository struct {
+	dbPool           *pgxpool.Pool
+	scanner          *helper.GenericScanner[domain.ProductImage]
+	renditionScanner *helper.GenericScanner[domain.ImageRendition]
+}
+
+func NewProductImageRepository(dbPool *pgxpool.Pool) IProductImageRepository {
+	return &ProductImageRepository{
+		dbPool:           dbPool,
+		scanner:          helper.NewGenericScanner(dbPool, helper.ScanProductImage),
+		renditionScanner: helper.NewGenericScanner(dbPool, helper.ScanImageRendition),
+	}
+}
+
+func (imageRepository *ProductImageRepository) GetImages(productId int64) ([]domain.ProductImage, error) {
+	ctx := context.Background()
+	images, err := imageRepository.scanner.QueryAndScan(ctx,
+		"SELECT * FROM product_images WHERE product_id = $1 ORDER BY position, id", productId)
+	if err != nil {
+		return []domain.ProductImage{}, err
+	}
+	return imageRepository.withRenditions(ctx, images)
+}
+
+func (imageRepository *ProductImageRepository) GetImageById(imageId int64) (domain.ProductImage, error) {
+	ctx := context.Background()
+	image, err := imageRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM product_images WHERE id = $1", imageId)
+	if err != nil {
+		return domain.ProductImage{}, err
+	}
+	images, err := imageRepository.withRenditions(ctx, []domain.ProductImage{image})
+	if err != nil {
+		return domain.ProductImage{}, err
+	}
+	return images[0], nil
+}
+
+// AddImage appends the image with its renditions to the end of the product's gallery.
+func (imageRepository *ProductImageRepository) AddImage(image domain.ProductImage) (domain.ProductImage, error) {
+	ctx := context.Background()
+	tx, err := imageRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.ProductImage{}, common.WrapError("begin add product image", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO product_images (product_id, storage_key, url, alt_text, position, content_type, width, height, size_bytes)
+		VALUES ($1, $2, $3, $4, (SELECT COALESCE(MAX(position) + 1, 0) FROM product_images WHERE product_id = $1), $5, $6, $7, $8)
+		RETURNING *`
+	added, err := helper.ScanProductImage(tx.QueryRow(ctx, query, image.ProductId, image.StorageKey, image.Url, image.AltText,
+		image.ContentType, image.Width, image.Height, image.SizeBytes))
+	if err != nil {
+		return domain.ProductImage{}, err
+	}
+
+	added.Renditions = make([]domain.ImageRendition, 0, len(image.Renditions))
+	for _, rendition := range image.Renditions {
+		saved, err := helper.ScanImageRendition(tx.QueryRow(ctx, `INSERT INTO product_image_renditions
+			(image_id, name, content_type, width, height, storage_key, url) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`,
+			added.Id, rendition.Name, rendition.ContentType, rendition.Width, rendition.Height, rendition.StorageKey, rendition.Url))
+		if err != nil {
+			return domain.ProductImage{}, err
+		}
+		added.Renditions = append(added.Renditions, saved)
+	}
+	if err := syncMainImage(ctx, tx, added.ProductId, ""); err != nil {
+		return domain.ProductImage{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.ProductImage{}, common.WrapError("commit add product image", err)
+	}
+	return added, nil
+}
+
+func (imageRepository *ProductImageRepository) UpdateAltText(imageId int64, altText string) (domain.ProductImage, error) {
+	ctx := context.Background()
+	query := "UPDATE product_images SET alt_text = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2 RETURNING *"
+	image, err := imageRepository.scanner.QueryRowAndScan(ctx, query, altText, imageId)
+	if err != nil {
+		return domain.ProductImage{}, err
+	}
+	images, err := imageRepository.withRenditions(ctx, []domain.ProductImage{image})
+	if err != nil {
+		return domain.ProductImage{}, err
+	}
+	return images[0], nil
+}
+
+// ReorderImages gives the images the positions of their order in imageIds.
+func (imageRepository *ProductImageRepository) ReorderImages(productId int64, imageIds []int64) error {
+	ctx := context.Background()
+	tx, err := imageRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return common.WrapError("begin reorder product images", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for position, imageId := range imageIds {
+		_, err := tx.Exec(ctx, "UPDATE product_images SET position = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2 AND product_id = $3",
+			position, imageId, productId)
+		if err != nil {
+			return common.WrapError("reorder product image", err)
+		}
+	}
+	if err := syncMainImage(ctx, tx, productId, ""); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return common.WrapError("commit reorder product images", err)
+	}
+	return nil
+}
+
+// DeleteImage removes the image and returns it with its renditions so their files can be deleted.
+func (imageRepository *ProductImageRepository) DeleteImage(imageId int64) (domain.ProductImage, error) {
+	ctx := context.Background()
+	image, err := imageRepository.GetImageById(imageId)
+	if err != nil {
+		return domain.ProductImage{}, err
+	}
+
+	tx, err := imageRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.ProductImage{}, common.WrapError("begin delete product image", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "DELETE FROM product_images WHERE id = $1", imageId); err != nil {
+		return domain.ProductImage{}, common.WrapError("delete product image", err)
+	}
+	if err := syncMainImage(ctx, tx, image.ProductId, image.Url); err != nil {
+		return domain.ProductImage{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.ProductImage{}, common.WrapError("commit delete product image", err)
+	}
+	return image, nil
+}
+
+// GetStorageKeys returns every storage key an image or rendition still points to.
+func (imageRepository *ProductImageRepository) GetStorageKeys() (map[string]bool, error) {
+	ctx := context.Background()
+	rows, err := imageRepository.dbPool.Query(ctx,
+		"SELECT storage_key FROM product_images UNION ALL SELECT storage_key FROM product_image_renditions")
+	if err != nil {
+		return nil, common.WrapError("query storage keys", err)
+	}
+	defer rows.Close()
+
+	keys := make(map[string]bool)
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, common.WrapError("scan storage key", err)
+		}
+		keys[key] = true
+	}
+	return keys, rows.Err()
+}
+
+func (imageRepository *ProductImageRepository) withRenditions(ctx context.Context, images []domain.ProductImage) ([]domain.ProductImage, error) {
+	if len(images) == 0 {
+		return []domain.ProductImage{}, nil
+	}
+	imageIds := make([]int64, 0, len(images))
+	for _, image := range images {
+		imageIds = append(imageIds, image.Id)
+	}
+	renditions, err := imageRepository.renditionScanner.QueryAndScan(ctx,
+		"SELECT * FROM product_image_renditions WHERE image_id = ANY($1) ORDER BY image_id, width, name", imageIds)
+	if err != nil {
+		return nil, err
+	}
+	renditionsByImage := make(map[int64][]domain.ImageRendition)
+	for _, rendition := range renditions {
+		renditionsByImage[rendition.ImageId] = append(renditionsByImage[rendition.ImageId], rendition)
+	}
+	for i := range images {
+		images[i].Renditions = renditionsByImage[images[i].Id]
+	}
+	return images, nil
+}
+
+// syncMainImage points the product's image_url to the first gallery image. Without gallery images the
+// product keeps its image_url, unless it was the removed image.
+func syncMainImage(ctx context.Context, tx pgx.Tx, productId int64, removedUrl string) error {
+	query := `UPDATE products SET image_url = COALESCE(
+			(SELECT url FROM product_images WHERE product_id = $1 ORDER BY position, id LIMIT 1),
+			CASE WHEN image_url = $2 THEN '' ELSE image_url END)
+		WHERE id = $1`
+	if _, err := tx.Exec(ctx, query, productId, removedUrl); err != nil {
+		return common.WrapError("sync main product image", err)
+	}
+	return nil
+}
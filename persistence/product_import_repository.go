@@ -0,0 +1,207 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type IProductImportRepository interface {
+	CreateJob(job domain.ProductImportJob) (domain.ProductImportJob, error)
+	GetJobById(jobId int64) (domain.ProductImportJob, error)
+	UpdateJob(job domain.ProductImportJob) error
+	AddResults(results []domain.ProductImportResult) error
+	GetResultsByJobId(jobId int64) ([]domain.ProductImportResult, error)
+	UpsertProducts(matchBy string, products []domain.Product, userId int64) ([]domain.ProductUpsert, error)
+}
+
+type ProductImportRepository struct {
+	dbPool        *pgxpool.Pool
+	scanner       *helper.GenericScanner[domain.ProductImportJob]
+	resultScanner *helper.GenericScanner[domain.ProductImportResult]
+}
+
+func NewProductImportRepository(dbPool *pgxpool.Pool) IProductImportRepository {
+	return &ProductImportRepository{
+		dbPool:        dbPool,
+		scanner:       helper.NewGenericScanner(dbPool, helper.ScanProductImportJob),
+		resultScanner: helper.NewGenericScanner(dbPool, helper.ScanProductImportResult),
+	}
+}
+
+func (importRepository *ProductImportRepository) CreateJob(job domain.ProductImportJob) (domain.ProductImportJob, error) {
+	ctx := context.Background()
+	query := `INSERT INTO product_import_jobs (file_name, format, match_by, status, total_rows, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING *`
+	return importRepository.scanner.QueryRowAndScan(ctx, query, job.FileName, job.Format, job.MatchBy, job.Status, job.TotalRows, job.CreatedBy)
+}
+
+func (importRepository *ProductImportRepository) GetJobById(jobId int64) (domain.ProductImportJob, error) {
+	ctx := context.Background()
+	return importRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM product_import_jobs WHERE id = $1", jobId)
+}
+
+func (importRepository *ProductImportRepository) UpdateJob(job domain.ProductImportJob) error {
+	ctx := context.Background()
+	query := `UPDATE product_import_jobs SET status = $1, total_rows = $2, processed_rows = $3, created_count = $4,
+		updated_count = $5, failed_count = $6, error = $7, completed_at = $8, updated_at = CURRENT_TIMESTAMP WHERE id = $9`
+	return importRepository.scanner.ExecuteExec(ctx, query, job.Status, job.TotalRows, job.ProcessedRows, job.CreatedCount,
+		job.UpdatedCount, job.FailedCount, job.Error, job.CompletedAt, job.Id)
+}
+
+func (importRepository *ProductImportRepository) AddResults(results []domain.ProductImportResult) error {
+	ctx := context.Background()
+	rows := make([][]interface{}, 0, len(results))
+	for _, result := range results {
+		rows = append(rows, []interface{}{result.JobId, result.RowNumber, result.Status, result.MatchKey, result.ProductId, result.Message})
+	}
+
+	_, err := importRepository.dbPool.CopyFrom(ctx, pgx.Identifier{"product_import_results"},
+		[]string{"job_id", "row_number", "status", "match_key", "product_id", "message"}, pgx.CopyFromRows(rows))
+	if err != nil {
+		return common.WrapError("insert import results", err)
+	}
+	return nil
+}
+
+func (importRepository *ProductImportRepository) GetResultsByJobId(jobId int64) ([]domain.ProductImportResult, error) {
+	ctx := context.Background()
+	results, err := importRepository.resultScanner.QueryAndScan(ctx,
+		"SELECT * FROM product_import_results WHERE job_id = $1 ORDER BY row_number", jobId)
+	if err != nil {
+		return []domain.ProductImportResult{}, err
+	}
+	return results, nil
+}
+
+// UpsertProducts inserts or updates a batch of products matched by SKU or slug in one transaction.
+// Every row runs in its own savepoint so a failing row is reported without losing the rest of the batch.
+// Existing products keep their slug, see the stable slug rules in the product service, and a trashed
+// product that is imported again is restored. An import does not move products between stores: a row matching
+// a product of another store fails with ErrImportOtherStore. Price changes are recorded for the importing user.
+func (importRepository *ProductImportRepository) UpsertProducts(matchBy string, products []domain.Product, userId int64) ([]domain.ProductUpsert, error) {
+	ctx := context.Background()
+	tx, err := importRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, common.WrapError("begin product upsert", err)
+	}
+	defer tx.Rollback(ctx)
+
+	existing, err := existingMatchKeys(ctx, tx, matchBy, products)
+	if err != nil {
+		return nil, err
+	}
+
+	conflict := "ON CONFLICT (sku) DO UPDATE SET"
+	if matchBy == domain.ImportMatchBySlug {
+		conflict = "ON CONFLICT (slug) DO UPDATE SET sku = COALESCE(EXCLUDED.sku, products.sku),"
+	}
+	// New products are created as drafts and go live through review like products created one by one. is_active
+	// only publishes or unpublishes products that were already approved; drafts and products waiting for review
+	// keep their status.
+	query := `INSERT INTO products
+		(name, slug, description, price, base_price, discount, image_url, meta_description, stock_quantity, status, is_active, is_featured, category_id, store_id, sku)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, 'draft', false, $11, $12, $13, $14) ` + conflict + `
+		name = EXCLUDED.name, description = EXCLUDED.description, price = EXCLUDED.price, base_price = EXCLUDED.base_price,
+		discount = EXCLUDED.discount, image_url = EXCLUDED.image_url, meta_description = EXCLUDED.meta_description,
+		status = CASE WHEN products.status IN ('published', 'unpublished')
+			THEN CASE WHEN $10::boolean THEN 'published' ELSE 'unpublished' END ELSE products.status END,
+		is_active = CASE WHEN products.status IN ('published', 'unpublished') THEN $10::boolean ELSE products.is_active END,
+		is_featured = EXCLUDED.is_featured,
+		category_id = EXCLUDED.category_id, deleted_at = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE products.store_id = EXCLUDED.store_id
+		RETURNING *`
+
+	upserts := make([]domain.ProductUpsert, 0, len(products))
+	for _, product := range products {
+		savepoint, err := tx.Begin(ctx)
+		if err != nil {
+			return nil, common.WrapError("begin product savepoint", err)
+		}
+
+		saved, err := helper.ScanProduct(savepoint.QueryRow(ctx, query,
+			product.Name, product.Slug, product.Description, product.Price, product.BasePrice, product.Discount,
+			product.ImageUrl, product.MetaDescription, 0, product.IsActive, product.IsFeatured,
+			product.CategoryId, product.StoreId, product.Sku))
+		if err != nil {
+			savepoint.Rollback(ctx)
+			if errors.Is(err, common.ErrProductNotFound) {
+				err = common.ErrImportOtherStore
+			}
+			upserts = append(upserts, domain.ProductUpsert{Product: product, Err: err})
+			continue
+		}
+		if _, err := savepoint.Exec(ctx, recordPriceChangeQuery, saved.Id, domain.PriceChangeSourceImport, userId); err != nil {
+			savepoint.Rollback(ctx)
+			upserts = append(upserts, domain.ProductUpsert{Product: product, Err: common.WrapError("record price change", err)})
+			continue
+		}
+		// The imported stock quantity is reached by booking the difference at the default warehouse.
+		if err := setStockQuantity(ctx, savepoint, int64(saved.Id), nil, product.StockQuantity, domain.StockMovementAdjustment,
+			"Product import"); err != nil {
+			savepoint.Rollback(ctx)
+			upserts = append(upserts, domain.ProductUpsert{Product: product, Err: err})
+			continue
+		}
+		saved, err = helper.ScanProduct(savepoint.QueryRow(ctx, "SELECT * FROM products WHERE id = $1", saved.Id))
+		if err != nil {
+			savepoint.Rollback(ctx)
+			upserts = append(upserts, domain.ProductUpsert{Product: product, Err: err})
+			continue
+		}
+		if err := savepoint.Commit(ctx); err != nil {
+			return nil, common.WrapError("release product savepoint", err)
+		}
+
+		key := matchKey(matchBy, saved)
+		upserts = append(upserts, domain.ProductUpsert{Product: saved, Created: !existing[key]})
+		existing[key] = true
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, common.WrapError("commit product upsert", err)
+	}
+	return upserts, nil
+}
+
+func existingMatchKeys(ctx context.Context, tx pgx.Tx, matchBy string, products []domain.Product) (map[string]bool, error) {
+	keys := make([]string, 0, len(products))
+	for _, product := range products {
+		keys = append(keys, matchKey(matchBy, product))
+	}
+
+	column := "sku"
+	if matchBy == domain.ImportMatchBySlug {
+		column = "slug"
+	}
+	rows, err := tx.Query(ctx, "SELECT "+column+" FROM products WHERE "+column+" = ANY($1)", keys)
+	if err != nil {
+		return nil, common.WrapError("query existing products", err)
+	}
+	defer rows.Close()
+
+	existing := make(map[string]bool)
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, common.WrapError("scan existing product key", err)
+		}
+		existing[key] = true
+	}
+	return existing, rows.Err()
+}
+
+func matchKey(matchBy string, product domain.Product) string {
+	if matchBy == domain.ImportMatchBySlug {
+		return product.Slug
+	}
+	if product.Sku == nil {
+		return ""
+	}
+	return *product.Sku
+}
@@ -0,0 +1,242 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// recordPriceChangeQuery appends the product's current prices to its history unless they equal the
+// latest entry, so it can run after any write that may have touched the prices.
+const recordPriceChangeQuery = `INSERT INTO product_price_history (product_id, price, base_price, discount, source, changed_by)
+	SELECT p.id, p.price, p.base_price, COALESCE(p.discount, 0), $2, $3 FROM products p
+	WHERE p.id = $1 AND NOT EXISTS (
+		SELECT 1 FROM (SELECT price, base_price, discount FROM product_price_history
+			WHERE product_id = p.id ORDER BY changed_at DESC, id DESC LIMIT 1) latest
+		WHERE latest.price = p.price AND latest.base_price = p.base_price AND latest.discount = COALESCE(p.discount, 0))`
+
+// recordPriceChange records a price change the user made through the product endpoints inside the caller's
+// transaction.
+func recordPriceChange(ctx context.Context, tx pgx.Tx, productId int64, userId int64) error {
+	_, err := tx.Exec(ctx, recordPriceChangeQuery, productId, domain.PriceChangeSourceManual, userId)
+	return common.WrapError("record price change", err)
+}
+
+type IProductPriceRepository interface {
+	GetPriceHistory(productId int64, limit int) ([]domain.PriceChange, error)
+	GetLowestPrice(productId int64, since time.Time) (*float64, error)
+	GetScheduleById(scheduleId int64) (domain.PriceSchedule, error)
+	GetSchedulesByProductId(productId int64) ([]domain.PriceSchedule, error)
+	HasOverlappingSchedule(productId int64, startsAt time.Time, endsAt *time.Time) (bool, error)
+	AddSchedule(schedule domain.PriceSchedule) (domain.PriceSchedule, error)
+	GetSchedulesToStart(now time.Time) ([]domain.PriceSchedule, error)
+	GetSchedulesToFinish(now time.Time) ([]domain.PriceSchedule, error)
+	StartSchedule(schedule domain.PriceSchedule) (domain.Product, error)
+	FinishSchedule(schedule domain.PriceSchedule, status string) (domain.Product, bool, error)
+}
+
+type ProductPriceRepository struct {
+	dbPool          *pgxpool.Pool
+	historyScanner  *helper.GenericScanner[domain.PriceChange]
+	scheduleScanner *helper.GenericScanner[domain.PriceSchedule]
+}
+
+func NewProductPriceRepository(dbPool *pgxpool.Pool) IProductPriceRepository {
+	return &ProductPriceRepository{
+		dbPool:          dbPool,
+		historyScanner:  helper.NewGenericScanner(dbPool, helper.ScanPriceChange),
+		scheduleScanner: helper.NewGenericScanner(dbPool, helper.ScanPriceSchedule),
+	}
+}
+
+func (priceRepository *ProductPriceRepository) GetPriceHistory(productId int64, limit int) ([]domain.PriceChange, error) {
+	ctx := context.Background()
+	changes, err := priceRepository.historyScanner.QueryAndScan(ctx,
+		"SELECT * FROM product_price_history WHERE product_id = $1 ORDER BY changed_at DESC, id DESC LIMIT $2", productId, limit)
+	if err != nil {
+		return []domain.PriceChange{}, err
+	}
+	return changes, nil
+}
+
+// GetLowestPrice returns the lowest price the product had since the given time, counting the price that
+// was already in effect at that moment. It is nil when the product has no history.
+func (priceRepository *ProductPriceRepository) GetLowestPrice(productId int64, since time.Time) (*float64, error) {
+	ctx := context.Background()
+	query := `SELECT MIN(price) FROM (
+		SELECT price FROM product_price_history WHERE product_id = $1 AND changed_at >= $2
+		UNION ALL
+		(SELECT price FROM product_price_history WHERE product_id = $1 AND changed_at < $2 ORDER BY changed_at DESC, id DESC LIMIT 1)
+	) prices`
+
+	var lowest *float64
+	if err := priceRepository.dbPool.QueryRow(ctx, query, productId, since).Scan(&lowest); err != nil {
+		return nil, common.WrapError("get lowest price", err)
+	}
+	return lowest, nil
+}
+
+func (priceRepository *ProductPriceRepository) GetScheduleById(scheduleId int64) (domain.PriceSchedule, error) {
+	ctx := context.Background()
+	return priceRepository.scheduleScanner.QueryRowAndScan(ctx, "SELECT * FROM product_price_schedules WHERE id = $1", scheduleId)
+}
+
+func (priceRepository *ProductPriceRepository) GetSchedulesByProductId(productId int64) ([]domain.PriceSchedule, error) {
+	ctx := context.Background()
+	schedules, err := priceRepository.scheduleScanner.QueryAndScan(ctx,
+		"SELECT * FROM product_price_schedules WHERE product_id = $1 ORDER BY starts_at DESC, id DESC", productId)
+	if err != nil {
+		return []domain.PriceSchedule{}, err
+	}
+	return schedules, nil
+}
+
+// HasOverlappingSchedule tells whether a pending or running schedule of the product overlaps the given
+// period. An open end overlaps everything after the start.
+func (priceRepository *ProductPriceRepository) HasOverlappingSchedule(productId int64, startsAt time.Time, endsAt *time.Time) (bool, error) {
+	ctx := context.Background()
+	query := `SELECT EXISTS (SELECT 1 FROM product_price_schedules
+		WHERE product_id = $1 AND status IN ($4, $5)
+			AND ($3::timestamp IS NULL OR starts_at < $3)
+			AND (ends_at IS NULL OR ends_at > $2))`
+
+	var overlapping bool
+	err := priceRepository.dbPool.QueryRow(ctx, query, productId, startsAt, endsAt,
+		domain.PriceScheduleStatusScheduled, domain.PriceScheduleStatusActive).Scan(&overlapping)
+	if err != nil {
+		return false, common.WrapError("check overlapping price schedule", err)
+	}
+	return overlapping, nil
+}
+
+func (priceRepository *ProductPriceRepository) AddSchedule(schedule domain.PriceSchedule) (domain.PriceSchedule, error) {
+	ctx := context.Background()
+	query := `INSERT INTO product_price_schedules (product_id, price, base_price, discount, starts_at, ends_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`
+	return priceRepository.scheduleScanner.QueryRowAndScan(ctx, query,
+		schedule.ProductId, schedule.Price, schedule.BasePrice, schedule.Discount, schedule.StartsAt, schedule.EndsAt, schedule.CreatedBy)
+}
+
+func (priceRepository *ProductPriceRepository) GetSchedulesToStart(now time.Time) ([]domain.PriceSchedule, error) {
+	ctx := context.Background()
+	query := `SELECT * FROM product_price_schedules
+		WHERE status = $1 AND starts_at <= $2 AND (ends_at IS NULL OR ends_at > $2)
+		ORDER BY starts_at, id`
+	schedules, err := priceRepository.scheduleScanner.QueryAndScan(ctx, query, domain.PriceScheduleStatusScheduled, now)
+	if err != nil {
+		return []domain.PriceSchedule{}, err
+	}
+	return schedules, nil
+}
+
+// GetSchedulesToFinish returns the running schedules whose end has passed, together with the ones that
+// ended before they could be started.
+func (priceRepository *ProductPriceRepository) GetSchedulesToFinish(now time.Time) ([]domain.PriceSchedule, error) {
+	ctx := context.Background()
+	query := `SELECT * FROM product_price_schedules
+		WHERE status IN ($1, $2) AND ends_at <= $3
+		ORDER BY ends_at, id`
+	schedules, err := priceRepository.scheduleScanner.QueryAndScan(ctx, query,
+		domain.PriceScheduleStatusScheduled, domain.PriceScheduleStatusActive, now)
+	if err != nil {
+		return []domain.PriceSchedule{}, err
+	}
+	return schedules, nil
+}
+
+// StartSchedule applies the scheduled prices to the product and remembers the prices it replaced so
+// they can be restored when the schedule ends.
+func (priceRepository *ProductPriceRepository) StartSchedule(schedule domain.PriceSchedule) (domain.Product, error) {
+	ctx := context.Background()
+	tx, err := priceRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Product{}, common.WrapError("begin price schedule start", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var previousPrice, previousBasePrice, previousDiscount float64
+	err = tx.QueryRow(ctx, `SELECT price, base_price, COALESCE(discount, 0) FROM products
+		WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, schedule.ProductId).
+		Scan(&previousPrice, &previousBasePrice, &previousDiscount)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.Product{}, common.ErrProductNotFound
+		}
+		return domain.Product{}, common.WrapError("lock product prices", err)
+	}
+
+	product, err := helper.ScanProduct(tx.QueryRow(ctx, `UPDATE products SET price = $1, base_price = $2, discount = $3,
+		updated_at = CURRENT_TIMESTAMP WHERE id = $4 RETURNING *`,
+		schedule.Price, schedule.BasePrice, schedule.Discount, schedule.ProductId))
+	if err != nil {
+		return domain.Product{}, err
+	}
+
+	status := domain.PriceScheduleStatusActive
+	if schedule.EndsAt == nil {
+		status = domain.PriceScheduleStatusCompleted
+	}
+	if _, err := tx.Exec(ctx, `UPDATE product_price_schedules SET status = $1, previous_price = $2, previous_base_price = $3,
+		previous_discount = $4, updated_at = CURRENT_TIMESTAMP WHERE id = $5`,
+		status, previousPrice, previousBasePrice, previousDiscount, schedule.Id); err != nil {
+		return domain.Product{}, common.WrapError("start price schedule", err)
+	}
+	if _, err := tx.Exec(ctx, recordPriceChangeQuery, schedule.ProductId, domain.PriceChangeSourceSchedule, schedule.CreatedBy); err != nil {
+		return domain.Product{}, common.WrapError("record price change", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Product{}, common.WrapError("commit price schedule start", err)
+	}
+	return product, nil
+}
+
+// FinishSchedule closes the schedule with the given status. A running schedule puts the previous prices
+// back, unless the product's prices were changed by hand in the meantime; the returned flag tells
+// whether the product was changed.
+func (priceRepository *ProductPriceRepository) FinishSchedule(schedule domain.PriceSchedule, status string) (domain.Product, bool, error) {
+	ctx := context.Background()
+	tx, err := priceRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Product{}, false, common.WrapError("begin price schedule finish", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var product domain.Product
+	reverted := false
+	if schedule.Status == domain.PriceScheduleStatusActive && schedule.PreviousPrice != nil {
+		product, err = helper.ScanProduct(tx.QueryRow(ctx, `UPDATE products SET price = $1, base_price = $2, discount = $3,
+			updated_at = CURRENT_TIMESTAMP
+			WHERE id = $4 AND deleted_at IS NULL AND price = $5 AND base_price = $6 AND COALESCE(discount, 0) = $7
+			RETURNING *`,
+			*schedule.PreviousPrice, *schedule.PreviousBasePrice, *schedule.PreviousDiscount, schedule.ProductId,
+			schedule.Price, schedule.BasePrice, schedule.Discount))
+		switch {
+		case err == nil:
+			reverted = true
+		case !errors.Is(err, common.ErrProductNotFound):
+			return domain.Product{}, false, err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE product_price_schedules SET status = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`,
+		status, schedule.Id); err != nil {
+		return domain.Product{}, false, common.WrapError("finish price schedule", err)
+	}
+	if reverted {
+		if _, err := tx.Exec(ctx, recordPriceChangeQuery, schedule.ProductId, domain.PriceChangeSourceSchedule, schedule.CreatedBy); err != nil {
+			return domain.Product{}, false, common.WrapError("record price change", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Product{}, false, common.WrapError("commit price schedule finish", err)
+	}
+	return product, reverted, nil
+}
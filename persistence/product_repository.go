@@ -6,9 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
 	"go-ecommerce-service/persistence/helper"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/elastic/go-elasticsearch/v8/esapi"
@@ -17,32 +20,72 @@ import (
 
 type IProductRepository interface {
 	GetAllProducts() []domain.Product
+	GetProducts(filter domain.ProductFilter) ([]domain.Product, error)
+	CountProducts(filter domain.ProductFilter) (int, error)
 	GetProductById(productId int64) (domain.Product, error)
-	AddProduct(product domain.Product) (domain.Product, error)
+	GetProductBySlug(slug string) (domain.Product, error)
+	AddProduct(product domain.Product, userId int64) (domain.Product, error)
 	DeleteProductById(productId int64) error
-	UpdateProduct(productId uint, product domain.Product) (domain.Product, error)
-	// GetProductsBy : Store,Slug,Featured,Category
-	SearchProducts(query string) ([]domain.Product, error)
+	UpdateProduct(productId uint, product domain.Product, userId int64) (domain.Product, error)
+	SearchProducts(query string, sort string, locale string) ([]domain.Product, error)
+	EnsureIndex() error
 	IndexProduct(product domain.Product) error
+	RemoveFromIndex(productId int64) error
+	GetDeletedProducts() ([]domain.Product, error)
+	RestoreProductById(productId int64) (domain.Product, error)
+	PurgeDeletedProducts(before time.Time) (int64, error)
 }
 
 type ProductRepository struct {
-	dbPool              *pgxpool.Pool
-	scannner            *helper.GenericScanner[domain.Product]
-	elasticSearchClient *elasticsearch.Client
+	dbPool                *pgxpool.Pool
+	scannner              *helper.GenericScanner[domain.Product]
+	attributeValueScanner *helper.GenericScanner[domain.ProductAttributeValue]
+	translationScanner    *helper.GenericScanner[domain.ProductTranslation]
+	elasticSearchClient   *elasticsearch.Client
+	defaultLocale         string
+	translatedLocales     []string
 }
 
-func NewProductRepository(dbPool *pgxpool.Pool, elasticSearchClient *elasticsearch.Client) IProductRepository {
+// NewProductRepository keeps one search index per locale. Products in the default locale are indexed in
+// "products", their translations in "products_<locale>".
+func NewProductRepository(dbPool *pgxpool.Pool, elasticSearchClient *elasticsearch.Client, defaultLocale string,
+	translatedLocales []string) IProductRepository {
 	return &ProductRepository{
-		dbPool:              dbPool,
-		scannner:            helper.NewGenericScanner(dbPool, helper.ScanProduct),
-		elasticSearchClient: elasticSearchClient,
+		dbPool:                dbPool,
+		scannner:              helper.NewGenericScanner(dbPool, helper.ScanProduct),
+		attributeValueScanner: helper.NewGenericScanner(dbPool, helper.ScanProductAttributeValue),
+		translationScanner:    helper.NewGenericScanner(dbPool, helper.ScanProductTranslation),
+		elasticSearchClient:   elasticSearchClient,
+		defaultLocale:         defaultLocale,
+		translatedLocales:     translatedLocales,
 	}
 }
 
+// languageAnalyzers maps locales to the built-in Elasticsearch analyzers that stem their text.
+var languageAnalyzers = map[string]string{
+	"ar": "arabic",
+	"de": "german",
+	"en": "english",
+	"es": "spanish",
+	"fr": "french",
+	"it": "italian",
+	"nl": "dutch",
+	"pt": "portuguese",
+	"ru": "russian",
+	"tr": "turkish",
+}
+
+// indexName returns the search index of the locale. Unknown locales search the default index.
+func (productRepository *ProductRepository) indexName(locale string) string {
+	if slices.Contains(productRepository.translatedLocales, locale) {
+		return "products_" + locale
+	}
+	return "products"
+}
+
 func (productRepository *ProductRepository) GetAllProducts() []domain.Product {
 	ctx := context.Background()
-	products, err := productRepository.scannner.QueryAndScan(ctx, "SELECT * FROM products")
+	products, err := productRepository.scannner.QueryAndScan(ctx, "SELECT * FROM products WHERE deleted_at IS NULL")
 	if err != nil {
 		fmt.Println("SCANNING ERROR:", err)
 		return nil
@@ -50,24 +93,143 @@ func (productRepository *ProductRepository) GetAllProducts() []domain.Product {
 	return products
 }
 
+// GetProducts returns one page of products matching the filter, ordered by the filter's sort and
+// continuing after the filter's cursor.
+func (productRepository *ProductRepository) GetProducts(filter domain.ProductFilter) ([]domain.Product, error) {
+	ctx := context.Background()
+	where, args := buildProductWhere(filter, true)
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf("SELECT * FROM products%s ORDER BY %s LIMIT $%d", where, productOrderBy(filter.Sort), len(args))
+
+	products, err := productRepository.scannner.QueryAndScan(ctx, query, args...)
+	if err != nil {
+		return []domain.Product{}, err
+	}
+	return products, nil
+}
+
+func (productRepository *ProductRepository) CountProducts(filter domain.ProductFilter) (int, error) {
+	ctx := context.Background()
+	where, args := buildProductWhere(filter, false)
+
+	var total int
+	if err := productRepository.dbPool.QueryRow(ctx, "SELECT COUNT(*) FROM products"+where, args...).Scan(&total); err != nil {
+		return 0, common.WrapError("count products", err)
+	}
+	return total, nil
+}
+
+func buildProductWhere(filter domain.ProductFilter, withCursor bool) (string, []interface{}) {
+	conditions := []string{"deleted_at IS NULL"}
+	args := make([]interface{}, 0)
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.StoreId != nil {
+		conditions = append(conditions, "store_id = "+arg(*filter.StoreId))
+	}
+	if len(filter.CategoryIds) > 0 {
+		conditions = append(conditions, "category_id = ANY("+arg(filter.CategoryIds)+")")
+	}
+	if filter.IsFeatured != nil {
+		conditions = append(conditions, "is_featured = "+arg(*filter.IsFeatured))
+	}
+	if filter.IsActive != nil {
+		conditions = append(conditions, "is_active = "+arg(*filter.IsActive))
+	}
+	if filter.MinPrice != nil {
+		conditions = append(conditions, "price >= "+arg(*filter.MinPrice))
+	}
+	if filter.MaxPrice != nil {
+		conditions = append(conditions, "price <= "+arg(*filter.MaxPrice))
+	}
+	if filter.InStock {
+		conditions = append(conditions, `(stock_quantity > 0 OR EXISTS (SELECT 1 FROM product_variants pv
+			WHERE pv.product_id = products.id AND pv.is_active AND pv.stock_quantity > 0))`)
+	}
+	for _, attribute := range filter.Attributes {
+		matches := []string{"ca.code = " + arg(attribute.Code)}
+		if len(attribute.Values) > 0 {
+			values := arg(attribute.Values)
+			matches = append(matches, fmt.Sprintf("(pav.text_value = ANY(%s) OR pav.bool_value::text = ANY(%s))", values, values))
+		}
+		if attribute.Min != nil {
+			matches = append(matches, "pav.number_value >= "+arg(*attribute.Min))
+		}
+		if attribute.Max != nil {
+			matches = append(matches, "pav.number_value <= "+arg(*attribute.Max))
+		}
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (SELECT 1 FROM product_attribute_values pav
+			JOIN category_attributes ca ON ca.id = pav.attribute_id
+			WHERE pav.product_id = products.id AND %s)`, strings.Join(matches, " AND ")))
+	}
+
+	if withCursor && filter.After != nil {
+		after := filter.After
+		switch filter.Sort {
+		case domain.ProductSortPriceAsc:
+			conditions = append(conditions, fmt.Sprintf("(price, id) > (%s, %s)", arg(after.Price), arg(after.Id)))
+		case domain.ProductSortPriceDesc:
+			conditions = append(conditions, fmt.Sprintf("(price, id) < (%s, %s)", arg(after.Price), arg(after.Id)))
+		case domain.ProductSortName:
+			conditions = append(conditions, fmt.Sprintf("(name, id) > (%s, %s)", arg(after.Name), arg(after.Id)))
+		case domain.ProductSortRating:
+			conditions = append(conditions, fmt.Sprintf("(average_rating, review_count, id) < (%s, %s, %s)",
+				arg(after.Rating), arg(after.Reviews), arg(after.Id)))
+		default:
+			conditions = append(conditions, fmt.Sprintf("(created_at, id) < (%s, %s)", arg(after.CreatedAt), arg(after.Id)))
+		}
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func productOrderBy(sort string) string {
+	switch sort {
+	case domain.ProductSortPriceAsc:
+		return "price ASC, id ASC"
+	case domain.ProductSortPriceDesc:
+		return "price DESC, id DESC"
+	case domain.ProductSortName:
+		return "name ASC, id ASC"
+	case domain.ProductSortRating:
+		return "average_rating DESC, review_count DESC, id DESC"
+	default:
+		return "created_at DESC, id DESC"
+	}
+}
+
 func (productRepository *ProductRepository) GetProductById(productId int64) (domain.Product, error) {
 	ctx := context.Background()
-	product, err := productRepository.scannner.QueryRowAndScan(ctx, "SELECT * FROM products WHERE id = $1", productId)
+	product, err := productRepository.scannner.QueryRowAndScan(ctx, "SELECT * FROM products WHERE id = $1 AND deleted_at IS NULL", productId)
 	if err != nil {
 		return domain.Product{}, err
 	}
 	return product, nil
 }
 
-func (productRepository *ProductRepository) AddProduct(product domain.Product) (domain.Product, error) {
+func (productRepository *ProductRepository) GetProductBySlug(slug string) (domain.Product, error) {
+	ctx := context.Background()
+	return productRepository.scannner.QueryRowAndScan(ctx, "SELECT * FROM products WHERE slug = $1 AND deleted_at IS NULL", slug)
+}
+
+func (productRepository *ProductRepository) AddProduct(product domain.Product, userId int64) (domain.Product, error) {
 	ctx := context.Background()
 	query := `
 		INSERT INTO products 
-		(name, slug, description, price, base_price, discount, image_url, meta_description, stock_quantity, is_active, is_featured, category_id, store_id) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING *
+		(name, slug, description, price, base_price, discount, image_url, meta_description, stock_quantity, status, is_active, is_featured, category_id, store_id, sku, product_type) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10 = 'published', $11, $12, $13, $14, $15) RETURNING *
 	`
 
-	addedProduct, err := productRepository.scannner.QueryRowAndScan(ctx, query,
+	tx, err := productRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Product{}, common.WrapError("begin product insert", err)
+	}
+	defer tx.Rollback(ctx)
+
+	addedProduct, err := helper.ScanProduct(tx.QueryRow(ctx, query,
 		product.Name,
 		product.Slug,
 		product.Description,
@@ -76,64 +238,163 @@ func (productRepository *ProductRepository) AddProduct(product domain.Product) (
 		product.Discount,
 		product.ImageUrl,
 		product.MetaDescription,
-		product.StockQuantity,
-		product.IsActive,
+		0,
+		product.Status,
 		product.IsFeatured,
 		product.CategoryId,
-		product.StoreId)
+		product.StoreId,
+		product.Sku,
+		product.ProductType))
 	if err != nil {
 		return domain.Product{}, err
 	}
-
-	productJSON, err := json.Marshal(addedProduct)
-	if err != nil {
-		return addedProduct, err
-	}
-
-	req := esapi.IndexRequest{
-		Index:      "products", // Table Name
-		DocumentID: strconv.Itoa(int(addedProduct.Id)),
-		Body:       bytes.NewReader(productJSON),
-		Refresh:    "true",
+	if err := recordPriceChange(ctx, tx, int64(addedProduct.Id), userId); err != nil {
+		return domain.Product{}, err
 	}
-
-	res, err := req.Do(ctx, productRepository.elasticSearchClient)
-	if err != nil {
+	if err := replaceAttributeValues(ctx, tx, int64(addedProduct.Id), product.Attributes); err != nil {
 		return domain.Product{}, err
 	}
-	defer res.Body.Close()
+	// The stock quantity is derived from the stock ledger, so the initial stock is received at the default warehouse.
+	if product.StockQuantity > 0 {
+		err := setStockQuantity(ctx, tx, int64(addedProduct.Id), nil, product.StockQuantity, domain.StockMovementReceipt, "Initial stock")
+		if err != nil {
+			return domain.Product{}, err
+		}
+		addedProduct, err = helper.ScanProduct(tx.QueryRow(ctx, "SELECT * FROM products WHERE id = $1", addedProduct.Id))
+		if err != nil {
+			return domain.Product{}, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Product{}, common.WrapError("commit product insert", err)
+	}
+	if addedProduct.Attributes, err = productRepository.attributeValues(ctx, addedProduct.Id); err != nil {
+		return addedProduct, err
+	}
 
-	if res.IsError() {
-		fmt.Printf("Elasticsearch index error: %s\n", res.String())
+	if err := productRepository.IndexProduct(addedProduct); err != nil {
+		fmt.Printf("Elasticsearch index error: %s\n", err)
 	} else {
 		fmt.Printf("Product indexed in Elasticsearch! ID: %d\n", addedProduct.Id)
 	}
 
-	return addedProduct, err
+	return addedProduct, nil
 }
 
-func (productRepository *ProductRepository) UpdateProduct(productId uint, product domain.Product) (domain.Product, error) {
+// UpdateProduct saves the product and replaces its attribute values with the given ones. The status is
+// left as it is; it only changes through the publishing workflow.
+func (productRepository *ProductRepository) UpdateProduct(productId uint, product domain.Product, userId int64) (domain.Product, error) {
 	ctx := context.Background()
-	query := `UPDATE products set name=$1, slug=$2, description=$3, price=$4, base_price=$5, discount = $6, image_url=$7, meta_description=$8, stock_quantity=$9, is_active=$10, is_featured=$11, category_id=$12, store_id=$13 WHERE id = $14 RETURNING *`
-	updatedProduct, err := productRepository.scannner.QueryRowAndScan(ctx, query,
-		product.Name, product.Slug, product.Description, product.Price, product.BasePrice, product.Discount, product.ImageUrl, product.MetaDescription, product.StockQuantity, product.IsActive, product.IsFeatured, product.CategoryId, product.StoreId, productId)
+	query := `UPDATE products set name=$1, slug=$2, description=$3, price=$4, base_price=$5, discount = $6, image_url=$7, meta_description=$8, is_featured=$9, category_id=$10, store_id=$11, sku=$12, product_type=$13, updated_at=CURRENT_TIMESTAMP WHERE id = $14 AND deleted_at IS NULL RETURNING *`
+	tx, err := productRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Product{}, common.WrapError("begin product update", err)
+	}
+	defer tx.Rollback(ctx)
+
+	updatedProduct, err := helper.ScanProduct(tx.QueryRow(ctx, query,
+		product.Name, product.Slug, product.Description, product.Price, product.BasePrice, product.Discount, product.ImageUrl, product.MetaDescription, product.IsFeatured, product.CategoryId, product.StoreId, product.Sku, product.ProductType, productId))
 
 	if err != nil {
 		return domain.Product{}, err
 	}
+	if err := recordPriceChange(ctx, tx, int64(productId), userId); err != nil {
+		return domain.Product{}, err
+	}
+	if err := replaceAttributeValues(ctx, tx, int64(productId), product.Attributes); err != nil {
+		return domain.Product{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Product{}, common.WrapError("commit product update", err)
+	}
+	if updatedProduct.Attributes, err = productRepository.attributeValues(ctx, updatedProduct.Id); err != nil {
+		return updatedProduct, err
+	}
 	return updatedProduct, nil
 }
 
+// DeleteProductById moves the product to the trash. It stays referenced by orders and can be restored
+// until the purge job removes it.
 func (productRepository *ProductRepository) DeleteProductById(productId int64) error {
 	ctx := context.Background()
-	err := productRepository.scannner.ExecuteExec(ctx, "delete from products where id = $1", productId)
+	query := `UPDATE products SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL RETURNING *`
+	_, err := productRepository.scannner.QueryRowAndScan(ctx, query, productId)
+	return err
+}
+
+func (productRepository *ProductRepository) GetDeletedProducts() ([]domain.Product, error) {
+	ctx := context.Background()
+	products, err := productRepository.scannner.QueryAndScan(ctx,
+		"SELECT * FROM products WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC")
 	if err != nil {
-		return err
+		return []domain.Product{}, err
 	}
-	return nil
+	return products, nil
 }
 
-func (productRepository *ProductRepository) SearchProducts(query string) ([]domain.Product, error) {
+// RestoreProductById takes the product out of the trash. Products of a trashed store are only restored
+// together with their store.
+func (productRepository *ProductRepository) RestoreProductById(productId int64) (domain.Product, error) {
+	ctx := context.Background()
+	query := `UPDATE products SET deleted_at = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND deleted_at IS NOT NULL
+			AND EXISTS (SELECT 1 FROM stores s WHERE s.id = products.store_id AND s.deleted_at IS NULL)
+		RETURNING *`
+	return productRepository.scannner.QueryRowAndScan(ctx, query, productId)
+}
+
+// ProductPurgeBlockers are the tables whose rows keep a trashed product from being purged: order history,
+// open carts and bundles, whose foreign keys to products do not cascade.
+var ProductPurgeBlockers = []string{"order_items", "order_item_components", "cart_items", "bundle_components"}
+
+// ProductPurgeCleared are the tables whose rows are removed together with a purged product.
+var ProductPurgeCleared = []string{"wishlist_items"}
+
+// PurgeDeletedProducts removes products trashed before the given time. Products that are still referenced
+// by a ProductPurgeBlockers table are kept so order history, open carts and bundles stay intact.
+func (productRepository *ProductRepository) PurgeDeletedProducts(before time.Time) (int64, error) {
+	ctx := context.Background()
+	tx, err := productRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, common.WrapError("begin product purge", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var productIds []int64
+	query := "SELECT COALESCE(array_agg(p.id), '{}') FROM products p WHERE p.deleted_at < $1"
+	for _, table := range ProductPurgeBlockers {
+		query += fmt.Sprintf(" AND NOT EXISTS (SELECT 1 FROM %s b WHERE b.product_id = p.id)", table)
+	}
+	if err := tx.QueryRow(ctx, query, before).Scan(&productIds); err != nil {
+		return 0, common.WrapError("select purgeable products", err)
+	}
+	if len(productIds) == 0 {
+		return 0, nil
+	}
+
+	for _, table := range ProductPurgeCleared {
+		if _, err := tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE product_id = ANY($1)", table), productIds); err != nil {
+			return 0, common.WrapError("purge "+table, err)
+		}
+	}
+	if _, err := tx.Exec(ctx, "DELETE FROM slug_history WHERE entity_type = $1 AND entity_id = ANY($2)",
+		domain.SlugEntityProduct, productIds); err != nil {
+		return 0, common.WrapError("purge product slugs", err)
+	}
+	tag, err := tx.Exec(ctx, "DELETE FROM products WHERE id = ANY($1)", productIds)
+	if err != nil {
+		return 0, common.WrapError("purge products", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, common.WrapError("commit product purge", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// SearchProducts runs a relevance search in the index of the locale. With the rating sort, matches are
+// ordered by their average rating and review count first and relevance breaks ties.
+func (productRepository *ProductRepository) SearchProducts(query string, sort string, locale string) ([]domain.Product, error) {
 	ctx := context.Background()
 	var buf bytes.Buffer
 
@@ -142,12 +403,17 @@ func (productRepository *ProductRepository) SearchProducts(query string) ([]doma
 	searchQuery := map[string]interface{}{
 		"query": map[string]interface{}{
 			"bool": map[string]interface{}{
+				// Only published products are searchable; drafts and products under review are indexed too.
+				"filter": []interface{}{
+					map[string]interface{}{"term": map[string]interface{}{"IsActive": true}},
+				},
+				"minimum_should_match": 1,
 				// Fuzzy match (typos)
 				"should": []interface{}{
 					map[string]interface{}{
 						"multi_match": map[string]interface{}{
 							"query":     query,
-							"fields":    []string{"Name", "Description", "Slug"},
+							"fields":    []string{"Name", "Description", "Slug", "Variants.Sku", "Variants.Barcode"},
 							"fuzziness": "AUTO",
 						},
 					},
@@ -172,13 +438,21 @@ func (productRepository *ProductRepository) SearchProducts(query string) ([]doma
 		},
 	}
 
+	if sort == domain.ProductSortRating {
+		searchQuery["sort"] = []interface{}{
+			map[string]interface{}{"AverageRating": map[string]interface{}{"order": "desc"}},
+			map[string]interface{}{"ReviewCount": map[string]interface{}{"order": "desc"}},
+			"_score",
+		}
+	}
+
 	if err := json.NewEncoder(&buf).Encode(searchQuery); err != nil {
 		return nil, err
 	}
 
 	res, err := productRepository.elasticSearchClient.Search(
 		productRepository.elasticSearchClient.Search.WithContext(ctx),
-		productRepository.elasticSearchClient.Search.WithIndex("products"),
+		productRepository.elasticSearchClient.Search.WithIndex(productRepository.indexName(locale)),
 		productRepository.elasticSearchClient.Search.WithBody(&buf),
 		productRepository.elasticSearchClient.Search.WithTrackTotalHits(true),
 	)
@@ -211,16 +485,138 @@ func (productRepository *ProductRepository) SearchProducts(query string) ([]doma
 	return products, nil
 }
 
+// EnsureIndex declares the numeric types of the fields search sorts on in every locale's index. Without it
+// the first indexed product, whose rating is still 0, would make Elasticsearch map the rating as an integer.
+// Attributes are nested so a facet or filter matches the code and the value of the same attribute. A new
+// index also analyzes its texts in the language of its locale; an existing index keeps its text mapping.
+func (productRepository *ProductRepository) EnsureIndex() error {
+	if err := productRepository.ensureIndex("products", productRepository.defaultLocale); err != nil {
+		return err
+	}
+	for _, locale := range productRepository.translatedLocales {
+		if err := productRepository.ensureIndex(productRepository.indexName(locale), locale); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (productRepository *ProductRepository) ensureIndex(index string, locale string) error {
+	ctx := context.Background()
+	fields := map[string]interface{}{
+		"AverageRating": map[string]interface{}{"type": "float"},
+		"ReviewCount":   map[string]interface{}{"type": "integer"},
+		"Attributes": map[string]interface{}{
+			"type": "nested",
+			"properties": map[string]interface{}{
+				"Code":        map[string]interface{}{"type": "keyword"},
+				"TextValue":   map[string]interface{}{"type": "keyword"},
+				"NumberValue": map[string]interface{}{"type": "double"},
+				"BoolValue":   map[string]interface{}{"type": "boolean"},
+			},
+		},
+	}
+
+	exists, err := esapi.IndicesExistsRequest{Index: []string{index}}.Do(ctx, productRepository.elasticSearchClient)
+	if err != nil {
+		return err
+	}
+	exists.Body.Close()
+
+	var res *esapi.Response
+	if exists.StatusCode == 404 {
+		if analyzer, ok := languageAnalyzers[locale]; ok {
+			for _, field := range []string{"Name", "Description", "MetaDescription"} {
+				fields[field] = map[string]interface{}{"type": "text", "analyzer": analyzer}
+			}
+		}
+		body, _ := json.Marshal(map[string]interface{}{"mappings": map[string]interface{}{"properties": fields}})
+		res, err = esapi.IndicesCreateRequest{Index: index, Body: bytes.NewReader(body)}.Do(ctx, productRepository.elasticSearchClient)
+	} else {
+		body, _ := json.Marshal(map[string]interface{}{"properties": fields})
+		res, err = esapi.IndicesPutMappingRequest{Index: []string{index}, Body: bytes.NewReader(body)}.Do(ctx, productRepository.elasticSearchClient)
+	}
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("Elasticsearch error: %s", res.String())
+	}
+	return nil
+}
+
+// RemoveFromIndex deletes the product's search documents in every locale. A document that is already gone
+// is not an error.
+func (productRepository *ProductRepository) RemoveFromIndex(productId int64) error {
+	ctx := context.Background()
+
+	indexes := []string{"products"}
+	for _, locale := range productRepository.translatedLocales {
+		indexes = append(indexes, productRepository.indexName(locale))
+	}
+	for _, index := range indexes {
+		req := esapi.DeleteRequest{
+			Index:      index,
+			DocumentID: strconv.FormatInt(productId, 10),
+			Refresh:    "true",
+		}
+
+		res, err := req.Do(ctx, productRepository.elasticSearchClient)
+		if err != nil {
+			return err
+		}
+		res.Body.Close()
+		if res.IsError() && res.StatusCode != 404 {
+			return fmt.Errorf("Elasticsearch error: %s", res.String())
+		}
+	}
+	return nil
+}
+
+// IndexProduct writes the product's search documents, including its current attribute values. Each
+// translated locale gets the product's translation, or its default content while it has none.
 func (productRepository *ProductRepository) IndexProduct(product domain.Product) error {
 	ctx := context.Background()
 
+	attributes, err := productRepository.attributeValues(ctx, product.Id)
+	if err != nil {
+		return err
+	}
+	product.Attributes = attributes
+	if err := productRepository.indexDocument(ctx, "products", product); err != nil {
+		return err
+	}
+	if len(productRepository.translatedLocales) == 0 {
+		return nil
+	}
+
+	translations, err := productTranslations(ctx, productRepository.translationScanner, int64(product.Id))
+	if err != nil {
+		return err
+	}
+	for _, locale := range productRepository.translatedLocales {
+		localized := product
+		for _, translation := range translations {
+			if translation.Locale == locale {
+				localized = translation.Apply(product)
+			}
+		}
+		if err := productRepository.indexDocument(ctx, productRepository.indexName(locale), localized); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (productRepository *ProductRepository) indexDocument(ctx context.Context, index string, product domain.Product) error {
 	productJSON, err := json.Marshal(product)
 	if err != nil {
 		return err
 	}
 
 	req := esapi.IndexRequest{
-		Index:      "products",
+		Index:      index,
 		DocumentID: strconv.Itoa(int(product.Id)),
 		Body:       bytes.NewReader(productJSON),
 		Refresh:    "true",
@@ -236,3 +632,11 @@ func (productRepository *ProductRepository) IndexProduct(product domain.Product)
 	}
 	return nil
 }
+
+func (productRepository *ProductRepository) attributeValues(ctx context.Context, productId uint) ([]domain.ProductAttributeValue, error) {
+	valuesByProduct, err := attributeValuesByProduct(ctx, productRepository.attributeValueScanner, []int64{int64(productId)})
+	if err != nil {
+		return nil, err
+	}
+	return valuesByProduct[int64(productId)], nil
+}
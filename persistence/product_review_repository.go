@@ -0,0 +1,232 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type IProductReviewRepository interface {
+	HasPurchased(userId int64, productId int64) (bool, error)
+	GetReviewById(reviewId int64) (domain.ProductReview, error)
+	GetReviewByProductAndUser(productId int64, userId int64) (domain.ProductReview, error)
+	GetApprovedReviews(productId int64, sort string, limit int, offset int) ([]domain.ProductReview, error)
+	GetReviewsByStatus(status string, limit int) ([]domain.ProductReview, error)
+	GetRatingSummary(productId int64) (domain.ReviewRatingSummary, error)
+	AddReview(review domain.ProductReview) (domain.ProductReview, error)
+	ModerateReview(reviewId int64, status string, note *string) (domain.ProductReview, error)
+	VoteReview(reviewId int64, userId int64, isHelpful bool) (domain.ProductReview, error)
+	RefreshProductRating(productId int64) error
+}
+
+type ProductReviewRepository struct {
+	dbPool       *pgxpool.Pool
+	scanner      *helper.GenericScanner[domain.ProductReview]
+	photoScanner *helper.GenericScanner[domain.ReviewPhoto]
+}
+
+func NewProductReviewRepository(dbPool *pgxpool.Pool) IProductReviewRepository {
+	return &ProductReviewRepository{
+		dbPool:       dbPool,
+		scanner:      helper.NewGenericScanner(dbPool, helper.ScanProductReview),
+		photoScanner: helper.NewGenericScanner(dbPool, helper.ScanReviewPhoto),
+	}
+}
+
+// HasPurchased tells whether the user ordered the product in an order that was paid for.
+func (reviewRepository *ProductReviewRepository) HasPurchased(userId int64, productId int64) (bool, error) {
+	ctx := context.Background()
+	query := `SELECT EXISTS (SELECT 1 FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		WHERE o.user_id = $1 AND oi.product_id = $2 AND LOWER(o.status) = ANY($3))`
+
+	var purchased bool
+	if err := reviewRepository.dbPool.QueryRow(ctx, query, userId, productId, domain.PaidOrderStatuses).Scan(&purchased); err != nil {
+		return false, common.WrapError("check purchase", err)
+	}
+	return purchased, nil
+}
+
+func (reviewRepository *ProductReviewRepository) GetReviewById(reviewId int64) (domain.ProductReview, error) {
+	ctx := context.Background()
+	review, err := reviewRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM product_reviews WHERE id = $1", reviewId)
+	if err != nil {
+		return domain.ProductReview{}, err
+	}
+	reviews, err := reviewRepository.withPhotos(ctx, []domain.ProductReview{review})
+	if err != nil {
+		return domain.ProductReview{}, err
+	}
+	return reviews[0], nil
+}
+
+func (reviewRepository *ProductReviewRepository) GetReviewByProductAndUser(productId int64, userId int64) (domain.ProductReview, error) {
+	ctx := context.Background()
+	return reviewRepository.scanner.QueryRowAndScan(ctx,
+		"SELECT * FROM product_reviews WHERE product_id = $1 AND user_id = $2", productId, userId)
+}
+
+func (reviewRepository *ProductReviewRepository) GetApprovedReviews(productId int64, sort string, limit int, offset int) ([]domain.ProductReview, error) {
+	ctx := context.Background()
+	query := `SELECT * FROM product_reviews WHERE product_id = $1 AND status = $2 ORDER BY ` + reviewOrderBy(sort) + ` LIMIT $3 OFFSET $4`
+	reviews, err := reviewRepository.scanner.QueryAndScan(ctx, query, productId, domain.ReviewStatusApproved, limit, offset)
+	if err != nil {
+		return []domain.ProductReview{}, err
+	}
+	return reviewRepository.withPhotos(ctx, reviews)
+}
+
+// GetReviewsByStatus returns the moderation queue for a status, oldest first.
+func (reviewRepository *ProductReviewRepository) GetReviewsByStatus(status string, limit int) ([]domain.ProductReview, error) {
+	ctx := context.Background()
+	reviews, err := reviewRepository.scanner.QueryAndScan(ctx,
+		"SELECT * FROM product_reviews WHERE status = $1 ORDER BY created_at, id LIMIT $2", status, limit)
+	if err != nil {
+		return []domain.ProductReview{}, err
+	}
+	return reviewRepository.withPhotos(ctx, reviews)
+}
+
+func (reviewRepository *ProductReviewRepository) GetRatingSummary(productId int64) (domain.ReviewRatingSummary, error) {
+	ctx := context.Background()
+	rows, err := reviewRepository.dbPool.Query(ctx,
+		"SELECT rating, COUNT(*) FROM product_reviews WHERE product_id = $1 AND status = $2 GROUP BY rating",
+		productId, domain.ReviewStatusApproved)
+	if err != nil {
+		return domain.ReviewRatingSummary{}, common.WrapError("query rating summary", err)
+	}
+	defer rows.Close()
+
+	summary := domain.ReviewRatingSummary{Distribution: map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0}}
+	total := 0
+	for rows.Next() {
+		var rating, count int
+		if err := rows.Scan(&rating, &count); err != nil {
+			return domain.ReviewRatingSummary{}, common.WrapError("scan rating summary", err)
+		}
+		summary.Distribution[rating] = count
+		summary.ReviewCount += count
+		total += rating * count
+	}
+	if summary.ReviewCount > 0 {
+		summary.AverageRating = float64(total) / float64(summary.ReviewCount)
+	}
+	return summary, rows.Err()
+}
+
+func (reviewRepository *ProductReviewRepository) AddReview(review domain.ProductReview) (domain.ProductReview, error) {
+	ctx := context.Background()
+	tx, err := reviewRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.ProductReview{}, common.WrapError("begin add review", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO product_reviews (product_id, user_id, rating, title, body, status) VALUES ($1, $2, $3, $4, $5, $6) RETURNING *`
+	added, err := helper.ScanProductReview(tx.QueryRow(ctx, query,
+		review.ProductId, review.UserId, review.Rating, review.Title, review.Body, review.Status))
+	if err != nil {
+		return domain.ProductReview{}, err
+	}
+
+	added.Photos = make([]domain.ReviewPhoto, 0, len(review.Photos))
+	for i, photo := range review.Photos {
+		saved, err := helper.ScanReviewPhoto(tx.QueryRow(ctx,
+			"INSERT INTO review_photos (review_id, url, sort_order) VALUES ($1, $2, $3) RETURNING *", added.Id, photo.Url, i))
+		if err != nil {
+			return domain.ProductReview{}, err
+		}
+		added.Photos = append(added.Photos, saved)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.ProductReview{}, common.WrapError("commit add review", err)
+	}
+	return added, nil
+}
+
+func (reviewRepository *ProductReviewRepository) ModerateReview(reviewId int64, status string, note *string) (domain.ProductReview, error) {
+	ctx := context.Background()
+	query := `UPDATE product_reviews SET status = $1, moderation_note = $2, moderated_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3 RETURNING *`
+	return reviewRepository.scanner.QueryRowAndScan(ctx, query, status, note, reviewId)
+}
+
+// VoteReview records or changes the user's helpfulness vote and recounts the review's votes.
+func (reviewRepository *ProductReviewRepository) VoteReview(reviewId int64, userId int64, isHelpful bool) (domain.ProductReview, error) {
+	ctx := context.Background()
+	tx, err := reviewRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.ProductReview{}, common.WrapError("begin review vote", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `INSERT INTO review_votes (review_id, user_id, is_helpful) VALUES ($1, $2, $3)
+		ON CONFLICT (review_id, user_id) DO UPDATE SET is_helpful = EXCLUDED.is_helpful, created_at = CURRENT_TIMESTAMP`,
+		reviewId, userId, isHelpful)
+	if err != nil {
+		return domain.ProductReview{}, common.WrapError("upsert review vote", err)
+	}
+	review, err := helper.ScanProductReview(tx.QueryRow(ctx, `UPDATE product_reviews SET
+			helpful_count = (SELECT COUNT(*) FROM review_votes WHERE review_id = $1 AND is_helpful),
+			not_helpful_count = (SELECT COUNT(*) FROM review_votes WHERE review_id = $1 AND NOT is_helpful)
+		WHERE id = $1 RETURNING *`, reviewId))
+	if err != nil {
+		return domain.ProductReview{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.ProductReview{}, common.WrapError("commit review vote", err)
+	}
+	return review, nil
+}
+
+// RefreshProductRating recomputes the denormalised rating columns of a product from its approved reviews.
+func (reviewRepository *ProductReviewRepository) RefreshProductRating(productId int64) error {
+	ctx := context.Background()
+	query := `UPDATE products SET
+			average_rating = COALESCE((SELECT ROUND(AVG(rating), 2) FROM product_reviews WHERE product_id = $1 AND status = $2), 0),
+			review_count = (SELECT COUNT(*) FROM product_reviews WHERE product_id = $1 AND status = $2)
+		WHERE id = $1`
+	return reviewRepository.scanner.ExecuteExec(ctx, query, productId, domain.ReviewStatusApproved)
+}
+
+func (reviewRepository *ProductReviewRepository) withPhotos(ctx context.Context, reviews []domain.ProductReview) ([]domain.ProductReview, error) {
+	if len(reviews) == 0 {
+		return []domain.ProductReview{}, nil
+	}
+	reviewIds := make([]int64, 0, len(reviews))
+	for _, review := range reviews {
+		reviewIds = append(reviewIds, review.Id)
+	}
+
+	photos, err := reviewRepository.photoScanner.QueryAndScan(ctx,
+		"SELECT * FROM review_photos WHERE review_id = ANY($1) ORDER BY review_id, sort_order", reviewIds)
+	if err != nil {
+		return nil, err
+	}
+	photosByReview := make(map[int64][]domain.ReviewPhoto)
+	for _, photo := range photos {
+		photosByReview[photo.ReviewId] = append(photosByReview[photo.ReviewId], photo)
+	}
+	for i := range reviews {
+		reviews[i].Photos = photosByReview[reviews[i].Id]
+	}
+	return reviews, nil
+}
+
+func reviewOrderBy(sort string) string {
+	switch sort {
+	case domain.ReviewSortHelpful:
+		return "helpful_count DESC, created_at DESC, id DESC"
+	case domain.ReviewSortRatingDesc:
+		return "rating DESC, created_at DESC, id DESC"
+	case domain.ReviewSortRatingAsc:
+		return "rating ASC, created_at DESC, id DESC"
+	default:
+		return "created_at DESC, id DESC"
+	}
+}
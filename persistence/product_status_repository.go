@@ -0,0 +1,143 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type IProductStatusRepository interface {
+	GetReviewQueue() ([]domain.Product, error)
+	GetStatusHistory(productId int64) ([]domain.ProductStatusChange, error)
+	ChangeStatus(change domain.ProductStatusChange, schedule domain.PublishSchedule) (domain.Product, error)
+	SetSchedule(change domain.ProductStatusChange, schedule domain.PublishSchedule) (domain.Product, error)
+	GetProductsToPublish(now time.Time) ([]domain.Product, error)
+	GetProductsToUnpublish(now time.Time) ([]domain.Product, error)
+}
+
+type ProductStatusRepository struct {
+	dbPool         *pgxpool.Pool
+	productScanner *helper.GenericScanner[domain.Product]
+	changeScanner  *helper.GenericScanner[domain.ProductStatusChange]
+}
+
+func NewProductStatusRepository(dbPool *pgxpool.Pool) IProductStatusRepository {
+	return &ProductStatusRepository{
+		dbPool:         dbPool,
+		productScanner: helper.NewGenericScanner(dbPool, helper.ScanProduct),
+		changeScanner:  helper.NewGenericScanner(dbPool, helper.ScanProductStatusChange),
+	}
+}
+
+// GetReviewQueue returns the products waiting for review, the longest waiting first.
+func (statusRepository *ProductStatusRepository) GetReviewQueue() ([]domain.Product, error) {
+	ctx := context.Background()
+	products, err := statusRepository.productScanner.QueryAndScan(ctx, `SELECT * FROM products
+		WHERE status = $1 AND deleted_at IS NULL ORDER BY submitted_at, id`, domain.ProductStatusPendingReview)
+	if err != nil {
+		return []domain.Product{}, err
+	}
+	return products, nil
+}
+
+func (statusRepository *ProductStatusRepository) GetStatusHistory(productId int64) ([]domain.ProductStatusChange, error) {
+	ctx := context.Background()
+	changes, err := statusRepository.changeScanner.QueryAndScan(ctx,
+		"SELECT * FROM product_status_changes WHERE product_id = $1 ORDER BY changed_at DESC, id DESC", productId)
+	if err != nil {
+		return []domain.ProductStatusChange{}, err
+	}
+	return changes, nil
+}
+
+// ChangeStatus moves the product from the change's FromStatus to its ToStatus, sets its publish schedule
+// and records the change. is_active follows the status. A product that is no longer in FromStatus is
+// reported as not found, so concurrent transitions cannot both apply. The note of a review decision is
+// kept on the product for its vendor, and the user who submits the product is kept so they cannot review it.
+func (statusRepository *ProductStatusRepository) ChangeStatus(change domain.ProductStatusChange, schedule domain.PublishSchedule) (domain.Product, error) {
+	ctx := context.Background()
+	tx, err := statusRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Product{}, common.WrapError("begin product status change", err)
+	}
+	defer tx.Rollback(ctx)
+
+	product, err := helper.ScanProduct(tx.QueryRow(ctx, `UPDATE products SET status = $1, is_active = ($1 = $2),
+		publish_at = $3, unpublish_at = $4,
+		submitted_at = CASE WHEN $1 = $5 THEN CURRENT_TIMESTAMP ELSE submitted_at END,
+		submitted_by = CASE WHEN $1 = $5 THEN $9 ELSE submitted_by END,
+		review_note = CASE WHEN $6 = $5 THEN $7 ELSE review_note END,
+		updated_at = CURRENT_TIMESTAMP
+		WHERE id = $8 AND status = $6 AND deleted_at IS NULL RETURNING *`,
+		change.ToStatus, domain.ProductStatusPublished, schedule.PublishAt, schedule.UnpublishAt,
+		domain.ProductStatusPendingReview, change.FromStatus, change.Note, change.ProductId, change.ChangedBy))
+	if err != nil {
+		return domain.Product{}, err
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO product_status_changes (product_id, from_status, to_status, note, changed_by)
+		VALUES ($1, $2, $3, $4, $5)`,
+		change.ProductId, change.FromStatus, change.ToStatus, change.Note, change.ChangedBy); err != nil {
+		return domain.Product{}, common.WrapError("record product status change", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Product{}, common.WrapError("commit product status change", err)
+	}
+	return product, nil
+}
+
+// SetSchedule replaces the product's publish schedule and records who changed it. The change keeps the
+// product's status.
+func (statusRepository *ProductStatusRepository) SetSchedule(change domain.ProductStatusChange, schedule domain.PublishSchedule) (domain.Product, error) {
+	ctx := context.Background()
+	tx, err := statusRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Product{}, common.WrapError("begin product schedule change", err)
+	}
+	defer tx.Rollback(ctx)
+
+	product, err := helper.ScanProduct(tx.QueryRow(ctx, `UPDATE products SET publish_at = $1, unpublish_at = $2,
+		updated_at = CURRENT_TIMESTAMP WHERE id = $3 AND deleted_at IS NULL RETURNING *`,
+		schedule.PublishAt, schedule.UnpublishAt, change.ProductId))
+	if err != nil {
+		return domain.Product{}, err
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO product_status_changes (product_id, from_status, to_status, note, changed_by)
+		VALUES ($1, $2, $2, $3, $4)`,
+		change.ProductId, product.Status, change.Note, change.ChangedBy); err != nil {
+		return domain.Product{}, common.WrapError("record product schedule change", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Product{}, common.WrapError("commit product schedule change", err)
+	}
+	return product, nil
+}
+
+// GetProductsToPublish returns the unpublished products whose publish time has passed. Products that are
+// not approved yet wait for their review.
+func (statusRepository *ProductStatusRepository) GetProductsToPublish(now time.Time) ([]domain.Product, error) {
+	ctx := context.Background()
+	products, err := statusRepository.productScanner.QueryAndScan(ctx, `SELECT * FROM products
+		WHERE status = $1 AND publish_at <= $2 AND deleted_at IS NULL ORDER BY publish_at, id`,
+		domain.ProductStatusUnpublished, now)
+	if err != nil {
+		return []domain.Product{}, err
+	}
+	return products, nil
+}
+
+func (statusRepository *ProductStatusRepository) GetProductsToUnpublish(now time.Time) ([]domain.Product, error) {
+	ctx := context.Background()
+	products, err := statusRepository.productScanner.QueryAndScan(ctx, `SELECT * FROM products
+		WHERE status = $1 AND unpublish_at <= $2 AND deleted_at IS NULL ORDER BY unpublish_at, id`,
+		domain.ProductStatusPublished, now)
+	if err != nil {
+		return []domain.Product{}, err
+	}
+	return products, nil
+}
@@ -0,0 +1,265 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type IProductVariantRepository interface {
+	GetOptionTypes() ([]domain.OptionType, error)
+	GetOptionTypeById(optionTypeId int64) (domain.OptionType, error)
+	AddOptionType(optionType domain.OptionType) (domain.OptionType, error)
+	AddOptionValue(optionValue domain.OptionValue) (domain.OptionValue, error)
+	GetOptionValuesByIds(optionValueIds []int64) ([]domain.OptionValue, error)
+	GetVariantsByProductId(productId int64) ([]domain.ProductVariant, error)
+	GetVariantsByProductIds(productIds []int64) (map[int64][]domain.ProductVariant, error)
+	GetVariantById(variantId int64) (domain.ProductVariant, error)
+	GetVariantBySku(sku string) (domain.ProductVariant, error)
+	AddVariant(variant domain.ProductVariant, optionValueIds []int64) (domain.ProductVariant, error)
+	UpdateVariant(variantId int64, variant domain.ProductVariant, optionValueIds []int64) (domain.ProductVariant, error)
+	DeleteVariantById(variantId int64) error
+}
+
+type ProductVariantRepository struct {
+	dbPool             *pgxpool.Pool
+	scanner            *helper.GenericScanner[domain.ProductVariant]
+	optionTypeScanner  *helper.GenericScanner[domain.OptionType]
+	optionValueScanner *helper.GenericScanner[domain.OptionValue]
+	optionScanner      *helper.GenericScanner[domain.VariantOption]
+}
+
+func NewProductVariantRepository(dbPool *pgxpool.Pool) IProductVariantRepository {
+	return &ProductVariantRepository{
+		dbPool:             dbPool,
+		scanner:            helper.NewGenericScanner(dbPool, helper.ScanProductVariant),
+		optionTypeScanner:  helper.NewGenericScanner(dbPool, helper.ScanOptionType),
+		optionValueScanner: helper.NewGenericScanner(dbPool, helper.ScanOptionValue),
+		optionScanner:      helper.NewGenericScanner(dbPool, helper.ScanVariantOption),
+	}
+}
+
+func (variantRepository *ProductVariantRepository) GetOptionTypes() ([]domain.OptionType, error) {
+	ctx := context.Background()
+	optionTypes, err := variantRepository.optionTypeScanner.QueryAndScan(ctx, "SELECT * FROM option_types ORDER BY id")
+	if err != nil {
+		return []domain.OptionType{}, err
+	}
+	values, err := variantRepository.optionValueScanner.QueryAndScan(ctx,
+		"SELECT * FROM option_values ORDER BY option_type_id, sort_order, id")
+	if err != nil {
+		return []domain.OptionType{}, err
+	}
+
+	valuesByType := make(map[int64][]domain.OptionValue)
+	for _, value := range values {
+		valuesByType[value.OptionTypeId] = append(valuesByType[value.OptionTypeId], value)
+	}
+	for i := range optionTypes {
+		optionTypes[i].Values = valuesByType[optionTypes[i].Id]
+	}
+	return optionTypes, nil
+}
+
+func (variantRepository *ProductVariantRepository) GetOptionTypeById(optionTypeId int64) (domain.OptionType, error) {
+	ctx := context.Background()
+	optionType, err := variantRepository.optionTypeScanner.QueryRowAndScan(ctx, "SELECT * FROM option_types WHERE id = $1", optionTypeId)
+	if err != nil {
+		return domain.OptionType{}, err
+	}
+	values, err := variantRepository.optionValueScanner.QueryAndScan(ctx,
+		"SELECT * FROM option_values WHERE option_type_id = $1 ORDER BY sort_order, id", optionTypeId)
+	if err != nil {
+		return domain.OptionType{}, err
+	}
+	optionType.Values = values
+	return optionType, nil
+}
+
+func (variantRepository *ProductVariantRepository) AddOptionType(optionType domain.OptionType) (domain.OptionType, error) {
+	ctx := context.Background()
+	query := `INSERT INTO option_types (name, display_name) VALUES ($1, $2) RETURNING *`
+	return variantRepository.optionTypeScanner.QueryRowAndScan(ctx, query, optionType.Name, optionType.DisplayName)
+}
+
+func (variantRepository *ProductVariantRepository) AddOptionValue(optionValue domain.OptionValue) (domain.OptionValue, error) {
+	ctx := context.Background()
+	query := `INSERT INTO option_values (option_type_id, value, sort_order) VALUES ($1, $2, $3) RETURNING *`
+	return variantRepository.optionValueScanner.QueryRowAndScan(ctx, query,
+		optionValue.OptionTypeId, optionValue.Value, optionValue.SortOrder)
+}
+
+func (variantRepository *ProductVariantRepository) GetOptionValuesByIds(optionValueIds []int64) ([]domain.OptionValue, error) {
+	ctx := context.Background()
+	values, err := variantRepository.optionValueScanner.QueryAndScan(ctx,
+		"SELECT * FROM option_values WHERE id = ANY($1)", optionValueIds)
+	if err != nil {
+		return []domain.OptionValue{}, err
+	}
+	return values, nil
+}
+
+func (variantRepository *ProductVariantRepository) GetVariantsByProductId(productId int64) ([]domain.ProductVariant, error) {
+	variantsByProduct, err := variantRepository.GetVariantsByProductIds([]int64{productId})
+	if err != nil {
+		return []domain.ProductVariant{}, err
+	}
+	return variantsByProduct[productId], nil
+}
+
+// GetVariantsByProductIds loads the variants of several products with their option values in two queries.
+func (variantRepository *ProductVariantRepository) GetVariantsByProductIds(productIds []int64) (map[int64][]domain.ProductVariant, error) {
+	ctx := context.Background()
+	variantsByProduct := make(map[int64][]domain.ProductVariant)
+	if len(productIds) == 0 {
+		return variantsByProduct, nil
+	}
+
+	variants, err := variantRepository.scanner.QueryAndScan(ctx,
+		"SELECT * FROM product_variants WHERE product_id = ANY($1) ORDER BY product_id, id", productIds)
+	if err != nil {
+		return nil, err
+	}
+	if err := variantRepository.attachOptions(ctx, variants); err != nil {
+		return nil, err
+	}
+
+	for _, variant := range variants {
+		variantsByProduct[variant.ProductId] = append(variantsByProduct[variant.ProductId], variant)
+	}
+	return variantsByProduct, nil
+}
+
+func (variantRepository *ProductVariantRepository) GetVariantById(variantId int64) (domain.ProductVariant, error) {
+	ctx := context.Background()
+	variant, err := variantRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM product_variants WHERE id = $1", variantId)
+	if err != nil {
+		return domain.ProductVariant{}, err
+	}
+	variants := []domain.ProductVariant{variant}
+	if err := variantRepository.attachOptions(ctx, variants); err != nil {
+		return domain.ProductVariant{}, err
+	}
+	return variants[0], nil
+}
+
+func (variantRepository *ProductVariantRepository) GetVariantBySku(sku string) (domain.ProductVariant, error) {
+	ctx := context.Background()
+	variant, err := variantRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM product_variants WHERE sku = $1", sku)
+	if err != nil {
+		return domain.ProductVariant{}, err
+	}
+	variants := []domain.ProductVariant{variant}
+	if err := variantRepository.attachOptions(ctx, variants); err != nil {
+		return domain.ProductVariant{}, err
+	}
+	return variants[0], nil
+}
+
+func (variantRepository *ProductVariantRepository) AddVariant(variant domain.ProductVariant, optionValueIds []int64) (domain.ProductVariant, error) {
+	ctx := context.Background()
+	tx, err := variantRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.ProductVariant{}, common.WrapError("begin add variant", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO product_variants (product_id, sku, price, stock_quantity, barcode, image_url, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`
+	createdVariant, err := helper.ScanProductVariant(tx.QueryRow(ctx, query,
+		variant.ProductId, variant.Sku, variant.Price, 0, variant.Barcode, variant.ImageUrl, variant.IsActive))
+	if err != nil {
+		return domain.ProductVariant{}, err
+	}
+	if err := replaceVariantOptions(ctx, tx, createdVariant.Id, optionValueIds); err != nil {
+		return domain.ProductVariant{}, err
+	}
+	if variant.StockQuantity > 0 {
+		err := setStockQuantity(ctx, tx, variant.ProductId, &createdVariant.Id, variant.StockQuantity, domain.StockMovementReceipt,
+			"Initial stock")
+		if err != nil {
+			return domain.ProductVariant{}, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return domain.ProductVariant{}, common.WrapError("commit add variant", err)
+	}
+	return variantRepository.GetVariantById(createdVariant.Id)
+}
+
+func (variantRepository *ProductVariantRepository) UpdateVariant(variantId int64, variant domain.ProductVariant, optionValueIds []int64) (domain.ProductVariant, error) {
+	ctx := context.Background()
+	tx, err := variantRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.ProductVariant{}, common.WrapError("begin update variant", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE product_variants SET sku = $1, price = $2, barcode = $3, image_url = $4,
+		is_active = $5, updated_at = CURRENT_TIMESTAMP WHERE id = $6 RETURNING *`
+	_, err = helper.ScanProductVariant(tx.QueryRow(ctx, query,
+		variant.Sku, variant.Price, variant.Barcode, variant.ImageUrl, variant.IsActive, variantId))
+	if err != nil {
+		return domain.ProductVariant{}, err
+	}
+	if err := replaceVariantOptions(ctx, tx, variantId, optionValueIds); err != nil {
+		return domain.ProductVariant{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return domain.ProductVariant{}, common.WrapError("commit update variant", err)
+	}
+	return variantRepository.GetVariantById(variantId)
+}
+
+func (variantRepository *ProductVariantRepository) DeleteVariantById(variantId int64) error {
+	ctx := context.Background()
+	_, err := variantRepository.scanner.QueryRowAndScan(ctx, "DELETE FROM product_variants WHERE id = $1 RETURNING *", variantId)
+	return err
+}
+
+func (variantRepository *ProductVariantRepository) attachOptions(ctx context.Context, variants []domain.ProductVariant) error {
+	if len(variants) == 0 {
+		return nil
+	}
+	variantIds := make([]int64, 0, len(variants))
+	for _, variant := range variants {
+		variantIds = append(variantIds, variant.Id)
+	}
+
+	query := `SELECT pvo.variant_id, ot.id, ot.name, ov.id, ov.value
+		FROM product_variant_options pvo
+		JOIN option_values ov ON ov.id = pvo.option_value_id
+		JOIN option_types ot ON ot.id = ov.option_type_id
+		WHERE pvo.variant_id = ANY($1)
+		ORDER BY ot.id, ov.sort_order`
+	options, err := variantRepository.optionScanner.QueryAndScan(ctx, query, variantIds)
+	if err != nil {
+		return err
+	}
+
+	optionsByVariant := make(map[int64][]domain.VariantOption)
+	for _, option := range options {
+		optionsByVariant[option.VariantId] = append(optionsByVariant[option.VariantId], option)
+	}
+	for i := range variants {
+		variants[i].Options = optionsByVariant[variants[i].Id]
+	}
+	return nil
+}
+
+func replaceVariantOptions(ctx context.Context, tx pgx.Tx, variantId int64, optionValueIds []int64) error {
+	if _, err := tx.Exec(ctx, "DELETE FROM product_variant_options WHERE variant_id = $1", variantId); err != nil {
+		return common.WrapError("delete variant options", err)
+	}
+	for _, optionValueId := range optionValueIds {
+		query := `INSERT INTO product_variant_options (variant_id, option_value_id) VALUES ($1, $2)`
+		if _, err := tx.Exec(ctx, query, variantId, optionValueId); err != nil {
+			return common.WrapError("insert variant option", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// boughtTogetherQuery keeps, per product, the products that appeared in the most orders together with it.
+const boughtTogetherQuery = `WITH pairs AS (
+		SELECT a.product_id, b.product_id AS related_product_id, COUNT(DISTINCT a.order_id) AS score
+		FROM order_items a
+		JOIN order_items b ON b.order_id = a.order_id AND b.product_id <> a.product_id
+		JOIN products p ON p.id = a.product_id AND p.deleted_at IS NULL
+		JOIN products r ON r.id = b.product_id AND r.deleted_at IS NULL AND r.is_active
+		GROUP BY a.product_id, b.product_id
+	), ranked AS (
+		SELECT *, ROW_NUMBER() OVER (PARTITION BY product_id ORDER BY score DESC, related_product_id) AS position FROM pairs
+	)
+	INSERT INTO product_relations (product_id, related_product_id, source, score, position)
+	SELECT product_id, related_product_id, $1, score, position FROM ranked WHERE position <= $2`
+
+// sameCategoryQuery tops up products with fewer than the wanted relations with products of the same category,
+// closest in price first.
+const sameCategoryQuery = `WITH taken AS (
+		SELECT product_id, COUNT(*) AS count FROM product_relations GROUP BY product_id
+	), candidates AS (
+		SELECT p.id AS product_id, c.id AS related_product_id,
+			ROW_NUMBER() OVER (PARTITION BY p.id ORDER BY ABS(c.price - p.price), c.average_rating DESC, c.id) AS rank
+		FROM products p
+		JOIN products c ON c.category_id = p.category_id AND c.id <> p.id AND c.deleted_at IS NULL AND c.is_active
+		WHERE p.deleted_at IS NULL AND p.category_id IS NOT NULL
+			AND NOT EXISTS (SELECT 1 FROM product_relations pr WHERE pr.product_id = p.id AND pr.related_product_id = c.id)
+	)
+	INSERT INTO product_relations (product_id, related_product_id, source, score, position)
+	SELECT c.product_id, c.related_product_id, $1, 0, COALESCE(t.count, 0) + c.rank
+	FROM candidates c LEFT JOIN taken t ON t.product_id = c.product_id
+	WHERE COALESCE(t.count, 0) + c.rank <= $2`
+
+type IRecommendationRepository interface {
+	RebuildRelations(limit int) (domain.RelationRun, error)
+	GetRelatedProducts(productId int64, limit int) ([]domain.RelatedProduct, error)
+	GetRecommendationsFor(productIds []int64, limit int) ([]domain.RelatedProduct, error)
+}
+
+type RecommendationRepository struct {
+	dbPool  *pgxpool.Pool
+	scanner *helper.GenericScanner[domain.RelatedProduct]
+}
+
+func NewRecommendationRepository(dbPool *pgxpool.Pool) IRecommendationRepository {
+	return &RecommendationRepository{
+		dbPool:  dbPool,
+		scanner: helper.NewGenericScanner(dbPool, helper.ScanRelatedProduct),
+	}
+}
+
+// RebuildRelations replaces all stored relations with freshly computed ones in a single transaction, so
+// readers keep seeing the previous relations until the new ones are complete.
+func (recommendationRepository *RecommendationRepository) RebuildRelations(limit int) (domain.RelationRun, error) {
+	ctx := context.Background()
+	tx, err := recommendationRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.RelationRun{}, common.WrapError("begin rebuild relations", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "DELETE FROM product_relations"); err != nil {
+		return domain.RelationRun{}, common.WrapError("clear relations", err)
+	}
+	boughtTogether, err := tx.Exec(ctx, boughtTogetherQuery, domain.RelationSourceBoughtTogether, limit)
+	if err != nil {
+		return domain.RelationRun{}, common.WrapError("compute bought together relations", err)
+	}
+	sameCategory, err := tx.Exec(ctx, sameCategoryQuery, domain.RelationSourceCategory, limit)
+	if err != nil {
+		return domain.RelationRun{}, common.WrapError("compute category relations", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.RelationRun{}, common.WrapError("commit rebuild relations", err)
+	}
+	return domain.RelationRun{
+		BoughtTogether: int(boughtTogether.RowsAffected()),
+		Category:       int(sameCategory.RowsAffected()),
+	}, nil
+}
+
+// GetRelatedProducts returns the stored relations of a product that are still active, best first.
+func (recommendationRepository *RecommendationRepository) GetRelatedProducts(productId int64, limit int) ([]domain.RelatedProduct, error) {
+	ctx := context.Background()
+	query := `SELECT p.*, r.source, r.score FROM product_relations r
+		JOIN products p ON p.id = r.related_product_id
+		WHERE r.product_id = $1 AND p.deleted_at IS NULL AND p.is_active
+		ORDER BY r.position LIMIT $2`
+	related, err := recommendationRepository.scanner.QueryAndScan(ctx, query, productId, limit)
+	if err != nil {
+		return []domain.RelatedProduct{}, err
+	}
+	return related, nil
+}
+
+// GetRecommendationsFor merges the relations of several products, leaving the products themselves out.
+// Products related to more of them and bought together more often come first.
+func (recommendationRepository *RecommendationRepository) GetRecommendationsFor(productIds []int64, limit int) ([]domain.RelatedProduct, error) {
+	ctx := context.Background()
+	query := `SELECT p.*,
+			CASE WHEN bool_or(r.source = $2) THEN $2 ELSE $3 END AS source,
+			SUM(r.score) AS score
+		FROM product_relations r
+		JOIN products p ON p.id = r.related_product_id
+		WHERE r.product_id = ANY($1) AND r.related_product_id <> ALL($1) AND p.deleted_at IS NULL AND p.is_active
+		GROUP BY p.id
+		ORDER BY COUNT(*) DESC, SUM(r.score) DESC, MIN(r.position), p.id
+		LIMIT $4`
+	recommendations, err := recommendationRepository.scanner.QueryAndScan(ctx, query, productIds,
+		domain.RelationSourceBoughtTogether, domain.RelationSourceCategory, limit)
+	if err != nil {
+		return []domain.RelatedProduct{}, err
+	}
+	return recommendations, nil
+}
@@ -0,0 +1,417 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	dirtyCartsKey      = "carts:dirty"
+	deadCartsKey       = "carts:dead_letter"
+	cartItemSeqKey     = "cart_items:id_seq"
+	cartCacheWarmKey   = "carts:cache:warm"
+	cartFlushBatchSize = 100
+	cartWriteRetries   = 5
+)
+
+// IHotCartItemRepository keeps carts in Redis as the primary store and persists them to Postgres
+// asynchronously (write-behind).
+type IHotCartItemRepository interface {
+	ICartItemRepository
+	FlushDirtyCarts() (int, error)
+	RebuildCache() (int, error)
+	IsCacheWarm() bool
+}
+
+type RedisCartItemRepository struct {
+	redisClient *redis.Client
+	database    ICartItemSnapshotRepository
+	ttl         time.Duration
+}
+
+func NewRedisCartItemRepository(redisClient *redis.Client, database ICartItemSnapshotRepository, ttl time.Duration) IHotCartItemRepository {
+	return &RedisCartItemRepository{
+		redisClient: redisClient,
+		database:    database,
+		ttl:         ttl,
+	}
+}
+
+func cartItemsKey(cartId int64) string {
+	return fmt.Sprintf("cart:%d:items", cartId)
+}
+
+func cartLoadedKey(cartId int64) string {
+	return fmt.Sprintf("cart:%d:loaded", cartId)
+}
+
+func cartItemIndexKey(cartItemId int64) string {
+	return fmt.Sprintf("cart_item:%d", cartItemId)
+}
+
+// AddItemToCart checks the cart and product against Postgres before the line is written, so the cart
+// can later be persisted.
+func (repository *RedisCartItemRepository) AddItemToCart(cartItem domain.CartItem) (domain.CartItem, error) {
+	ctx := context.Background()
+	if err := repository.database.ValidateItemReferences(cartItem.CartId, cartItem.ProductId); err != nil {
+		return domain.CartItem{}, err
+	}
+	if _, err := repository.loadCart(ctx, cartItem.CartId); err != nil {
+		return domain.CartItem{}, err
+	}
+
+	return repository.modifyCart(ctx, cartItem.CartId, func(items []domain.CartItem) (domain.CartItem, error) {
+		// The same product (and variant) added twice is merged into one cart line.
+		for _, item := range items {
+			if item.ProductId == cartItem.ProductId && sameVariant(item.VariantId, cartItem.VariantId) {
+				item.Quantity += cartItem.Quantity
+				return item, nil
+			}
+		}
+
+		itemId, err := repository.nextItemId(ctx)
+		if err != nil {
+			return domain.CartItem{}, err
+		}
+		newItem := cartItem
+		newItem.Id = itemId
+		return newItem, nil
+	})
+}
+
+func (repository *RedisCartItemRepository) UpdateItemQuantity(cartItemId int64, newQuantity int) (domain.CartItem, error) {
+	return repository.modifyItem(cartItemId, func(item *domain.CartItem) { item.Quantity = newQuantity })
+}
+
+func (repository *RedisCartItemRepository) RemoveItemFromCart(cartItemId int64) error {
+	ctx := context.Background()
+	item, err := repository.findItem(ctx, cartItemId)
+	if err != nil {
+		return err
+	}
+
+	pipe := repository.redisClient.TxPipeline()
+	pipe.HDel(ctx, cartItemsKey(item.CartId), strconv.FormatInt(item.Id, 10))
+	pipe.Del(ctx, cartItemIndexKey(item.Id))
+	pipe.SAdd(ctx, dirtyCartsKey, item.CartId)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (repository *RedisCartItemRepository) GetItemsByCartId(cartId int64) []domain.CartItem {
+	items, err := repository.loadCart(context.Background(), cartId)
+	if err != nil {
+		log.Error().Err(err).Int64("cart_id", cartId).Msg("Cart could not be loaded from Redis")
+		return []domain.CartItem{}
+	}
+	return items
+}
+
+func (repository *RedisCartItemRepository) GetItemById(cartItemId int64) (domain.CartItem, error) {
+	return repository.findItem(context.Background(), cartItemId)
+}
+
+func (repository *RedisCartItemRepository) ClearCartItems(cartId int64) error {
+	ctx := context.Background()
+	items, err := repository.loadCart(ctx, cartId)
+	if err != nil {
+		return err
+	}
+
+	pipe := repository.redisClient.TxPipeline()
+	pipe.Del(ctx, cartItemsKey(cartId))
+	for _, item := range items {
+		pipe.Del(ctx, cartItemIndexKey(item.Id))
+	}
+	pipe.Set(ctx, cartLoadedKey(cartId), 1, repository.ttl)
+	pipe.SAdd(ctx, dirtyCartsKey, cartId)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (repository *RedisCartItemRepository) IncreaseItemQuantity(cartItemId int64, amount int) error {
+	_, err := repository.modifyItem(cartItemId, func(item *domain.CartItem) { item.Quantity += amount })
+	return err
+}
+
+func (repository *RedisCartItemRepository) DecreaseItemQuantity(cartItemId int64, amount int) error {
+	_, err := repository.modifyItem(cartItemId, func(item *domain.CartItem) { item.Quantity -= amount })
+	return err
+}
+
+// FlushDirtyCarts writes every cart changed since the last flush to Postgres. Carts that fail to persist
+// are put back on the dirty set and retried on the next run, except carts referencing a row that no longer
+// exists: retrying cannot fix them, so they are moved to the dead-letter set.
+func (repository *RedisCartItemRepository) FlushDirtyCarts() (int, error) {
+	ctx := context.Background()
+	flushed := 0
+	for {
+		members, err := repository.redisClient.SPopN(ctx, dirtyCartsKey, cartFlushBatchSize).Result()
+		if err != nil {
+			return flushed, err
+		}
+		if len(members) == 0 {
+			return flushed, nil
+		}
+
+		for _, member := range members {
+			cartId, parseErr := strconv.ParseInt(member, 10, 64)
+			if parseErr != nil {
+				continue
+			}
+
+			loaded, existsErr := repository.redisClient.Exists(ctx, cartLoadedKey(cartId)).Result()
+			if existsErr != nil || loaded == 0 {
+				// The cart expired or Redis lost it before the flush; Postgres keeps the last persisted state.
+				continue
+			}
+
+			items, readErr := repository.readCart(ctx, repository.redisClient, cartId)
+			if readErr == nil {
+				readErr = repository.database.SaveCartSnapshot(cartId, items)
+			}
+			if common.IsForeignKeyViolation(readErr) {
+				log.Error().Err(readErr).Int64("cart_id", cartId).Msg("Cart references a missing row, moved to the dead-letter set")
+				repository.redisClient.SAdd(ctx, deadCartsKey, cartId)
+				continue
+			}
+			if readErr != nil {
+				log.Error().Err(readErr).Int64("cart_id", cartId).Msg("Cart could not be persisted")
+				repository.redisClient.SAdd(ctx, dirtyCartsKey, cartId)
+				continue
+			}
+			flushed++
+		}
+
+		if len(members) < cartFlushBatchSize {
+			return flushed, nil
+		}
+	}
+}
+
+// RebuildCache reloads recently active carts from Postgres, e.g. after Redis was flushed or restarted.
+func (repository *RedisCartItemRepository) RebuildCache() (int, error) {
+	ctx := context.Background()
+	cartIds, err := repository.database.GetCartIdsUpdatedSince(time.Now().Add(-repository.ttl))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := repository.seedItemSequence(ctx); err != nil {
+		return 0, err
+	}
+
+	rebuilt := 0
+	for _, cartId := range cartIds {
+		if _, loadErr := repository.loadCart(ctx, cartId); loadErr != nil {
+			log.Error().Err(loadErr).Int64("cart_id", cartId).Msg("Cart could not be rebuilt in Redis")
+			continue
+		}
+		rebuilt++
+	}
+
+	if err := repository.redisClient.Set(ctx, cartCacheWarmKey, time.Now().Unix(), 0).Err(); err != nil {
+		return rebuilt, err
+	}
+	return rebuilt, nil
+}
+
+func (repository *RedisCartItemRepository) IsCacheWarm() bool {
+	exists, err := repository.redisClient.Exists(context.Background(), cartCacheWarmKey).Result()
+	return err == nil && exists == 1
+}
+
+// loadCart returns the cart lines from Redis, populating Redis from Postgres on a miss.
+func (repository *RedisCartItemRepository) loadCart(ctx context.Context, cartId int64) ([]domain.CartItem, error) {
+	loaded, err := repository.redisClient.Exists(ctx, cartLoadedKey(cartId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if loaded == 1 {
+		items, readErr := repository.readCart(ctx, repository.redisClient, cartId)
+		if readErr != nil {
+			return nil, readErr
+		}
+		repository.refreshTTL(ctx, cartId, items)
+		return items, nil
+	}
+
+	items := repository.database.GetItemsByCartId(cartId)
+	pipe := repository.redisClient.TxPipeline()
+	pipe.Del(ctx, cartItemsKey(cartId))
+	for _, item := range items {
+		data, marshalErr := json.Marshal(item)
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		pipe.HSet(ctx, cartItemsKey(cartId), strconv.FormatInt(item.Id, 10), data)
+		pipe.Set(ctx, cartItemIndexKey(item.Id), cartId, repository.ttl)
+	}
+	pipe.Expire(ctx, cartItemsKey(cartId), repository.ttl)
+	pipe.Set(ctx, cartLoadedKey(cartId), 1, repository.ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (repository *RedisCartItemRepository) readCart(ctx context.Context, client redis.Cmdable, cartId int64) ([]domain.CartItem, error) {
+	values, err := client.HVals(ctx, cartItemsKey(cartId)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.CartItem, 0, len(values))
+	for _, value := range values {
+		var item domain.CartItem
+		if err := json.Unmarshal([]byte(value), &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Id < items[j].Id })
+	return items, nil
+}
+
+func (repository *RedisCartItemRepository) findItem(ctx context.Context, cartItemId int64) (domain.CartItem, error) {
+	cartId, err := repository.redisClient.Get(ctx, cartItemIndexKey(cartItemId)).Int64()
+	if errors.Is(err, redis.Nil) {
+		item, dbErr := repository.database.GetItemById(cartItemId)
+		if dbErr != nil {
+			return domain.CartItem{}, dbErr
+		}
+		cartId = item.CartId
+	} else if err != nil {
+		return domain.CartItem{}, err
+	}
+
+	if _, err := repository.loadCart(ctx, cartId); err != nil {
+		return domain.CartItem{}, err
+	}
+
+	value, err := repository.redisClient.HGet(ctx, cartItemsKey(cartId), strconv.FormatInt(cartItemId, 10)).Result()
+	if errors.Is(err, redis.Nil) {
+		return domain.CartItem{}, common.ErrCartItemNotFound
+	}
+	if err != nil {
+		return domain.CartItem{}, err
+	}
+
+	var item domain.CartItem
+	if err := json.Unmarshal([]byte(value), &item); err != nil {
+		return domain.CartItem{}, err
+	}
+	return item, nil
+}
+
+// modifyCart runs a read-modify-write of one cart line under WATCH, so concurrent writers to the same cart
+// cannot overwrite each other's changes. The change is retried when the cart was written in between.
+func (repository *RedisCartItemRepository) modifyCart(ctx context.Context, cartId int64,
+	modify func(items []domain.CartItem) (domain.CartItem, error)) (domain.CartItem, error) {
+	for attempt := 0; attempt < cartWriteRetries; attempt++ {
+		var saved domain.CartItem
+		err := repository.redisClient.Watch(ctx, func(tx *redis.Tx) error {
+			items, err := repository.readCart(ctx, tx, cartId)
+			if err != nil {
+				return err
+			}
+			item, err := modify(items)
+			if err != nil {
+				return err
+			}
+			data, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.HSet(ctx, cartItemsKey(cartId), strconv.FormatInt(item.Id, 10), data)
+				pipe.Expire(ctx, cartItemsKey(cartId), repository.ttl)
+				pipe.Set(ctx, cartItemIndexKey(item.Id), cartId, repository.ttl)
+				pipe.Set(ctx, cartLoadedKey(cartId), 1, repository.ttl)
+				pipe.SAdd(ctx, dirtyCartsKey, cartId)
+				return nil
+			})
+			saved = item
+			return err
+		}, cartItemsKey(cartId))
+
+		if errors.Is(err, redis.TxFailedErr) {
+			continue
+		}
+		if err != nil {
+			return domain.CartItem{}, err
+		}
+		return saved, nil
+	}
+	return domain.CartItem{}, common.ErrCartConflict
+}
+
+// modifyItem changes an existing cart line atomically.
+func (repository *RedisCartItemRepository) modifyItem(cartItemId int64, change func(item *domain.CartItem)) (domain.CartItem, error) {
+	ctx := context.Background()
+	current, err := repository.findItem(ctx, cartItemId)
+	if err != nil {
+		return domain.CartItem{}, err
+	}
+
+	return repository.modifyCart(ctx, current.CartId, func(items []domain.CartItem) (domain.CartItem, error) {
+		for _, item := range items {
+			if item.Id == cartItemId {
+				change(&item)
+				return item, nil
+			}
+		}
+		return domain.CartItem{}, common.ErrCartItemNotFound
+	})
+}
+
+func (repository *RedisCartItemRepository) refreshTTL(ctx context.Context, cartId int64, items []domain.CartItem) {
+	pipe := repository.redisClient.Pipeline()
+	pipe.Expire(ctx, cartItemsKey(cartId), repository.ttl)
+	pipe.Expire(ctx, cartLoadedKey(cartId), repository.ttl)
+	for _, item := range items {
+		pipe.Expire(ctx, cartItemIndexKey(item.Id), repository.ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Warn().Err(err).Int64("cart_id", cartId).Msg("Cart TTL could not be refreshed")
+	}
+}
+
+func (repository *RedisCartItemRepository) nextItemId(ctx context.Context) (int64, error) {
+	if err := repository.seedItemSequence(ctx); err != nil {
+		return 0, err
+	}
+	return repository.redisClient.Incr(ctx, cartItemSeqKey).Result()
+}
+
+// seedItemSequence makes sure the Redis id sequence starts after the ids already persisted in Postgres.
+func (repository *RedisCartItemRepository) seedItemSequence(ctx context.Context) error {
+	exists, err := repository.redisClient.Exists(ctx, cartItemSeqKey).Result()
+	if err != nil || exists == 1 {
+		return err
+	}
+
+	lastId, err := repository.database.GetLastItemId()
+	if err != nil {
+		return err
+	}
+	return repository.redisClient.SetNX(ctx, cartItemSeqKey, lastId, 0).Err()
+}
+
+func sameVariant(left *int64, right *int64) bool {
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+	return *left == *right
+}
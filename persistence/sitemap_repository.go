@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type ISitemapRepository interface {
+	GetPageStates(entityType string, pageSize int) ([]domain.SitemapPage, error)
+	GetEntries(entityType string, page int, pageSize int) ([]domain.SitemapEntry, error)
+	GetPages() ([]domain.SitemapPage, error)
+	GetPageContent(entityType string, page int) (string, error)
+	SavePage(page domain.SitemapPage, content string) error
+	DeletePagesExcept(entityType string, pages []int) (int64, error)
+}
+
+// sitemapSource holds the rows listed in the sitemaps of an entity type: live, active entities, each with
+// its slug, last modification and translated slugs. Categories have neither slugs nor timestamps.
+type sitemapSource struct {
+	rows string
+}
+
+var sitemapSources = map[string]sitemapSource{
+	domain.SitemapProducts: {rows: `SELECT p.id, p.slug, GREATEST(p.updated_at, t.updated_at) AS last_modified,
+			COALESCE(t.locales, '{}') AS locales, COALESCE(t.slugs, '{}') AS slugs
+		FROM products p
+		LEFT JOIN (SELECT product_id, max(updated_at) AS updated_at,
+				array_agg(locale ORDER BY locale) AS locales, array_agg(slug ORDER BY locale) AS slugs
+			FROM product_translations GROUP BY product_id) t ON t.product_id = p.id
+		WHERE p.is_active = true AND p.deleted_at IS NULL`},
+	domain.SitemapCategories: {rows: `SELECT id, '' AS slug, NULL::timestamp AS last_modified,
+			'{}'::text[] AS locales, '{}'::text[] AS slugs
+		FROM categories WHERE is_active = true AND deleted_at IS NULL`},
+	domain.SitemapStores: {rows: `SELECT id, slug, updated_at AS last_modified, '{}'::text[] AS locales, '{}'::text[] AS slugs
+		FROM stores WHERE is_active = true AND deleted_at IS NULL`},
+}
+
+type SitemapRepository struct {
+	dbPool       *pgxpool.Pool
+	pageScanner  *helper.GenericScanner[domain.SitemapPage]
+	entryScanner *helper.GenericScanner[domain.SitemapEntry]
+}
+
+func NewSitemapRepository(dbPool *pgxpool.Pool) ISitemapRepository {
+	return &SitemapRepository{
+		dbPool:       dbPool,
+		pageScanner:  helper.NewGenericScanner(dbPool, helper.ScanSitemapPage),
+		entryScanner: helper.NewGenericScanner(dbPool, helper.ScanSitemapEntry),
+	}
+}
+
+// GetPageStates computes the current state of every non-empty page of the entity type. Page n holds the
+// entities with ids from (n-1)*pageSize+1 to n*pageSize; its fingerprint changes whenever an entry is
+// added, removed, renamed or modified.
+func (sitemapRepository *SitemapRepository) GetPageStates(entityType string, pageSize int) ([]domain.SitemapPage, error) {
+	ctx := context.Background()
+	source, ok := sitemapSources[entityType]
+	if !ok {
+		return nil, fmt.Errorf("unknown sitemap entity type %q", entityType)
+	}
+
+	query := fmt.Sprintf(`SELECT $2::varchar, (e.id - 1) / $1 + 1 AS page,
+			md5(string_agg(e.id || ':' || e.slug || ':' || COALESCE(e.last_modified::text, '') || ':' ||
+				array_to_string(e.locales, ',') || ':' || array_to_string(e.slugs, ','), ',' ORDER BY e.id)),
+			count(*)::int, max(e.last_modified), CURRENT_TIMESTAMP
+		FROM (%s) e GROUP BY 2 ORDER BY 2`, source.rows)
+	states, err := sitemapRepository.pageScanner.QueryAndScan(ctx, query, pageSize, entityType)
+	if err != nil {
+		return []domain.SitemapPage{}, err
+	}
+	return states, nil
+}
+
+func (sitemapRepository *SitemapRepository) GetEntries(entityType string, page int, pageSize int) ([]domain.SitemapEntry, error) {
+	ctx := context.Background()
+	source, ok := sitemapSources[entityType]
+	if !ok {
+		return nil, fmt.Errorf("unknown sitemap entity type %q", entityType)
+	}
+
+	query := fmt.Sprintf(`SELECT * FROM (%s) e WHERE e.id BETWEEN ($1 - 1) * $2 + 1 AND $1 * $2 ORDER BY e.id`, source.rows)
+	entries, err := sitemapRepository.entryScanner.QueryAndScan(ctx, query, page, pageSize)
+	if err != nil {
+		return []domain.SitemapEntry{}, err
+	}
+	return entries, nil
+}
+
+// GetPages returns the generated pages without their content, ordered for the sitemap index.
+func (sitemapRepository *SitemapRepository) GetPages() ([]domain.SitemapPage, error) {
+	ctx := context.Background()
+	pages, err := sitemapRepository.pageScanner.QueryAndScan(ctx, `SELECT entity_type, page, fingerprint, entry_count,
+		last_modified, generated_at FROM sitemap_pages ORDER BY entity_type, page`)
+	if err != nil {
+		return []domain.SitemapPage{}, err
+	}
+	return pages, nil
+}
+
+func (sitemapRepository *SitemapRepository) GetPageContent(entityType string, page int) (string, error) {
+	ctx := context.Background()
+	var content string
+	err := sitemapRepository.dbPool.QueryRow(ctx, "SELECT content FROM sitemap_pages WHERE entity_type = $1 AND page = $2",
+		entityType, page).Scan(&content)
+	if err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return "", common.ErrSitemapNotFound
+		}
+		return "", common.WrapError("query sitemap page", err)
+	}
+	return content, nil
+}
+
+func (sitemapRepository *SitemapRepository) SavePage(page domain.SitemapPage, content string) error {
+	ctx := context.Background()
+	query := `INSERT INTO sitemap_pages (entity_type, page, fingerprint, entry_count, last_modified, content)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (entity_type, page) DO UPDATE SET fingerprint = EXCLUDED.fingerprint, entry_count = EXCLUDED.entry_count,
+			last_modified = EXCLUDED.last_modified, content = EXCLUDED.content, generated_at = CURRENT_TIMESTAMP`
+	_, err := sitemapRepository.dbPool.Exec(ctx, query, page.EntityType, page.Page, page.Fingerprint, page.EntryCount,
+		page.LastModified, content)
+	return common.WrapError("save sitemap page", err)
+}
+
+// DeletePagesExcept removes the pages of the entity type that are no longer in use, e.g. after every
+// entity of a page was deleted.
+func (sitemapRepository *SitemapRepository) DeletePagesExcept(entityType string, pages []int) (int64, error) {
+	ctx := context.Background()
+	tag, err := sitemapRepository.dbPool.Exec(ctx,
+		"DELETE FROM sitemap_pages WHERE entity_type = $1 AND NOT (page = ANY($2))", entityType, pages)
+	if err != nil {
+		return 0, common.WrapError("delete sitemap pages", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,82 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type ISlugHistoryRepository interface {
+	GetBySlug(entityType string, slug string) (domain.SlugHistory, error)
+	RecordSlug(entityType string, entityId int64, slug string) error
+	ReleaseSlug(entityType string, entityId int64, slug string) error
+	IsSlugTaken(entityType string, slug string, exceptEntityId int64) (bool, error)
+}
+
+type SlugHistoryRepository struct {
+	dbPool  *pgxpool.Pool
+	scanner *helper.GenericScanner[domain.SlugHistory]
+}
+
+func NewSlugHistoryRepository(dbPool *pgxpool.Pool) ISlugHistoryRepository {
+	return &SlugHistoryRepository{
+		dbPool:  dbPool,
+		scanner: helper.NewGenericScanner(dbPool, helper.ScanSlugHistory),
+	}
+}
+
+var slugEntityTables = map[string]string{
+	domain.SlugEntityProduct: "products",
+	domain.SlugEntityStore:   "stores",
+}
+
+// slugTranslationTables holds the locale-specific slugs of an entity type, keyed by the column of the entity id.
+var slugTranslationTables = map[string][2]string{
+	domain.SlugEntityProduct: {"product_translations", "product_id"},
+}
+
+func (slugRepository *SlugHistoryRepository) GetBySlug(entityType string, slug string) (domain.SlugHistory, error) {
+	ctx := context.Background()
+	query := `SELECT * FROM slug_history WHERE entity_type = $1 AND slug = $2`
+	return slugRepository.scanner.QueryRowAndScan(ctx, query, entityType, slug)
+}
+
+// RecordSlug stores a slug the entity no longer uses so requests for it can be redirected.
+func (slugRepository *SlugHistoryRepository) RecordSlug(entityType string, entityId int64, slug string) error {
+	ctx := context.Background()
+	query := `INSERT INTO slug_history (entity_type, entity_id, slug) VALUES ($1, $2, $3)
+		ON CONFLICT (entity_type, slug) DO UPDATE SET entity_id = EXCLUDED.entity_id, created_at = CURRENT_TIMESTAMP`
+	return slugRepository.scanner.ExecuteExec(ctx, query, entityType, entityId, slug)
+}
+
+// ReleaseSlug removes a historical slug of the entity, used when the entity takes the slug back.
+func (slugRepository *SlugHistoryRepository) ReleaseSlug(entityType string, entityId int64, slug string) error {
+	ctx := context.Background()
+	query := `DELETE FROM slug_history WHERE entity_type = $1 AND entity_id = $2 AND slug = $3`
+	return slugRepository.scanner.ExecuteExec(ctx, query, entityType, entityId, slug)
+}
+
+// IsSlugTaken reports whether another entity of the same type uses the slug now, in any locale, or used it before.
+func (slugRepository *SlugHistoryRepository) IsSlugTaken(entityType string, slug string, exceptEntityId int64) (bool, error) {
+	ctx := context.Background()
+	table, ok := slugEntityTables[entityType]
+	if !ok {
+		return false, fmt.Errorf("unknown slug entity type %q", entityType)
+	}
+
+	query := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE slug = $1 AND id <> $2)
+		OR EXISTS (SELECT 1 FROM slug_history WHERE entity_type = $3 AND slug = $1 AND entity_id <> $2)`, table)
+	if translations, ok := slugTranslationTables[entityType]; ok {
+		query += fmt.Sprintf(`
+		OR EXISTS (SELECT 1 FROM %s WHERE slug = $1 AND %s <> $2)`, translations[0], translations[1])
+	}
+	var taken bool
+	if err := slugRepository.dbPool.QueryRow(ctx, query, slug, exceptEntityId, entityType).Scan(&taken); err != nil {
+		return false, common.WrapError("check slug", err)
+	}
+	return taken, nil
+}
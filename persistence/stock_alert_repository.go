@@ -0,0 +1,145 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/persistence/helper"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// lowStockQuery selects the thresholds whose product or variant stock is at or below the threshold.
+const lowStockQuery = `SELECT t.id, t.product_id, t.variant_id, p.name, COALESCE(v.sku, p.sku), p.store_id,
+		COALESCE(v.stock_quantity, p.stock_quantity), t.threshold
+	FROM stock_thresholds t
+	JOIN products p ON p.id = t.product_id AND p.deleted_at IS NULL
+	LEFT JOIN product_variants v ON v.id = t.variant_id
+	WHERE COALESCE(v.stock_quantity, p.stock_quantity) <= t.threshold`
+
+type IStockAlertRepository interface {
+	GetThresholds(productId int64) ([]domain.StockThreshold, error)
+	SetThreshold(threshold domain.StockThreshold) (domain.StockThreshold, error)
+	DeleteThreshold(productId int64, variantId *int64) error
+	GetLowStockItems(storeId *uint) ([]domain.LowStockItem, error)
+	GetUnalertedLowStockItems() ([]domain.LowStockItem, error)
+	MarkAlerted(thresholdId int64) error
+	ResetRecoveredAlerts() (int64, error)
+	GetStockVelocities(since time.Time, storeId *uint) ([]domain.StockVelocity, error)
+}
+
+type StockAlertRepository struct {
+	dbPool          *pgxpool.Pool
+	scanner         *helper.GenericScanner[domain.StockThreshold]
+	lowStockScanner *helper.GenericScanner[domain.LowStockItem]
+	velocityScanner *helper.GenericScanner[domain.StockVelocity]
+}
+
+func NewStockAlertRepository(dbPool *pgxpool.Pool) IStockAlertRepository {
+	return &StockAlertRepository{
+		dbPool:          dbPool,
+		scanner:         helper.NewGenericScanner(dbPool, helper.ScanStockThreshold),
+		lowStockScanner: helper.NewGenericScanner(dbPool, helper.ScanLowStockItem),
+		velocityScanner: helper.NewGenericScanner(dbPool, helper.ScanStockVelocity),
+	}
+}
+
+func (alertRepository *StockAlertRepository) GetThresholds(productId int64) ([]domain.StockThreshold, error) {
+	ctx := context.Background()
+	query := "SELECT * FROM stock_thresholds WHERE product_id = $1 ORDER BY variant_id NULLS FIRST"
+	thresholds, err := alertRepository.scanner.QueryAndScan(ctx, query, productId)
+	if err != nil {
+		return []domain.StockThreshold{}, err
+	}
+	return thresholds, nil
+}
+
+// SetThreshold creates or replaces the threshold of a product or variant. A changed threshold may alert again.
+func (alertRepository *StockAlertRepository) SetThreshold(threshold domain.StockThreshold) (domain.StockThreshold, error) {
+	ctx := context.Background()
+	query := `INSERT INTO stock_thresholds (product_id, variant_id, threshold, lead_time_days) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (product_id, (COALESCE(variant_id, 0))) DO UPDATE SET threshold = EXCLUDED.threshold,
+		lead_time_days = EXCLUDED.lead_time_days, alerted_at = NULL, updated_at = CURRENT_TIMESTAMP
+		RETURNING *`
+	return alertRepository.scanner.QueryRowAndScan(ctx, query,
+		threshold.ProductId, threshold.VariantId, threshold.Threshold, threshold.LeadTimeDays)
+}
+
+func (alertRepository *StockAlertRepository) DeleteThreshold(productId int64, variantId *int64) error {
+	ctx := context.Background()
+	query := "DELETE FROM stock_thresholds WHERE product_id = $1 AND variant_id IS NOT DISTINCT FROM $2 RETURNING *"
+	_, err := alertRepository.scanner.QueryRowAndScan(ctx, query, productId, variantId)
+	return err
+}
+
+func (alertRepository *StockAlertRepository) GetLowStockItems(storeId *uint) ([]domain.LowStockItem, error) {
+	ctx := context.Background()
+	query := lowStockQuery + " AND ($1::BIGINT IS NULL OR p.store_id = $1) ORDER BY COALESCE(v.stock_quantity, p.stock_quantity), t.id"
+	items, err := alertRepository.lowStockScanner.QueryAndScan(ctx, query, storeId)
+	if err != nil {
+		return []domain.LowStockItem{}, err
+	}
+	return items, nil
+}
+
+// GetUnalertedLowStockItems returns the items that crossed their threshold since the last alert.
+func (alertRepository *StockAlertRepository) GetUnalertedLowStockItems() ([]domain.LowStockItem, error) {
+	ctx := context.Background()
+	items, err := alertRepository.lowStockScanner.QueryAndScan(ctx, lowStockQuery+" AND t.alerted_at IS NULL ORDER BY t.id")
+	if err != nil {
+		return []domain.LowStockItem{}, err
+	}
+	return items, nil
+}
+
+func (alertRepository *StockAlertRepository) MarkAlerted(thresholdId int64) error {
+	ctx := context.Background()
+	query := "UPDATE stock_thresholds SET alerted_at = CURRENT_TIMESTAMP WHERE id = $1"
+	return alertRepository.scanner.ExecuteExec(ctx, query, thresholdId)
+}
+
+// ResetRecoveredAlerts re-arms the thresholds whose stock went back above the threshold.
+func (alertRepository *StockAlertRepository) ResetRecoveredAlerts() (int64, error) {
+	ctx := context.Background()
+	query := `UPDATE stock_thresholds t SET alerted_at = NULL
+		WHERE t.alerted_at IS NOT NULL AND t.threshold < COALESCE(
+			(SELECT v.stock_quantity FROM product_variants v WHERE v.id = t.variant_id),
+			(SELECT p.stock_quantity FROM products p WHERE p.id = t.product_id))`
+	result, err := alertRepository.dbPool.Exec(ctx, query)
+	if err != nil {
+		return 0, common.WrapError("reset recovered alerts", err)
+	}
+	return result.RowsAffected(), nil
+}
+
+// GetStockVelocities returns the active products and variants that have a threshold or were sold since the
+// given time, with the units sold in non-cancelled orders.
+func (alertRepository *StockAlertRepository) GetStockVelocities(since time.Time, storeId *uint) ([]domain.StockVelocity, error) {
+	ctx := context.Background()
+	query := `WITH items AS (
+			SELECT p.id AS product_id, NULL::BIGINT AS variant_id, p.name, p.sku, p.store_id, p.stock_quantity
+			FROM products p WHERE p.deleted_at IS NULL AND p.is_active
+			UNION ALL
+			SELECT p.id, v.id, p.name, v.sku, p.store_id, v.stock_quantity
+			FROM product_variants v JOIN products p ON p.id = v.product_id
+			WHERE p.deleted_at IS NULL AND p.is_active AND v.is_active
+		), sales AS (
+			SELECT oi.product_id, oi.variant_id, SUM(oi.quantity) AS units
+			FROM order_items oi JOIN orders o ON o.id = oi.order_id
+			WHERE o.created_at >= $1 AND LOWER(o.status) <> 'cancelled'
+			GROUP BY oi.product_id, oi.variant_id
+		)
+		SELECT i.product_id, i.variant_id, i.name, i.sku, i.store_id, i.stock_quantity, t.threshold, t.lead_time_days,
+			COALESCE(s.units, 0)
+		FROM items i
+		LEFT JOIN sales s ON s.product_id = i.product_id AND s.variant_id IS NOT DISTINCT FROM i.variant_id
+		LEFT JOIN stock_thresholds t ON t.product_id = i.product_id AND t.variant_id IS NOT DISTINCT FROM i.variant_id
+		WHERE (s.units IS NOT NULL OR t.id IS NOT NULL) AND ($2::BIGINT IS NULL OR i.store_id = $2)
+		ORDER BY i.product_id, i.variant_id NULLS FIRST`
+	velocities, err := alertRepository.velocityScanner.QueryAndScan(ctx, query, since, storeId)
+	if err != nil {
+		return []domain.StockVelocity{}, err
+	}
+	return velocities, nil
+}
@@ -0,0 +1,100 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/helper"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type IStockSubscriptionRepository interface {
+	Subscribe(subscription domain.StockSubscription) (domain.StockSubscription, error)
+	GetSubscriptionById(id int64) (domain.StockSubscription, error)
+	GetUserSubscriptions(userId int64) ([]domain.StockSubscription, error)
+	Unsubscribe(id int64) (domain.StockSubscription, error)
+	GetPendingNotifications(notifiedSince time.Time, limit int) ([]domain.RestockNotification, error)
+	MarkNotified(id int64) error
+}
+
+type StockSubscriptionRepository struct {
+	dbPool              *pgxpool.Pool
+	scanner             *helper.GenericScanner[domain.StockSubscription]
+	notificationScanner *helper.GenericScanner[domain.RestockNotification]
+}
+
+func NewStockSubscriptionRepository(dbPool *pgxpool.Pool) IStockSubscriptionRepository {
+	return &StockSubscriptionRepository{
+		dbPool:              dbPool,
+		scanner:             helper.NewGenericScanner(dbPool, helper.ScanStockSubscription),
+		notificationScanner: helper.NewGenericScanner(dbPool, helper.ScanRestockNotification),
+	}
+}
+
+// Subscribe keeps a single subscription per product, variant and email. Subscribing again re-activates a
+// notified or unsubscribed subscription and links it to the user when one is given.
+func (subscriptionRepository *StockSubscriptionRepository) Subscribe(subscription domain.StockSubscription) (domain.StockSubscription, error) {
+	ctx := context.Background()
+	query := `INSERT INTO stock_subscriptions (product_id, variant_id, user_id, email) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (product_id, (COALESCE(variant_id, 0)), (LOWER(email))) DO UPDATE SET status = 'active',
+		user_id = COALESCE(EXCLUDED.user_id, stock_subscriptions.user_id), notified_at = NULL, updated_at = CURRENT_TIMESTAMP
+		RETURNING *`
+	return subscriptionRepository.scanner.QueryRowAndScan(ctx, query,
+		subscription.ProductId, subscription.VariantId, subscription.UserId, subscription.Email)
+}
+
+func (subscriptionRepository *StockSubscriptionRepository) GetSubscriptionById(id int64) (domain.StockSubscription, error) {
+	ctx := context.Background()
+	return subscriptionRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM stock_subscriptions WHERE id = $1", id)
+}
+
+func (subscriptionRepository *StockSubscriptionRepository) GetUserSubscriptions(userId int64) ([]domain.StockSubscription, error) {
+	ctx := context.Background()
+	query := "SELECT * FROM stock_subscriptions WHERE user_id = $1 AND status = 'active' ORDER BY created_at DESC"
+	subscriptions, err := subscriptionRepository.scanner.QueryAndScan(ctx, query, userId)
+	if err != nil {
+		return []domain.StockSubscription{}, err
+	}
+	return subscriptions, nil
+}
+
+func (subscriptionRepository *StockSubscriptionRepository) Unsubscribe(id int64) (domain.StockSubscription, error) {
+	ctx := context.Background()
+	query := `UPDATE stock_subscriptions SET status = 'unsubscribed', updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 RETURNING *`
+	return subscriptionRepository.scanner.QueryRowAndScan(ctx, query, id)
+}
+
+// GetPendingNotifications returns active subscriptions whose product or variant is back in stock. Each email
+// gets at most one notification per call and none while it was notified after notifiedSince.
+func (subscriptionRepository *StockSubscriptionRepository) GetPendingNotifications(notifiedSince time.Time, limit int) ([]domain.RestockNotification, error) {
+	ctx := context.Background()
+	query := `WITH pending AS (
+			SELECT DISTINCT ON (LOWER(s.email)) s.id, s.product_id, s.variant_id, s.user_id, s.email, p.name, p.slug,
+				COALESCE(v.sku, p.sku) AS sku, COALESCE(v.stock_quantity, p.stock_quantity) AS stock_quantity
+			FROM stock_subscriptions s
+			JOIN products p ON p.id = s.product_id AND p.deleted_at IS NULL AND p.is_active
+			LEFT JOIN product_variants v ON v.id = s.variant_id
+			WHERE s.status = 'active' AND (s.variant_id IS NULL OR v.is_active)
+				AND COALESCE(v.stock_quantity, p.stock_quantity) > 0
+				AND NOT EXISTS (
+					SELECT 1 FROM stock_subscriptions n
+					WHERE LOWER(n.email) = LOWER(s.email) AND n.notified_at > $1
+				)
+			ORDER BY LOWER(s.email), s.created_at
+		)
+		SELECT * FROM pending ORDER BY id LIMIT $2`
+	notifications, err := subscriptionRepository.notificationScanner.QueryAndScan(ctx, query, notifiedSince, limit)
+	if err != nil {
+		return []domain.RestockNotification{}, err
+	}
+	return notifications, nil
+}
+
+func (subscriptionRepository *StockSubscriptionRepository) MarkNotified(id int64) error {
+	ctx := context.Background()
+	query := `UPDATE stock_subscriptions SET status = 'notified', notified_at = CURRENT_TIMESTAMP,
+		updated_at = CURRENT_TIMESTAMP WHERE id = $1`
+	return subscriptionRepository.scanner.ExecuteExec(ctx, query, id)
+}
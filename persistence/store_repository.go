@@ -3,18 +3,27 @@ package persistence
 import (
 	"context"
 	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
 	"go-ecommerce-service/persistence/helper"
 	"time"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type IStoreRepository interface {
 	GetAllStores() []domain.Store
 	GetStoreById(storeId uint) (domain.Store, error)
+	GetStoreBySlug(slug string) (domain.Store, error)
 	AddStore(store domain.Store) (domain.Store, error)
-	DeleteStoreById(storeId uint) error
+	DeleteStoreById(storeId uint) ([]int64, error)
 	UpdateStoreById(id uint, store domain.Store) (domain.Store, error)
+	GetDeletedStores() ([]domain.Store, error)
+	RestoreStoreById(storeId uint) (domain.Store, []int64, error)
+	PurgeDeletedStores(before time.Time) (int64, error)
+	AddStoreOwner(storeId uint, userId int64) error
+	RemoveStoreOwner(storeId uint, userId int64) error
+	IsStoreOwner(storeId uint, userId int64) (bool, error)
 }
 
 type StoreRepository struct {
@@ -32,7 +41,7 @@ func NewStoreRepository(dbPool *pgxpool.Pool) IStoreRepository {
 func (storeRepository *StoreRepository) GetAllStores() []domain.Store {
 	ctx := context.Background()
 
-	stores, err := storeRepository.scanner.QueryAndScan(ctx, "SELECT * FROM stores")
+	stores, err := storeRepository.scanner.QueryAndScan(ctx, "SELECT * FROM stores WHERE deleted_at IS NULL")
 
 	if err != nil {
 		return []domain.Store{}
@@ -41,13 +50,18 @@ func (storeRepository *StoreRepository) GetAllStores() []domain.Store {
 }
 func (storeRepository *StoreRepository) GetStoreById(storeId uint) (domain.Store, error) {
 	ctx := context.Background()
-	query := `Select * from stores where id = $1`
+	query := `Select * from stores where id = $1 and deleted_at is null`
 	store, err := storeRepository.scanner.QueryRowAndScan(ctx, query, storeId)
 	if err != nil {
 		return domain.Store{}, err
 	}
 	return store, nil
 }
+func (storeRepository *StoreRepository) GetStoreBySlug(slug string) (domain.Store, error) {
+	ctx := context.Background()
+	query := `Select * from stores where slug = $1 and deleted_at is null`
+	return storeRepository.scanner.QueryRowAndScan(ctx, query, slug)
+}
 func (storeRepository *StoreRepository) AddStore(store domain.Store) (domain.Store, error) {
 	ctx := context.Background()
 	query := `INSERT INTO stores (name,slug,description,logo_url,contact_email,contact_phone,contact_address,is_active,created_at,updated_at) values ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING *`
@@ -61,18 +75,128 @@ func (storeRepository *StoreRepository) AddStore(store domain.Store) (domain.Sto
 	}
 	return store, nil
 }
-func (storeRepository *StoreRepository) DeleteStoreById(storeId uint) error {
+
+// DeleteStoreById moves the store and its live products to the trash with the same timestamp, so a
+// restore brings back exactly the products that went away with the store. The ids of those products
+// are returned.
+func (storeRepository *StoreRepository) DeleteStoreById(storeId uint) ([]int64, error) {
 	ctx := context.Background()
-	query := `DELETE from stores where id = $1`
-	err := storeRepository.scanner.ExecuteExec(ctx, query, storeId)
+	tx, err := storeRepository.dbPool.Begin(ctx)
 	if err != nil {
-		return err
+		return nil, common.WrapError("begin store delete", err)
 	}
-	return nil
+	defer tx.Rollback(ctx)
+
+	store, err := helper.ScanStore(tx.QueryRow(ctx,
+		`UPDATE stores SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL RETURNING *`, storeId))
+	if err != nil {
+		return nil, err
+	}
+	productIds, err := setProductsDeletedAt(ctx, tx,
+		`UPDATE products SET deleted_at = $1 WHERE store_id = $2 AND deleted_at IS NULL RETURNING id`, store.DeletedAt, storeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, common.WrapError("commit store delete", err)
+	}
+	return productIds, nil
+}
+func (storeRepository *StoreRepository) GetDeletedStores() ([]domain.Store, error) {
+	ctx := context.Background()
+	stores, err := storeRepository.scanner.QueryAndScan(ctx, "SELECT * FROM stores WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC")
+	if err != nil {
+		return []domain.Store{}, err
+	}
+	return stores, nil
+}
+func (storeRepository *StoreRepository) RestoreStoreById(storeId uint) (domain.Store, []int64, error) {
+	ctx := context.Background()
+	tx, err := storeRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.Store{}, nil, common.WrapError("begin store restore", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var deletedAt time.Time
+	if err := tx.QueryRow(ctx, `SELECT deleted_at FROM stores WHERE id = $1 AND deleted_at IS NOT NULL`, storeId).Scan(&deletedAt); err != nil {
+		if err.Error() == common.NOT_FOUND {
+			return domain.Store{}, nil, common.ErrStoreNotFound
+		}
+		return domain.Store{}, nil, common.WrapError("select deleted store", err)
+	}
+	store, err := helper.ScanStore(tx.QueryRow(ctx,
+		`UPDATE stores SET deleted_at = NULL, updated_at = CURRENT_TIMESTAMP WHERE id = $1 RETURNING *`, storeId))
+	if err != nil {
+		return domain.Store{}, nil, err
+	}
+	productIds, err := setProductsDeletedAt(ctx, tx,
+		`UPDATE products SET deleted_at = NULL WHERE store_id = $2 AND deleted_at = $1 RETURNING id`, deletedAt, storeId)
+	if err != nil {
+		return domain.Store{}, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Store{}, nil, common.WrapError("commit store restore", err)
+	}
+	return store, productIds, nil
+}
+
+// PurgeDeletedStores removes stores trashed before the given time once none of their products are left.
+func (storeRepository *StoreRepository) PurgeDeletedStores(before time.Time) (int64, error) {
+	ctx := context.Background()
+	tx, err := storeRepository.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, common.WrapError("begin store purge", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var storeIds []int64
+	query := `SELECT COALESCE(array_agg(s.id), '{}') FROM stores s
+		WHERE s.deleted_at < $1 AND NOT EXISTS (SELECT 1 FROM products p WHERE p.store_id = s.id)`
+	if err := tx.QueryRow(ctx, query, before).Scan(&storeIds); err != nil {
+		return 0, common.WrapError("select purgeable stores", err)
+	}
+	if len(storeIds) == 0 {
+		return 0, nil
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM slug_history WHERE entity_type = $1 AND entity_id = ANY($2)",
+		domain.SlugEntityStore, storeIds); err != nil {
+		return 0, common.WrapError("purge store slugs", err)
+	}
+	tag, err := tx.Exec(ctx, "DELETE FROM stores WHERE id = ANY($1)", storeIds)
+	if err != nil {
+		return 0, common.WrapError("purge stores", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, common.WrapError("commit store purge", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func setProductsDeletedAt(ctx context.Context, tx pgx.Tx, query string, deletedAt interface{}, storeId uint) ([]int64, error) {
+	rows, err := tx.Query(ctx, query, deletedAt, storeId)
+	if err != nil {
+		return nil, common.WrapError("update store products", err)
+	}
+	defer rows.Close()
+
+	productIds := make([]int64, 0)
+	for rows.Next() {
+		var productId int64
+		if err := rows.Scan(&productId); err != nil {
+			return nil, common.WrapError("scan store product id", err)
+		}
+		productIds = append(productIds, productId)
+	}
+	return productIds, rows.Err()
 }
 func (storeRepository *StoreRepository) UpdateStoreById(id uint, store domain.Store) (domain.Store, error) {
 	ctx := context.Background()
-	query := `UPDATE stores set name=$1,slug=$2, description=$3,logo_url=$4,contact_email=$5,contact_phone=$6,contact_address=$7,is_active=$8,created_at=$9 , updated_at =$10 WHERE id = $11`
+	query := `UPDATE stores set name=$1,slug=$2, description=$3,logo_url=$4,contact_email=$5,contact_phone=$6,contact_address=$7,is_active=$8,created_at=$9 , updated_at =$10 WHERE id = $11 AND deleted_at IS NULL RETURNING *`
 	store, err := storeRepository.scanner.QueryRowAndScan(ctx, query,
 		store.Name, store.Slug, store.Description,
 		store.LogoUrl, store.ContactEmail, store.ContactPhone,
@@ -82,3 +206,35 @@ func (storeRepository *StoreRepository) UpdateStoreById(id uint, store domain.St
 	}
 	return store, nil
 }
+
+// AddStoreOwner makes the user an owner of the store; adding an owner twice is a no-op.
+func (storeRepository *StoreRepository) AddStoreOwner(storeId uint, userId int64) error {
+	ctx := context.Background()
+	query := `INSERT INTO store_owners (store_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	if _, err := storeRepository.dbPool.Exec(ctx, query, storeId, userId); err != nil {
+		return common.WrapError("add store owner", err)
+	}
+	return nil
+}
+
+func (storeRepository *StoreRepository) RemoveStoreOwner(storeId uint, userId int64) error {
+	ctx := context.Background()
+	tag, err := storeRepository.dbPool.Exec(ctx, `DELETE FROM store_owners WHERE store_id = $1 AND user_id = $2`, storeId, userId)
+	if err != nil {
+		return common.WrapError("remove store owner", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return common.ErrStoreOwnerNotFound
+	}
+	return nil
+}
+
+func (storeRepository *StoreRepository) IsStoreOwner(storeId uint, userId int64) (bool, error) {
+	ctx := context.Background()
+	var owner bool
+	query := `SELECT EXISTS (SELECT 1 FROM store_owners WHERE store_id = $1 AND user_id = $2)`
+	if err := storeRepository.dbPool.QueryRow(ctx, query, storeId, userId).Scan(&owner); err != nil {
+		return false, common.WrapError("check store owner", err)
+	}
+	return owner, nil
+}
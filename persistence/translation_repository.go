@@ -0,0 +1,183 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type ITranslationRepository interface {
+	GetProductTranslations(productId int64) ([]domain.ProductTranslation, error)
+	GetProductTranslationsByLocale(locale string, productIds []int64) (map[int64]domain.ProductTranslation, error)
+	GetProductTranslationBySlug(slug string) (domain.ProductTranslation, error)
+	SaveProductTranslation(translation domain.ProductTranslation) (domain.ProductTranslation, error)
+	DeleteProductTranslation(productId int64, locale string) (domain.ProductTranslation, error)
+	GetCategoryTranslations(categoryId int64) ([]domain.CategoryTranslation, error)
+	GetCategoryTranslationsByLocale(locale string, categoryIds []int64) (map[int64]domain.CategoryTranslation, error)
+	SaveCategoryTranslation(translation domain.CategoryTranslation) (domain.CategoryTranslation, error)
+	DeleteCategoryTranslation(categoryId int64, locale string) error
+	GetStoreTranslations(storeId int64) ([]domain.StoreTranslation, error)
+	GetStoreTranslationsByLocale(locale string, storeIds []int64) (map[int64]domain.StoreTranslation, error)
+	SaveStoreTranslation(translation domain.StoreTranslation) (domain.StoreTranslation, error)
+	DeleteStoreTranslation(storeId int64, locale string) error
+}
+
+type TranslationRepository struct {
+	dbPool          *pgxpool.Pool
+	productScanner  *helper.GenericScanner[domain.ProductTranslation]
+	categoryScanner *helper.GenericScanner[domain.CategoryTranslation]
+	storeScanner    *helper.GenericScanner[domain.StoreTranslation]
+}
+
+func NewTranslationRepository(dbPool *pgxpool.Pool) ITranslationRepository {
+	return &TranslationRepository{
+		dbPool:          dbPool,
+		productScanner:  helper.NewGenericScanner(dbPool, helper.ScanProductTranslation),
+		categoryScanner: helper.NewGenericScanner(dbPool, helper.ScanCategoryTranslation),
+		storeScanner:    helper.NewGenericScanner(dbPool, helper.ScanStoreTranslation),
+	}
+}
+
+// productTranslations loads every translation of a product, used to build its per-locale search documents.
+func productTranslations(ctx context.Context, scanner *helper.GenericScanner[domain.ProductTranslation], productId int64) ([]domain.ProductTranslation, error) {
+	return scanner.QueryAndScan(ctx, "SELECT * FROM product_translations WHERE product_id = $1 ORDER BY locale", productId)
+}
+
+func (translationRepository *TranslationRepository) GetProductTranslations(productId int64) ([]domain.ProductTranslation, error) {
+	ctx := context.Background()
+	translations, err := productTranslations(ctx, translationRepository.productScanner, productId)
+	if err != nil {
+		return []domain.ProductTranslation{}, err
+	}
+	return translations, nil
+}
+
+func (translationRepository *TranslationRepository) GetProductTranslationsByLocale(locale string, productIds []int64) (map[int64]domain.ProductTranslation, error) {
+	ctx := context.Background()
+	translationsByProduct := make(map[int64]domain.ProductTranslation)
+	if len(productIds) == 0 {
+		return translationsByProduct, nil
+	}
+	translations, err := translationRepository.productScanner.QueryAndScan(ctx,
+		"SELECT * FROM product_translations WHERE locale = $1 AND product_id = ANY($2)", locale, productIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, translation := range translations {
+		translationsByProduct[translation.ProductId] = translation
+	}
+	return translationsByProduct, nil
+}
+
+func (translationRepository *TranslationRepository) GetProductTranslationBySlug(slug string) (domain.ProductTranslation, error) {
+	ctx := context.Background()
+	return translationRepository.productScanner.QueryRowAndScan(ctx,
+		"SELECT * FROM product_translations WHERE slug = $1 ORDER BY locale LIMIT 1", slug)
+}
+
+func (translationRepository *TranslationRepository) SaveProductTranslation(translation domain.ProductTranslation) (domain.ProductTranslation, error) {
+	ctx := context.Background()
+	query := `INSERT INTO product_translations (product_id, locale, name, slug, description, meta_description)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (product_id, locale) DO UPDATE SET name = EXCLUDED.name, slug = EXCLUDED.slug,
+			description = EXCLUDED.description, meta_description = EXCLUDED.meta_description, updated_at = CURRENT_TIMESTAMP
+		RETURNING *`
+	return translationRepository.productScanner.QueryRowAndScan(ctx, query, translation.ProductId, translation.Locale,
+		translation.Name, translation.Slug, translation.Description, translation.MetaDescription)
+}
+
+// DeleteProductTranslation removes the translation and returns it, so its slug can be kept for redirects.
+func (translationRepository *TranslationRepository) DeleteProductTranslation(productId int64, locale string) (domain.ProductTranslation, error) {
+	ctx := context.Background()
+	return translationRepository.productScanner.QueryRowAndScan(ctx,
+		"DELETE FROM product_translations WHERE product_id = $1 AND locale = $2 RETURNING *", productId, locale)
+}
+
+func (translationRepository *TranslationRepository) GetCategoryTranslations(categoryId int64) ([]domain.CategoryTranslation, error) {
+	ctx := context.Background()
+	translations, err := translationRepository.categoryScanner.QueryAndScan(ctx,
+		"SELECT * FROM category_translations WHERE category_id = $1 ORDER BY locale", categoryId)
+	if err != nil {
+		return []domain.CategoryTranslation{}, err
+	}
+	return translations, nil
+}
+
+func (translationRepository *TranslationRepository) GetCategoryTranslationsByLocale(locale string, categoryIds []int64) (map[int64]domain.CategoryTranslation, error) {
+	ctx := context.Background()
+	translationsByCategory := make(map[int64]domain.CategoryTranslation)
+	if len(categoryIds) == 0 {
+		return translationsByCategory, nil
+	}
+	translations, err := translationRepository.categoryScanner.QueryAndScan(ctx,
+		"SELECT * FROM category_translations WHERE locale = $1 AND category_id = ANY($2)", locale, categoryIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, translation := range translations {
+		translationsByCategory[translation.CategoryId] = translation
+	}
+	return translationsByCategory, nil
+}
+
+func (translationRepository *TranslationRepository) SaveCategoryTranslation(translation domain.CategoryTranslation) (domain.CategoryTranslation, error) {
+	ctx := context.Background()
+	query := `INSERT INTO category_translations (category_id, locale, name, description) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (category_id, locale) DO UPDATE SET name = EXCLUDED.name, description = EXCLUDED.description,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING *`
+	return translationRepository.categoryScanner.QueryRowAndScan(ctx, query, translation.CategoryId, translation.Locale,
+		translation.Name, translation.Description)
+}
+
+func (translationRepository *TranslationRepository) DeleteCategoryTranslation(categoryId int64, locale string) error {
+	ctx := context.Background()
+	_, err := translationRepository.categoryScanner.QueryRowAndScan(ctx,
+		"DELETE FROM category_translations WHERE category_id = $1 AND locale = $2 RETURNING *", categoryId, locale)
+	return err
+}
+
+func (translationRepository *TranslationRepository) GetStoreTranslations(storeId int64) ([]domain.StoreTranslation, error) {
+	ctx := context.Background()
+	translations, err := translationRepository.storeScanner.QueryAndScan(ctx,
+		"SELECT * FROM store_translations WHERE store_id = $1 ORDER BY locale", storeId)
+	if err != nil {
+		return []domain.StoreTranslation{}, err
+	}
+	return translations, nil
+}
+
+func (translationRepository *TranslationRepository) GetStoreTranslationsByLocale(locale string, storeIds []int64) (map[int64]domain.StoreTranslation, error) {
+	ctx := context.Background()
+	translationsByStore := make(map[int64]domain.StoreTranslation)
+	if len(storeIds) == 0 {
+		return translationsByStore, nil
+	}
+	translations, err := translationRepository.storeScanner.QueryAndScan(ctx,
+		"SELECT * FROM store_translations WHERE locale = $1 AND store_id = ANY($2)", locale, storeIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, translation := range translations {
+		translationsByStore[translation.StoreId] = translation
+	}
+	return translationsByStore, nil
+}
+
+func (translationRepository *TranslationRepository) SaveStoreTranslation(translation domain.StoreTranslation) (domain.StoreTranslation, error) {
+	ctx := context.Background()
+	query := `INSERT INTO store_translations (store_id, locale, description) VALUES ($1, $2, $3)
+		ON CONFLICT (store_id, locale) DO UPDATE SET description = EXCLUDED.description, updated_at = CURRENT_TIMESTAMP
+		RETURNING *`
+	return translationRepository.storeScanner.QueryRowAndScan(ctx, query, translation.StoreId, translation.Locale,
+		translation.Description)
+}
+
+func (translationRepository *TranslationRepository) DeleteStoreTranslation(storeId int64, locale string) error {
+	ctx := context.Background()
+	_, err := translationRepository.storeScanner.QueryRowAndScan(ctx,
+		"DELETE FROM store_translations WHERE store_id = $1 AND locale = $2 RETURNING *", storeId, locale)
+	return err
+}
@@ -0,0 +1,141 @@
+package persistence
+
+import (
+	"context"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/helper"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type IWishlistRepository interface {
+	GetWishlistsByUserId(userId int64) ([]domain.Wishlist, error)
+	GetWishlistById(wishlistId int64) (domain.Wishlist, error)
+	GetWishlistByShareToken(shareToken string) (domain.Wishlist, error)
+	CreateWishlist(wishlist domain.Wishlist) (domain.Wishlist, error)
+	UpdateWishlist(wishlistId int64, wishlist domain.Wishlist) (domain.Wishlist, error)
+	DeleteWishlistById(wishlistId int64) error
+	GetItemsByWishlistId(wishlistId int64) ([]domain.WishlistItem, error)
+	GetItemById(itemId int64) (domain.WishlistItem, error)
+	AddItem(item domain.WishlistItem) (domain.WishlistItem, error)
+	RemoveItem(itemId int64) error
+	GetPriceDrops() ([]domain.WishlistPriceDrop, error)
+	MarkPriceDropNotified(itemId int64, price float64) error
+}
+
+type WishlistRepository struct {
+	dbPool           *pgxpool.Pool
+	scanner          *helper.GenericScanner[domain.Wishlist]
+	itemScanner      *helper.GenericScanner[domain.WishlistItem]
+	priceDropScanner *helper.GenericScanner[domain.WishlistPriceDrop]
+}
+
+func NewWishlistRepository(dbPool *pgxpool.Pool) IWishlistRepository {
+	return &WishlistRepository{
+		dbPool:           dbPool,
+		scanner:          helper.NewGenericScanner(dbPool, helper.ScanWishlist),
+		itemScanner:      helper.NewGenericScanner(dbPool, helper.ScanWishlistItem),
+		priceDropScanner: helper.NewGenericScanner(dbPool, helper.ScanWishlistPriceDrop),
+	}
+}
+
+func (wishlistRepository *WishlistRepository) GetWishlistsByUserId(userId int64) ([]domain.Wishlist, error) {
+	ctx := context.Background()
+	wishlists, err := wishlistRepository.scanner.QueryAndScan(ctx, "SELECT * FROM wishlists WHERE user_id = $1 ORDER BY id", userId)
+	if err != nil {
+		return []domain.Wishlist{}, err
+	}
+	return wishlists, nil
+}
+
+func (wishlistRepository *WishlistRepository) GetWishlistById(wishlistId int64) (domain.Wishlist, error) {
+	ctx := context.Background()
+	return wishlistRepository.scanner.QueryRowAndScan(ctx, "SELECT * FROM wishlists WHERE id = $1", wishlistId)
+}
+
+func (wishlistRepository *WishlistRepository) GetWishlistByShareToken(shareToken string) (domain.Wishlist, error) {
+	ctx := context.Background()
+	query := `SELECT * FROM wishlists WHERE share_token = $1 AND is_public = true`
+	return wishlistRepository.scanner.QueryRowAndScan(ctx, query, shareToken)
+}
+
+func (wishlistRepository *WishlistRepository) CreateWishlist(wishlist domain.Wishlist) (domain.Wishlist, error) {
+	ctx := context.Background()
+	query := `INSERT INTO wishlists (user_id, name, is_public, share_token) VALUES ($1, $2, $3, $4) RETURNING *`
+	createdWishlist, err := wishlistRepository.scanner.QueryRowAndScan(ctx, query,
+		wishlist.UserId, wishlist.Name, wishlist.IsPublic, wishlist.ShareToken)
+	if err != nil {
+		return domain.Wishlist{}, err
+	}
+	return createdWishlist, nil
+}
+
+func (wishlistRepository *WishlistRepository) UpdateWishlist(wishlistId int64, wishlist domain.Wishlist) (domain.Wishlist, error) {
+	ctx := context.Background()
+	query := `UPDATE wishlists SET name = $1, is_public = $2, share_token = $3, updated_at = CURRENT_TIMESTAMP WHERE id = $4 RETURNING *`
+	updatedWishlist, err := wishlistRepository.scanner.QueryRowAndScan(ctx, query,
+		wishlist.Name, wishlist.IsPublic, wishlist.ShareToken, wishlistId)
+	if err != nil {
+		return domain.Wishlist{}, err
+	}
+	return updatedWishlist, nil
+}
+
+func (wishlistRepository *WishlistRepository) DeleteWishlistById(wishlistId int64) error {
+	ctx := context.Background()
+	return wishlistRepository.scanner.ExecuteExec(ctx, "DELETE FROM wishlists WHERE id = $1", wishlistId)
+}
+
+func (wishlistRepository *WishlistRepository) GetItemsByWishlistId(wishlistId int64) ([]domain.WishlistItem, error) {
+	ctx := context.Background()
+	query := `SELECT * FROM wishlist_items WHERE wishlist_id = $1 ORDER BY created_at`
+	items, err := wishlistRepository.itemScanner.QueryAndScan(ctx, query, wishlistId)
+	if err != nil {
+		return []domain.WishlistItem{}, err
+	}
+	return items, nil
+}
+
+func (wishlistRepository *WishlistRepository) GetItemById(itemId int64) (domain.WishlistItem, error) {
+	ctx := context.Background()
+	return wishlistRepository.itemScanner.QueryRowAndScan(ctx, "SELECT * FROM wishlist_items WHERE id = $1", itemId)
+}
+
+func (wishlistRepository *WishlistRepository) AddItem(item domain.WishlistItem) (domain.WishlistItem, error) {
+	ctx := context.Background()
+	// Adding a product or variant that is already on the list keeps the original entry and its reference price.
+	query := `INSERT INTO wishlist_items (wishlist_id, product_id, variant_id, added_price) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (wishlist_id, product_id, (COALESCE(variant_id, 0))) DO UPDATE SET wishlist_id = EXCLUDED.wishlist_id
+		RETURNING *`
+	addedItem, err := wishlistRepository.itemScanner.QueryRowAndScan(ctx, query, item.WishlistId, item.ProductId, item.VariantId, item.AddedPrice)
+	if err != nil {
+		return domain.WishlistItem{}, err
+	}
+	return addedItem, nil
+}
+
+func (wishlistRepository *WishlistRepository) RemoveItem(itemId int64) error {
+	ctx := context.Background()
+	return wishlistRepository.itemScanner.ExecuteExec(ctx, "DELETE FROM wishlist_items WHERE id = $1", itemId)
+}
+
+func (wishlistRepository *WishlistRepository) GetPriceDrops() ([]domain.WishlistPriceDrop, error) {
+	ctx := context.Background()
+	query := `SELECT wi.id, wi.wishlist_id, w.user_id, p.id, p.name,
+			COALESCE(wi.last_notified_price, wi.added_price), p.price
+		FROM wishlist_items wi
+		JOIN wishlists w ON w.id = wi.wishlist_id
+		JOIN products p ON p.id = wi.product_id
+		WHERE p.is_active = true AND p.deleted_at IS NULL AND p.price < COALESCE(wi.last_notified_price, wi.added_price)`
+	drops, err := wishlistRepository.priceDropScanner.QueryAndScan(ctx, query)
+	if err != nil {
+		return []domain.WishlistPriceDrop{}, err
+	}
+	return drops, nil
+}
+
+func (wishlistRepository *WishlistRepository) MarkPriceDropNotified(itemId int64, price float64) error {
+	ctx := context.Background()
+	query := `UPDATE wishlist_items SET last_notified_price = $1 WHERE id = $2`
+	return wishlistRepository.itemScanner.ExecuteExec(ctx, query, price, itemId)
+}
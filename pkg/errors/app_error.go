@@ -39,6 +39,13 @@ func NewUnauthorized(message string) *AppError {
 	}
 }
 
+func NewForbidden(message string) *AppError {
+	return &AppError{
+		Code:    http.StatusForbidden,
+		Message: message,
+	}
+}
+
 func NewInternalServerError(err error) *AppError {
 	return &AppError{
 		Code:     http.StatusInternalServerError,
@@ -0,0 +1,107 @@
+package locale
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locales holds the content locales the catalog is served in. Content in the default locale is stored on
+// the entities themselves; the other locales are translations that fall back to it.
+type Locales struct {
+	defaultLocale string
+	supported     []string
+}
+
+// New builds the locales from the default locale and a comma separated list of supported locales. The
+// default locale is always supported.
+func New(defaultLocale string, supported string) Locales {
+	defaultLocale = normalize(defaultLocale)
+	locales := Locales{defaultLocale: defaultLocale, supported: []string{defaultLocale}}
+	for _, value := range strings.Split(supported, ",") {
+		if value = normalize(value); value != "" && !slices.Contains(locales.supported, value) {
+			locales.supported = append(locales.supported, value)
+		}
+	}
+	return locales
+}
+
+func (locales Locales) Default() string {
+	return locales.defaultLocale
+}
+
+// Supported returns all supported locales, the default one first.
+func (locales Locales) Supported() []string {
+	return locales.supported
+}
+
+// Translated returns the supported locales other than the default one.
+func (locales Locales) Translated() []string {
+	return locales.supported[1:]
+}
+
+func (locales Locales) IsSupported(locale string) bool {
+	return slices.Contains(locales.supported, locale)
+}
+
+// IsTranslated reports whether content in the locale comes from translations.
+func (locales Locales) IsTranslated(locale string) bool {
+	return locale != locales.defaultLocale && locales.IsSupported(locale)
+}
+
+// Resolve picks the locale of a request. An explicitly requested locale wins, then the Accept-Language
+// preferences in order of their weight; a region falls back to its language, e.g. en-GB to en. Without
+// a supported match the default locale is used.
+func (locales Locales) Resolve(requested string, acceptLanguage string) string {
+	if match, ok := locales.match(requested); ok {
+		return match
+	}
+
+	type preference struct {
+		tag    string
+		weight float64
+	}
+	preferences := []preference{}
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		weight := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		if tag != "" && tag != "*" && weight > 0 {
+			preferences = append(preferences, preference{tag: tag, weight: weight})
+		}
+	}
+	sort.SliceStable(preferences, func(i, j int) bool { return preferences[i].weight > preferences[j].weight })
+
+	for _, preference := range preferences {
+		if match, ok := locales.match(preference.tag); ok {
+			return match
+		}
+	}
+	return locales.defaultLocale
+}
+
+func (locales Locales) match(tag string) (string, bool) {
+	tag = normalize(tag)
+	if tag == "" {
+		return "", false
+	}
+	if locales.IsSupported(tag) {
+		return tag, true
+	}
+	language, _, _ := strings.Cut(tag, "-")
+	if locales.IsSupported(language) {
+		return language, true
+	}
+	return "", false
+}
+
+func normalize(tag string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), "_", "-")
+}
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"go-ecommerce-service/pkg/locale"
+
+	"github.com/labstack/echo/v4"
+)
+
+// LocaleMiddleware resolves the content locale from the locale query parameter or the Accept-Language
+// header and announces it in the Content-Language header.
+func LocaleMiddleware(locales locale.Locales) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			resolved := locales.Resolve(c.QueryParam("locale"), c.Request().Header.Get("Accept-Language"))
+			c.Set("locale", resolved)
+			c.Response().Header().Set("Content-Language", resolved)
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"go-ecommerce-service/internal/jwt"
+	_errors "go-ecommerce-service/pkg/errors"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequireRole lets a request through only when the user authenticated by AuthMiddleware has one of the roles.
+func RequireRole(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			claim, ok := c.Get("userId").(*jwt.Claim)
+			if !ok || claim == nil {
+				return c.JSON(http.StatusUnauthorized, _errors.NewUnauthorized("Authentication required"))
+			}
+			if !slices.Contains(roles, claim.Role) {
+				return c.JSON(http.StatusForbidden, _errors.NewForbidden("You are not allowed to do this"))
+			}
+			return next(c)
+		}
+	}
+}
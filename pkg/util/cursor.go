@@ -0,0 +1,30 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("Invalid cursor")
+
+// EncodeCursor serializes a pagination cursor into an opaque URL-safe string.
+func EncodeCursor(cursor interface{}) string {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor reverses EncodeCursor into the given cursor value.
+func DecodeCursor(encoded string, cursor interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("Invalid token")
+	ErrTokenExpired = errors.New("Token expired")
+)
+
+// SignToken produces a URL-safe "<subject>.<expiry>.<signature>" token signed with HMAC-SHA256.
+func SignToken(secret, subject string, expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(subject)) + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+	return payload + "." + sign(secret, payload)
+}
+
+// VerifyToken checks the signature and expiry of a token created by SignToken and returns its subject.
+func VerifyToken(secret, token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrInvalidToken
+	}
+
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(sign(secret, payload)), []byte(parts[2])) {
+		return "", ErrInvalidToken
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	if time.Now().Unix() > expiresAt {
+		return "", ErrTokenExpired
+	}
+
+	subject, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	return string(subject), nil
+}
+
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
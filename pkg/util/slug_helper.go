@@ -7,12 +7,14 @@ import (
 	"github.com/gosimple/slug"
 )
 
+// turkishSub transliterates the Turkish letters of the catalog's default content.
+var turkishSub = map[string]string{
+	"ı": "i", "İ": "i", "ş": "s", "Ş": "s", "ğ": "g", "Ğ": "g",
+	"ü": "u", "Ü": "u", "ö": "o", "Ö": "o", "ç": "c", "Ç": "c",
+}
+
 func GenerateSlug(text string) string {
-	slug.CustomSub = map[string]string{
-		"ı": "i", "İ": "i", "ş": "s", "Ş": "s", "ğ": "g", "Ğ": "g",
-		"ü": "u", "Ü": "u", "ö": "o", "Ö": "o", "ç": "c", "Ç": "c",
-	}
-	return slug.Make(text)
+	return slug.Make(slug.Substitute(text, turkishSub))
 }
 
 func GenerateUniqueSlug(text string) string {
@@ -20,3 +22,18 @@ func GenerateUniqueSlug(text string) string {
 	randomPart := strings.Split(uuid.New().String(), "-")[0]
 	return s + "-" + randomPart
 }
+
+// GenerateLocaleSlug builds a slug with the transliteration rules of the content's locale, e.g. "ü" becomes
+// "ue" in German. Turkish content keeps the rules of GenerateSlug.
+func GenerateLocaleSlug(text string, locale string) string {
+	if locale == "tr" {
+		return GenerateSlug(text)
+	}
+	return slug.MakeLang(text, locale)
+}
+
+func GenerateUniqueLocaleSlug(text string, locale string) string {
+	s := GenerateLocaleSlug(text, locale)
+	randomPart := strings.Split(uuid.New().String(), "-")[0]
+	return s + "-" + randomPart
+}
@@ -51,7 +51,7 @@ func (authService *AuthService) Login(loginModel model.LoginCreate) (string, err
 	if checkPasswordHash == false {
 		return "", _errors.NewBadRequest("Password Error")
 	}
-	token, tokenErr := authService.jwtManager.GenerateToken(userByEmail.Id, userByEmail.Email)
+	token, tokenErr := authService.jwtManager.GenerateToken(userByEmail.Id, userByEmail.Email, userByEmail.Role)
 	if tokenErr != nil {
 		return "", _errors.NewBadRequest(tokenErr.Error())
 	}
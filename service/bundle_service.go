@@ -0,0 +1,245 @@
+package service
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/pricing"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+type IBundleService interface {
+	GetBundle(productId int64) (dto.BundleResponse, error)
+	SaveBundle(userId int64, role string, productId int64, bundleRequest dto.SaveBundleRequest) (dto.BundleResponse, error)
+	DeleteBundle(userId int64, role string, productId int64) error
+	RefreshBundles() (int, error)
+}
+
+type BundleService struct {
+	bundleRepository  persistence.IBundleRepository
+	productRepository persistence.IProductRepository
+	variantRepository persistence.IProductVariantRepository
+	validator         *rules.BundleRules
+	redisClient       *redis.Client
+	managers          productManagers
+}
+
+func NewBundleService(bundleRepository persistence.IBundleRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, storeRepository persistence.IStoreRepository, rdb *redis.Client) IBundleService {
+	return &BundleService{
+		bundleRepository:  bundleRepository,
+		productRepository: productRepository,
+		variantRepository: variantRepository,
+		validator:         rules.NewBundleRules(),
+		redisClient:       rdb,
+		managers:          newProductManagers(productRepository, storeRepository),
+	}
+}
+
+func (bundleService *BundleService) GetBundle(productId int64) (dto.BundleResponse, error) {
+	bundle, err := bundleService.bundleRepository.GetBundle(productId)
+	if err != nil {
+		if errors.Is(err, common.ErrBundleNotFound) {
+			return dto.BundleResponse{}, _errors.NewNotFound(err.Error())
+		}
+		return dto.BundleResponse{}, _errors.NewInternalServerError(err)
+	}
+	product, err := bundleService.productRepository.GetProductById(productId)
+	if err != nil {
+		return dto.BundleResponse{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	return convertToBundleResponse(bundle, product), nil
+}
+
+// SaveBundle makes the product a bundle of the components, or replaces the contents and pricing of the bundle.
+// Bundles are one level deep: a bundle cannot contain bundles or be part of one, and a product with variants
+// cannot be a bundle. Components with variants are sold as one of their variants.
+func (bundleService *BundleService) SaveBundle(userId int64, role string, productId int64, bundleRequest dto.SaveBundleRequest) (dto.BundleResponse, error) {
+	if validationErr := bundleService.validator.ValidateSave(bundleRequest); validationErr != nil {
+		return dto.BundleResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := bundleService.managers.product(userId, role, productId); err != nil {
+		return dto.BundleResponse{}, err
+	}
+	isComponent, err := bundleService.bundleRepository.IsBundleComponent(productId)
+	if err != nil {
+		return dto.BundleResponse{}, _errors.NewInternalServerError(err)
+	}
+	if isComponent {
+		return dto.BundleResponse{}, _errors.NewBadRequest("The product is part of another bundle")
+	}
+
+	productIds := []int64{productId}
+	for _, component := range bundleRequest.Components {
+		productIds = append(productIds, component.ProductId)
+	}
+	variantsByProduct, err := bundleService.variantRepository.GetVariantsByProductIds(productIds)
+	if err != nil {
+		return dto.BundleResponse{}, _errors.NewInternalServerError(err)
+	}
+	if len(activeVariants(variantsByProduct[productId])) > 0 {
+		return dto.BundleResponse{}, _errors.NewBadRequest("A product with variants cannot be a bundle")
+	}
+
+	bundle := domain.ProductBundle{
+		ProductId:       productId,
+		PricingMode:     bundleRequest.PricingMode,
+		FixedPrice:      bundleRequest.FixedPrice,
+		DiscountPercent: bundleRequest.DiscountPercent,
+		Components:      make([]domain.BundleComponent, 0, len(bundleRequest.Components)),
+	}
+	for _, componentRequest := range bundleRequest.Components {
+		if err := bundleService.validateComponent(productId, componentRequest, variantsByProduct[componentRequest.ProductId]); err != nil {
+			return dto.BundleResponse{}, err
+		}
+		bundle.Components = append(bundle.Components, domain.BundleComponent{
+			ProductId: componentRequest.ProductId,
+			VariantId: componentRequest.VariantId,
+			Quantity:  componentRequest.Quantity,
+		})
+	}
+
+	saved, err := bundleService.bundleRepository.SaveBundle(bundle, userId)
+	if err != nil {
+		return dto.BundleResponse{}, _errors.NewInternalServerError(err)
+	}
+	product, err := bundleService.productRepository.GetProductById(productId)
+	if err != nil {
+		return dto.BundleResponse{}, _errors.NewInternalServerError(err)
+	}
+	refreshProducts(bundleService.productRepository, bundleService.variantRepository, bundleService.redisClient, []domain.Product{product})
+	return convertToBundleResponse(saved, product), nil
+}
+
+// DeleteBundle turns the bundle back into a plain product. Its price is kept; its stock is its own stock again.
+func (bundleService *BundleService) DeleteBundle(userId int64, role string, productId int64) error {
+	if _, err := bundleService.managers.product(userId, role, productId); err != nil {
+		return err
+	}
+	if err := bundleService.bundleRepository.DeleteBundle(productId); err != nil {
+		if errors.Is(err, common.ErrBundleNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	refreshProductIds(bundleService.productRepository, bundleService.variantRepository, bundleService.redisClient, []int64{productId})
+	return nil
+}
+
+// RefreshBundles reprices the bundles from the current component prices and derives their stock from the
+// current component stock. It returns the number of bundles that changed.
+func (bundleService *BundleService) RefreshBundles() (int, error) {
+	changed, err := bundleService.bundleRepository.RefreshBundles()
+	if err != nil {
+		return 0, err
+	}
+	refreshProductIds(bundleService.productRepository, bundleService.variantRepository, bundleService.redisClient, changed)
+	return len(changed), nil
+}
+
+func (bundleService *BundleService) validateComponent(bundleId int64, componentRequest dto.BundleComponentRequest,
+	variants []domain.ProductVariant) error {
+	if componentRequest.ProductId == bundleId {
+		return _errors.NewBadRequest("A bundle cannot contain itself")
+	}
+	component, err := bundleService.productRepository.GetProductById(componentRequest.ProductId)
+	if err != nil {
+		return _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	if _, err := bundleService.bundleRepository.GetBundle(componentRequest.ProductId); err == nil {
+		return _errors.NewBadRequest("A bundle cannot contain another bundle")
+	} else if !errors.Is(err, common.ErrBundleNotFound) {
+		return _errors.NewInternalServerError(err)
+	}
+
+	if componentRequest.VariantId == nil {
+		if len(activeVariants(variants)) > 0 {
+			return _errors.NewBadRequest("Choose a variant of " + component.Name)
+		}
+		return nil
+	}
+	for _, variant := range variants {
+		if variant.Id == *componentRequest.VariantId {
+			return nil
+		}
+	}
+	return _errors.NewNotFound(common.ErrProductVariantNotFound.Error())
+}
+
+// refreshProductIds updates the cached and indexed copies of products whose price or stock changed outside
+// the product endpoints, such as bundles and the components of ordered bundles.
+func refreshProductIds(productRepository persistence.IProductRepository, variantRepository persistence.IProductVariantRepository,
+	redisClient *redis.Client, productIds []int64) {
+	products := make([]domain.Product, 0, len(productIds))
+	for _, productId := range productIds {
+		product, err := productRepository.GetProductById(productId)
+		if err != nil {
+			log.Error().Err(err).Int64("product_id", productId).Msg("Product could not be loaded for refreshing")
+			continue
+		}
+		products = append(products, product)
+	}
+	refreshProducts(productRepository, variantRepository, redisClient, products)
+}
+
+// allocateBundlePrice splits the price of one bundle over its components in proportion to their list price,
+// rounded to cents with the remainder on the last component so the shares add up to the bundle price.
+func allocateBundlePrice(price float64, components []domain.BundleComponent) []float64 {
+	weights := make([]float64, len(components))
+	total := 0.0
+	for i, component := range components {
+		weights[i] = component.ListPrice * float64(component.Quantity)
+		total += weights[i]
+	}
+	if total == 0 {
+		for i, component := range components {
+			weights[i] = float64(component.Quantity)
+			total += weights[i]
+		}
+	}
+
+	shares := make([]float64, len(components))
+	allocated := 0.0
+	for i := range components {
+		if i == len(components)-1 {
+			shares[i] = pricing.Round(price - allocated)
+			break
+		}
+		shares[i] = pricing.Round(price * weights[i] / total)
+		allocated += shares[i]
+	}
+	return shares
+}
+
+func convertToBundleResponse(bundle domain.ProductBundle, product domain.Product) dto.BundleResponse {
+	response := dto.BundleResponse{
+		ProductId:       bundle.ProductId,
+		PricingMode:     bundle.PricingMode,
+		FixedPrice:      bundle.FixedPrice,
+		DiscountPercent: bundle.DiscountPercent,
+		Price:           product.Price,
+		ListPrice:       product.BasePrice,
+		Savings:         product.Discount,
+		StockQuantity:   product.StockQuantity,
+		Components:      make([]dto.BundleComponentResponse, 0, len(bundle.Components)),
+		UpdatedAt:       bundle.UpdatedAt,
+	}
+	for _, component := range bundle.Components {
+		response.Components = append(response.Components, dto.BundleComponentResponse{
+			ProductId:     component.ProductId,
+			VariantId:     component.VariantId,
+			Name:          component.Name,
+			Sku:           component.Sku,
+			Quantity:      component.Quantity,
+			UnitPrice:     component.ListPrice,
+			StockQuantity: component.StockQuantity,
+		})
+	}
+	return response
+}
@@ -1,10 +1,12 @@
 package service
 
 import (
+	"errors"
 	"go-ecommerce-service/domain"
 	"go-ecommerce-service/internal/dto"
 	"go-ecommerce-service/internal/rules"
 	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
 	_errors "go-ecommerce-service/pkg/errors"
 )
 
@@ -20,12 +22,14 @@ type ICartItemService interface {
 
 type CartItemService struct {
 	cartItemRepository persistence.ICartItemRepository
+	variantRepository  persistence.IProductVariantRepository
 	validator          *rules.CartItemRules
 }
 
-func NewCartItemService(cartItemRepository persistence.ICartItemRepository) ICartItemService {
+func NewCartItemService(cartItemRepository persistence.ICartItemRepository, variantRepository persistence.IProductVariantRepository) ICartItemService {
 	return &CartItemService{
 		cartItemRepository: cartItemRepository,
+		variantRepository:  variantRepository,
 		validator:          rules.NewCartItemRules(),
 	}
 }
@@ -35,35 +39,39 @@ func (cartItemService *CartItemService) AddItemToCart(cartItem dto.CreateCartIte
 		return dto.CartItemResponse{}, _errors.NewBadRequest(validationErr.Error())
 	}
 
+	if cartItem.VariantId != nil {
+		variant, err := cartItemService.variantRepository.GetVariantById(*cartItem.VariantId)
+		if err != nil {
+			return dto.CartItemResponse{}, _errors.NewNotFound(err.Error())
+		}
+		if variant.ProductId != cartItem.ProductId {
+			return dto.CartItemResponse{}, _errors.NewBadRequest("Variant does not belong to the product")
+		}
+		if !variant.IsActive {
+			return dto.CartItemResponse{}, _errors.NewBadRequest("Variant is not available")
+		}
+	}
+
 	item, err := cartItemService.cartItemRepository.AddItemToCart(domain.CartItem{
 		CartId:    cartItem.CartId,
 		ProductId: cartItem.ProductId,
+		VariantId: cartItem.VariantId,
 		Quantity:  cartItem.Quantity,
 	})
 
+	if errors.Is(err, common.ErrCartNotFound) || errors.Is(err, common.ErrProductNotFound) {
+		return dto.CartItemResponse{}, _errors.NewNotFound(err.Error())
+	}
 	if err != nil {
 		return dto.CartItemResponse{}, _errors.NewBadRequest(err.Error())
 	}
 
-	cartItemDto := dto.CartItemResponse{
-		CartId:    item.CartId,
-		ProductId: item.ProductId,
-		Quantity:  item.Quantity,
-	}
-	return cartItemDto, nil
+	return convertToCartItemResponse(item), nil
 }
 
 func (cartItemService *CartItemService) GetItemsByCartId(cartId int64) []dto.CartItemResponse {
 	items := cartItemService.cartItemRepository.GetItemsByCartId(cartId)
-	itemsDto := make([]dto.CartItemResponse, 0, len(items))
-	for _, item := range items {
-		itemsDto = append(itemsDto, dto.CartItemResponse{
-			CartId:    item.CartId,
-			ProductId: item.ProductId,
-			Quantity:  item.Quantity,
-		})
-	}
-	return itemsDto
+	return convertToCartItemsResponse(items)
 }
 
 func (cartItemService *CartItemService) UpdateItemQuantity(cartItemId int64, newQuantity int) (dto.CartItemResponse, error) {
@@ -71,12 +79,7 @@ func (cartItemService *CartItemService) UpdateItemQuantity(cartItemId int64, new
 	if err != nil {
 		return dto.CartItemResponse{}, _errors.NewBadRequest(err.Error())
 	}
-	cartItemDto := dto.CartItemResponse{
-		CartId:    item.CartId,
-		ProductId: item.ProductId,
-		Quantity:  item.Quantity,
-	}
-	return cartItemDto, nil
+	return convertToCartItemResponse(item), nil
 }
 
 func (cartItemService *CartItemService) RemoveItemFromCart(cartItemId int64) error {
@@ -94,3 +97,21 @@ func (cartItemService *CartItemService) IncreaseItemQuantity(cartItemId int64, a
 func (cartItemService *CartItemService) DecreaseItemQuantity(cartItemId int64, amount int) error {
 	return cartItemService.cartItemRepository.DecreaseItemQuantity(cartItemId, amount)
 }
+
+func convertToCartItemResponse(item domain.CartItem) dto.CartItemResponse {
+	return dto.CartItemResponse{
+		Id:        item.Id,
+		CartId:    item.CartId,
+		ProductId: item.ProductId,
+		VariantId: item.VariantId,
+		Quantity:  item.Quantity,
+	}
+}
+
+func convertToCartItemsResponse(items []domain.CartItem) []dto.CartItemResponse {
+	itemsDto := make([]dto.CartItemResponse, 0, len(items))
+	for _, item := range items {
+		itemsDto = append(itemsDto, convertToCartItemResponse(item))
+	}
+	return itemsDto
+}
@@ -1,12 +1,23 @@
 package service
 
 import (
+	"fmt"
+	"go-ecommerce-service/config"
 	"go-ecommerce-service/domain"
+	"go-ecommerce-service/infrastructure/rabbitmq"
 	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/pricing"
 	"go-ecommerce-service/internal/rules"
 	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
 	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/util"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/rs/zerolog/log"
 )
 
 type ICartService interface {
@@ -15,27 +26,41 @@ type ICartService interface {
 	CreateCart(cart dto.CreateCartRequest) (dto.CartResponse, error)
 	DeleteCartById(cartId int64) error
 	ClearUserCart(userId int64) error
+	DetectAbandonedCarts() (int, error)
+	RecoverCart(token string) (dto.RecoveredCartResponse, error)
+	GetAbandonmentStats(since time.Time, storeId *uint) ([]dto.CartAbandonmentStatResponse, error)
+	GetCartTotals(cartId int64) (dto.CartTotalsResponse, error)
 }
 
 type CartService struct {
-	cartRepository persistence.ICartRepository
-	validator      *rules.CartRules
+	cartRepository     persistence.ICartRepository
+	cartItemRepository persistence.ICartItemRepository
+	productRepository  persistence.IProductRepository
+	variantRepository  persistence.IProductVariantRepository
+	rabbitMQClient     rabbitmq.IRabbitMQClient
+	validator          *rules.CartRules
+	pricer             pricer
+	cartConfig         config.CartConfig
 }
 
-func NewCartService(cartRepository persistence.ICartRepository) ICartService {
+func NewCartService(cartRepository persistence.ICartRepository, cartItemRepository persistence.ICartItemRepository,
+	productRepository persistence.IProductRepository, variantRepository persistence.IProductVariantRepository,
+	ruleRepository persistence.IPriceRuleRepository, rabbit rabbitmq.IRabbitMQClient, cartConfig config.CartConfig) ICartService {
 	return &CartService{
-		cartRepository: cartRepository,
-		validator:      rules.NewCartRules(),
+		cartRepository:     cartRepository,
+		cartItemRepository: cartItemRepository,
+		productRepository:  productRepository,
+		variantRepository:  variantRepository,
+		rabbitMQClient:     rabbit,
+		validator:          rules.NewCartRules(),
+		pricer:             pricer{ruleRepository: ruleRepository},
+		cartConfig:         cartConfig,
 	}
 }
 
 func (cartService *CartService) GetCartById(cartId int64) dto.CartResponse {
 	cart := cartService.cartRepository.GetCartById(cartId)
-	cartDto := dto.CartResponse{
-		UserId:    cart.UserId,
-		CreatedAt: cart.CreatedAt,
-	}
-	return cartDto
+	return convertToCartResponse(cart)
 }
 
 func (cartService *CartService) CreateCart(cart dto.CreateCartRequest) (dto.CartResponse, error) {
@@ -52,12 +77,7 @@ func (cartService *CartService) CreateCart(cart dto.CreateCartRequest) (dto.Cart
 		return dto.CartResponse{}, _errors.NewBadRequest(err.Error())
 	}
 
-	createdCartDto := dto.CartResponse{
-		UserId:    createdCart.UserId,
-		CreatedAt: createdCart.CreatedAt,
-	}
-
-	return createdCartDto, nil
+	return convertToCartResponse(createdCart), nil
 }
 
 func (cartService *CartService) GetCartsByUserId(userId int64) []dto.CartResponse {
@@ -65,10 +85,7 @@ func (cartService *CartService) GetCartsByUserId(userId int64) []dto.CartRespons
 
 	cartsDto := make([]dto.CartResponse, 0, len(carts))
 	for _, cart := range carts {
-		cartsDto = append(cartsDto, dto.CartResponse{
-			UserId:    cart.UserId,
-			CreatedAt: cart.CreatedAt,
-		})
+		cartsDto = append(cartsDto, convertToCartResponse(cart))
 	}
 	return cartsDto
 }
@@ -80,3 +97,172 @@ func (cartService *CartService) DeleteCartById(cartId int64) error {
 func (cartService *CartService) ClearUserCart(userId int64) error {
 	return cartService.cartRepository.ClearUserCart(userId)
 }
+
+// DetectAbandonedCarts marks carts that have been idle longer than the configured period as abandoned
+// and publishes a cart.abandoned event with a signed recovery link for each of them.
+func (cartService *CartService) DetectAbandonedCarts() (int, error) {
+	abandonAfter := config.ParseDuration(cartService.cartConfig.AbandonAfter, 24*time.Hour)
+	carts, err := cartService.cartRepository.GetInactiveCarts(time.Now().Add(-abandonAfter))
+	if err != nil {
+		return 0, _errors.NewInternalServerError(err)
+	}
+
+	abandonedCount := 0
+	for _, cart := range carts {
+		abandonedCart, markErr := cartService.cartRepository.MarkCartAbandoned(cart.Id)
+		if markErr != nil {
+			log.Error().Err(markErr).Int64("cart_id", cart.Id).Msg("Cart could not be marked as abandoned")
+			continue
+		}
+		abandonedCount++
+
+		if publishErr := cartService.publishCartAbandoned(abandonedCart); publishErr != nil {
+			log.Error().Err(publishErr).Int64("cart_id", cart.Id).Msg("cart.abandoned event could not be published")
+		}
+	}
+	return abandonedCount, nil
+}
+
+func (cartService *CartService) RecoverCart(token string) (dto.RecoveredCartResponse, error) {
+	subject, tokenErr := util.VerifyToken(cartService.cartConfig.RecoverySecret, token)
+	if tokenErr != nil {
+		return dto.RecoveredCartResponse{}, _errors.NewBadRequest(tokenErr.Error())
+	}
+	cartId, abandonedAt, parseErr := parseRecoverySubject(subject)
+	if parseErr != nil {
+		return dto.RecoveredCartResponse{}, _errors.NewBadRequest(util.ErrInvalidToken.Error())
+	}
+
+	cart, err := cartService.cartRepository.RestoreCart(cartId, abandonedAt)
+	if err != nil {
+		return dto.RecoveredCartResponse{}, _errors.NewNotFound(common.ErrCartNotRecoverable.Error())
+	}
+
+	items := cartService.cartItemRepository.GetItemsByCartId(cart.Id)
+	return dto.RecoveredCartResponse{
+		Cart:  convertToCartResponse(cart),
+		Items: convertToCartItemsResponse(items),
+	}, nil
+}
+
+func (cartService *CartService) GetAbandonmentStats(since time.Time, storeId *uint) ([]dto.CartAbandonmentStatResponse, error) {
+	stats, err := cartService.cartRepository.GetAbandonmentStats(since, storeId)
+	if err != nil {
+		return []dto.CartAbandonmentStatResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	statsDto := make([]dto.CartAbandonmentStatResponse, 0, len(stats))
+	for _, stat := range stats {
+		statDto := dto.CartAbandonmentStatResponse{
+			StoreId:        stat.StoreId,
+			TotalCarts:     stat.TotalCarts,
+			AbandonedCarts: stat.AbandonedCarts,
+			RecoveredCarts: stat.RecoveredCarts,
+		}
+		if stat.TotalCarts > 0 {
+			statDto.AbandonmentRate = float64(stat.AbandonedCarts) / float64(stat.TotalCarts)
+		}
+		if stat.AbandonedCarts > 0 {
+			statDto.RecoveryRate = float64(stat.RecoveredCarts) / float64(stat.AbandonedCarts)
+		}
+		statsDto = append(statsDto, statDto)
+	}
+	return statsDto, nil
+}
+
+// GetCartTotals prices every line of the cart for the cart's owner with the rules active right now.
+func (cartService *CartService) GetCartTotals(cartId int64) (dto.CartTotalsResponse, error) {
+	cart := cartService.cartRepository.GetCartById(cartId)
+	if cart.Id == 0 {
+		return dto.CartTotalsResponse{}, _errors.NewNotFound(common.ErrCartNotFound.Error())
+	}
+	calculator, err := cartService.pricer.calculator(cart.UserId)
+	if err != nil {
+		return dto.CartTotalsResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	totals := dto.CartTotalsResponse{CartId: cart.Id, Lines: []dto.CartLineResponse{}}
+	for _, item := range cartService.cartItemRepository.GetItemsByCartId(cartId) {
+		product, productErr := cartService.productRepository.GetProductById(item.ProductId)
+		if productErr != nil || !product.IsActive {
+			continue
+		}
+		var variant *domain.ProductVariant
+		if item.VariantId != nil {
+			found, variantErr := cartService.variantRepository.GetVariantById(*item.VariantId)
+			if variantErr != nil || !found.IsActive {
+				continue
+			}
+			variant = &found
+		}
+
+		quote := calculator.Quote(product, variant)
+		line := dto.CartLineResponse{
+			CartItemId: item.Id,
+			ProductId:  item.ProductId,
+			VariantId:  item.VariantId,
+			Quantity:   item.Quantity,
+			BasePrice:  quote.BasePrice,
+			UnitPrice:  quote.Price,
+			LineTotal:  pricing.Round(quote.Price * float64(item.Quantity)),
+		}
+		totals.Lines = append(totals.Lines, line)
+		totals.Subtotal = pricing.Round(totals.Subtotal + line.LineTotal)
+		totals.Savings = pricing.Round(totals.Savings + (quote.BasePrice-quote.Price)*float64(item.Quantity))
+	}
+	return totals, nil
+}
+
+func (cartService *CartService) publishCartAbandoned(cart domain.Cart) error {
+	recoveryTTL := config.ParseDuration(cartService.cartConfig.RecoveryTTL, 7*24*time.Hour)
+	expiresAt := time.Now().Add(recoveryTTL)
+	token := util.SignToken(cartService.cartConfig.RecoverySecret, recoverySubject(cart), expiresAt)
+
+	items := cartService.cartItemRepository.GetItemsByCartId(cart.Id)
+	payload := map[string]interface{}{
+		"event":               "cart.abandoned",
+		"cart_id":             cart.Id,
+		"user_id":             cart.UserId,
+		"abandoned_at":        cart.AbandonedAt,
+		"last_activity_at":    cart.UpdatedAt,
+		"items":               convertToCartItemsResponse(items),
+		"recovery_url":        fmt.Sprintf("%s?token=%s", cartService.cartConfig.RecoveryBaseUrl, url.QueryEscape(token)),
+		"recovery_expires_at": expiresAt,
+	}
+	return rabbitmq.PublishJSON(cartService.rabbitMQClient, rabbitmq.CartAbandonedQueue, payload)
+}
+
+// recoverySubject ties a recovery token to one abandonment of the cart, so it cannot restore the cart twice.
+func recoverySubject(cart domain.Cart) string {
+	var abandonedAt int64
+	if cart.AbandonedAt != nil {
+		abandonedAt = cart.AbandonedAt.Unix()
+	}
+	return strconv.FormatInt(cart.Id, 10) + ":" + strconv.FormatInt(abandonedAt, 10)
+}
+
+func parseRecoverySubject(subject string) (int64, int64, error) {
+	cartPart, abandonedPart, found := strings.Cut(subject, ":")
+	if !found {
+		return 0, 0, util.ErrInvalidToken
+	}
+	cartId, err := strconv.ParseInt(cartPart, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	abandonedAt, err := strconv.ParseInt(abandonedPart, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return cartId, abandonedAt, nil
+}
+
+func convertToCartResponse(cart domain.Cart) dto.CartResponse {
+	return dto.CartResponse{
+		Id:        cart.Id,
+		UserId:    cart.UserId,
+		Status:    cart.Status,
+		CreatedAt: cart.CreatedAt,
+		UpdatedAt: cart.UpdatedAt,
+	}
+}
@@ -1,62 +1,111 @@
 package service
 
 import (
+	"errors"
 	"go-ecommerce-service/domain"
 	"go-ecommerce-service/internal/dto"
 	"go-ecommerce-service/internal/rules"
 	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
 	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/locale"
 )
 
 type ICategoryService interface {
-	GetAllCategories() []dto.CategoryResponse
-	GetCategoryById(id int) (dto.CategoryResponse, error)
-	GetCategoriesByIsActive(isActive bool) ([]dto.CategoryResponse, error)
+	GetAllCategories(locale string) []dto.CategoryResponse
+	GetCategoryById(id int, locale string) (dto.CategoryResponse, error)
+	GetCategoriesByIsActive(isActive bool, locale string) ([]dto.CategoryResponse, error)
+	GetCategoryTree(locale string, activeOnly bool) []dto.CategoryTreeResponse
+	GetCategorySubtree(id uint, locale string, activeOnly bool) (dto.CategoryTreeResponse, error)
 	AddCategory(categoryCreate dto.CreateCategoryRequest) (dto.CategoryResponse, error)
 	UpdateCategory(categoryId uint, categoryCreate dto.CreateCategoryRequest) (dto.CategoryResponse, error)
+	MoveCategory(categoryId uint, moveRequest dto.MoveCategoryRequest) (dto.CategoryResponse, error)
 	DeleteCategory(id uint) error
 }
 
 type CategoryService struct {
 	categoryRepository persistence.ICategoryRepository
 	validator          *rules.CategoryRules
+	translator         translator
 }
 
-func NewCategoryService(categoryRepository persistence.ICategoryRepository) ICategoryService {
+func NewCategoryService(categoryRepository persistence.ICategoryRepository, translationRepository persistence.ITranslationRepository,
+	locales locale.Locales) ICategoryService {
 	return &CategoryService{
 		categoryRepository: categoryRepository,
 		validator:          rules.NewCategoryRules(),
+		translator:         translator{translationRepository: translationRepository, locales: locales},
 	}
 }
 
-func (categoryService *CategoryService) GetAllCategories() []dto.CategoryResponse {
+func (categoryService *CategoryService) GetAllCategories(locale string) []dto.CategoryResponse {
 	categories := categoryService.categoryRepository.GetAllCategories()
-	return convertCategoriesResponse(categories)
+	return convertCategoriesResponse(categoryService.translator.categories(locale, categories))
 }
-func (categoryService *CategoryService) GetCategoryById(id int) (dto.CategoryResponse, error) {
+func (categoryService *CategoryService) GetCategoryById(id int, locale string) (dto.CategoryResponse, error) {
 	category, err := categoryService.categoryRepository.GetCategoryById(id)
 	if err != nil {
 		return dto.CategoryResponse{}, _errors.NewBadRequest(err.Error())
 	}
 
-	return convertToCategoryResponse(category), nil
+	return convertToCategoryResponse(categoryService.translator.categories(locale, []domain.Category{category})[0]), nil
 }
-func (categoryService *CategoryService) GetCategoriesByIsActive(isActive bool) ([]dto.CategoryResponse, error) {
+func (categoryService *CategoryService) GetCategoriesByIsActive(isActive bool, locale string) ([]dto.CategoryResponse, error) {
 	categories, err := categoryService.categoryRepository.GetCategoriesByIsActive(isActive)
 	if err != nil {
 		return []dto.CategoryResponse{}, _errors.NewBadRequest(err.Error())
 	}
 
-	return convertCategoriesResponse(categories), nil
+	return convertCategoriesResponse(categoryService.translator.categories(locale, categories)), nil
+}
+
+// GetCategoryTree returns the root categories with their subcategories nested below them. With activeOnly an
+// inactive category is left out together with everything below it.
+func (categoryService *CategoryService) GetCategoryTree(locale string, activeOnly bool) []dto.CategoryTreeResponse {
+	categories := categoryService.translator.categories(locale, categoryService.categoryRepository.GetAllCategories())
+	return buildCategoryTree(categories, nil, activeOnly)
+}
+
+// GetCategorySubtree returns the category with its subcategories nested below it.
+func (categoryService *CategoryService) GetCategorySubtree(id uint, locale string, activeOnly bool) (dto.CategoryTreeResponse, error) {
+	subtree, err := categoryService.categoryRepository.GetSubtree(id)
+	if err != nil {
+		if errors.Is(err, common.ErrCategoryNotFound) {
+			return dto.CategoryTreeResponse{}, _errors.NewNotFound(err.Error())
+		}
+		return dto.CategoryTreeResponse{}, _errors.NewInternalServerError(err)
+	}
+	subtree = categoryService.translator.categories(locale, subtree)
+	root := subtree[0]
+	if activeOnly && !root.IsActive {
+		return dto.CategoryTreeResponse{}, _errors.NewNotFound(common.ErrCategoryNotFound.Error())
+	}
+
+	return dto.CategoryTreeResponse{
+		Id:          root.Id,
+		Name:        root.Name,
+		Description: root.Description,
+		IsActive:    root.IsActive,
+		SortOrder:   root.SortOrder,
+		Children:    buildCategoryTree(subtree[1:], &root.Id, activeOnly),
+	}, nil
 }
+
 func (categoryService *CategoryService) AddCategory(categoryCreate dto.CreateCategoryRequest) (dto.CategoryResponse, error) {
 	if validationErr := categoryService.validator.ValidateStructure(categoryCreate); validationErr != nil {
 		return dto.CategoryResponse{}, _errors.NewBadRequest(validationErr.Error())
 	}
+	if categoryCreate.ParentId != nil {
+		if _, err := categoryService.categoryRepository.GetCategoryById(int(*categoryCreate.ParentId)); err != nil {
+			return dto.CategoryResponse{}, _errors.NewNotFound("Parent category not found")
+		}
+	}
 	addedCategory, err := categoryService.categoryRepository.AddCategory(domain.Category{
 		Name:        categoryCreate.Name,
 		Description: categoryCreate.Description,
 		IsActive:    categoryCreate.IsActive,
+		ParentId:    categoryCreate.ParentId,
+		SortOrder:   categoryCreate.SortOrder,
 	})
 	if err != nil {
 		return dto.CategoryResponse{}, _errors.NewBadRequest(err.Error())
@@ -82,8 +131,51 @@ func (categoryService *CategoryService) UpdateCategory(categoryId uint, category
 	return convertToCategoryResponse(updateCategory), nil
 }
 
+// MoveCategory puts the category, together with its subcategories, under a new parent or at the root.
+func (categoryService *CategoryService) MoveCategory(categoryId uint, moveRequest dto.MoveCategoryRequest) (dto.CategoryResponse, error) {
+	if validationErr := categoryService.validator.ValidateMove(moveRequest); validationErr != nil {
+		return dto.CategoryResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if moveRequest.ParentId != nil {
+		if _, err := categoryService.categoryRepository.GetCategoryById(int(*moveRequest.ParentId)); err != nil {
+			return dto.CategoryResponse{}, _errors.NewNotFound("Parent category not found")
+		}
+	}
+
+	category, err := categoryService.categoryRepository.MoveCategory(categoryId, moveRequest.ParentId, moveRequest.SortOrder)
+	if err != nil {
+		if errors.Is(err, common.ErrCategoryCycle) {
+			return dto.CategoryResponse{}, _errors.NewBadRequest(err.Error())
+		}
+		if errors.Is(err, common.ErrCategoryNotFound) {
+			return dto.CategoryResponse{}, _errors.NewNotFound(err.Error())
+		}
+		return dto.CategoryResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToCategoryResponse(category), nil
+}
+
+// DeleteCategory moves a category without subcategories to the trash. Subcategories have to be moved or deleted
+// first so no live category ends up below a trashed one.
 func (categoryService *CategoryService) DeleteCategory(id uint) error {
-	return categoryService.categoryRepository.DeleteCategory(id)
+	subtree, err := categoryService.categoryRepository.GetSubtree(id)
+	if err != nil {
+		if errors.Is(err, common.ErrCategoryNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	if len(subtree) > 1 {
+		return _errors.NewBadRequest("Category has subcategories; move or delete them first")
+	}
+
+	if err := categoryService.categoryRepository.DeleteCategory(id); err != nil {
+		if errors.Is(err, common.ErrCategoryNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	return nil
 }
 
 func convertToCategoryResponse(category domain.Category) dto.CategoryResponse {
@@ -91,7 +183,62 @@ func convertToCategoryResponse(category domain.Category) dto.CategoryResponse {
 		Id:          category.Id,
 		Name:        category.Name,
 		Description: category.Description,
+		ParentId:    category.ParentId,
+		SortOrder:   category.SortOrder,
+		Depth:       category.Depth(),
+		DeletedAt:   category.DeletedAt,
+	}
+}
+
+// buildCategoryTree nests the categories below parentId, or below the roots when parentId is nil. Siblings keep
+// the order they are given in.
+func buildCategoryTree(categories []domain.Category, parentId *uint, activeOnly bool) []dto.CategoryTreeResponse {
+	childrenByParent := make(map[uint][]domain.Category)
+	for _, category := range categories {
+		var key uint
+		if category.ParentId != nil {
+			key = *category.ParentId
+		}
+		childrenByParent[key] = append(childrenByParent[key], category)
+	}
+
+	var nest func(parent uint) []dto.CategoryTreeResponse
+	nest = func(parent uint) []dto.CategoryTreeResponse {
+		nodes := make([]dto.CategoryTreeResponse, 0, len(childrenByParent[parent]))
+		for _, category := range childrenByParent[parent] {
+			if activeOnly && !category.IsActive {
+				continue
+			}
+			nodes = append(nodes, dto.CategoryTreeResponse{
+				Id:          category.Id,
+				Name:        category.Name,
+				Description: category.Description,
+				IsActive:    category.IsActive,
+				SortOrder:   category.SortOrder,
+				Children:    nest(category.Id),
+			})
+		}
+		return nodes
+	}
+
+	var root uint
+	if parentId != nil {
+		root = *parentId
+	}
+	return nest(root)
+}
+
+// categoryBreadcrumbs returns the path from the root down to the category. Categories on the path that are
+// missing from categoriesById are skipped.
+func categoryBreadcrumbs(category domain.Category, categoriesById map[uint]domain.Category) []dto.CategoryBreadcrumb {
+	pathIds := category.PathIds()
+	breadcrumbs := make([]dto.CategoryBreadcrumb, 0, len(pathIds))
+	for _, id := range pathIds {
+		if ancestor, ok := categoriesById[id]; ok {
+			breadcrumbs = append(breadcrumbs, dto.CategoryBreadcrumb{Id: ancestor.Id, Name: ancestor.Name})
+		}
 	}
+	return breadcrumbs
 }
 
 func convertCategoriesResponse(categories []domain.Category) []dto.CategoryResponse {
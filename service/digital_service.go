@@ -0,0 +1,318 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/infrastructure/storage"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/util"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const digitalFilePrefix = "products/"
+
+type IDigitalService interface {
+	GetFiles(userId int64, role string, productId int64) ([]dto.DigitalFileResponse, error)
+	UploadFile(userId int64, role string, productId int64, upload dto.UploadDigitalFileRequest) (dto.DigitalFileResponse, error)
+	DeleteFile(userId int64, role string, productId int64, fileId int64) error
+	GetLicenseKeyPool(userId int64, role string, productId int64) (dto.LicenseKeyPoolResponse, error)
+	AddLicenseKeys(userId int64, role string, productId int64, keysRequest dto.AddLicenseKeysRequest) (dto.LicenseKeyPoolResponse, error)
+	GetOrderDownloads(userId int64, orderId int64) (dto.OrderDownloadsResponse, error)
+	Download(token string) (dto.DownloadFile, error)
+	FulfillOrders() (domain.FulfillmentRun, error)
+}
+
+type DigitalService struct {
+	digitalRepository persistence.IDigitalRepository
+	productRepository persistence.IProductRepository
+	managers          productManagers
+	orderRepository   persistence.IOrderRepository
+	fileStorage       storage.IObjectStorage
+	validator         *rules.DigitalRules
+	digitalConfig     config.DigitalConfig
+}
+
+// NewDigitalService takes the private storage of digital files; it must not be the storage served as public media.
+func NewDigitalService(digitalRepository persistence.IDigitalRepository, productRepository persistence.IProductRepository,
+	storeRepository persistence.IStoreRepository, orderRepository persistence.IOrderRepository, fileStorage storage.IObjectStorage, digitalConfig config.DigitalConfig) IDigitalService {
+	return &DigitalService{
+		digitalRepository: digitalRepository,
+		productRepository: productRepository,
+		managers:          newProductManagers(productRepository, storeRepository),
+		orderRepository:   orderRepository,
+		fileStorage:       fileStorage,
+		validator:         rules.NewDigitalRules(),
+		digitalConfig:     digitalConfig,
+	}
+}
+
+func (digitalService *DigitalService) GetFiles(userId int64, role string, productId int64) ([]dto.DigitalFileResponse, error) {
+	if _, err := digitalService.managedDigitalProduct(userId, role, productId); err != nil {
+		return nil, err
+	}
+	files, err := digitalService.digitalRepository.GetFiles(productId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	responses := make([]dto.DigitalFileResponse, 0, len(files))
+	for _, file := range files {
+		responses = append(responses, convertToDigitalFileResponse(file))
+	}
+	return responses, nil
+}
+
+// UploadFile stores the file privately and attaches it to the digital product. Buyers of paid orders receive
+// it with the next fulfillment run, including buyers who ordered before the file was added.
+func (digitalService *DigitalService) UploadFile(userId int64, role string, productId int64, upload dto.UploadDigitalFileRequest) (dto.DigitalFileResponse, error) {
+	upload.FileName = path.Base(strings.ReplaceAll(strings.TrimSpace(upload.FileName), "\\", "/"))
+	if validationErr := digitalService.validator.ValidateUpload(upload); validationErr != nil {
+		return dto.DigitalFileResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := digitalService.managedDigitalProduct(userId, role, productId); err != nil {
+		return dto.DigitalFileResponse{}, err
+	}
+
+	contentType := upload.ContentType
+	content := upload.Content
+	if contentType == "" || contentType == "application/octet-stream" {
+		// Sniff the type from the first bytes and put them back in front of the rest of the stream.
+		head := make([]byte, 512)
+		read, readErr := io.ReadFull(upload.Content, head)
+		if readErr != nil && !errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return dto.DigitalFileResponse{}, _errors.NewBadRequest("File could not be read")
+		}
+		contentType = http.DetectContentType(head[:read])
+		content = io.MultiReader(bytes.NewReader(head[:read]), upload.Content)
+	}
+	file := domain.DigitalFile{
+		ProductId:   productId,
+		FileName:    upload.FileName,
+		StorageKey:  fmt.Sprintf("%s%d/%s%s", digitalFilePrefix, productId, uuid.NewString(), path.Ext(upload.FileName)),
+		ContentType: contentType,
+		SizeBytes:   upload.Size,
+	}
+	if putErr := digitalService.fileStorage.PutStream(file.StorageKey, content, upload.Size, contentType); putErr != nil {
+		return dto.DigitalFileResponse{}, _errors.NewInternalServerError(putErr)
+	}
+	added, err := digitalService.digitalRepository.AddFile(file)
+	if err != nil {
+		digitalService.deleteContent(file.StorageKey)
+		return dto.DigitalFileResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToDigitalFileResponse(added), nil
+}
+
+// DeleteFile removes the file together with the downloads granted for it.
+func (digitalService *DigitalService) DeleteFile(userId int64, role string, productId int64, fileId int64) error {
+	if _, err := digitalService.managedDigitalProduct(userId, role, productId); err != nil {
+		return err
+	}
+	file, err := digitalService.digitalRepository.DeleteFile(productId, fileId)
+	if err != nil {
+		if errors.Is(err, common.ErrDigitalFileNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	digitalService.deleteContent(file.StorageKey)
+	return nil
+}
+
+func (digitalService *DigitalService) GetLicenseKeyPool(userId int64, role string, productId int64) (dto.LicenseKeyPoolResponse, error) {
+	if _, err := digitalService.managedDigitalProduct(userId, role, productId); err != nil {
+		return dto.LicenseKeyPoolResponse{}, err
+	}
+	pool, err := digitalService.digitalRepository.GetLicenseKeyPool(productId)
+	if err != nil {
+		return dto.LicenseKeyPoolResponse{}, _errors.NewInternalServerError(err)
+	}
+	return dto.LicenseKeyPoolResponse{ProductId: productId, Available: pool.Available, Assigned: pool.Assigned}, nil
+}
+
+// AddLicenseKeys adds keys to the product's pool. Keys already in the pool are skipped, so a list can be
+// uploaded again after a failure.
+func (digitalService *DigitalService) AddLicenseKeys(userId int64, role string, productId int64, keysRequest dto.AddLicenseKeysRequest) (dto.LicenseKeyPoolResponse, error) {
+	keys, validationErr := digitalService.validator.ValidateLicenseKeys(keysRequest)
+	if validationErr != nil {
+		return dto.LicenseKeyPoolResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := digitalService.managedDigitalProduct(userId, role, productId); err != nil {
+		return dto.LicenseKeyPoolResponse{}, err
+	}
+	added, err := digitalService.digitalRepository.AddLicenseKeys(productId, keys)
+	if err != nil {
+		return dto.LicenseKeyPoolResponse{}, _errors.NewInternalServerError(err)
+	}
+	pool, err := digitalService.digitalRepository.GetLicenseKeyPool(productId)
+	if err != nil {
+		return dto.LicenseKeyPoolResponse{}, _errors.NewInternalServerError(err)
+	}
+	return dto.LicenseKeyPoolResponse{ProductId: productId, Added: added, Available: pool.Available, Assigned: pool.Assigned}, nil
+}
+
+// GetOrderDownloads lists the downloads and licence keys of the user's order, with a freshly signed URL for
+// every download that can still be used.
+func (digitalService *DigitalService) GetOrderDownloads(userId int64, orderId int64) (dto.OrderDownloadsResponse, error) {
+	order := digitalService.orderRepository.GetOrderById(orderId)
+	if order.Id == 0 || order.UserId != userId {
+		return dto.OrderDownloadsResponse{}, _errors.NewNotFound(common.ErrOrderNotFound.Error())
+	}
+	grants, err := digitalService.digitalRepository.GetOrderDownloads(orderId)
+	if err != nil {
+		return dto.OrderDownloadsResponse{}, _errors.NewInternalServerError(err)
+	}
+	keys, err := digitalService.digitalRepository.GetOrderLicenseKeys(orderId)
+	if err != nil {
+		return dto.OrderDownloadsResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	response := dto.OrderDownloadsResponse{
+		OrderId:     orderId,
+		Downloads:   make([]dto.DownloadResponse, 0, len(grants)),
+		LicenseKeys: make([]dto.LicenseKeyResponse, 0, len(keys)),
+	}
+	now := time.Now()
+	linkTTL := config.ParseDuration(digitalService.digitalConfig.LinkTTL, 15*time.Minute)
+	for _, grant := range grants {
+		download := dto.DownloadResponse{
+			OrderItemId:   grant.OrderItemId,
+			ProductId:     grant.File.ProductId,
+			FileId:        grant.FileId,
+			FileName:      grant.File.FileName,
+			SizeBytes:     grant.File.SizeBytes,
+			DownloadsLeft: grant.DownloadsLeft(),
+			ExpiresAt:     grant.ExpiresAt,
+		}
+		if grant.Available(now) {
+			urlExpiresAt := now.Add(linkTTL)
+			if grant.ExpiresAt.Before(urlExpiresAt) {
+				urlExpiresAt = grant.ExpiresAt
+			}
+			token := util.SignToken(digitalService.digitalConfig.DownloadSecret, strconv.FormatInt(grant.Id, 10), urlExpiresAt)
+			download.Url = strings.TrimRight(digitalService.digitalConfig.DownloadBaseUrl, "/") + "/" + token
+			download.UrlExpiresAt = &urlExpiresAt
+		}
+		response.Downloads = append(response.Downloads, download)
+	}
+	for _, key := range keys {
+		response.LicenseKeys = append(response.LicenseKeys, dto.LicenseKeyResponse{
+			OrderItemId: *key.OrderItemId,
+			ProductId:   key.ProductId,
+			LicenseKey:  key.Key,
+			AssignedAt:  key.AssignedAt,
+		})
+	}
+	return response, nil
+}
+
+// Download opens the file of a signed download link and counts the download. The file is opened before the
+// download is counted, so a storage failure does not use up a download.
+func (digitalService *DigitalService) Download(token string) (dto.DownloadFile, error) {
+	subject, tokenErr := util.VerifyToken(digitalService.digitalConfig.DownloadSecret, token)
+	if tokenErr != nil {
+		return dto.DownloadFile{}, _errors.NewBadRequest(tokenErr.Error())
+	}
+	grantId, parseErr := strconv.ParseInt(subject, 10, 64)
+	if parseErr != nil {
+		return dto.DownloadFile{}, _errors.NewBadRequest(util.ErrInvalidToken.Error())
+	}
+
+	grant, err := digitalService.digitalRepository.GetDownloadGrant(grantId)
+	if err != nil {
+		if errors.Is(err, common.ErrDownloadNotFound) {
+			return dto.DownloadFile{}, _errors.NewNotFound(err.Error())
+		}
+		return dto.DownloadFile{}, _errors.NewInternalServerError(err)
+	}
+	if !grant.Available(time.Now()) {
+		return dto.DownloadFile{}, _errors.NewBadRequest(common.ErrDownloadUnavailable.Error())
+	}
+	content, err := digitalService.fileStorage.Open(grant.File.StorageKey)
+	if err != nil {
+		return dto.DownloadFile{}, _errors.NewInternalServerError(err)
+	}
+	if _, err := digitalService.digitalRepository.RecordDownload(grantId); err != nil {
+		content.Close()
+		if errors.Is(err, common.ErrDownloadUnavailable) {
+			return dto.DownloadFile{}, _errors.NewBadRequest(err.Error())
+		}
+		return dto.DownloadFile{}, _errors.NewInternalServerError(err)
+	}
+	return dto.DownloadFile{FileName: grant.File.FileName, ContentType: grant.File.ContentType, Content: content}, nil
+}
+
+// FulfillOrders grants the downloads and assigns the licence keys of paid digital order lines that are
+// still missing them.
+func (digitalService *DigitalService) FulfillOrders() (domain.FulfillmentRun, error) {
+	run := domain.FulfillmentRun{}
+	expiry := config.ParseDuration(digitalService.digitalConfig.DownloadExpiry, 30*24*time.Hour)
+	downloadLimit := digitalService.digitalConfig.DownloadLimit
+	if downloadLimit <= 0 {
+		downloadLimit = 5
+	}
+
+	grants, err := digitalService.digitalRepository.GrantDownloads(downloadLimit, time.Now().Add(expiry))
+	if err != nil {
+		return run, err
+	}
+	run.Grants = grants
+	run.Keys, run.WaitingLines, err = digitalService.digitalRepository.AssignLicenseKeys()
+	return run, err
+}
+
+// digitalProduct loads the product and requires it to be digital.
+func (digitalService *DigitalService) digitalProduct(productId int64) (domain.Product, error) {
+	product, err := digitalService.productRepository.GetProductById(productId)
+	if err != nil {
+		return domain.Product{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	if product.ProductType != domain.ProductTypeDigital {
+		return domain.Product{}, _errors.NewBadRequest("Files and licence keys can only be added to digital products")
+	}
+	return product, nil
+}
+
+// managedDigitalProduct loads the digital product for a user managing its files and licence keys: an admin
+// or an owner of the product's store.
+func (digitalService *DigitalService) managedDigitalProduct(userId int64, role string, productId int64) (domain.Product, error) {
+	product, err := digitalService.digitalProduct(productId)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	if err := digitalService.managers.authorize(userId, role, product); err != nil {
+		return domain.Product{}, err
+	}
+	return product, nil
+}
+
+func (digitalService *DigitalService) deleteContent(storageKey string) {
+	if err := digitalService.fileStorage.Delete(storageKey); err != nil {
+		log.Error().Err(err).Str("key", storageKey).Msg("Digital file could not be deleted")
+	}
+}
+
+func convertToDigitalFileResponse(file domain.DigitalFile) dto.DigitalFileResponse {
+	return dto.DigitalFileResponse{
+		Id:          file.Id,
+		ProductId:   file.ProductId,
+		FileName:    file.FileName,
+		ContentType: file.ContentType,
+		SizeBytes:   file.SizeBytes,
+		CreatedAt:   file.CreatedAt,
+	}
+}
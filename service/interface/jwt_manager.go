@@ -5,6 +5,6 @@ import (
 )
 
 type JWTManager interface {
-	GenerateToken(userId int64, email string) (string, error)
+	GenerateToken(userId int64, email string, role string) (string, error)
 	ValidateToken(token string) (jwt2.Claims, error)
 }
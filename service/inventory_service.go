@@ -0,0 +1,332 @@
+package service
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const defaultStockMovementPageSize = 50
+
+type IInventoryService interface {
+	GetWarehouses() ([]dto.WarehouseResponse, error)
+	AddWarehouse(warehouseCreate dto.CreateWarehouseRequest) (dto.WarehouseResponse, error)
+	UpdateWarehouse(warehouseId int64, warehouseUpdate dto.CreateWarehouseRequest) (dto.WarehouseResponse, error)
+	GetProductStock(productId int64) (dto.ProductStockResponse, error)
+	GetMovements(productId int64, listRequest dto.StockMovementListRequest) ([]dto.StockMovementResponse, error)
+	RecordMovement(userId int64, role string, productId int64, movementCreate dto.CreateStockMovementRequest) (dto.StockMovementResponse, error)
+	TransferStock(userId int64, role string, productId int64, transfer dto.StockTransferRequest) ([]dto.StockMovementResponse, error)
+}
+
+type InventoryService struct {
+	inventoryRepository persistence.IInventoryRepository
+	productRepository   persistence.IProductRepository
+	variantRepository   persistence.IProductVariantRepository
+	managers            productManagers
+	validator           *rules.InventoryRules
+	redisClient         *redis.Client
+}
+
+func NewInventoryService(inventoryRepository persistence.IInventoryRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, storeRepository persistence.IStoreRepository, rdb *redis.Client) IInventoryService {
+	return &InventoryService{
+		inventoryRepository: inventoryRepository,
+		productRepository:   productRepository,
+		variantRepository:   variantRepository,
+		managers:            newProductManagers(productRepository, storeRepository),
+		validator:           rules.NewInventoryRules(),
+		redisClient:         rdb,
+	}
+}
+
+func (inventoryService *InventoryService) GetWarehouses() ([]dto.WarehouseResponse, error) {
+	warehouses, err := inventoryService.inventoryRepository.GetWarehouses()
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	response := make([]dto.WarehouseResponse, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		response = append(response, convertToWarehouseResponse(warehouse))
+	}
+	return response, nil
+}
+
+func (inventoryService *InventoryService) AddWarehouse(warehouseCreate dto.CreateWarehouseRequest) (dto.WarehouseResponse, error) {
+	if validationErr := inventoryService.validator.ValidateWarehouse(warehouseCreate); validationErr != nil {
+		return dto.WarehouseResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	warehouse, err := inventoryService.inventoryRepository.AddWarehouse(toWarehouse(warehouseCreate))
+	if err != nil {
+		return dto.WarehouseResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	return convertToWarehouseResponse(warehouse), nil
+}
+
+// UpdateWarehouse updates a warehouse. Deactivating it takes its stock out of the available-to-sell quantities.
+func (inventoryService *InventoryService) UpdateWarehouse(warehouseId int64, warehouseUpdate dto.CreateWarehouseRequest) (dto.WarehouseResponse, error) {
+	existing, err := inventoryService.inventoryRepository.GetWarehouseById(warehouseId)
+	if err != nil {
+		return dto.WarehouseResponse{}, _errors.NewNotFound(common.ErrWarehouseNotFound.Error())
+	}
+	if validationErr := inventoryService.validator.ValidateWarehouseUpdate(existing, warehouseUpdate); validationErr != nil {
+		return dto.WarehouseResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	warehouse, err := inventoryService.inventoryRepository.UpdateWarehouse(warehouseId, toWarehouse(warehouseUpdate))
+	if err != nil {
+		return dto.WarehouseResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	return convertToWarehouseResponse(warehouse), nil
+}
+
+// GetProductStock returns the stock of the product and its variants at every warehouse together with the
+// quantities available to sell, which leave inactive warehouses out.
+func (inventoryService *InventoryService) GetProductStock(productId int64) (dto.ProductStockResponse, error) {
+	if _, err := inventoryService.productRepository.GetProductById(productId); err != nil {
+		return dto.ProductStockResponse{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	levels, err := inventoryService.inventoryRepository.GetStockLevels(productId)
+	if err != nil {
+		return dto.ProductStockResponse{}, _errors.NewInternalServerError(err)
+	}
+	warehouses, err := inventoryService.inventoryRepository.GetWarehouses()
+	if err != nil {
+		return dto.ProductStockResponse{}, _errors.NewInternalServerError(err)
+	}
+	warehousesById := make(map[int64]domain.Warehouse, len(warehouses))
+	for _, warehouse := range warehouses {
+		warehousesById[warehouse.Id] = warehouse
+	}
+
+	stock := dto.ProductStockResponse{
+		ProductId: productId,
+		Variants:  []dto.VariantStockResponse{},
+		Locations: make([]dto.StockLevelResponse, 0, len(levels)),
+	}
+	variantIndex := make(map[int64]int)
+	for _, level := range levels {
+		warehouse := warehousesById[level.WarehouseId]
+		stock.Locations = append(stock.Locations, dto.StockLevelResponse{
+			WarehouseId:   level.WarehouseId,
+			WarehouseCode: warehouse.Code,
+			VariantId:     level.VariantId,
+			Quantity:      level.Quantity,
+			IsSellable:    warehouse.IsActive,
+			UpdatedAt:     level.UpdatedAt,
+		})
+
+		sellable := 0
+		if warehouse.IsActive {
+			sellable = level.Quantity
+		}
+		if level.VariantId == nil {
+			stock.AvailableToSell += sellable
+			continue
+		}
+		index, found := variantIndex[*level.VariantId]
+		if !found {
+			index = len(stock.Variants)
+			variantIndex[*level.VariantId] = index
+			stock.Variants = append(stock.Variants, dto.VariantStockResponse{VariantId: *level.VariantId})
+		}
+		stock.Variants[index].AvailableToSell += sellable
+	}
+	return stock, nil
+}
+
+func (inventoryService *InventoryService) GetMovements(productId int64, listRequest dto.StockMovementListRequest) ([]dto.StockMovementResponse, error) {
+	if validationErr := inventoryService.validator.ValidateMovementList(listRequest); validationErr != nil {
+		return nil, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := inventoryService.productRepository.GetProductById(productId); err != nil {
+		return nil, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	limit := listRequest.Limit
+	if limit == 0 {
+		limit = defaultStockMovementPageSize
+	}
+
+	movements, err := inventoryService.inventoryRepository.GetMovements(domain.StockMovementFilter{
+		ProductId:   productId,
+		WarehouseId: listRequest.WarehouseId,
+		Before:      listRequest.Before,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return convertToStockMovementsResponse(movements), nil
+}
+
+// RecordMovement books a receipt, sale, return or adjustment at a warehouse on behalf of an admin or an owner
+// of the product's store.
+func (inventoryService *InventoryService) RecordMovement(userId int64, role string, productId int64, movementCreate dto.CreateStockMovementRequest) (dto.StockMovementResponse, error) {
+	if validationErr := inventoryService.validator.ValidateMovement(movementCreate); validationErr != nil {
+		return dto.StockMovementResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	product, err := inventoryService.stockedProduct(userId, role, productId, movementCreate.VariantId)
+	if err != nil {
+		return dto.StockMovementResponse{}, err
+	}
+	if err := inventoryService.activeWarehouse(movementCreate.WarehouseId); err != nil {
+		return dto.StockMovementResponse{}, err
+	}
+
+	quantity := movementCreate.Quantity
+	if movementCreate.Type == domain.StockMovementSale {
+		quantity = -quantity
+	}
+	recorded, err := inventoryService.record(product, []domain.StockMovement{{
+		WarehouseId: movementCreate.WarehouseId,
+		ProductId:   productId,
+		VariantId:   movementCreate.VariantId,
+		Type:        movementCreate.Type,
+		Quantity:    quantity,
+		Reason:      movementCreate.Reason,
+		Reference:   movementCreate.Reference,
+		CreatedBy:   &userId,
+	}})
+	if err != nil {
+		return dto.StockMovementResponse{}, err
+	}
+	return convertToStockMovementResponse(recorded[0]), nil
+}
+
+// TransferStock moves stock between two warehouses. Both legs are booked together and share a reference.
+func (inventoryService *InventoryService) TransferStock(userId int64, role string, productId int64, transfer dto.StockTransferRequest) ([]dto.StockMovementResponse, error) {
+	if validationErr := inventoryService.validator.ValidateTransfer(transfer); validationErr != nil {
+		return nil, _errors.NewBadRequest(validationErr.Error())
+	}
+	product, err := inventoryService.stockedProduct(userId, role, productId, transfer.VariantId)
+	if err != nil {
+		return nil, err
+	}
+	for _, warehouseId := range []int64{transfer.FromWarehouseId, transfer.ToWarehouseId} {
+		if err := inventoryService.activeWarehouse(warehouseId); err != nil {
+			return nil, err
+		}
+	}
+
+	reference := "transfer:" + uuid.NewString()
+	leg := func(warehouseId int64, quantity int) domain.StockMovement {
+		return domain.StockMovement{
+			WarehouseId: warehouseId,
+			ProductId:   productId,
+			VariantId:   transfer.VariantId,
+			Type:        domain.StockMovementTransfer,
+			Quantity:    quantity,
+			Reason:      transfer.Reason,
+			Reference:   &reference,
+			CreatedBy:   &userId,
+		}
+	}
+	recorded, err := inventoryService.record(product, []domain.StockMovement{
+		leg(transfer.FromWarehouseId, -transfer.Quantity),
+		leg(transfer.ToWarehouseId, transfer.Quantity),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertToStockMovementsResponse(recorded), nil
+}
+
+// record books the movements and refreshes the cached and indexed copy of the product, whose stock changed.
+func (inventoryService *InventoryService) record(product domain.Product, movements []domain.StockMovement) ([]domain.StockMovement, error) {
+	recorded, err := inventoryService.inventoryRepository.RecordMovements(movements)
+	if err != nil {
+		if errors.Is(err, common.ErrInsufficientStock) || errors.Is(err, common.ErrBundleStock) {
+			return nil, _errors.NewBadRequest(err.Error())
+		}
+		return nil, _errors.NewInternalServerError(err)
+	}
+
+	if updated, err := inventoryService.productRepository.GetProductById(int64(product.Id)); err == nil {
+		product = updated
+	}
+	refreshProducts(inventoryService.productRepository, inventoryService.variantRepository, inventoryService.redisClient,
+		[]domain.Product{product})
+	return recorded, nil
+}
+
+// stockedProduct loads the product a movement is booked for, for a user allowed to book its stock, and checks
+// that the variant, if any, belongs to it.
+func (inventoryService *InventoryService) stockedProduct(userId int64, role string, productId int64, variantId *int64) (domain.Product, error) {
+	product, err := inventoryService.managers.product(userId, role, productId)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	if variantId != nil {
+		variant, err := inventoryService.variantRepository.GetVariantById(*variantId)
+		if err != nil || variant.ProductId != productId {
+			return domain.Product{}, _errors.NewNotFound(common.ErrProductVariantNotFound.Error())
+		}
+	}
+	return product, nil
+}
+
+func (inventoryService *InventoryService) activeWarehouse(warehouseId int64) error {
+	warehouse, err := inventoryService.inventoryRepository.GetWarehouseById(warehouseId)
+	if err != nil {
+		return _errors.NewNotFound(common.ErrWarehouseNotFound.Error())
+	}
+	if !warehouse.IsActive {
+		return _errors.NewBadRequest("Warehouse " + warehouse.Code + " is not active")
+	}
+	return nil
+}
+
+func toWarehouse(request dto.CreateWarehouseRequest) domain.Warehouse {
+	return domain.Warehouse{
+		Name:      strings.TrimSpace(request.Name),
+		Code:      strings.ToUpper(strings.TrimSpace(request.Code)),
+		Address:   request.Address,
+		IsDefault: request.IsDefault,
+		IsActive:  request.IsActive,
+	}
+}
+
+func convertToWarehouseResponse(warehouse domain.Warehouse) dto.WarehouseResponse {
+	return dto.WarehouseResponse{
+		Id:        warehouse.Id,
+		Name:      warehouse.Name,
+		Code:      warehouse.Code,
+		Address:   warehouse.Address,
+		IsDefault: warehouse.IsDefault,
+		IsActive:  warehouse.IsActive,
+		CreatedAt: warehouse.CreatedAt,
+		UpdatedAt: warehouse.UpdatedAt,
+	}
+}
+
+func convertToStockMovementResponse(movement domain.StockMovement) dto.StockMovementResponse {
+	return dto.StockMovementResponse{
+		Id:           movement.Id,
+		WarehouseId:  movement.WarehouseId,
+		ProductId:    movement.ProductId,
+		VariantId:    movement.VariantId,
+		Type:         movement.Type,
+		Quantity:     movement.Quantity,
+		BalanceAfter: movement.BalanceAfter,
+		Reason:       movement.Reason,
+		Reference:    movement.Reference,
+		CreatedBy:    movement.CreatedBy,
+		CreatedAt:    movement.CreatedAt,
+	}
+}
+
+func convertToStockMovementsResponse(movements []domain.StockMovement) []dto.StockMovementResponse {
+	response := make([]dto.StockMovementResponse, 0, len(movements))
+	for _, movement := range movements {
+		response = append(response, convertToStockMovementResponse(movement))
+	}
+	return response
+}
@@ -14,8 +14,8 @@ func NewJWTService() _interface.JWTManager {
 	return &JWTService{}
 }
 
-func (j *JWTService) GenerateToken(userId int64, email string) (string, error) {
-	return jwt.GenerateToken(userId, email)
+func (j *JWTService) GenerateToken(userId int64, email string, role string) (string, error) {
+	return jwt.GenerateToken(userId, email, role)
 }
 
 func (j *JWTService) ValidateToken(token string) (jwt2.Claims, error) {
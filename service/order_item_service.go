@@ -1,13 +1,20 @@
 package service
 
 import (
+	"errors"
 	"go-ecommerce-service/domain"
 	"go-ecommerce-service/internal/dto"
 	"go-ecommerce-service/internal/rules"
 	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
 	_errors "go-ecommerce-service/pkg/errors"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
 )
 
+const bundleLineLocked = "Bundle order lines cannot be changed; remove the line and add the bundle again"
+
 type IOrderItemService interface {
 	AddOrderItem(orderItemCreate dto.CreateOrderItemRequest) (dto.OrderItemResponse, error)
 	GetOrderItemById(orderItemId int64) (dto.OrderItemResponse, error)
@@ -21,13 +28,27 @@ type IOrderItemService interface {
 
 type OrderItemService struct {
 	orderItemRepository persistence.IOrderItemRepository
+	orderRepository     persistence.IOrderRepository
+	productRepository   persistence.IProductRepository
+	variantRepository   persistence.IProductVariantRepository
+	bundleRepository    persistence.IBundleRepository
 	validator           *rules.OrderItemRules
+	pricer              pricer
+	redisClient         *redis.Client
 }
 
-func NewOrderItemService(orderItemRepository persistence.IOrderItemRepository) IOrderItemService {
+func NewOrderItemService(orderItemRepository persistence.IOrderItemRepository, orderRepository persistence.IOrderRepository,
+	productRepository persistence.IProductRepository, variantRepository persistence.IProductVariantRepository,
+	ruleRepository persistence.IPriceRuleRepository, bundleRepository persistence.IBundleRepository, rdb *redis.Client) IOrderItemService {
 	return &OrderItemService{
 		orderItemRepository: orderItemRepository,
+		orderRepository:     orderRepository,
+		productRepository:   productRepository,
+		variantRepository:   variantRepository,
+		bundleRepository:    bundleRepository,
 		validator:           rules.NewOrderItemRules(),
+		pricer:              pricer{ruleRepository: ruleRepository},
+		redisClient:         rdb,
 	}
 }
 
@@ -35,25 +56,73 @@ func (orderItemService *OrderItemService) AddOrderItem(orderItemCreate dto.Creat
 	if validationErr := orderItemService.validator.ValidateStructure(orderItemCreate); validationErr != nil {
 		return dto.OrderItemResponse{}, _errors.NewBadRequest(validationErr.Error())
 	}
-
-	addedOrderItem, repositoryErr := orderItemService.orderItemRepository.AddOrderItem(domain.OrderItem{
+	price, priceErr := orderItemService.unitPrice(orderItemCreate)
+	if priceErr != nil {
+		return dto.OrderItemResponse{}, priceErr
+	}
+	orderItem := domain.OrderItem{
 		OrderId:   orderItemCreate.OrderId,
 		ProductId: orderItemCreate.ProductId,
+		VariantId: orderItemCreate.VariantId,
 		Quantity:  orderItemCreate.Quantity,
-		Price:     orderItemCreate.Price,
-	})
+		Price:     price,
+	}
+
+	bundle, bundleErr := orderItemService.bundleRepository.GetBundle(orderItemCreate.ProductId)
+	if bundleErr == nil {
+		return orderItemService.addBundleOrderItem(orderItem, bundle)
+	}
+	if !errors.Is(bundleErr, common.ErrBundleNotFound) {
+		return dto.OrderItemResponse{}, _errors.NewInternalServerError(bundleErr)
+	}
+
+	addedOrderItem, repositoryErr := orderItemService.orderItemRepository.AddOrderItem(orderItem)
 	if repositoryErr != nil {
 		return dto.OrderItemResponse{}, _errors.NewBadRequest(repositoryErr.Error())
 	}
+	orderItemService.refreshStock([]int64{addedOrderItem.ProductId})
 	return convertToOrderItemResponse(addedOrderItem), nil
 }
 
+// addBundleOrderItem orders a bundle as one line priced as the bundle, broken down into the component units
+// that ship. The component units are sold from stock right away; the bundle price is shared out over the
+// components by list price so that returns and reports can value each unit.
+func (orderItemService *OrderItemService) addBundleOrderItem(orderItem domain.OrderItem, bundle domain.ProductBundle) (dto.OrderItemResponse, error) {
+	if orderItem.VariantId != nil {
+		return dto.OrderItemResponse{}, _errors.NewBadRequest("A bundle is ordered without a variant")
+	}
+	shares := allocateBundlePrice(float64(orderItem.Price), bundle.Components)
+	components := make([]domain.OrderItemComponent, 0, len(bundle.Components))
+	for i, component := range bundle.Components {
+		components = append(components, domain.OrderItemComponent{
+			ProductId:      component.ProductId,
+			VariantId:      component.VariantId,
+			Quantity:       component.Quantity * orderItem.Quantity,
+			AllocatedPrice: shares[i],
+		})
+	}
+
+	addedOrderItem, components, repositoryErr := orderItemService.bundleRepository.AddBundleOrderItem(orderItem, components)
+	if repositoryErr != nil {
+		return dto.OrderItemResponse{}, _errors.NewBadRequest(repositoryErr.Error())
+	}
+	orderItemService.refreshComponents(components)
+
+	response := convertToOrderItemResponse(addedOrderItem)
+	response.Components = convertToOrderItemComponentsResponse(components)
+	return response, nil
+}
+
 func (orderItemService *OrderItemService) GetOrderItemById(orderItemId int64) (dto.OrderItemResponse, error) {
 	orderItem, repositoryErr := orderItemService.orderItemRepository.GetOrderItemById(orderItemId)
 	if repositoryErr != nil {
 		return dto.OrderItemResponse{}, _errors.NewBadRequest(repositoryErr.Error())
 	}
-	return convertToOrderItemResponse(orderItem), nil
+	responses, err := orderItemService.withComponents([]domain.OrderItem{orderItem})
+	if err != nil {
+		return dto.OrderItemResponse{}, err
+	}
+	return responses[0], nil
 }
 
 func (orderItemService *OrderItemService) GetOrderItemsByOrderId(orderId int64) ([]dto.OrderItemResponse, error) {
@@ -61,7 +130,7 @@ func (orderItemService *OrderItemService) GetOrderItemsByOrderId(orderId int64)
 	if repositoryErr != nil {
 		return []dto.OrderItemResponse{}, _errors.NewBadRequest(repositoryErr.Error())
 	}
-	return convertToOrderItemsResponse(orderItems), nil
+	return orderItemService.withComponents(orderItems)
 }
 
 func (orderItemService *OrderItemService) GetOrderItemsByProductId(productId int64) ([]dto.OrderItemResponse, error) {
@@ -70,61 +139,240 @@ func (orderItemService *OrderItemService) GetOrderItemsByProductId(productId int
 	if repositoryErr != nil {
 		return []dto.OrderItemResponse{}, _errors.NewBadRequest(repositoryErr.Error())
 	}
-	return convertToOrderItemsResponse(orderItems), nil
+	return orderItemService.withComponents(orderItems)
 }
 
 func (orderItemService *OrderItemService) UpdateOrderItem(orderItemId int64, orderItem dto.CreateOrderItemRequest) (dto.OrderItemResponse, error) {
 	if validationErr := orderItemService.validator.ValidateStructure(orderItem); validationErr != nil {
 		return dto.OrderItemResponse{}, _errors.NewBadRequest(validationErr.Error())
 	}
+	price, priceErr := orderItemService.unitPrice(orderItem)
+	if priceErr != nil {
+		return dto.OrderItemResponse{}, priceErr
+	}
+	previous, err := orderItemService.regularLine(orderItemId)
+	if err != nil {
+		return dto.OrderItemResponse{}, err
+	}
+	if _, bundleErr := orderItemService.bundleRepository.GetBundle(orderItem.ProductId); bundleErr == nil {
+		return dto.OrderItemResponse{}, _errors.NewBadRequest(bundleLineLocked)
+	} else if !errors.Is(bundleErr, common.ErrBundleNotFound) {
+		return dto.OrderItemResponse{}, _errors.NewInternalServerError(bundleErr)
+	}
 
 	updatedOrderItem, repositoryErr := orderItemService.orderItemRepository.UpdateOrderItem(orderItemId, domain.OrderItem{
 		Id:        orderItemId,
 		Quantity:  orderItem.Quantity,
-		Price:     orderItem.Price,
+		Price:     price,
 		OrderId:   orderItem.OrderId,
 		ProductId: orderItem.ProductId,
+		VariantId: orderItem.VariantId,
 	})
 	if repositoryErr != nil {
 		return dto.OrderItemResponse{}, _errors.NewBadRequest(repositoryErr.Error())
 	}
+	orderItemService.refreshStock([]int64{previous.ProductId, updatedOrderItem.ProductId})
 	return convertToOrderItemResponse(updatedOrderItem), nil
 }
 
 func (orderItemService *OrderItemService) UpdateOrderItemQuantity(orderItemId int64, quantity int) (dto.OrderItemResponse, error) {
+	if _, err := orderItemService.regularLine(orderItemId); err != nil {
+		return dto.OrderItemResponse{}, err
+	}
 	orderItem, repositoryErr := orderItemService.orderItemRepository.UpdateOrderItemQuantity(orderItemId, quantity)
 	if repositoryErr != nil {
 		return dto.OrderItemResponse{}, repositoryErr
 	}
+	orderItemService.refreshStock([]int64{orderItem.ProductId})
 	return convertToOrderItemResponse(orderItem), nil
 }
 
+// DeleteOrderItemById removes the line and returns its units, or the component units of an ordered bundle,
+// to stock.
 func (orderItemService *OrderItemService) DeleteOrderItemById(orderItemId int64) error {
-	repositoryErr := orderItemService.orderItemRepository.DeleteOrderItemById(orderItemId)
+	components, err := orderItemService.bundleRepository.GetOrderItemComponents([]int64{orderItemId})
+	if err != nil {
+		return _errors.NewInternalServerError(err)
+	}
+	if len(components[orderItemId]) > 0 {
+		if err := orderItemService.bundleRepository.DeleteBundleOrderItems([]int64{orderItemId}); err != nil {
+			return _errors.NewInternalServerError(err)
+		}
+		orderItemService.refreshComponents(components[orderItemId])
+		return nil
+	}
+
+	orderItem, repositoryErr := orderItemService.orderItemRepository.GetOrderItemById(orderItemId)
+	if repositoryErr != nil {
+		return repositoryErr
+	}
+	repositoryErr = orderItemService.orderItemRepository.DeleteOrderItemById(orderItemId)
 	if repositoryErr != nil {
 		return repositoryErr
 	}
+	orderItemService.refreshStock([]int64{orderItem.ProductId})
 	return nil
 }
 
 func (orderItemService *OrderItemService) DeleteAllOrderItemsByOrderId(orderId int64) error {
-	repositoryErr := orderItemService.orderItemRepository.DeleteAllOrderItemsByOrderId(orderId)
+	orderItems, repositoryErr := orderItemService.orderItemRepository.GetOrderItemsByOrderId(orderId)
+	if repositoryErr != nil {
+		return _errors.NewBadRequest(repositoryErr.Error())
+	}
+	orderItemIds := make([]int64, 0, len(orderItems))
+	for _, orderItem := range orderItems {
+		orderItemIds = append(orderItemIds, orderItem.Id)
+	}
+	components, err := orderItemService.bundleRepository.GetOrderItemComponents(orderItemIds)
+	if err != nil {
+		return _errors.NewInternalServerError(err)
+	}
+	if len(components) > 0 {
+		bundleItemIds := make([]int64, 0, len(components))
+		returned := make([]domain.OrderItemComponent, 0)
+		for orderItemId, itemComponents := range components {
+			bundleItemIds = append(bundleItemIds, orderItemId)
+			returned = append(returned, itemComponents...)
+		}
+		if err := orderItemService.bundleRepository.DeleteBundleOrderItems(bundleItemIds); err != nil {
+			return _errors.NewInternalServerError(err)
+		}
+		orderItemService.refreshComponents(returned)
+	}
+
+	repositoryErr = orderItemService.orderItemRepository.DeleteAllOrderItemsByOrderId(orderId)
 	if repositoryErr != nil {
 		return repositoryErr
 	}
+	productIds := make([]int64, 0, len(orderItems))
+	for _, orderItem := range orderItems {
+		if _, bundle := components[orderItem.Id]; !bundle {
+			productIds = append(productIds, orderItem.ProductId)
+		}
+	}
+	orderItemService.refreshStock(productIds)
 	return nil
 }
 
+// regularLine loads a line about to be changed. Ordered bundles are kept as they were sold: their component
+// units have left stock, so a changed line is ordered again instead.
+func (orderItemService *OrderItemService) regularLine(orderItemId int64) (domain.OrderItem, error) {
+	components, err := orderItemService.bundleRepository.GetOrderItemComponents([]int64{orderItemId})
+	if err != nil {
+		return domain.OrderItem{}, _errors.NewInternalServerError(err)
+	}
+	if len(components[orderItemId]) > 0 {
+		return domain.OrderItem{}, _errors.NewBadRequest(bundleLineLocked)
+	}
+	orderItem, err := orderItemService.orderItemRepository.GetOrderItemById(orderItemId)
+	if err != nil {
+		return domain.OrderItem{}, _errors.NewNotFound(err.Error())
+	}
+	return orderItem, nil
+}
+
+// withComponents converts the lines and attaches the breakdown of the ordered bundles among them.
+func (orderItemService *OrderItemService) withComponents(orderItems []domain.OrderItem) ([]dto.OrderItemResponse, error) {
+	responses := convertToOrderItemsResponse(orderItems)
+	if len(orderItems) == 0 {
+		return responses, nil
+	}
+	orderItemIds := make([]int64, 0, len(orderItems))
+	for _, orderItem := range orderItems {
+		orderItemIds = append(orderItemIds, orderItem.Id)
+	}
+	components, err := orderItemService.bundleRepository.GetOrderItemComponents(orderItemIds)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	for i := range responses {
+		if itemComponents, ok := components[responses[i].Id]; ok {
+			responses[i].Components = convertToOrderItemComponentsResponse(itemComponents)
+		}
+	}
+	return responses, nil
+}
+
+// refreshComponents refreshes the stock of the components an ordered bundle took or returned.
+func (orderItemService *OrderItemService) refreshComponents(components []domain.OrderItemComponent) {
+	productIds := make([]int64, 0, len(components))
+	for _, component := range components {
+		productIds = append(productIds, component.ProductId)
+	}
+	orderItemService.refreshStock(productIds)
+}
+
+// refreshStock brings the bundles in line with the stock that an order took or returned, and refreshes the
+// cached products and bundles.
+func (orderItemService *OrderItemService) refreshStock(productIds []int64) {
+	unique := make([]int64, 0, len(productIds))
+	seen := make(map[int64]bool)
+	for _, productId := range productIds {
+		if !seen[productId] {
+			seen[productId] = true
+			unique = append(unique, productId)
+		}
+	}
+	productIds = unique
+	changed, err := orderItemService.bundleRepository.RefreshBundles()
+	if err != nil {
+		log.Error().Err(err).Msg("Bundles could not be refreshed after an order change")
+	}
+	productIds = append(productIds, changed...)
+	refreshProductIds(orderItemService.productRepository, orderItemService.variantRepository, orderItemService.redisClient, productIds)
+}
+
+// unitPrice prices the product, or its variant, for the customer of the order.
+func (orderItemService *OrderItemService) unitPrice(orderItem dto.CreateOrderItemRequest) (float32, error) {
+	order := orderItemService.orderRepository.GetOrderById(orderItem.OrderId)
+	if order.Id == 0 {
+		return 0, _errors.NewNotFound(common.ErrOrderNotFound.Error())
+	}
+	product, err := orderItemService.productRepository.GetProductById(orderItem.ProductId)
+	if err != nil {
+		return 0, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+
+	var variant *domain.ProductVariant
+	if orderItem.VariantId != nil {
+		found, err := orderItemService.variantRepository.GetVariantById(*orderItem.VariantId)
+		if err != nil || found.ProductId != orderItem.ProductId {
+			return 0, _errors.NewNotFound(common.ErrProductVariantNotFound.Error())
+		}
+		variant = &found
+	}
+
+	calculator, err := orderItemService.pricer.calculator(order.UserId)
+	if err != nil {
+		return 0, _errors.NewInternalServerError(err)
+	}
+	return float32(calculator.UnitPrice(product, variant)), nil
+}
+
 func convertToOrderItemResponse(orderItem domain.OrderItem) dto.OrderItemResponse {
 	return dto.OrderItemResponse{
 		Id:        orderItem.Id,
 		OrderId:   orderItem.OrderId,
 		ProductId: orderItem.ProductId,
+		VariantId: orderItem.VariantId,
 		Quantity:  orderItem.Quantity,
 		Price:     orderItem.Price,
 	}
 }
 
+func convertToOrderItemComponentsResponse(components []domain.OrderItemComponent) []dto.OrderItemComponentResponse {
+	responses := make([]dto.OrderItemComponentResponse, 0, len(components))
+	for _, component := range components {
+		responses = append(responses, dto.OrderItemComponentResponse{
+			ProductId:      component.ProductId,
+			VariantId:      component.VariantId,
+			Quantity:       component.Quantity,
+			AllocatedPrice: component.AllocatedPrice,
+		})
+	}
+	return responses
+}
+
 func convertToOrderItemsResponse(orderItem []domain.OrderItem) []dto.OrderItemResponse {
 	orderItemsDto := make([]dto.OrderItemResponse, 0, len(orderItem))
 
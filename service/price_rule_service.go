@@ -0,0 +1,152 @@
+package service
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/util"
+)
+
+type IPriceRuleService interface {
+	GetPriceRules() ([]dto.PriceRuleResponse, error)
+	AddPriceRule(ruleCreate dto.CreatePriceRuleRequest) (dto.PriceRuleResponse, error)
+	DeletePriceRule(ruleId int64) error
+	SetCustomerGroup(userId int64, groupRequest dto.CustomerGroupRequest) error
+	QuoteProduct(productId int64, variantId *int64) (dto.PriceQuoteResponse, error)
+}
+
+type PriceRuleService struct {
+	ruleRepository    persistence.IPriceRuleRepository
+	productRepository persistence.IProductRepository
+	variantRepository persistence.IProductVariantRepository
+	validator         *rules.PriceRuleRules
+	pricer            pricer
+}
+
+func NewPriceRuleService(ruleRepository persistence.IPriceRuleRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository) IPriceRuleService {
+	return &PriceRuleService{
+		ruleRepository:    ruleRepository,
+		productRepository: productRepository,
+		variantRepository: variantRepository,
+		validator:         rules.NewPriceRuleRules(),
+		pricer:            pricer{ruleRepository: ruleRepository},
+	}
+}
+
+func (ruleService *PriceRuleService) GetPriceRules() ([]dto.PriceRuleResponse, error) {
+	priceRules, err := ruleService.ruleRepository.GetPriceRules()
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	responses := make([]dto.PriceRuleResponse, 0, len(priceRules))
+	for _, rule := range priceRules {
+		responses = append(responses, convertToPriceRuleResponse(rule))
+	}
+	return responses, nil
+}
+
+func (ruleService *PriceRuleService) AddPriceRule(ruleCreate dto.CreatePriceRuleRequest) (dto.PriceRuleResponse, error) {
+	if validationErr := ruleService.validator.ValidateCreate(ruleCreate); validationErr != nil {
+		return dto.PriceRuleResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	customerGroup := ruleCreate.CustomerGroup
+	if customerGroup != nil {
+		group := util.GenerateSlug(*customerGroup)
+		customerGroup = &group
+	}
+	rule, err := ruleService.ruleRepository.AddPriceRule(domain.PriceRule{
+		Name:          ruleCreate.Name,
+		Kind:          ruleCreate.Kind,
+		CustomerGroup: customerGroup,
+		ProductId:     ruleCreate.ProductId,
+		StoreId:       ruleCreate.StoreId,
+		CategoryId:    ruleCreate.CategoryId,
+		PercentOff:    ruleCreate.PercentOff,
+		StartsAt:      ruleCreate.StartsAt,
+		EndsAt:        ruleCreate.EndsAt,
+		IsActive:      ruleCreate.IsActive,
+	})
+	if err != nil {
+		return dto.PriceRuleResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	return convertToPriceRuleResponse(rule), nil
+}
+
+func (ruleService *PriceRuleService) DeletePriceRule(ruleId int64) error {
+	if err := ruleService.ruleRepository.DeletePriceRule(ruleId); err != nil {
+		if errors.Is(err, common.ErrPriceRuleNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func (ruleService *PriceRuleService) SetCustomerGroup(userId int64, groupRequest dto.CustomerGroupRequest) error {
+	if validationErr := ruleService.validator.ValidateCustomerGroup(groupRequest); validationErr != nil {
+		return _errors.NewBadRequest(validationErr.Error())
+	}
+	if err := ruleService.ruleRepository.SetCustomerGroup(userId, util.GenerateSlug(groupRequest.CustomerGroup)); err != nil {
+		if errors.Is(err, common.ErrUserNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	return nil
+}
+
+// QuoteProduct prices one unit of the product, or of its variant, for anonymous shoppers.
+func (ruleService *PriceRuleService) QuoteProduct(productId int64, variantId *int64) (dto.PriceQuoteResponse, error) {
+	product, err := ruleService.productRepository.GetProductById(productId)
+	if err != nil {
+		return dto.PriceQuoteResponse{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	var variant *domain.ProductVariant
+	if variantId != nil {
+		found, err := ruleService.variantRepository.GetVariantById(*variantId)
+		if err != nil || found.ProductId != productId {
+			return dto.PriceQuoteResponse{}, _errors.NewNotFound(common.ErrProductVariantNotFound.Error())
+		}
+		variant = &found
+	}
+
+	calculator, err := ruleService.pricer.calculator(0)
+	if err != nil {
+		return dto.PriceQuoteResponse{}, _errors.NewInternalServerError(err)
+	}
+	quote := calculator.Quote(product, variant)
+	return dto.PriceQuoteResponse{
+		ProductId:       productId,
+		VariantId:       variantId,
+		CustomerGroup:   domain.CustomerGroupRetail,
+		BasePrice:       quote.BasePrice,
+		ProductDiscount: quote.ProductDiscount,
+		SaleDiscount:    quote.SaleDiscount,
+		GroupDiscount:   quote.GroupDiscount,
+		Price:           quote.Price,
+		AppliedRuleIds:  quote.AppliedRuleIds,
+	}, nil
+}
+
+func convertToPriceRuleResponse(rule domain.PriceRule) dto.PriceRuleResponse {
+	return dto.PriceRuleResponse{
+		Id:            rule.Id,
+		Name:          rule.Name,
+		Kind:          rule.Kind,
+		CustomerGroup: rule.CustomerGroup,
+		ProductId:     rule.ProductId,
+		StoreId:       rule.StoreId,
+		CategoryId:    rule.CategoryId,
+		PercentOff:    rule.PercentOff,
+		StartsAt:      rule.StartsAt,
+		EndsAt:        rule.EndsAt,
+		IsActive:      rule.IsActive,
+		CreatedAt:     rule.CreatedAt,
+	}
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/pricing"
+	"go-ecommerce-service/persistence"
+	"time"
+)
+
+// pricer builds price calculators with the rules active right now, so product, cart and order code
+// all charge the same price for a unit.
+type pricer struct {
+	ruleRepository persistence.IPriceRuleRepository
+}
+
+// calculator returns a calculator for the customer group of the user; userId 0 prices for anonymous
+// shoppers, who get the retail group.
+func (p pricer) calculator(userId int64) (pricing.Calculator, error) {
+	rules, err := p.ruleRepository.GetActiveRules(time.Now())
+	if err != nil {
+		return pricing.Calculator{}, err
+	}
+
+	customerGroup := domain.CustomerGroupRetail
+	if userId != 0 {
+		if customerGroup, err = p.ruleRepository.GetCustomerGroup(userId); err != nil {
+			return pricing.Calculator{}, err
+		}
+	}
+
+	categoryPaths, err := p.categoryPaths(rules)
+	if err != nil {
+		return pricing.Calculator{}, err
+	}
+	return pricing.NewCalculator(rules, customerGroup, categoryPaths), nil
+}
+
+// categoryPaths loads the paths the category rules need to reach products in subcategories; it skips the
+// query when no rule is limited to a category.
+func (p pricer) categoryPaths(rules []domain.PriceRule) (map[uint]string, error) {
+	categoryIds := make([]uint, 0)
+	for _, rule := range rules {
+		if rule.CategoryId != nil {
+			categoryIds = append(categoryIds, *rule.CategoryId)
+		}
+	}
+	if len(categoryIds) == 0 {
+		return map[uint]string{}, nil
+	}
+	return p.ruleRepository.GetCategoryPaths(categoryIds)
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+type IProductAttributeService interface {
+	GetCategoryAttributes(categoryId int64) ([]dto.CategoryAttributeResponse, error)
+	AddAttribute(categoryId int64, attributeCreate dto.CreateCategoryAttributeRequest) (dto.CategoryAttributeResponse, error)
+	UpdateAttribute(categoryId int64, attributeId int64, attributeUpdate dto.UpdateCategoryAttributeRequest) (dto.CategoryAttributeResponse, error)
+	DeleteAttribute(categoryId int64, attributeId int64) error
+	GetFacets(categoryId int64) ([]dto.AttributeFacetResponse, error)
+}
+
+type ProductAttributeService struct {
+	attributeRepository persistence.IProductAttributeRepository
+	categoryRepository  persistence.ICategoryRepository
+	productRepository   persistence.IProductRepository
+	variantRepository   persistence.IProductVariantRepository
+	redisClient         *redis.Client
+	validator           *rules.ProductAttributeRules
+}
+
+func NewProductAttributeService(attributeRepository persistence.IProductAttributeRepository, categoryRepository persistence.ICategoryRepository,
+	productRepository persistence.IProductRepository, variantRepository persistence.IProductVariantRepository, redisClient *redis.Client) IProductAttributeService {
+	return &ProductAttributeService{
+		attributeRepository: attributeRepository,
+		categoryRepository:  categoryRepository,
+		productRepository:   productRepository,
+		variantRepository:   variantRepository,
+		redisClient:         redisClient,
+		validator:           rules.NewProductAttributeRules(),
+	}
+}
+
+func (attributeService *ProductAttributeService) GetCategoryAttributes(categoryId int64) ([]dto.CategoryAttributeResponse, error) {
+	if err := attributeService.ensureCategory(categoryId); err != nil {
+		return nil, err
+	}
+	attributes, err := attributeService.attributeRepository.GetCategoryAttributes(categoryId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+
+	response := make([]dto.CategoryAttributeResponse, 0, len(attributes))
+	for _, attribute := range attributes {
+		response = append(response, convertToCategoryAttributeResponse(attribute))
+	}
+	return response, nil
+}
+
+func (attributeService *ProductAttributeService) AddAttribute(categoryId int64, attributeCreate dto.CreateCategoryAttributeRequest) (dto.CategoryAttributeResponse, error) {
+	attributeCreate.Code = strings.TrimSpace(attributeCreate.Code)
+	attributeCreate.Name = strings.TrimSpace(attributeCreate.Name)
+	if validationErr := attributeService.validator.ValidateCreate(attributeCreate); validationErr != nil {
+		return dto.CategoryAttributeResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if err := attributeService.ensureCategory(categoryId); err != nil {
+		return dto.CategoryAttributeResponse{}, err
+	}
+
+	attributes, err := attributeService.attributeRepository.GetCategoryAttributes(categoryId)
+	if err != nil {
+		return dto.CategoryAttributeResponse{}, _errors.NewInternalServerError(err)
+	}
+	for _, attribute := range attributes {
+		if attribute.Code == attributeCreate.Code {
+			return dto.CategoryAttributeResponse{}, _errors.NewBadRequest("Attribute code already exists in the category")
+		}
+	}
+
+	added, err := attributeService.attributeRepository.AddAttribute(domain.CategoryAttribute{
+		CategoryId:   categoryId,
+		Code:         attributeCreate.Code,
+		Name:         attributeCreate.Name,
+		Type:         attributeCreate.Type,
+		Unit:         strings.TrimSpace(attributeCreate.Unit),
+		Options:      nonNilOptions(attributeCreate.Options),
+		IsRequired:   attributeCreate.IsRequired,
+		IsFilterable: attributeCreate.IsFilterable,
+		Position:     attributeCreate.Position,
+	})
+	if err != nil {
+		return dto.CategoryAttributeResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToCategoryAttributeResponse(added), nil
+}
+
+// UpdateAttribute changes the definition of an attribute. Its code and type cannot change; making it
+// required applies to products the next time they are saved.
+func (attributeService *ProductAttributeService) UpdateAttribute(categoryId int64, attributeId int64, attributeUpdate dto.UpdateCategoryAttributeRequest) (dto.CategoryAttributeResponse, error) {
+	attribute, err := attributeService.categoryAttribute(categoryId, attributeId)
+	if err != nil {
+		return dto.CategoryAttributeResponse{}, err
+	}
+	usedValues := []string{}
+	if attribute.Type == domain.AttributeTypeEnum {
+		if usedValues, err = attributeService.attributeRepository.GetUsedValues(attributeId); err != nil {
+			return dto.CategoryAttributeResponse{}, _errors.NewInternalServerError(err)
+		}
+	}
+	attributeUpdate.Name = strings.TrimSpace(attributeUpdate.Name)
+	if validationErr := attributeService.validator.ValidateUpdate(attributeUpdate, attribute, usedValues); validationErr != nil {
+		return dto.CategoryAttributeResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	attribute.Name = attributeUpdate.Name
+	attribute.Unit = strings.TrimSpace(attributeUpdate.Unit)
+	attribute.Options = nonNilOptions(attributeUpdate.Options)
+	attribute.IsRequired = attributeUpdate.IsRequired
+	attribute.IsFilterable = attributeUpdate.IsFilterable
+	attribute.Position = attributeUpdate.Position
+	updated, err := attributeService.attributeRepository.UpdateAttribute(attributeId, attribute)
+	if err != nil {
+		return dto.CategoryAttributeResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToCategoryAttributeResponse(updated), nil
+}
+
+// DeleteAttribute removes the attribute and its values, then refreshes the products that had a value.
+func (attributeService *ProductAttributeService) DeleteAttribute(categoryId int64, attributeId int64) error {
+	if _, err := attributeService.categoryAttribute(categoryId, attributeId); err != nil {
+		return err
+	}
+	productIds, err := attributeService.attributeRepository.DeleteAttribute(attributeId)
+	if err != nil {
+		return _errors.NewInternalServerError(err)
+	}
+
+	products := make([]domain.Product, 0, len(productIds))
+	for _, productId := range productIds {
+		product, err := attributeService.productRepository.GetProductById(productId)
+		if err != nil {
+			log.Error().Err(err).Int64("product_id", productId).Msg("Product could not be reloaded after an attribute was deleted")
+			continue
+		}
+		products = append(products, product)
+	}
+	refreshProducts(attributeService.productRepository, attributeService.variantRepository, attributeService.redisClient, products)
+	return nil
+}
+
+func (attributeService *ProductAttributeService) GetFacets(categoryId int64) ([]dto.AttributeFacetResponse, error) {
+	if err := attributeService.ensureCategory(categoryId); err != nil {
+		return nil, err
+	}
+	attributes, err := attributeService.attributeRepository.GetCategoryAttributes(categoryId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	facets, err := attributeService.attributeRepository.GetFacets(categoryId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+
+	facetsByAttribute := make(map[int64][]domain.AttributeFacet)
+	for _, facet := range facets {
+		facetsByAttribute[facet.AttributeId] = append(facetsByAttribute[facet.AttributeId], facet)
+	}
+	response := []dto.AttributeFacetResponse{}
+	for _, attribute := range attributes {
+		attributeFacets := facetsByAttribute[attribute.Id]
+		if !attribute.IsFilterable || len(attributeFacets) == 0 {
+			continue
+		}
+		facet := dto.AttributeFacetResponse{Code: attribute.Code, Name: attribute.Name, Type: attribute.Type, Unit: attribute.Unit}
+		for _, value := range attributeFacets {
+			facet.Count += value.Count
+			if attribute.Type == domain.AttributeTypeNumber {
+				facet.Min, facet.Max = value.MinNumber, value.MaxNumber
+				continue
+			}
+			facet.Values = append(facet.Values, dto.AttributeFacetValueResponse{Value: value.Value, Count: value.Count})
+		}
+		response = append(response, facet)
+	}
+	return response, nil
+}
+
+func (attributeService *ProductAttributeService) ensureCategory(categoryId int64) error {
+	if _, err := attributeService.categoryRepository.GetCategoryById(int(categoryId)); err != nil {
+		return _errors.NewNotFound(common.ErrCategoryNotFound.Error())
+	}
+	return nil
+}
+
+// categoryAttribute loads an attribute and checks that it belongs to the category.
+func (attributeService *ProductAttributeService) categoryAttribute(categoryId int64, attributeId int64) (domain.CategoryAttribute, error) {
+	attribute, err := attributeService.attributeRepository.GetAttributeById(attributeId)
+	if err != nil || attribute.CategoryId != categoryId {
+		return domain.CategoryAttribute{}, _errors.NewNotFound(common.ErrAttributeNotFound.Error())
+	}
+	return attribute, nil
+}
+
+func nonNilOptions(options []string) []string {
+	if options == nil {
+		return []string{}
+	}
+	return options
+}
+
+func convertToCategoryAttributeResponse(attribute domain.CategoryAttribute) dto.CategoryAttributeResponse {
+	return dto.CategoryAttributeResponse{
+		Id:           attribute.Id,
+		CategoryId:   attribute.CategoryId,
+		Code:         attribute.Code,
+		Name:         attribute.Name,
+		Type:         attribute.Type,
+		Unit:         attribute.Unit,
+		Options:      attribute.Options,
+		IsRequired:   attribute.IsRequired,
+		IsFilterable: attribute.IsFilterable,
+		Position:     attribute.Position,
+		CreatedAt:    attribute.CreatedAt,
+		UpdatedAt:    attribute.UpdatedAt,
+	}
+}
+
+func convertToProductAttributesResponse(values []domain.ProductAttributeValue) []dto.ProductAttributeResponse {
+	if len(values) == 0 {
+		return nil
+	}
+	response := make([]dto.ProductAttributeResponse, 0, len(values))
+	for _, value := range values {
+		response = append(response, dto.ProductAttributeResponse{
+			Code:  value.Code,
+			Name:  value.Name,
+			Type:  value.Type,
+			Unit:  value.Unit,
+			Value: value.Value(),
+		})
+	}
+	return response
+}
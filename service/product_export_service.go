@@ -0,0 +1,138 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence"
+	_errors "go-ecommerce-service/pkg/errors"
+	"io"
+	"time"
+)
+
+type IProductExportService interface {
+	ExportFile(exportRequest dto.ProductExportRequest) (dto.ProductExportFile, error)
+	WriteExport(exportRequest dto.ProductExportRequest, w io.Writer) error
+}
+
+type ProductExportService struct {
+	productRepository persistence.IProductRepository
+	variantRepository persistence.IProductVariantRepository
+	exportConfig      config.ExportConfig
+}
+
+func NewProductExportService(productRepository persistence.IProductRepository, variantRepository persistence.IProductVariantRepository,
+	exportConfig config.ExportConfig) IProductExportService {
+	if exportConfig.BatchSize <= 0 {
+		exportConfig.BatchSize = 500
+	}
+	return &ProductExportService{
+		productRepository: productRepository,
+		variantRepository: variantRepository,
+		exportConfig:      exportConfig,
+	}
+}
+
+// ExportFile returns the content type and file name of an export so they can be sent before streaming starts.
+func (exportService *ProductExportService) ExportFile(exportRequest dto.ProductExportRequest) (dto.ProductExportFile, error) {
+	fileName := "products-" + time.Now().Format("20060102")
+	switch exportRequest.Format {
+	case exportFormatCsv:
+		return dto.ProductExportFile{ContentType: "text/csv; charset=utf-8", FileName: fileName + ".csv"}, nil
+	case exportFormatJsonLines:
+		return dto.ProductExportFile{ContentType: "application/x-ndjson", FileName: fileName + ".jsonl"}, nil
+	case exportFormatGoogleFeed:
+		return dto.ProductExportFile{ContentType: "application/xml; charset=utf-8", FileName: fileName + ".xml"}, nil
+	default:
+		return dto.ProductExportFile{}, _errors.NewBadRequest("Format must be one of csv, jsonl, google")
+	}
+}
+
+// WriteExport streams the filtered catalog to w. Products are read in keyset pages of the configured
+// batch size, so memory use does not grow with the catalog. The Google feed only lists active products
+// unless the request asks otherwise.
+func (exportService *ProductExportService) WriteExport(exportRequest dto.ProductExportRequest, w io.Writer) error {
+	if _, err := exportService.ExportFile(exportRequest); err != nil {
+		return err
+	}
+
+	buffered := bufio.NewWriter(w)
+	var exportWriter productExportWriter
+	switch exportRequest.Format {
+	case exportFormatCsv:
+		exportWriter = &csvExportWriter{writer: csv.NewWriter(buffered)}
+	case exportFormatJsonLines:
+		exportWriter = &jsonLinesExportWriter{encoder: json.NewEncoder(buffered)}
+	default:
+		exportWriter = newGoogleFeedWriter(buffered, exportService.exportConfig.SiteBaseUrl, exportService.exportConfig.Currency)
+		if exportRequest.IsActive == nil {
+			active := true
+			exportRequest.IsActive = &active
+		}
+	}
+
+	filter := domain.ProductFilter{
+		StoreId:  exportRequest.StoreId,
+		IsActive: exportRequest.IsActive,
+		Sort:     domain.ProductSortNewest,
+		Limit:    exportService.exportConfig.BatchSize,
+	}
+	if exportRequest.CategoryId != nil {
+		filter.CategoryIds = []uint{*exportRequest.CategoryId}
+	}
+
+	if err := exportWriter.begin(); err != nil {
+		return err
+	}
+	for {
+		products, err := exportService.productRepository.GetProducts(filter)
+		if err != nil {
+			return _errors.NewInternalServerError(err)
+		}
+		if len(products) == 0 {
+			break
+		}
+
+		products, err = exportService.withVariants(products)
+		if err != nil {
+			return _errors.NewInternalServerError(err)
+		}
+		for _, product := range products {
+			if err := exportWriter.write(product); err != nil {
+				return err
+			}
+		}
+		if err := buffered.Flush(); err != nil {
+			return err
+		}
+
+		if len(products) < filter.Limit {
+			break
+		}
+		last := products[len(products)-1]
+		filter.After = &domain.ProductCursor{Id: last.Id, CreatedAt: last.CreatedAt}
+	}
+	if err := exportWriter.end(); err != nil {
+		return err
+	}
+	return buffered.Flush()
+}
+
+func (exportService *ProductExportService) withVariants(products []domain.Product) ([]domain.Product, error) {
+	productIds := make([]int64, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, int64(product.Id))
+	}
+
+	variantsByProduct, err := exportService.variantRepository.GetVariantsByProductIds(productIds)
+	if err != nil {
+		return nil, err
+	}
+	for i := range products {
+		products[i].Variants = variantsByProduct[int64(products[i].Id)]
+	}
+	return products, nil
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	exportFormatCsv        = "csv"
+	exportFormatJsonLines  = "jsonl"
+	exportFormatGoogleFeed = "google"
+)
+
+// productExportWriter writes products to an export stream one at a time so an export never holds
+// more than one batch in memory.
+type productExportWriter interface {
+	begin() error
+	write(product domain.Product) error
+	end() error
+}
+
+// csvExportColumns use the import field names so an exported file can be imported again.
+var csvExportColumns = []string{
+	"id", "sku", "name", "slug", "description", "price", "base_price", "discount", "image_url", "meta_description",
+	"stock_quantity", "is_active", "is_featured", "category_id", "store_id", "created_at", "updated_at",
+}
+
+type csvExportWriter struct {
+	writer *csv.Writer
+}
+
+func (exportWriter *csvExportWriter) begin() error {
+	return exportWriter.writer.Write(csvExportColumns)
+}
+
+func (exportWriter *csvExportWriter) write(product domain.Product) error {
+	categoryId := ""
+	if product.CategoryId != nil {
+		categoryId = strconv.FormatUint(uint64(*product.CategoryId), 10)
+	}
+	return exportWriter.writer.Write([]string{
+		strconv.FormatUint(uint64(product.Id), 10),
+		stringValue(product.Sku),
+		product.Name,
+		product.Slug,
+		product.Description,
+		formatPrice(product.Price),
+		formatPrice(product.BasePrice),
+		formatPrice(product.Discount),
+		product.ImageUrl,
+		product.MetaDescription,
+		strconv.Itoa(product.StockQuantity),
+		strconv.FormatBool(product.IsActive),
+		strconv.FormatBool(product.IsFeatured),
+		categoryId,
+		strconv.FormatUint(uint64(product.StoreId), 10),
+		product.CreatedAt.Format(time.RFC3339),
+		product.UpdatedAt.Format(time.RFC3339),
+	})
+}
+
+func (exportWriter *csvExportWriter) end() error {
+	exportWriter.writer.Flush()
+	return exportWriter.writer.Error()
+}
+
+// jsonLinesExportWriter writes one product response per line, variants included.
+type jsonLinesExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (exportWriter *jsonLinesExportWriter) begin() error {
+	return nil
+}
+
+func (exportWriter *jsonLinesExportWriter) write(product domain.Product) error {
+	return exportWriter.encoder.Encode(convertToProductResponse(product))
+}
+
+func (exportWriter *jsonLinesExportWriter) end() error {
+	return nil
+}
+
+// googleFeedItem is an item of a Google Merchant Center RSS 2.0 feed.
+type googleFeedItem struct {
+	XMLName      xml.Name `xml:"item"`
+	Id           string   `xml:"g:id"`
+	Title        string   `xml:"title"`
+	Description  string   `xml:"description"`
+	Link         string   `xml:"link"`
+	ImageLink    string   `xml:"g:image_link,omitempty"`
+	Price        string   `xml:"g:price"`
+	SalePrice    string   `xml:"g:sale_price,omitempty"`
+	Availability string   `xml:"g:availability"`
+	Condition    string   `xml:"g:condition"`
+	Gtin         string   `xml:"g:gtin,omitempty"`
+	ItemGroupId  string   `xml:"g:item_group_id,omitempty"`
+}
+
+// googleFeedWriter writes a Google Shopping feed. Products with active variants are written as one
+// item per variant grouped by the product, the way Merchant Center expects variant products.
+type googleFeedWriter struct {
+	w        io.Writer
+	encoder  *xml.Encoder
+	baseUrl  string
+	currency string
+}
+
+func newGoogleFeedWriter(w io.Writer, baseUrl string, currency string) *googleFeedWriter {
+	return &googleFeedWriter{w: w, encoder: xml.NewEncoder(w), baseUrl: strings.TrimRight(baseUrl, "/"), currency: currency}
+}
+
+func (exportWriter *googleFeedWriter) begin() error {
+	_, err := io.WriteString(exportWriter.w, xml.Header+`<rss version="2.0" xmlns:g="http://base.google.com/ns/1.0"><channel>`)
+	if err != nil {
+		return err
+	}
+	return exportWriter.encoder.Encode(struct {
+		XMLName xml.Name `xml:"title"`
+		Value   string   `xml:",chardata"`
+	}{Value: "Product feed"})
+}
+
+func (exportWriter *googleFeedWriter) write(product domain.Product) error {
+	link := exportWriter.baseUrl + "/products/" + product.Slug
+	item := googleFeedItem{
+		Id:          strconv.FormatUint(uint64(product.Id), 10),
+		Title:       product.Name,
+		Description: product.Description,
+		Link:        link,
+		ImageLink:   product.ImageUrl,
+		Condition:   "new",
+	}
+	if product.Sku != nil {
+		item.Id = *product.Sku
+	}
+
+	variants := activeVariants(product.Variants)
+	if len(variants) == 0 {
+		exportWriter.setPrice(&item, product.BasePrice, product.Price)
+		item.Availability = availability(product.StockQuantity)
+		return exportWriter.encoder.Encode(item)
+	}
+
+	for _, variant := range variants {
+		variantItem := item
+		variantItem.Id = variant.Sku
+		variantItem.ItemGroupId = item.Id
+		variantItem.Title = variantTitle(product.Name, variant)
+		variantItem.Link = fmt.Sprintf("%s?variant=%d", link, variant.Id)
+		if variant.ImageUrl != nil {
+			variantItem.ImageLink = *variant.ImageUrl
+		}
+		variantItem.Gtin = stringValue(variant.Barcode)
+		exportWriter.setPrice(&variantItem, product.BasePrice, variant.EffectivePrice(product.Price))
+		variantItem.Availability = availability(variant.StockQuantity)
+		if err := exportWriter.encoder.Encode(variantItem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exportWriter *googleFeedWriter) end() error {
+	if err := exportWriter.encoder.Flush(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(exportWriter.w, "</channel></rss>\n")
+	return err
+}
+
+// setPrice uses the base price as the list price and the selling price as the sale price when it is lower.
+func (exportWriter *googleFeedWriter) setPrice(item *googleFeedItem, basePrice float64, price float64) {
+	if basePrice > price {
+		item.Price = formatPrice(basePrice) + " " + exportWriter.currency
+		item.SalePrice = formatPrice(price) + " " + exportWriter.currency
+		return
+	}
+	item.Price = formatPrice(price) + " " + exportWriter.currency
+}
+
+func activeVariants(variants []domain.ProductVariant) []domain.ProductVariant {
+	active := make([]domain.ProductVariant, 0, len(variants))
+	for _, variant := range variants {
+		if variant.IsActive {
+			active = append(active, variant)
+		}
+	}
+	return active
+}
+
+func variantTitle(productName string, variant domain.ProductVariant) string {
+	values := make([]string, 0, len(variant.Options))
+	for _, option := range variant.Options {
+		values = append(values, option.Value)
+	}
+	if len(values) == 0 {
+		return productName
+	}
+	return productName + " - " + strings.Join(values, " / ")
+}
+
+func availability(stockQuantity int) string {
+	if stockQuantity > 0 {
+		return "in_stock"
+	}
+	return "out_of_stock"
+}
+
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', 2, 64)
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"fmt"
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/infrastructure/storage"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/media"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+const productImagePrefix = "products/"
+
+var defaultThumbnailWidths = []int{160, 480, 1024}
+
+type IProductImageService interface {
+	GetImages(productId int64) ([]dto.ProductImageResponse, error)
+	UploadImage(userId int64, role string, productId int64, upload dto.UploadProductImageRequest) (dto.ProductImageResponse, error)
+	UpdateImage(userId int64, role string, productId int64, imageId int64, imageUpdate dto.UpdateProductImageRequest) (dto.ProductImageResponse, error)
+	ReorderImages(userId int64, role string, productId int64, reorder dto.ReorderProductImagesRequest) ([]dto.ProductImageResponse, error)
+	DeleteImage(userId int64, role string, productId int64, imageId int64) error
+	CleanupOrphanedFiles() (int, error)
+}
+
+type ProductImageService struct {
+	imageRepository   persistence.IProductImageRepository
+	productRepository persistence.IProductRepository
+	variantRepository persistence.IProductVariantRepository
+	redisClient       *redis.Client
+	objectStorage     storage.IObjectStorage
+	validator         *rules.ProductImageRules
+	mediaConfig       config.MediaConfig
+	thumbnailWidths   []int
+	managers          productManagers
+}
+
+func NewProductImageService(imageRepository persistence.IProductImageRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, storeRepository persistence.IStoreRepository, redisClient *redis.Client,
+	objectStorage storage.IObjectStorage, mediaConfig config.MediaConfig) IProductImageService {
+	thumbnailWidths, err := media.ParseWidths(mediaConfig.ThumbnailWidths)
+	if err != nil {
+		log.Warn().Err(err).Msg("Thumbnail widths could not be parsed, using defaults")
+		thumbnailWidths = defaultThumbnailWidths
+	}
+	return &ProductImageService{
+		imageRepository:   imageRepository,
+		productRepository: productRepository,
+		variantRepository: variantRepository,
+		redisClient:       redisClient,
+		objectStorage:     objectStorage,
+		validator:         rules.NewProductImageRules(),
+		mediaConfig:       mediaConfig,
+		thumbnailWidths:   thumbnailWidths,
+		managers:          newProductManagers(productRepository, storeRepository),
+	}
+}
+
+func (imageService *ProductImageService) GetImages(productId int64) ([]dto.ProductImageResponse, error) {
+	if _, err := imageService.productRepository.GetProductById(productId); err != nil {
+		return nil, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	images, err := imageService.imageRepository.GetImages(productId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return convertToProductImagesResponse(images), nil
+}
+
+// UploadImage stores the original and its renditions, then appends the image to the product's gallery.
+// Files of a failed upload are removed right away; anything left behind is removed by the orphan cleanup.
+func (imageService *ProductImageService) UploadImage(userId int64, role string, productId int64, upload dto.UploadProductImageRequest) (dto.ProductImageResponse, error) {
+	if _, err := imageService.managers.product(userId, role, productId); err != nil {
+		return dto.ProductImageResponse{}, err
+	}
+	images, err := imageService.imageRepository.GetImages(productId)
+	if err != nil {
+		return dto.ProductImageResponse{}, _errors.NewInternalServerError(err)
+	}
+	if validationErr := imageService.validator.ValidateUpload(upload, len(images), imageService.mediaConfig.MaxImagesPerProduct); validationErr != nil {
+		return dto.ProductImageResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	processed, processErr := media.Process(upload.Content, imageService.thumbnailWidths)
+	if processErr != nil {
+		return dto.ProductImageResponse{}, _errors.NewBadRequest(processErr.Error())
+	}
+
+	keyPrefix := fmt.Sprintf("%s%d/%s", productImagePrefix, productId, uuid.NewString())
+	image := domain.ProductImage{
+		ProductId:   productId,
+		StorageKey:  fmt.Sprintf("%s/original.%s", keyPrefix, processed.Extension),
+		AltText:     strings.TrimSpace(upload.AltText),
+		ContentType: processed.ContentType,
+		Width:       processed.Width,
+		Height:      processed.Height,
+		SizeBytes:   int64(len(upload.Content)),
+	}
+	image.Url = imageService.objectStorage.URL(image.StorageKey)
+
+	storedKeys := make([]string, 0, len(processed.Renditions)+1)
+	if putErr := imageService.objectStorage.Put(image.StorageKey, upload.Content, processed.ContentType); putErr != nil {
+		return dto.ProductImageResponse{}, _errors.NewInternalServerError(putErr)
+	}
+	storedKeys = append(storedKeys, image.StorageKey)
+	for _, rendition := range processed.Renditions {
+		key := fmt.Sprintf("%s/%s.%s", keyPrefix, rendition.Name, rendition.Extension)
+		if putErr := imageService.objectStorage.Put(key, rendition.Content, rendition.ContentType); putErr != nil {
+			imageService.deleteFiles(storedKeys)
+			return dto.ProductImageResponse{}, _errors.NewInternalServerError(putErr)
+		}
+		storedKeys = append(storedKeys, key)
+		image.Renditions = append(image.Renditions, domain.ImageRendition{
+			Name:        rendition.Name,
+			ContentType: rendition.ContentType,
+			Width:       rendition.Width,
+			Height:      rendition.Height,
+			StorageKey:  key,
+			Url:         imageService.objectStorage.URL(key),
+		})
+	}
+
+	added, err := imageService.imageRepository.AddImage(image)
+	if err != nil {
+		imageService.deleteFiles(storedKeys)
+		return dto.ProductImageResponse{}, _errors.NewInternalServerError(err)
+	}
+	imageService.refreshProduct(productId)
+	return convertToProductImageResponse(added), nil
+}
+
+func (imageService *ProductImageService) UpdateImage(userId int64, role string, productId int64, imageId int64, imageUpdate dto.UpdateProductImageRequest) (dto.ProductImageResponse, error) {
+	if validationErr := imageService.validator.ValidateUpdate(imageUpdate); validationErr != nil {
+		return dto.ProductImageResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := imageService.productImage(userId, role, productId, imageId); err != nil {
+		return dto.ProductImageResponse{}, err
+	}
+
+	updated, err := imageService.imageRepository.UpdateAltText(imageId, strings.TrimSpace(imageUpdate.AltText))
+	if err != nil {
+		return dto.ProductImageResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToProductImageResponse(updated), nil
+}
+
+func (imageService *ProductImageService) ReorderImages(userId int64, role string, productId int64, reorder dto.ReorderProductImagesRequest) ([]dto.ProductImageResponse, error) {
+	if _, err := imageService.managers.product(userId, role, productId); err != nil {
+		return nil, err
+	}
+	images, err := imageService.imageRepository.GetImages(productId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	if validationErr := imageService.validator.ValidateReorder(reorder, images); validationErr != nil {
+		return nil, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	if err := imageService.imageRepository.ReorderImages(productId, reorder.ImageIds); err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	imageService.refreshProduct(productId)
+	return imageService.GetImages(productId)
+}
+
+func (imageService *ProductImageService) DeleteImage(userId int64, role string, productId int64, imageId int64) error {
+	if _, err := imageService.productImage(userId, role, productId, imageId); err != nil {
+		return err
+	}
+
+	deleted, err := imageService.imageRepository.DeleteImage(imageId)
+	if err != nil {
+		return _errors.NewInternalServerError(err)
+	}
+	keys := []string{deleted.StorageKey}
+	for _, rendition := range deleted.Renditions {
+		keys = append(keys, rendition.StorageKey)
+	}
+	imageService.deleteFiles(keys)
+	imageService.refreshProduct(productId)
+	return nil
+}
+
+// CleanupOrphanedFiles deletes stored product image files no image or rendition points to anymore, such as
+// files of deleted products. Files younger than the grace period are kept so running uploads are not cut.
+func (imageService *ProductImageService) CleanupOrphanedFiles() (int, error) {
+	gracePeriod := config.ParseDuration(imageService.mediaConfig.OrphanGracePeriod, time.Hour)
+	objects, err := imageService.objectStorage.List(productImagePrefix)
+	if err != nil {
+		return 0, _errors.NewInternalServerError(err)
+	}
+	referencedKeys, err := imageService.imageRepository.GetStorageKeys()
+	if err != nil {
+		return 0, _errors.NewInternalServerError(err)
+	}
+
+	cutoff := time.Now().Add(-gracePeriod)
+	deletedCount := 0
+	for _, object := range objects {
+		if referencedKeys[object.Key] || object.ModifiedAt.After(cutoff) {
+			continue
+		}
+		if deleteErr := imageService.objectStorage.Delete(object.Key); deleteErr != nil {
+			log.Error().Err(deleteErr).Str("key", object.Key).Msg("Orphaned file could not be deleted")
+			continue
+		}
+		deletedCount++
+	}
+	return deletedCount, nil
+}
+
+// productImage loads an image for a user about to change it and checks that it belongs to the product.
+func (imageService *ProductImageService) productImage(userId int64, role string, productId int64, imageId int64) (domain.ProductImage, error) {
+	if _, err := imageService.managers.product(userId, role, productId); err != nil {
+		return domain.ProductImage{}, err
+	}
+	image, err := imageService.imageRepository.GetImageById(imageId)
+	if err != nil || image.ProductId != productId {
+		return domain.ProductImage{}, _errors.NewNotFound(common.ErrProductImageNotFound.Error())
+	}
+	return image, nil
+}
+
+func (imageService *ProductImageService) deleteFiles(keys []string) {
+	for _, key := range keys {
+		if err := imageService.objectStorage.Delete(key); err != nil {
+			log.Error().Err(err).Str("key", key).Msg("Product image file could not be deleted")
+		}
+	}
+}
+
+// refreshProduct re-indexes the product after its main image may have changed.
+func (imageService *ProductImageService) refreshProduct(productId int64) {
+	product, err := imageService.productRepository.GetProductById(productId)
+	if err != nil {
+		log.Error().Err(err).Int64("product_id", productId).Msg("Product could not be reloaded after an image change")
+		return
+	}
+	refreshProducts(imageService.productRepository, imageService.variantRepository, imageService.redisClient, []domain.Product{product})
+}
+
+func convertToProductImagesResponse(images []domain.ProductImage) []dto.ProductImageResponse {
+	response := make([]dto.ProductImageResponse, 0, len(images))
+	for _, image := range images {
+		response = append(response, convertToProductImageResponse(image))
+	}
+	return response
+}
+
+func convertToProductImageResponse(image domain.ProductImage) dto.ProductImageResponse {
+	renditions := make([]dto.ImageRenditionResponse, 0, len(image.Renditions))
+	for _, rendition := range image.Renditions {
+		renditions = append(renditions, dto.ImageRenditionResponse{
+			Name:        rendition.Name,
+			ContentType: rendition.ContentType,
+			Width:       rendition.Width,
+			Height:      rendition.Height,
+			Url:         rendition.Url,
+		})
+	}
+	return dto.ProductImageResponse{
+		Id:          image.Id,
+		ProductId:   image.ProductId,
+		Url:         image.Url,
+		AltText:     image.AltText,
+		Position:    image.Position,
+		ContentType: image.ContentType,
+		Width:       image.Width,
+		Height:      image.Height,
+		SizeBytes:   image.SizeBytes,
+		Renditions:  renditions,
+		CreatedAt:   image.CreatedAt,
+	}
+}
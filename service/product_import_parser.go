@@ -0,0 +1,189 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-ecommerce-service/internal/dto"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	importFormatCsv  = "csv"
+	importFormatJson = "json"
+)
+
+// importRow is one record of an import file keyed by its source column names.
+type importRow map[string]string
+
+func detectImportFormat(fileName string, format string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileName)), ".")
+	}
+	switch strings.ToLower(format) {
+	case importFormatCsv:
+		return importFormatCsv, nil
+	case importFormatJson:
+		return importFormatJson, nil
+	default:
+		return "", errors.New("Import file must be CSV or JSON")
+	}
+}
+
+func parseImportFile(format string, content []byte) ([]importRow, error) {
+	if format == importFormatJson {
+		return parseJsonImport(content)
+	}
+	return parseCsvImport(content)
+}
+
+func parseCsvImport(content []byte) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("CSV header could not be read: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	rows := make([]importRow, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CSV could not be read: %w", err)
+		}
+		row := make(importRow, len(header))
+		for i, column := range header {
+			if i < len(record) {
+				row[column] = record[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func parseJsonImport(content []byte) ([]importRow, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var records []map[string]interface{}
+	if err := decoder.Decode(&records); err != nil {
+		return nil, fmt.Errorf("JSON must be an array of objects: %w", err)
+	}
+
+	rows := make([]importRow, 0, len(records))
+	for _, record := range records {
+		row := make(importRow, len(record))
+		for column, value := range record {
+			if value != nil {
+				row[column] = fmt.Sprint(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// importRecord converts a row into a product request using the column mapping. Missing mappings fall
+// back to a column with the field's own name. All field errors of the row are reported together.
+func importRecord(row importRow, mapping map[string]string, defaultStoreId *uint) (dto.CreateProductRequest, error) {
+	value := func(field string) string {
+		column, ok := mapping[field]
+		if !ok {
+			column = field
+		}
+		return strings.TrimSpace(row[column])
+	}
+
+	fieldErrors := make([]string, 0)
+	parseFloat := func(field string) float64 {
+		raw := value(field)
+		if raw == "" {
+			return 0
+		}
+		parsed, err := strconv.ParseFloat(strings.Replace(raw, ",", ".", 1), 64)
+		if err != nil {
+			fieldErrors = append(fieldErrors, field+": invalid number")
+		}
+		return parsed
+	}
+	parseInt := func(field string) int {
+		raw := value(field)
+		if raw == "" {
+			return 0
+		}
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			fieldErrors = append(fieldErrors, field+": invalid integer")
+		}
+		return parsed
+	}
+	parseBool := func(field string, fallback bool) bool {
+		raw := value(field)
+		if raw == "" {
+			return fallback
+		}
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			fieldErrors = append(fieldErrors, field+": invalid boolean")
+		}
+		return parsed
+	}
+	parseId := func(field string) *uint {
+		raw := value(field)
+		if raw == "" {
+			return nil
+		}
+		parsed, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			fieldErrors = append(fieldErrors, field+": invalid id")
+			return nil
+		}
+		id := uint(parsed)
+		return &id
+	}
+
+	record := dto.CreateProductRequest{
+		Name:            value("name"),
+		Slug:            value("slug"),
+		Description:     value("description"),
+		Price:           parseFloat("price"),
+		BasePrice:       parseFloat("base_price"),
+		Discount:        parseFloat("discount"),
+		ImageUrl:        value("image_url"),
+		MetaDescription: value("meta_description"),
+		StockQuantity:   parseInt("stock_quantity"),
+		IsActive:        parseBool("is_active", true),
+		IsFeatured:      parseBool("is_featured", false),
+		CategoryId:      parseId("category_id"),
+	}
+	if sku := value("sku"); sku != "" {
+		record.Sku = &sku
+	}
+	if storeId := parseId("store_id"); storeId != nil {
+		record.StoreId = *storeId
+	} else if defaultStoreId != nil {
+		record.StoreId = *defaultStoreId
+	}
+	if record.StoreId == 0 {
+		fieldErrors = append(fieldErrors, "store_id: required")
+	}
+
+	if len(fieldErrors) > 0 {
+		return record, errors.New(strings.Join(fieldErrors, "; "))
+	}
+	return record, nil
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/util"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+type IProductImportService interface {
+	StartImport(userId int64, role string, fileName string, content []byte, options dto.ProductImportOptions) (dto.ProductImportJobResponse, error)
+	GetImportJob(userId int64, role string, jobId int64) (dto.ProductImportJobResponse, error)
+	WriteImportReport(userId int64, role string, jobId int64, w io.Writer) error
+}
+
+type ProductImportService struct {
+	importRepository  persistence.IProductImportRepository
+	productRepository persistence.IProductRepository
+	variantRepository persistence.IProductVariantRepository
+	managers          productManagers
+	validator         *rules.ProductRules
+	redisClient       *redis.Client
+	batchSize         int
+}
+
+func NewProductImportService(importRepository persistence.IProductImportRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, storeRepository persistence.IStoreRepository, rdb *redis.Client,
+	importConfig config.ImportConfig) IProductImportService {
+	batchSize := importConfig.BatchSize
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &ProductImportService{
+		importRepository:  importRepository,
+		productRepository: productRepository,
+		variantRepository: variantRepository,
+		managers:          newProductManagers(productRepository, storeRepository),
+		validator:         rules.NewProductRules(),
+		redisClient:       rdb,
+		batchSize:         batchSize,
+	}
+}
+
+// StartImport parses the file, records a pending job and processes the rows in the background.
+// The returned job is polled through GetImportJob until it is completed or failed. Admins import into any
+// store; other users only into the stores they own, and rows for other stores fail.
+func (importService *ProductImportService) StartImport(userId int64, role string, fileName string, content []byte,
+	options dto.ProductImportOptions) (dto.ProductImportJobResponse, error) {
+	format, formatErr := detectImportFormat(fileName, options.Format)
+	if formatErr != nil {
+		return dto.ProductImportJobResponse{}, _errors.NewBadRequest(formatErr.Error())
+	}
+	if validationErr := importService.validator.ValidateImport(options); validationErr != nil {
+		return dto.ProductImportJobResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if options.DefaultStoreId != nil {
+		if err := importService.managers.authorizeStore(userId, role, *options.DefaultStoreId); err != nil {
+			return dto.ProductImportJobResponse{}, err
+		}
+	}
+	matchBy := options.MatchBy
+	if matchBy == "" {
+		matchBy = domain.ImportMatchBySku
+	}
+
+	rows, parseErr := parseImportFile(format, content)
+	if parseErr != nil {
+		return dto.ProductImportJobResponse{}, _errors.NewBadRequest(parseErr.Error())
+	}
+	if len(rows) == 0 {
+		return dto.ProductImportJobResponse{}, _errors.NewBadRequest("Import file has no rows")
+	}
+
+	job, repositoryErr := importService.importRepository.CreateJob(domain.ProductImportJob{
+		FileName:  fileName,
+		Format:    format,
+		MatchBy:   matchBy,
+		Status:    domain.ImportStatusPending,
+		TotalRows: len(rows),
+		CreatedBy: &userId,
+	})
+	if repositoryErr != nil {
+		return dto.ProductImportJobResponse{}, _errors.NewInternalServerError(repositoryErr)
+	}
+
+	go importService.run(job, rows, options, importService.storeAuthorizer(userId, role))
+
+	return convertToProductImportJobResponse(job), nil
+}
+
+// GetImportJob returns the job to the user who started it or to an admin.
+func (importService *ProductImportService) GetImportJob(userId int64, role string, jobId int64) (dto.ProductImportJobResponse, error) {
+	job, err := importService.importRepository.GetJobById(jobId)
+	if err != nil {
+		if errors.Is(err, common.ErrImportJobNotFound) {
+			return dto.ProductImportJobResponse{}, _errors.NewNotFound(err.Error())
+		}
+		return dto.ProductImportJobResponse{}, _errors.NewInternalServerError(err)
+	}
+	if role != domain.UserRoleAdmin && (job.CreatedBy == nil || *job.CreatedBy != userId) {
+		return dto.ProductImportJobResponse{}, _errors.NewNotFound(common.ErrImportJobNotFound.Error())
+	}
+	return convertToProductImportJobResponse(job), nil
+}
+
+// WriteImportReport writes the per-row results of a job as CSV.
+func (importService *ProductImportService) WriteImportReport(userId int64, role string, jobId int64, w io.Writer) error {
+	if _, err := importService.GetImportJob(userId, role, jobId); err != nil {
+		return err
+	}
+	results, err := importService.importRepository.GetResultsByJobId(jobId)
+	if err != nil {
+		return _errors.NewInternalServerError(err)
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"row", "status", "match_key", "product_id", "message"})
+	for _, result := range results {
+		productId := ""
+		if result.ProductId != nil {
+			productId = strconv.FormatInt(*result.ProductId, 10)
+		}
+		writer.Write([]string{strconv.Itoa(result.RowNumber), result.Status, stringValue(result.MatchKey), productId, stringValue(result.Message)})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (importService *ProductImportService) run(job domain.ProductImportJob, rows []importRow, options dto.ProductImportOptions,
+	authorizeStore func(storeId uint) error) {
+	job.Status = domain.ImportStatusRunning
+	importService.saveJob(job)
+
+	for start := 0; start < len(rows); start += importService.batchSize {
+		end := start + importService.batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		results, err := importService.importBatch(job, rows[start:end], start, options, authorizeStore)
+		if err != nil {
+			log.Error().Err(err).Int64("job_id", job.Id).Msg("Product import failed")
+			message := err.Error()
+			job.Status = domain.ImportStatusFailed
+			job.Error = &message
+			importService.finish(job)
+			return
+		}
+
+		for _, result := range results {
+			switch result.Status {
+			case domain.ImportRowCreated:
+				job.CreatedCount++
+			case domain.ImportRowUpdated:
+				job.UpdatedCount++
+			default:
+				job.FailedCount++
+			}
+		}
+		job.ProcessedRows = end
+		importService.saveJob(job)
+	}
+
+	job.Status = domain.ImportStatusCompleted
+	importService.finish(job)
+}
+
+// importBatch validates one batch of rows, upserts the valid ones and stores a result for every row.
+// Row numbers start at 1 with the first data row of the file.
+func (importService *ProductImportService) importBatch(job domain.ProductImportJob, rows []importRow, offset int,
+	options dto.ProductImportOptions, authorizeStore func(storeId uint) error) ([]domain.ProductImportResult, error) {
+	results := make([]domain.ProductImportResult, len(rows))
+	products := make([]domain.Product, 0, len(rows))
+	positions := make([]int, 0, len(rows))
+
+	for i, row := range rows {
+		results[i] = domain.ProductImportResult{JobId: job.Id, RowNumber: offset + i + 1}
+
+		product, key, err := importService.rowToProduct(row, job.MatchBy, options, authorizeStore)
+		if key != "" {
+			results[i].MatchKey = &key
+		}
+		if err != nil {
+			message := err.Error()
+			results[i].Status = domain.ImportRowFailed
+			results[i].Message = &message
+			continue
+		}
+		products = append(products, product)
+		positions = append(positions, i)
+	}
+
+	if len(products) > 0 {
+		upserts, err := importService.importRepository.UpsertProducts(job.MatchBy, products, *job.CreatedBy)
+		if err != nil {
+			return nil, err
+		}
+
+		saved := make([]domain.Product, 0, len(upserts))
+		for i, upsert := range upserts {
+			result := &results[positions[i]]
+			if upsert.Err != nil {
+				message := upsert.Err.Error()
+				result.Status = domain.ImportRowFailed
+				result.Message = &message
+				continue
+			}
+			productId := int64(upsert.Product.Id)
+			result.ProductId = &productId
+			result.Status = domain.ImportRowUpdated
+			if upsert.Created {
+				result.Status = domain.ImportRowCreated
+			}
+			saved = append(saved, upsert.Product)
+		}
+		refreshProducts(importService.productRepository, importService.variantRepository, importService.redisClient, saved)
+	}
+
+	if err := importService.importRepository.AddResults(results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (importService *ProductImportService) rowToProduct(row importRow, matchBy string, options dto.ProductImportOptions,
+	authorizeStore func(storeId uint) error) (domain.Product, string, error) {
+	record, recordErr := importRecord(row, options.Mapping, options.DefaultStoreId)
+
+	key := record.Slug
+	if matchBy == domain.ImportMatchBySku {
+		key = stringValue(record.Sku)
+	}
+	if recordErr != nil {
+		return domain.Product{}, key, recordErr
+	}
+	if key == "" {
+		return domain.Product{}, key, fmt.Errorf("%s: required to match the product", matchBy)
+	}
+	prices, validationErr := importService.validator.ValidateCreate(record)
+	if validationErr != nil {
+		return domain.Product{}, key, validationErr
+	}
+	if storeErr := authorizeStore(record.StoreId); storeErr != nil {
+		return domain.Product{}, key, storeErr
+	}
+
+	slug := record.Slug
+	if slug == "" {
+		slug = util.GenerateUniqueSlug(record.Name)
+	}
+	return domain.Product{
+		Name:            record.Name,
+		Slug:            slug,
+		Sku:             record.Sku,
+		Description:     record.Description,
+		Price:           prices.Price,
+		BasePrice:       prices.BasePrice,
+		Discount:        prices.Discount,
+		ImageUrl:        record.ImageUrl,
+		MetaDescription: record.MetaDescription,
+		StockQuantity:   record.StockQuantity,
+		IsActive:        record.IsActive,
+		IsFeatured:      record.IsFeatured,
+		CategoryId:      record.CategoryId,
+		StoreId:         record.StoreId,
+	}, key, nil
+}
+
+// storeAuthorizer tells whether the importing user may write to a store, asking once per store and job.
+func (importService *ProductImportService) storeAuthorizer(userId int64, role string) func(storeId uint) error {
+	checked := make(map[uint]error)
+	return func(storeId uint) error {
+		if err, ok := checked[storeId]; ok {
+			return err
+		}
+		manager, err := importService.managers.managesStore(userId, role, storeId)
+		if err == nil && !manager {
+			err = fmt.Errorf("store_id: %d is not one of your stores", storeId)
+		}
+		checked[storeId] = err
+		return err
+	}
+}
+
+func (importService *ProductImportService) saveJob(job domain.ProductImportJob) {
+	if err := importService.importRepository.UpdateJob(job); err != nil {
+		log.Error().Err(err).Int64("job_id", job.Id).Msg("Import job progress could not be saved")
+	}
+}
+
+func (importService *ProductImportService) finish(job domain.ProductImportJob) {
+	completedAt := time.Now()
+	job.CompletedAt = &completedAt
+	importService.saveJob(job)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func convertToProductImportJobResponse(job domain.ProductImportJob) dto.ProductImportJobResponse {
+	progress := 0.0
+	if job.TotalRows > 0 {
+		progress = float64(job.ProcessedRows) * 100 / float64(job.TotalRows)
+	}
+	return dto.ProductImportJobResponse{
+		Id:            job.Id,
+		FileName:      job.FileName,
+		Format:        job.Format,
+		MatchBy:       job.MatchBy,
+		Status:        job.Status,
+		TotalRows:     job.TotalRows,
+		ProcessedRows: job.ProcessedRows,
+		Progress:      progress,
+		CreatedCount:  job.CreatedCount,
+		UpdatedCount:  job.UpdatedCount,
+		FailedCount:   job.FailedCount,
+		Error:         job.Error,
+		ReportUrl:     fmt.Sprintf("/api/v1/products/imports/%d/report", job.Id),
+		CreatedAt:     job.CreatedAt,
+		CompletedAt:   job.CompletedAt,
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+)
+
+// productManagers decides who may change a product and the data hanging off it: admins, and the owners of
+// the store that sells the product.
+type productManagers struct {
+	productRepository persistence.IProductRepository
+	storeRepository   persistence.IStoreRepository
+}
+
+func newProductManagers(productRepository persistence.IProductRepository, storeRepository persistence.IStoreRepository) productManagers {
+	return productManagers{productRepository: productRepository, storeRepository: storeRepository}
+}
+
+// product loads the product for a user about to change it.
+func (managers productManagers) product(userId int64, role string, productId int64) (domain.Product, error) {
+	product, err := managers.productRepository.GetProductById(productId)
+	if err != nil {
+		return domain.Product{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	if err := managers.authorize(userId, role, product); err != nil {
+		return domain.Product{}, err
+	}
+	return product, nil
+}
+
+// authorize lets admins and the owners of the product's store through.
+func (managers productManagers) authorize(userId int64, role string, product domain.Product) error {
+	if role == domain.UserRoleAdmin {
+		return nil
+	}
+	return managers.authorizeStore(userId, role, product.StoreId)
+}
+
+// authorizeStore lets admins and the owners of the store through.
+func (managers productManagers) authorizeStore(userId int64, role string, storeId uint) error {
+	manager, err := managers.managesStore(userId, role, storeId)
+	if err != nil {
+		return _errors.NewInternalServerError(err)
+	}
+	if !manager {
+		return _errors.NewForbidden("Only the owners of the product's store can change it")
+	}
+	return nil
+}
+
+// managesStore tells whether the user is an admin or an owner of the store.
+func (managers productManagers) managesStore(userId int64, role string, storeId uint) (bool, error) {
+	if role == domain.UserRoleAdmin {
+		return true, nil
+	}
+	return managers.storeRepository.IsStoreOwner(storeId, userId)
+}
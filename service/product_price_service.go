@@ -0,0 +1,263 @@
+package service
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/pricing"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultPriceHistorySize = 50
+	// lowestPriceWindowDays is the period the lowest price is disclosed for next to a discount.
+	lowestPriceWindowDays = 30
+)
+
+type IProductPriceService interface {
+	GetPriceHistory(productId int64, limit int) ([]dto.PriceChangeResponse, error)
+	GetLowestPrice(productId int64) (dto.LowestPriceResponse, error)
+	GetSchedules(userId int64, role string, productId int64) ([]dto.PriceScheduleResponse, error)
+	AddSchedule(userId int64, role string, productId int64, scheduleCreate dto.CreatePriceScheduleRequest) (dto.PriceScheduleResponse, error)
+	CancelSchedule(userId int64, role string, scheduleId int64) (dto.PriceScheduleResponse, error)
+	ApplySchedules() (domain.PriceScheduleRun, error)
+}
+
+type ProductPriceService struct {
+	priceRepository   persistence.IProductPriceRepository
+	productRepository persistence.IProductRepository
+	variantRepository persistence.IProductVariantRepository
+	validator         *rules.ProductPriceRules
+	redisClient       *redis.Client
+	managers          productManagers
+}
+
+func NewProductPriceService(priceRepository persistence.IProductPriceRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, storeRepository persistence.IStoreRepository, rdb *redis.Client) IProductPriceService {
+	return &ProductPriceService{
+		priceRepository:   priceRepository,
+		productRepository: productRepository,
+		variantRepository: variantRepository,
+		validator:         rules.NewProductPriceRules(),
+		redisClient:       rdb,
+		managers:          newProductManagers(productRepository, storeRepository),
+	}
+}
+
+func (priceService *ProductPriceService) GetPriceHistory(productId int64, limit int) ([]dto.PriceChangeResponse, error) {
+	if validationErr := priceService.validator.ValidateHistoryLimit(limit); validationErr != nil {
+		return nil, _errors.NewBadRequest(validationErr.Error())
+	}
+	if limit == 0 {
+		limit = defaultPriceHistorySize
+	}
+	if _, err := priceService.productRepository.GetProductById(productId); err != nil {
+		return nil, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+
+	changes, err := priceService.priceRepository.GetPriceHistory(productId, limit)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return convertToPriceChangesResponse(changes), nil
+}
+
+// GetLowestPrice returns the lowest price of the last 30 days, the reference price a discount has to be
+// disclosed against. The current price always takes part, so a product without history reports it.
+func (priceService *ProductPriceService) GetLowestPrice(productId int64) (dto.LowestPriceResponse, error) {
+	product, err := priceService.productRepository.GetProductById(productId)
+	if err != nil {
+		return dto.LowestPriceResponse{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+
+	since := time.Now().AddDate(0, 0, -lowestPriceWindowDays)
+	lowest, err := priceService.priceRepository.GetLowestPrice(productId, since)
+	if err != nil {
+		return dto.LowestPriceResponse{}, _errors.NewInternalServerError(err)
+	}
+	lowestPrice := product.Price
+	if lowest != nil && *lowest < lowestPrice {
+		lowestPrice = *lowest
+	}
+
+	return dto.LowestPriceResponse{
+		ProductId:    productId,
+		CurrentPrice: product.Price,
+		LowestPrice:  lowestPrice,
+		Days:         lowestPriceWindowDays,
+		Since:        since,
+	}, nil
+}
+
+// GetSchedules lists the price schedules of the product to admins and the owners of its store.
+func (priceService *ProductPriceService) GetSchedules(userId int64, role string, productId int64) ([]dto.PriceScheduleResponse, error) {
+	if _, err := priceService.managers.product(userId, role, productId); err != nil {
+		return nil, err
+	}
+	schedules, err := priceService.priceRepository.GetSchedulesByProductId(productId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return convertToPriceSchedulesResponse(schedules), nil
+}
+
+// AddSchedule plans a price change for the product. Schedules of the same product may not overlap, so
+// the prices restored at the end of a schedule are always the ones it replaced.
+func (priceService *ProductPriceService) AddSchedule(userId int64, role string, productId int64, scheduleCreate dto.CreatePriceScheduleRequest) (dto.PriceScheduleResponse, error) {
+	if validationErr := priceService.validator.ValidateSchedule(scheduleCreate, time.Now()); validationErr != nil {
+		return dto.PriceScheduleResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	product, err := priceService.managers.product(userId, role, productId)
+	if err != nil {
+		return dto.PriceScheduleResponse{}, err
+	}
+
+	overlapping, err := priceService.priceRepository.HasOverlappingSchedule(productId, scheduleCreate.StartsAt, scheduleCreate.EndsAt)
+	if err != nil {
+		return dto.PriceScheduleResponse{}, _errors.NewInternalServerError(err)
+	}
+	if overlapping {
+		return dto.PriceScheduleResponse{}, _errors.NewBadRequest("Another price schedule of the product overlaps this period")
+	}
+
+	basePrice := scheduleCreate.BasePrice
+	if basePrice == 0 {
+		basePrice = product.BasePrice
+	}
+	prices, priceErr := pricing.Resolve(scheduleCreate.Price, basePrice, scheduleCreate.Discount)
+	if priceErr != nil {
+		return dto.PriceScheduleResponse{}, _errors.NewBadRequest(priceErr.Error())
+	}
+	schedule, err := priceService.priceRepository.AddSchedule(domain.PriceSchedule{
+		ProductId: productId,
+		Price:     prices.Price,
+		BasePrice: prices.BasePrice,
+		Discount:  prices.Discount,
+		StartsAt:  scheduleCreate.StartsAt,
+		EndsAt:    scheduleCreate.EndsAt,
+		CreatedBy: userId,
+	})
+	if err != nil {
+		return dto.PriceScheduleResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToPriceScheduleResponse(schedule), nil
+}
+
+// CancelSchedule drops a pending schedule, or ends a running one early and restores the previous prices.
+func (priceService *ProductPriceService) CancelSchedule(userId int64, role string, scheduleId int64) (dto.PriceScheduleResponse, error) {
+	schedule, err := priceService.priceRepository.GetScheduleById(scheduleId)
+	if err != nil {
+		if errors.Is(err, common.ErrPriceScheduleNotFound) {
+			return dto.PriceScheduleResponse{}, _errors.NewNotFound(err.Error())
+		}
+		return dto.PriceScheduleResponse{}, _errors.NewInternalServerError(err)
+	}
+	if _, err := priceService.managers.product(userId, role, schedule.ProductId); err != nil {
+		return dto.PriceScheduleResponse{}, err
+	}
+	if schedule.Status != domain.PriceScheduleStatusScheduled && schedule.Status != domain.PriceScheduleStatusActive {
+		return dto.PriceScheduleResponse{}, _errors.NewBadRequest("Only scheduled or active price schedules can be cancelled")
+	}
+
+	product, reverted, err := priceService.priceRepository.FinishSchedule(schedule, domain.PriceScheduleStatusCancelled)
+	if err != nil {
+		return dto.PriceScheduleResponse{}, _errors.NewInternalServerError(err)
+	}
+	if reverted {
+		refreshProducts(priceService.productRepository, priceService.variantRepository, priceService.redisClient, []domain.Product{product})
+	}
+
+	schedule.Status = domain.PriceScheduleStatusCancelled
+	return convertToPriceScheduleResponse(schedule), nil
+}
+
+// ApplySchedules finishes the schedules whose end has passed and then starts the ones that are due.
+// A schedule that fails is logged and retried on the next run.
+func (priceService *ProductPriceService) ApplySchedules() (domain.PriceScheduleRun, error) {
+	now := time.Now()
+	run := domain.PriceScheduleRun{}
+	changed := make([]domain.Product, 0)
+
+	finishing, err := priceService.priceRepository.GetSchedulesToFinish(now)
+	if err != nil {
+		return run, err
+	}
+	for _, schedule := range finishing {
+		product, reverted, err := priceService.priceRepository.FinishSchedule(schedule, domain.PriceScheduleStatusCompleted)
+		if err != nil {
+			log.Error().Err(err).Int64("schedule_id", schedule.Id).Msg("Price schedule could not be finished")
+			continue
+		}
+		run.Finished++
+		if reverted {
+			changed = append(changed, product)
+		}
+	}
+
+	starting, err := priceService.priceRepository.GetSchedulesToStart(now)
+	if err != nil {
+		return run, err
+	}
+	for _, schedule := range starting {
+		product, err := priceService.priceRepository.StartSchedule(schedule)
+		if err != nil {
+			log.Error().Err(err).Int64("schedule_id", schedule.Id).Msg("Price schedule could not be started")
+			continue
+		}
+		run.Started++
+		changed = append(changed, product)
+	}
+
+	refreshProducts(priceService.productRepository, priceService.variantRepository, priceService.redisClient, changed)
+	return run, nil
+}
+
+func convertToPriceChangesResponse(changes []domain.PriceChange) []dto.PriceChangeResponse {
+	responses := make([]dto.PriceChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		responses = append(responses, dto.PriceChangeResponse{
+			Id:        change.Id,
+			ProductId: change.ProductId,
+			Price:     change.Price,
+			BasePrice: change.BasePrice,
+			Discount:  change.Discount,
+			Source:    change.Source,
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.ChangedAt,
+		})
+	}
+	return responses
+}
+
+func convertToPriceScheduleResponse(schedule domain.PriceSchedule) dto.PriceScheduleResponse {
+	return dto.PriceScheduleResponse{
+		Id:                schedule.Id,
+		ProductId:         schedule.ProductId,
+		Price:             schedule.Price,
+		BasePrice:         schedule.BasePrice,
+		Discount:          schedule.Discount,
+		StartsAt:          schedule.StartsAt,
+		EndsAt:            schedule.EndsAt,
+		Status:            schedule.Status,
+		PreviousPrice:     schedule.PreviousPrice,
+		PreviousBasePrice: schedule.PreviousBasePrice,
+		PreviousDiscount:  schedule.PreviousDiscount,
+		CreatedBy:         schedule.CreatedBy,
+		CreatedAt:         schedule.CreatedAt,
+	}
+}
+
+func convertToPriceSchedulesResponse(schedules []domain.PriceSchedule) []dto.PriceScheduleResponse {
+	responses := make([]dto.PriceScheduleResponse, 0, len(schedules))
+	for _, schedule := range schedules {
+		responses = append(responses, convertToPriceScheduleResponse(schedule))
+	}
+	return responses
+}
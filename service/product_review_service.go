@@ -0,0 +1,232 @@
+package service
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultReviewPageSize = 10
+	moderationQueueSize   = 100
+)
+
+type IProductReviewService interface {
+	GetProductReviews(productId int64, listRequest dto.ReviewListRequest) (dto.ProductReviewsResponse, error)
+	AddReview(userId int64, productId int64, reviewCreate dto.CreateReviewRequest) (dto.ReviewResponse, error)
+	GetModerationQueue(status string) ([]dto.ReviewResponse, error)
+	ModerateReview(reviewId int64, moderation dto.ModerateReviewRequest) (dto.ReviewResponse, error)
+	VoteReview(userId int64, reviewId int64, vote dto.ReviewVoteRequest) (dto.ReviewResponse, error)
+}
+
+type ProductReviewService struct {
+	reviewRepository  persistence.IProductReviewRepository
+	productRepository persistence.IProductRepository
+	variantRepository persistence.IProductVariantRepository
+	validator         *rules.ProductReviewRules
+	redisClient       *redis.Client
+}
+
+func NewProductReviewService(reviewRepository persistence.IProductReviewRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, rdb *redis.Client) IProductReviewService {
+	return &ProductReviewService{
+		reviewRepository:  reviewRepository,
+		productRepository: productRepository,
+		variantRepository: variantRepository,
+		validator:         rules.NewProductReviewRules(),
+		redisClient:       rdb,
+	}
+}
+
+// GetProductReviews returns the approved reviews of a product with its rating summary.
+func (reviewService *ProductReviewService) GetProductReviews(productId int64, listRequest dto.ReviewListRequest) (dto.ProductReviewsResponse, error) {
+	if validationErr := reviewService.validator.ValidateList(listRequest); validationErr != nil {
+		return dto.ProductReviewsResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if listRequest.Limit == 0 {
+		listRequest.Limit = defaultReviewPageSize
+	}
+
+	summary, err := reviewService.reviewRepository.GetRatingSummary(productId)
+	if err != nil {
+		return dto.ProductReviewsResponse{}, _errors.NewInternalServerError(err)
+	}
+	reviews, err := reviewService.reviewRepository.GetApprovedReviews(productId, listRequest.Sort, listRequest.Limit, listRequest.Offset)
+	if err != nil {
+		return dto.ProductReviewsResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	return dto.ProductReviewsResponse{
+		Summary: dto.RatingSummaryResponse{
+			AverageRating: summary.AverageRating,
+			ReviewCount:   summary.ReviewCount,
+			Distribution:  summary.Distribution,
+		},
+		Reviews: convertToReviewsResponse(reviews),
+		Limit:   listRequest.Limit,
+		Offset:  listRequest.Offset,
+		HasMore: listRequest.Offset+len(reviews) < summary.ReviewCount,
+	}, nil
+}
+
+// AddReview stores a review for moderation. Only customers who ordered the product may review it, once.
+func (reviewService *ProductReviewService) AddReview(userId int64, productId int64, reviewCreate dto.CreateReviewRequest) (dto.ReviewResponse, error) {
+	if validationErr := reviewService.validator.ValidateStructure(reviewCreate); validationErr != nil {
+		return dto.ReviewResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := reviewService.productRepository.GetProductById(productId); err != nil {
+		return dto.ReviewResponse{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+
+	purchased, err := reviewService.reviewRepository.HasPurchased(userId, productId)
+	if err != nil {
+		return dto.ReviewResponse{}, _errors.NewInternalServerError(err)
+	}
+	if !purchased {
+		return dto.ReviewResponse{}, _errors.NewBadRequest("Only customers who purchased the product can review it")
+	}
+	if _, err := reviewService.reviewRepository.GetReviewByProductAndUser(productId, userId); err == nil {
+		return dto.ReviewResponse{}, _errors.NewBadRequest("You have already reviewed this product")
+	} else if !errors.Is(err, common.ErrReviewNotFound) {
+		return dto.ReviewResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	photos := make([]domain.ReviewPhoto, 0, len(reviewCreate.PhotoUrls))
+	for _, url := range reviewCreate.PhotoUrls {
+		photos = append(photos, domain.ReviewPhoto{Url: url})
+	}
+	review, err := reviewService.reviewRepository.AddReview(domain.ProductReview{
+		ProductId: productId,
+		UserId:    userId,
+		Rating:    reviewCreate.Rating,
+		Title:     reviewCreate.Title,
+		Body:      reviewCreate.Body,
+		Status:    domain.ReviewStatusPending,
+		Photos:    photos,
+	})
+	if err != nil {
+		return dto.ReviewResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToReviewResponse(review), nil
+}
+
+func (reviewService *ProductReviewService) GetModerationQueue(status string) ([]dto.ReviewResponse, error) {
+	if status == "" {
+		status = domain.ReviewStatusPending
+	}
+	switch status {
+	case domain.ReviewStatusPending, domain.ReviewStatusApproved, domain.ReviewStatusRejected:
+	default:
+		return nil, _errors.NewBadRequest("Status must be one of pending, approved, rejected")
+	}
+
+	reviews, err := reviewService.reviewRepository.GetReviewsByStatus(status, moderationQueueSize)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return convertToReviewsResponse(reviews), nil
+}
+
+// ModerateReview approves or rejects a review. When the review enters or leaves the approved set the
+// product's rating is recomputed and the product is refreshed in the cache and search index.
+func (reviewService *ProductReviewService) ModerateReview(reviewId int64, moderation dto.ModerateReviewRequest) (dto.ReviewResponse, error) {
+	if validationErr := reviewService.validator.ValidateModeration(moderation); validationErr != nil {
+		return dto.ReviewResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	existing, err := reviewService.getReview(reviewId)
+	if err != nil {
+		return dto.ReviewResponse{}, err
+	}
+	review, err := reviewService.reviewRepository.ModerateReview(reviewId, moderation.Status, moderation.Note)
+	if err != nil {
+		return dto.ReviewResponse{}, _errors.NewInternalServerError(err)
+	}
+	review.Photos = existing.Photos
+
+	if existing.Status == domain.ReviewStatusApproved || review.Status == domain.ReviewStatusApproved {
+		reviewService.refreshRating(review.ProductId)
+	}
+	return convertToReviewResponse(review), nil
+}
+
+// VoteReview records whether the user found an approved review helpful. Voting again changes the vote.
+func (reviewService *ProductReviewService) VoteReview(userId int64, reviewId int64, vote dto.ReviewVoteRequest) (dto.ReviewResponse, error) {
+	existing, err := reviewService.getReview(reviewId)
+	if err != nil {
+		return dto.ReviewResponse{}, err
+	}
+	if existing.Status != domain.ReviewStatusApproved {
+		return dto.ReviewResponse{}, _errors.NewNotFound(common.ErrReviewNotFound.Error())
+	}
+	if existing.UserId == userId {
+		return dto.ReviewResponse{}, _errors.NewBadRequest("You cannot vote on your own review")
+	}
+
+	review, err := reviewService.reviewRepository.VoteReview(reviewId, userId, vote.Helpful)
+	if err != nil {
+		return dto.ReviewResponse{}, _errors.NewInternalServerError(err)
+	}
+	review.Photos = existing.Photos
+	return convertToReviewResponse(review), nil
+}
+
+func (reviewService *ProductReviewService) getReview(reviewId int64) (domain.ProductReview, error) {
+	review, err := reviewService.reviewRepository.GetReviewById(reviewId)
+	if err != nil {
+		if errors.Is(err, common.ErrReviewNotFound) {
+			return domain.ProductReview{}, _errors.NewNotFound(err.Error())
+		}
+		return domain.ProductReview{}, _errors.NewInternalServerError(err)
+	}
+	return review, nil
+}
+
+func (reviewService *ProductReviewService) refreshRating(productId int64) {
+	if err := reviewService.reviewRepository.RefreshProductRating(productId); err != nil {
+		log.Error().Err(err).Int64("product_id", productId).Msg("Product rating could not be refreshed")
+		return
+	}
+	product, err := reviewService.productRepository.GetProductById(productId)
+	if err != nil {
+		return
+	}
+	refreshProducts(reviewService.productRepository, reviewService.variantRepository, reviewService.redisClient, []domain.Product{product})
+}
+
+func convertToReviewResponse(review domain.ProductReview) dto.ReviewResponse {
+	photoUrls := make([]string, 0, len(review.Photos))
+	for _, photo := range review.Photos {
+		photoUrls = append(photoUrls, photo.Url)
+	}
+	return dto.ReviewResponse{
+		Id:              review.Id,
+		ProductId:       review.ProductId,
+		UserId:          review.UserId,
+		Rating:          review.Rating,
+		Title:           review.Title,
+		Body:            review.Body,
+		Status:          review.Status,
+		ModerationNote:  review.ModerationNote,
+		HelpfulCount:    review.HelpfulCount,
+		NotHelpfulCount: review.NotHelpfulCount,
+		PhotoUrls:       photoUrls,
+		CreatedAt:       review.CreatedAt,
+		ModeratedAt:     review.ModeratedAt,
+	}
+}
+
+func convertToReviewsResponse(reviews []domain.ProductReview) []dto.ReviewResponse {
+	reviewsDto := make([]dto.ReviewResponse, 0, len(reviews))
+	for _, review := range reviews {
+		reviewsDto = append(reviewsDto, convertToReviewResponse(review))
+	}
+	return reviewsDto
+}
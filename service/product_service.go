@@ -3,48 +3,171 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"go-ecommerce-service/domain"
 	"go-ecommerce-service/internal/dto"
 	"go-ecommerce-service/internal/rules"
 	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
 	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/locale"
 	"go-ecommerce-service/pkg/util"
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
 )
 
+const defaultProductPageSize = 20
+
 type IProductService interface {
 	GetAllProducts() []dto.ProductResponse
-	GetProductById(productId int64) (dto.ProductResponse, error)
-	AddProduct(productCreate dto.CreateProductRequest) (dto.ProductResponse, error)
+	ListProducts(listRequest dto.ProductListRequest) (dto.ProductPageResponse, error)
+	GetProductById(productId int64, locale string) (dto.ProductResponse, error)
+	GetProductBySlug(slug string, locale string) (dto.ProductResponse, string, error)
+	AddProduct(userId int64, role string, productCreate dto.CreateProductRequest) (dto.ProductResponse, error)
 	DeleteProductById(productId int64) error
-	UpdateProduct(productId uint, product dto.CreateProductRequest) (dto.ProductResponse, error)
-	SearchProducts(query string) ([]dto.ProductResponse, error)
+	UpdateProduct(userId int64, role string, productId uint, product dto.CreateProductRequest) (dto.ProductResponse, error)
+	SearchProducts(query string, sort string, locale string) ([]dto.ProductResponse, error)
 	SyncElasticsearch() error
 }
 
 type ProductService struct {
-	productRepository persistence.IProductRepository
-	validator         *rules.ProductRules
-	redisClient       *redis.Client
+	productRepository   persistence.IProductRepository
+	variantRepository   persistence.IProductVariantRepository
+	attributeRepository persistence.IProductAttributeRepository
+	categoryRepository  persistence.ICategoryRepository
+	validator           *rules.ProductRules
+	attributeValidator  *rules.ProductAttributeRules
+	redisClient         *redis.Client
+	slugs               slugResolver
+	translator          translator
+	managers            productManagers
 }
 
-func NewProductService(productRepository persistence.IProductRepository, rdb *redis.Client) IProductService {
+func NewProductService(productRepository persistence.IProductRepository, variantRepository persistence.IProductVariantRepository,
+	attributeRepository persistence.IProductAttributeRepository, categoryRepository persistence.ICategoryRepository,
+	slugRepository persistence.ISlugHistoryRepository, translationRepository persistence.ITranslationRepository, locales locale.Locales,
+	storeRepository persistence.IStoreRepository, rdb *redis.Client) IProductService {
 	return &ProductService{
-		productRepository: productRepository,
-		validator:         rules.NewProductRules(),
-		redisClient:       rdb,
+		productRepository:   productRepository,
+		variantRepository:   variantRepository,
+		attributeRepository: attributeRepository,
+		categoryRepository:  categoryRepository,
+		validator:           rules.NewProductRules(),
+		attributeValidator:  rules.NewProductAttributeRules(),
+		redisClient:         rdb,
+		slugs:               slugResolver{slugRepository: slugRepository, entityType: domain.SlugEntityProduct},
+		translator:          translator{translationRepository: translationRepository, locales: locales},
+		managers:            newProductManagers(productRepository, storeRepository),
 	}
 }
 
 func (productService *ProductService) GetAllProducts() []dto.ProductResponse {
-	products := productService.productRepository.GetAllProducts()
+	products := productService.withDetails(productService.productRepository.GetAllProducts())
 	return convertToProductsResponse(products)
 }
 
-func (productService *ProductService) GetProductById(productId int64) (dto.ProductResponse, error) {
+// ListProducts returns one page of the filtered listing of published products. Pages are chained with the
+// opaque next_cursor so inserts between requests do not shift or repeat results.
+func (productService *ProductService) ListProducts(listRequest dto.ProductListRequest) (dto.ProductPageResponse, error) {
+	if validationErr := productService.validator.ValidateList(listRequest); validationErr != nil {
+		return dto.ProductPageResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	active := true
+	filter := domain.ProductFilter{
+		IsActive:   &active,
+		StoreId:    listRequest.StoreId,
+		IsFeatured: listRequest.IsFeatured,
+		MinPrice:   listRequest.MinPrice,
+		MaxPrice:   listRequest.MaxPrice,
+		InStock:    listRequest.InStock,
+		Sort:       listRequest.Sort,
+		Limit:      listRequest.Limit,
+	}
+	if filter.Sort == "" {
+		filter.Sort = domain.ProductSortNewest
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultProductPageSize
+	}
+	attributeFilters, filterErr := productService.attributeValidator.ParseFilters(listRequest.Attributes)
+	if filterErr != nil {
+		return dto.ProductPageResponse{}, _errors.NewBadRequest(filterErr.Error())
+	}
+	filter.Attributes = attributeFilters
+	if listRequest.CategoryId != nil {
+		categoryIds, err := productService.categoryScope(*listRequest.CategoryId)
+		if err != nil {
+			return dto.ProductPageResponse{}, _errors.NewInternalServerError(err)
+		}
+		filter.CategoryIds = categoryIds
+	}
+	if listRequest.Cursor != "" {
+		var cursor domain.ProductCursor
+		if err := util.DecodeCursor(listRequest.Cursor, &cursor); err != nil {
+			return dto.ProductPageResponse{}, _errors.NewBadRequest(err.Error())
+		}
+		filter.After = &cursor
+	}
+
+	total, err := productService.productRepository.CountProducts(filter)
+	if err != nil {
+		return dto.ProductPageResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	// One extra row tells whether another page exists without a second query.
+	pageSize := filter.Limit
+	filter.Limit = pageSize + 1
+	products, err := productService.productRepository.GetProducts(filter)
+	if err != nil {
+		return dto.ProductPageResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	page := dto.PageResponse{Limit: pageSize, Total: total}
+	if len(products) > pageSize {
+		products = products[:pageSize]
+		page.HasMore = true
+		last := products[len(products)-1]
+		page.NextCursor = util.EncodeCursor(domain.ProductCursor{
+			Id:        last.Id,
+			CreatedAt: last.CreatedAt,
+			Price:     last.Price,
+			Name:      last.Name,
+			Rating:    last.AverageRating,
+			Reviews:   last.ReviewCount,
+		})
+	}
+
+	return dto.ProductPageResponse{
+		Items: productService.withBreadcrumbs(listRequest.Locale,
+			convertToProductsResponse(productService.translator.products(listRequest.Locale, productService.withDetails(products)))),
+		Page: page,
+	}, nil
+}
+
+// categoryScope returns the category ids matched by a category filter: the category and all of its subcategories.
+// A category that is not live only matches itself.
+func (productService *ProductService) categoryScope(categoryId uint) ([]uint, error) {
+	subtree, err := productService.categoryRepository.GetSubtree(categoryId)
+	if err != nil {
+		if errors.Is(err, common.ErrCategoryNotFound) {
+			return []uint{categoryId}, nil
+		}
+		return nil, err
+	}
+	categoryIds := make([]uint, 0, len(subtree))
+	for _, category := range subtree {
+		categoryIds = append(categoryIds, category.Id)
+	}
+	return categoryIds, nil
+}
+
+// GetProductById returns the published product in the locale. The cache holds the default content, translations
+// are applied on top of it. Products that are not published are not found.
+func (productService *ProductService) GetProductById(productId int64, locale string) (dto.ProductResponse, error) {
 	ctx := context.Background()
 	key := fmt.Sprintf("product:%d", productId)
 
@@ -52,38 +175,103 @@ func (productService *ProductService) GetProductById(productId int64) (dto.Produ
 	if redisErr == nil {
 		var cachedProduct dto.ProductResponse
 		json.Unmarshal([]byte(result), &cachedProduct)
-		return cachedProduct, nil
+		if cachedProduct.Status != domain.ProductStatusPublished {
+			return dto.ProductResponse{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+		}
+		cachedProduct = productService.translator.productResponse(locale, cachedProduct)
+		return productService.withBreadcrumbs(locale, []dto.ProductResponse{cachedProduct})[0], nil
 	}
 	product, repositoryErr := productService.productRepository.GetProductById(productId)
 	if repositoryErr != nil {
 		return dto.ProductResponse{}, repositoryErr
 	}
+	product = productService.withDetails([]domain.Product{product})[0]
 	response := convertToProductResponse(product)
 	data, _ := json.Marshal(response)
 	productService.redisClient.Set(ctx, key, data, 10*time.Minute)
-	return response, nil
+	if response.Status != domain.ProductStatusPublished {
+		return dto.ProductResponse{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	response = productService.translator.productResponse(locale, response)
+	return productService.withBreadcrumbs(locale, []dto.ProductResponse{response})[0], nil
 }
 
-func (productService *ProductService) AddProduct(productCreate dto.CreateProductRequest) (dto.ProductResponse, error) {
-	if validationErr := productService.validator.ValidateCreate(productCreate); validationErr != nil {
+// GetProductBySlug returns the product in the locale using its slug in any locale or a previous slug.
+// When the slug is not the product's slug in the requested locale, that slug is returned as well so the
+// caller can redirect. Products that are not published are not found.
+func (productService *ProductService) GetProductBySlug(slug string, locale string) (dto.ProductResponse, string, error) {
+	product, err := productService.productRepository.GetProductBySlug(slug)
+	if err != nil {
+		productId, found := productService.translatedSlug(slug)
+		if !found {
+			if productId, found = productService.slugs.moved(slug); !found {
+				return dto.ProductResponse{}, "", _errors.NewNotFound(err.Error())
+			}
+		}
+		if product, err = productService.productRepository.GetProductById(productId); err != nil {
+			return dto.ProductResponse{}, "", _errors.NewNotFound(err.Error())
+		}
+	}
+	if product.Status != domain.ProductStatusPublished {
+		return dto.ProductResponse{}, "", _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+
+	product = productService.translator.products(locale, productService.withDetails([]domain.Product{product}))[0]
+	response := productService.withBreadcrumbs(locale, []dto.ProductResponse{convertToProductResponse(product)})[0]
+	if product.Slug == slug {
+		return response, "", nil
+	}
+	return response, product.Slug, nil
+}
+
+// translatedSlug looks a slug up among the locale-specific slugs and returns the id of its product.
+func (productService *ProductService) translatedSlug(slug string) (int64, bool) {
+	translation, err := productService.translator.translationRepository.GetProductTranslationBySlug(slug)
+	if err != nil {
+		return 0, false
+	}
+	return translation.ProductId, true
+}
+
+// AddProduct creates the product as a draft; it goes live through review, see the product status service.
+// Only admins and the owners of the store can add products to it.
+func (productService *ProductService) AddProduct(userId int64, role string, productCreate dto.CreateProductRequest) (dto.ProductResponse, error) {
+	prices, validationErr := productService.validator.ValidateCreate(productCreate)
+	if validationErr != nil {
 		return dto.ProductResponse{}, _errors.NewBadRequest(validationErr.Error())
 	}
+	if err := productService.managers.authorizeStore(userId, role, productCreate.StoreId); err != nil {
+		return dto.ProductResponse{}, err
+	}
+
+	attributes, attributesErr := productService.resolveAttributes(productCreate.CategoryId, productCreate.Attributes)
+	if attributesErr != nil {
+		return dto.ProductResponse{}, attributesErr
+	}
+
+	slug, slugErr := productService.slugs.resolve(0, productCreate.Slug, util.GenerateUniqueSlug(productCreate.Name))
+	if slugErr != nil {
+		return dto.ProductResponse{}, slugErr
+	}
 
 	addedProduct, repositoryErr := productService.productRepository.AddProduct(domain.Product{
 		Name:            productCreate.Name,
-		Slug:            util.GenerateUniqueSlug(productCreate.Name),
+		Slug:            slug,
+		Sku:             productCreate.Sku,
 		Description:     productCreate.Description,
-		Price:           productCreate.Price,
-		BasePrice:       productCreate.BasePrice,
-		Discount:        productCreate.Discount,
+		Price:           prices.Price,
+		BasePrice:       prices.BasePrice,
+		Discount:        prices.Discount,
 		ImageUrl:        productCreate.ImageUrl,
 		MetaDescription: productCreate.MetaDescription,
 		StockQuantity:   productCreate.StockQuantity,
-		IsActive:        productCreate.IsActive,
+		Status:          domain.ProductStatusDraft,
+		ProductType:     productType(productCreate.ProductType, domain.ProductTypePhysical),
 		IsFeatured:      productCreate.IsFeatured,
 		CategoryId:      productCreate.CategoryId,
 		StoreId:         productCreate.StoreId,
-	})
+		Attributes:      attributes,
+	}, userId)
 	if repositoryErr != nil {
 		return dto.ProductResponse{}, _errors.NewInternalServerError(repositoryErr)
 	}
@@ -91,81 +279,150 @@ func (productService *ProductService) AddProduct(productCreate dto.CreateProduct
 	return convertToProductResponse(addedProduct), nil
 }
 
+// DeleteProductById moves the product to the trash and takes it out of search and the cache.
 func (productService *ProductService) DeleteProductById(productId int64) error {
 	err := productService.productRepository.DeleteProductById(productId)
 	if err != nil {
+		if errors.Is(err, common.ErrProductNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
 		return err
 	}
 
-	ctx := context.Background()
-
-	key := fmt.Sprintf("product:%d", productId)
-	productService.redisClient.Del(ctx, key)
+	evictProducts(productService.productRepository, productService.redisClient, []int64{productId})
 	return nil
 }
 
-func (productService *ProductService) UpdateProduct(productId uint, product dto.CreateProductRequest) (dto.ProductResponse, error) {
-	if validationErr := productService.validator.ValidateCreate(product); validationErr != nil {
+// UpdateProduct saves the product for an admin or an owner of its store. Moving the product to another store
+// also takes owning that store.
+func (productService *ProductService) UpdateProduct(userId int64, role string, productId uint, product dto.CreateProductRequest) (dto.ProductResponse, error) {
+	prices, validationErr := productService.validator.ValidateCreate(product)
+	if validationErr != nil {
 		return dto.ProductResponse{}, _errors.NewBadRequest(validationErr.Error())
 	}
 
+	existingProduct, repositoryErr := productService.productRepository.GetProductById(int64(productId))
+	if repositoryErr != nil {
+		return dto.ProductResponse{}, _errors.NewNotFound(repositoryErr.Error())
+	}
+	if err := productService.managers.authorize(userId, role, existingProduct); err != nil {
+		return dto.ProductResponse{}, err
+	}
+	if product.StoreId != existingProduct.StoreId {
+		if err := productService.managers.authorizeStore(userId, role, product.StoreId); err != nil {
+			return dto.ProductResponse{}, err
+		}
+	}
+	slug, slugErr := productService.slugs.resolve(int64(productId), product.Slug, existingProduct.Slug)
+	if slugErr != nil {
+		return dto.ProductResponse{}, slugErr
+	}
+	sku := product.Sku
+	if sku == nil {
+		sku = existingProduct.Sku
+	}
+	attributeValues := product.Attributes
+	if attributeValues == nil && sameCategory(existingProduct.CategoryId, product.CategoryId) {
+		if attributeValues, repositoryErr = productService.currentAttributes(existingProduct); repositoryErr != nil {
+			return dto.ProductResponse{}, _errors.NewInternalServerError(repositoryErr)
+		}
+	}
+	attributes, attributesErr := productService.resolveAttributes(product.CategoryId, attributeValues)
+	if attributesErr != nil {
+		return dto.ProductResponse{}, attributesErr
+	}
+
 	updatedProduct, repositoryErr := productService.productRepository.UpdateProduct(productId, domain.Product{
 		Name:            product.Name,
-		Slug:            util.GenerateUniqueSlug(product.Name),
+		Slug:            slug,
+		Sku:             sku,
 		Description:     product.Description,
-		Price:           product.Price,
-		BasePrice:       product.BasePrice,
-		Discount:        product.Discount,
+		Price:           prices.Price,
+		BasePrice:       prices.BasePrice,
+		Discount:        prices.Discount,
 		ImageUrl:        product.ImageUrl,
 		MetaDescription: product.MetaDescription,
-		StockQuantity:   product.StockQuantity,
-		IsActive:        product.IsActive,
 		IsFeatured:      product.IsFeatured,
+		ProductType:     productType(product.ProductType, existingProduct.ProductType),
 		CategoryId:      product.CategoryId,
 		StoreId:         product.StoreId,
 		UpdatedAt:       time.Now(),
-	})
+		Attributes:      attributes,
+	}, userId)
 
 	if repositoryErr != nil {
 		return dto.ProductResponse{}, _errors.NewBadRequest(repositoryErr.Error())
 	}
 
-	ctx := context.Background()
-	key := fmt.Sprintf("product:%d", productId)
-	productService.redisClient.Del(ctx, key)
+	productService.slugs.changed(int64(productId), existingProduct.Slug, updatedProduct.Slug)
+
+	// Re-indexing keeps the attribute values of the search document in step with the product.
+	refreshProducts(productService.productRepository, productService.variantRepository, productService.redisClient,
+		[]domain.Product{updatedProduct})
 	return convertToProductResponse(updatedProduct), nil
 }
 
-func (productService *ProductService) SearchProducts(query string) ([]dto.ProductResponse, error) {
-	products, err := productService.productRepository.SearchProducts(query)
+// resolveAttributes validates attribute values against the schema of the product's category.
+func (productService *ProductService) resolveAttributes(categoryId *uint, values map[string]interface{}) ([]domain.ProductAttributeValue, error) {
+	if categoryId == nil {
+		if len(values) > 0 {
+			return nil, _errors.NewBadRequest("Attributes require a category")
+		}
+		return nil, nil
+	}
+	schema, err := productService.attributeRepository.GetCategoryAttributes(int64(*categoryId))
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	attributes, validationErr := productService.attributeValidator.ValidateValues(schema, values)
+	if validationErr != nil {
+		return nil, _errors.NewBadRequest(validationErr.Error())
+	}
+	return attributes, nil
+}
+
+// currentAttributes returns the product's stored attribute values keyed by code, as an update request would send them.
+func (productService *ProductService) currentAttributes(product domain.Product) (map[string]interface{}, error) {
+	if product.CategoryId == nil {
+		return nil, nil
+	}
+	valuesByProduct, err := productService.attributeRepository.GetAttributeValues([]int64{int64(product.Id)})
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{})
+	for _, value := range valuesByProduct[int64(product.Id)] {
+		values[value.Code] = value.Value()
+	}
+	return values, nil
+}
+
+func sameCategory(current *uint, next *uint) bool {
+	if current == nil || next == nil {
+		return current == next
+	}
+	return *current == *next
+}
+
+// SearchProducts searches the index of the locale, whose documents already hold the translated content.
+func (productService *ProductService) SearchProducts(query string, sort string, locale string) ([]dto.ProductResponse, error) {
+	if sort != "" && sort != domain.ProductSortRating {
+		return nil, _errors.NewBadRequest("Sort must be empty for relevance or rating")
+	}
+	products, err := productService.productRepository.SearchProducts(query, sort, locale)
 	if err != nil {
 		return nil, err
 	}
-	return convertToProductsResponse(products), nil
+	return productService.withBreadcrumbs(locale, convertToProductsResponse(products)), nil
 }
 
 func (productService *ProductService) SyncElasticsearch() error {
-	allProducts := productService.GetAllProducts()
+	allProducts := productService.withDetails(productService.productRepository.GetAllProducts())
 
 	fmt.Printf("🔄 Sync starting... Total products: %d\n", len(allProducts))
 	for _, p := range allProducts {
-		err := productService.productRepository.IndexProduct(domain.Product{
-			Id:              p.Id,
-			Name:            p.Name,
-			Slug:            p.Slug,
-			Description:     p.Description,
-			Price:           p.Price,
-			BasePrice:       p.BasePrice,
-			Discount:        p.Discount,
-			ImageUrl:        p.ImageUrl,
-			MetaDescription: p.MetaDescription,
-			StockQuantity:   p.StockQuantity,
-			IsActive:        p.IsActive,
-			IsFeatured:      p.IsFeatured,
-			CategoryId:      p.CategoryId,
-			StoreId:         p.StoreId,
-			UpdatedAt:       time.Now(),
-		})
+		p.UpdatedAt = time.Now()
+		err := productService.productRepository.IndexProduct(p)
 		if err != nil {
 			fmt.Printf("❌ Error (ID: %d): %v\n", p.Id, err)
 			continue
@@ -176,11 +433,106 @@ func (productService *ProductService) SyncElasticsearch() error {
 	return nil
 }
 
+// withDetails attaches the variants and attribute values of the given products so they are listed with
+// their parent product.
+func (productService *ProductService) withDetails(products []domain.Product) []domain.Product {
+	productIds := make([]int64, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, int64(product.Id))
+	}
+
+	variantsByProduct, err := productService.variantRepository.GetVariantsByProductIds(productIds)
+	if err != nil {
+		log.Error().Err(err).Msg("Product variants could not be loaded")
+		return products
+	}
+	attributesByProduct, err := productService.attributeRepository.GetAttributeValues(productIds)
+	if err != nil {
+		log.Error().Err(err).Msg("Product attributes could not be loaded")
+	}
+	for i := range products {
+		products[i].Variants = variantsByProduct[int64(products[i].Id)]
+		products[i].Attributes = attributesByProduct[int64(products[i].Id)]
+	}
+	return products
+}
+
+// withBreadcrumbs sets the path from the root category down to each product's category, in the locale.
+// Breadcrumbs are added to the responses rather than cached so renamed and moved categories show up at once.
+func (productService *ProductService) withBreadcrumbs(locale string, responses []dto.ProductResponse) []dto.ProductResponse {
+	categoryIds := make([]uint, 0, len(responses))
+	for _, response := range responses {
+		if response.CategoryId != nil {
+			categoryIds = append(categoryIds, *response.CategoryId)
+		}
+	}
+	if len(categoryIds) == 0 {
+		return responses
+	}
+
+	categories, err := productService.categoryRepository.GetCategoriesWithAncestors(categoryIds)
+	if err != nil {
+		log.Error().Err(err).Msg("Breadcrumb categories could not be loaded")
+		return responses
+	}
+	categoriesById := make(map[uint]domain.Category, len(categories))
+	for _, category := range productService.translator.categories(locale, categories) {
+		categoriesById[category.Id] = category
+	}
+	for i := range responses {
+		if responses[i].CategoryId == nil {
+			continue
+		}
+		if category, ok := categoriesById[*responses[i].CategoryId]; ok {
+			responses[i].Breadcrumbs = categoryBreadcrumbs(category, categoriesById)
+		}
+	}
+	return responses
+}
+
+// refreshProducts drops the cached copies of the given products and re-indexes them with their variants.
+func refreshProducts(productRepository persistence.IProductRepository, variantRepository persistence.IProductVariantRepository,
+	redisClient *redis.Client, products []domain.Product) {
+	if len(products) == 0 {
+		return
+	}
+	ctx := context.Background()
+
+	productIds := make([]int64, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, int64(product.Id))
+	}
+	variantsByProduct, err := variantRepository.GetVariantsByProductIds(productIds)
+	if err != nil {
+		log.Error().Err(err).Msg("Variants could not be loaded for indexing")
+	}
+
+	for _, product := range products {
+		redisClient.Del(ctx, fmt.Sprintf("product:%d", product.Id))
+		product.Variants = variantsByProduct[int64(product.Id)]
+		if err := productRepository.IndexProduct(product); err != nil {
+			log.Error().Err(err).Uint("product_id", product.Id).Msg("Product could not be indexed")
+		}
+	}
+}
+
+// evictProducts drops trashed products from the cache and the search index.
+func evictProducts(productRepository persistence.IProductRepository, redisClient *redis.Client, productIds []int64) {
+	ctx := context.Background()
+	for _, productId := range productIds {
+		redisClient.Del(ctx, fmt.Sprintf("product:%d", productId))
+		if err := productRepository.RemoveFromIndex(productId); err != nil {
+			log.Error().Err(err).Int64("product_id", productId).Msg("Product could not be removed from the search index")
+		}
+	}
+}
+
 func convertToProductResponse(product domain.Product) dto.ProductResponse {
 	return dto.ProductResponse{
 		Id:              product.Id,
 		Name:            product.Name,
 		Slug:            product.Slug,
+		Sku:             product.Sku,
 		Description:     product.Description,
 		Price:           product.Price,
 		BasePrice:       product.BasePrice,
@@ -194,7 +546,26 @@ func convertToProductResponse(product domain.Product) dto.ProductResponse {
 		StoreId:         product.StoreId,
 		CreatedAt:       product.CreatedAt,
 		UpdatedAt:       product.UpdatedAt,
+		Variants:        convertToProductVariantsResponse(product.Variants, product.Price),
+		DeletedAt:       product.DeletedAt,
+		AverageRating:   product.AverageRating,
+		ReviewCount:     product.ReviewCount,
+		Attributes:      convertToProductAttributesResponse(product.Attributes),
+		Status:          product.Status,
+		PublishAt:       product.PublishAt,
+		UnpublishAt:     product.UnpublishAt,
+		SubmittedAt:     product.SubmittedAt,
+		ReviewNote:      product.ReviewNote,
+		ProductType:     product.ProductType,
+	}
+}
+
+// productType returns the requested product type, or the fallback when the request leaves it out.
+func productType(requested string, fallback string) string {
+	if requested == "" {
+		return fallback
 	}
+	return requested
 }
 
 func convertToProductsResponse(products []domain.Product) []dto.ProductResponse {
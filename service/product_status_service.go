@@ -0,0 +1,280 @@
+package service
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+type IProductStatusService interface {
+	GetReviewQueue() ([]dto.ProductResponse, error)
+	GetStatusHistory(userId int64, role string, productId int64) ([]dto.ProductStatusChangeResponse, error)
+	Submit(userId int64, role string, productId int64) (dto.ProductResponse, error)
+	Approve(userId int64, productId int64, review dto.ReviewProductRequest) (dto.ProductResponse, error)
+	Reject(userId int64, productId int64, review dto.ReviewProductRequest) (dto.ProductResponse, error)
+	Publish(userId int64, productId int64) (dto.ProductResponse, error)
+	Unpublish(userId int64, role string, productId int64) (dto.ProductResponse, error)
+	SetSchedule(userId int64, role string, productId int64, scheduleRequest dto.ProductScheduleRequest) (dto.ProductResponse, error)
+	ApplySchedules() (domain.ProductPublishRun, error)
+}
+
+type ProductStatusService struct {
+	statusRepository  persistence.IProductStatusRepository
+	productRepository persistence.IProductRepository
+	variantRepository persistence.IProductVariantRepository
+	managers          productManagers
+	validator         *rules.ProductStatusRules
+	redisClient       *redis.Client
+}
+
+func NewProductStatusService(statusRepository persistence.IProductStatusRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, storeRepository persistence.IStoreRepository, rdb *redis.Client) IProductStatusService {
+	return &ProductStatusService{
+		statusRepository:  statusRepository,
+		productRepository: productRepository,
+		variantRepository: variantRepository,
+		managers:          newProductManagers(productRepository, storeRepository),
+		validator:         rules.NewProductStatusRules(),
+		redisClient:       rdb,
+	}
+}
+
+func (statusService *ProductStatusService) GetReviewQueue() ([]dto.ProductResponse, error) {
+	products, err := statusService.statusRepository.GetReviewQueue()
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return convertToProductsResponse(products), nil
+}
+
+func (statusService *ProductStatusService) GetStatusHistory(userId int64, role string, productId int64) ([]dto.ProductStatusChangeResponse, error) {
+	if _, err := statusService.managedProduct(userId, role, productId); err != nil {
+		return nil, err
+	}
+	changes, err := statusService.statusRepository.GetStatusHistory(productId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+
+	response := make([]dto.ProductStatusChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		response = append(response, dto.ProductStatusChangeResponse{
+			Id:         change.Id,
+			ProductId:  change.ProductId,
+			FromStatus: change.FromStatus,
+			ToStatus:   change.ToStatus,
+			Note:       change.Note,
+			ChangedBy:  change.ChangedBy,
+			ChangedAt:  change.ChangedAt,
+		})
+	}
+	return response, nil
+}
+
+// Submit puts a draft into the review queue.
+func (statusService *ProductStatusService) Submit(userId int64, role string, productId int64) (dto.ProductResponse, error) {
+	product, err := statusService.managedProduct(userId, role, productId)
+	if err != nil {
+		return dto.ProductResponse{}, err
+	}
+	return statusService.changeStatus(product, domain.ProductStatusPendingReview, "", &userId,
+		domain.PublishSchedule{PublishAt: product.PublishAt, UnpublishAt: product.UnpublishAt})
+}
+
+// Approve releases a reviewed product. It goes live right away unless its publish time is still ahead,
+// in which case it waits unpublished for the scheduler.
+func (statusService *ProductStatusService) Approve(userId int64, productId int64, review dto.ReviewProductRequest) (dto.ProductResponse, error) {
+	if validationErr := statusService.validator.ValidateReview(review, false); validationErr != nil {
+		return dto.ProductResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	product, err := statusService.loadProduct(productId)
+	if err != nil {
+		return dto.ProductResponse{}, err
+	}
+	if validationErr := statusService.validator.ValidateApproval(product.Status); validationErr != nil {
+		return dto.ProductResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if reviewerErr := statusService.validator.ValidateReviewer(product, userId); reviewerErr != nil {
+		return dto.ProductResponse{}, _errors.NewForbidden(reviewerErr.Error())
+	}
+
+	schedule := domain.PublishSchedule{PublishAt: product.PublishAt, UnpublishAt: product.UnpublishAt}
+	status := domain.ProductStatusUnpublished
+	if product.PublishAt == nil || !product.PublishAt.After(time.Now()) {
+		status = domain.ProductStatusPublished
+		schedule.PublishAt = nil
+	}
+	return statusService.applyStatus(product, status, strings.TrimSpace(review.Note), &userId, schedule)
+}
+
+// Reject sends a reviewed product back to draft with the reviewer's note.
+func (statusService *ProductStatusService) Reject(userId int64, productId int64, review dto.ReviewProductRequest) (dto.ProductResponse, error) {
+	if validationErr := statusService.validator.ValidateReview(review, true); validationErr != nil {
+		return dto.ProductResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	product, err := statusService.loadProduct(productId)
+	if err != nil {
+		return dto.ProductResponse{}, err
+	}
+	if reviewerErr := statusService.validator.ValidateReviewer(product, userId); reviewerErr != nil {
+		return dto.ProductResponse{}, _errors.NewForbidden(reviewerErr.Error())
+	}
+	return statusService.changeStatus(product, domain.ProductStatusDraft, strings.TrimSpace(review.Note), &userId,
+		domain.PublishSchedule{PublishAt: product.PublishAt, UnpublishAt: product.UnpublishAt})
+}
+
+// Publish puts an approved product live right away and drops its pending publish time.
+func (statusService *ProductStatusService) Publish(userId int64, productId int64) (dto.ProductResponse, error) {
+	product, err := statusService.loadProduct(productId)
+	if err != nil {
+		return dto.ProductResponse{}, err
+	}
+	return statusService.changeStatus(product, domain.ProductStatusPublished, "", &userId,
+		domain.PublishSchedule{UnpublishAt: product.UnpublishAt})
+}
+
+// Unpublish takes a product down right away and drops its pending unpublish time.
+func (statusService *ProductStatusService) Unpublish(userId int64, role string, productId int64) (dto.ProductResponse, error) {
+	product, err := statusService.managedProduct(userId, role, productId)
+	if err != nil {
+		return dto.ProductResponse{}, err
+	}
+	return statusService.changeStatus(product, domain.ProductStatusUnpublished, "", &userId, domain.PublishSchedule{})
+}
+
+// SetSchedule replaces the product's publish schedule. A publish time only takes effect once the product is
+// approved; until then it tells the reviewer when the product is meant to go live.
+func (statusService *ProductStatusService) SetSchedule(userId int64, role string, productId int64, scheduleRequest dto.ProductScheduleRequest) (dto.ProductResponse, error) {
+	product, err := statusService.managedProduct(userId, role, productId)
+	if err != nil {
+		return dto.ProductResponse{}, err
+	}
+	if validationErr := statusService.validator.ValidateSchedule(scheduleRequest, product.Status, time.Now()); validationErr != nil {
+		return dto.ProductResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	updated, err := statusService.statusRepository.SetSchedule(domain.ProductStatusChange{
+		ProductId: productId,
+		Note:      "Publish schedule changed",
+		ChangedBy: &userId,
+	}, domain.PublishSchedule{
+		PublishAt:   scheduleRequest.PublishAt,
+		UnpublishAt: scheduleRequest.UnpublishAt,
+	})
+	if err != nil {
+		if errors.Is(err, common.ErrProductNotFound) {
+			return dto.ProductResponse{}, _errors.NewNotFound(err.Error())
+		}
+		return dto.ProductResponse{}, _errors.NewInternalServerError(err)
+	}
+	refreshProducts(statusService.productRepository, statusService.variantRepository, statusService.redisClient, []domain.Product{updated})
+	return convertToProductResponse(updated), nil
+}
+
+// ApplySchedules publishes the approved products whose publish time has passed and then unpublishes the
+// products whose unpublish time has passed. A product that fails is logged and retried on the next run.
+func (statusService *ProductStatusService) ApplySchedules() (domain.ProductPublishRun, error) {
+	now := time.Now()
+	run := domain.ProductPublishRun{}
+	changed := make([]domain.Product, 0)
+
+	publishing, err := statusService.statusRepository.GetProductsToPublish(now)
+	if err != nil {
+		return run, err
+	}
+	for _, product := range publishing {
+		published, err := statusService.statusRepository.ChangeStatus(domain.ProductStatusChange{
+			ProductId:  int64(product.Id),
+			FromStatus: domain.ProductStatusUnpublished,
+			ToStatus:   domain.ProductStatusPublished,
+			Note:       "Scheduled publish",
+		}, domain.PublishSchedule{UnpublishAt: product.UnpublishAt})
+		if err != nil {
+			log.Error().Err(err).Uint("product_id", product.Id).Msg("Product could not be published")
+			continue
+		}
+		run.Published++
+		changed = append(changed, published)
+	}
+
+	unpublishing, err := statusService.statusRepository.GetProductsToUnpublish(now)
+	if err != nil {
+		return run, err
+	}
+	for _, product := range unpublishing {
+		unpublished, err := statusService.statusRepository.ChangeStatus(domain.ProductStatusChange{
+			ProductId:  int64(product.Id),
+			FromStatus: domain.ProductStatusPublished,
+			ToStatus:   domain.ProductStatusUnpublished,
+			Note:       "Scheduled unpublish",
+		}, domain.PublishSchedule{})
+		if err != nil {
+			log.Error().Err(err).Uint("product_id", product.Id).Msg("Product could not be unpublished")
+			continue
+		}
+		run.Unpublished++
+		changed = append(changed, unpublished)
+	}
+
+	refreshProducts(statusService.productRepository, statusService.variantRepository, statusService.redisClient, changed)
+	return run, nil
+}
+
+func (statusService *ProductStatusService) loadProduct(productId int64) (domain.Product, error) {
+	product, err := statusService.productRepository.GetProductById(productId)
+	if err != nil {
+		return domain.Product{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	return product, nil
+}
+
+// managedProduct loads the product for a reviewer or an owner of the product's store.
+func (statusService *ProductStatusService) managedProduct(userId int64, role string, productId int64) (domain.Product, error) {
+	product, err := statusService.loadProduct(productId)
+	if err != nil || slices.Contains(domain.ReviewerRoles, role) {
+		return product, err
+	}
+	if err := statusService.managers.authorize(userId, role, product); err != nil {
+		return domain.Product{}, err
+	}
+	return product, nil
+}
+
+// changeStatus validates and applies a transition.
+func (statusService *ProductStatusService) changeStatus(product domain.Product, status string, note string, userId *int64,
+	schedule domain.PublishSchedule) (dto.ProductResponse, error) {
+	if validationErr := statusService.validator.ValidateTransition(product.Status, status); validationErr != nil {
+		return dto.ProductResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	return statusService.applyStatus(product, status, note, userId, schedule)
+}
+
+// applyStatus records the status change, then refreshes the product's cached and indexed copies.
+func (statusService *ProductStatusService) applyStatus(product domain.Product, status string, note string, userId *int64,
+	schedule domain.PublishSchedule) (dto.ProductResponse, error) {
+	changed, err := statusService.statusRepository.ChangeStatus(domain.ProductStatusChange{
+		ProductId:  int64(product.Id),
+		FromStatus: product.Status,
+		ToStatus:   status,
+		Note:       note,
+		ChangedBy:  userId,
+	}, schedule)
+	if err != nil {
+		if errors.Is(err, common.ErrProductNotFound) {
+			return dto.ProductResponse{}, _errors.NewBadRequest("Product status was changed in the meantime")
+		}
+		return dto.ProductResponse{}, _errors.NewInternalServerError(err)
+	}
+	refreshProducts(statusService.productRepository, statusService.variantRepository, statusService.redisClient, []domain.Product{changed})
+	return convertToProductResponse(changed), nil
+}
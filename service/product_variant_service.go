@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"sort"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+type IProductVariantService interface {
+	GetOptionTypes() ([]dto.OptionTypeResponse, error)
+	AddOptionType(optionTypeCreate dto.CreateOptionTypeRequest) (dto.OptionTypeResponse, error)
+	AddOptionValue(optionTypeId int64, optionValueCreate dto.CreateOptionValueRequest) (dto.OptionValueResponse, error)
+	GetVariantsByProductId(productId int64) ([]dto.ProductVariantResponse, error)
+	GetVariantBySku(sku string) (dto.ProductVariantResponse, error)
+	AddVariant(userId int64, role string, productId int64, variantCreate dto.CreateProductVariantRequest) (dto.ProductVariantResponse, error)
+	UpdateVariant(userId int64, role string, productId int64, variantId int64, variantUpdate dto.CreateProductVariantRequest) (dto.ProductVariantResponse, error)
+	DeleteVariant(userId int64, role string, productId int64, variantId int64) error
+}
+
+type ProductVariantService struct {
+	variantRepository persistence.IProductVariantRepository
+	productRepository persistence.IProductRepository
+	redisClient       *redis.Client
+	validator         *rules.ProductVariantRules
+	managers          productManagers
+}
+
+func NewProductVariantService(variantRepository persistence.IProductVariantRepository, productRepository persistence.IProductRepository,
+	storeRepository persistence.IStoreRepository, rdb *redis.Client) IProductVariantService {
+	return &ProductVariantService{
+		variantRepository: variantRepository,
+		productRepository: productRepository,
+		redisClient:       rdb,
+		validator:         rules.NewProductVariantRules(),
+		managers:          newProductManagers(productRepository, storeRepository),
+	}
+}
+
+func (variantService *ProductVariantService) GetOptionTypes() ([]dto.OptionTypeResponse, error) {
+	optionTypes, err := variantService.variantRepository.GetOptionTypes()
+	if err != nil {
+		return []dto.OptionTypeResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	optionTypesDto := make([]dto.OptionTypeResponse, 0, len(optionTypes))
+	for _, optionType := range optionTypes {
+		optionTypesDto = append(optionTypesDto, convertToOptionTypeResponse(optionType))
+	}
+	return optionTypesDto, nil
+}
+
+func (variantService *ProductVariantService) AddOptionType(optionTypeCreate dto.CreateOptionTypeRequest) (dto.OptionTypeResponse, error) {
+	if validationErr := variantService.validator.ValidateOptionType(optionTypeCreate); validationErr != nil {
+		return dto.OptionTypeResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	optionType, err := variantService.variantRepository.AddOptionType(domain.OptionType{
+		Name:        strings.ToLower(strings.TrimSpace(optionTypeCreate.Name)),
+		DisplayName: strings.TrimSpace(optionTypeCreate.DisplayName),
+	})
+	if err != nil {
+		return dto.OptionTypeResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	return convertToOptionTypeResponse(optionType), nil
+}
+
+func (variantService *ProductVariantService) AddOptionValue(optionTypeId int64, optionValueCreate dto.CreateOptionValueRequest) (dto.OptionValueResponse, error) {
+	if validationErr := variantService.validator.ValidateOptionValue(optionValueCreate); validationErr != nil {
+		return dto.OptionValueResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := variantService.variantRepository.GetOptionTypeById(optionTypeId); err != nil {
+		return dto.OptionValueResponse{}, _errors.NewNotFound(err.Error())
+	}
+
+	optionValue, err := variantService.variantRepository.AddOptionValue(domain.OptionValue{
+		OptionTypeId: optionTypeId,
+		Value:        strings.TrimSpace(optionValueCreate.Value),
+		SortOrder:    optionValueCreate.SortOrder,
+	})
+	if err != nil {
+		return dto.OptionValueResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	return convertToOptionValueResponse(optionValue), nil
+}
+
+// GetVariantsByProductId lists the variants of a published product; like the product itself, the variants of
+// other products are not found.
+func (variantService *ProductVariantService) GetVariantsByProductId(productId int64) ([]dto.ProductVariantResponse, error) {
+	product, err := variantService.publishedProduct(productId)
+	if err != nil {
+		return []dto.ProductVariantResponse{}, err
+	}
+
+	variants, err := variantService.variantRepository.GetVariantsByProductId(productId)
+	if err != nil {
+		return []dto.ProductVariantResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToProductVariantsResponse(variants, product.Price), nil
+}
+
+func (variantService *ProductVariantService) GetVariantBySku(sku string) (dto.ProductVariantResponse, error) {
+	variant, err := variantService.variantRepository.GetVariantBySku(sku)
+	if err != nil {
+		return dto.ProductVariantResponse{}, _errors.NewNotFound(err.Error())
+	}
+
+	product, err := variantService.publishedProduct(variant.ProductId)
+	if err != nil {
+		return dto.ProductVariantResponse{}, _errors.NewNotFound(common.ErrProductVariantNotFound.Error())
+	}
+	return convertToProductVariantResponse(variant, product.Price), nil
+}
+
+func (variantService *ProductVariantService) AddVariant(userId int64, role string, productId int64, variantCreate dto.CreateProductVariantRequest) (dto.ProductVariantResponse, error) {
+	if validationErr := variantService.validator.ValidateCreate(variantCreate); validationErr != nil {
+		return dto.ProductVariantResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	product, err := variantService.managers.product(userId, role, productId)
+	if err != nil {
+		return dto.ProductVariantResponse{}, err
+	}
+	if err := variantService.validateOptionCombination(productId, 0, variantCreate.OptionValueIds); err != nil {
+		return dto.ProductVariantResponse{}, err
+	}
+
+	variant, err := variantService.variantRepository.AddVariant(domain.ProductVariant{
+		ProductId:     productId,
+		Sku:           strings.TrimSpace(variantCreate.Sku),
+		Price:         variantCreate.Price,
+		StockQuantity: variantCreate.StockQuantity,
+		Barcode:       variantCreate.Barcode,
+		ImageUrl:      variantCreate.ImageUrl,
+		IsActive:      variantCreate.IsActive,
+	}, variantCreate.OptionValueIds)
+	if err != nil {
+		return dto.ProductVariantResponse{}, _errors.NewBadRequest(err.Error())
+	}
+
+	variantService.refreshProduct(product)
+	return convertToProductVariantResponse(variant, product.Price), nil
+}
+
+func (variantService *ProductVariantService) UpdateVariant(userId int64, role string, productId int64, variantId int64, variantUpdate dto.CreateProductVariantRequest) (dto.ProductVariantResponse, error) {
+	if validationErr := variantService.validator.ValidateCreate(variantUpdate); validationErr != nil {
+		return dto.ProductVariantResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	product, err := variantService.managers.product(userId, role, productId)
+	if err != nil {
+		return dto.ProductVariantResponse{}, err
+	}
+	if _, err := variantService.getProductVariant(productId, variantId); err != nil {
+		return dto.ProductVariantResponse{}, err
+	}
+	if err := variantService.validateOptionCombination(productId, variantId, variantUpdate.OptionValueIds); err != nil {
+		return dto.ProductVariantResponse{}, err
+	}
+
+	variant, err := variantService.variantRepository.UpdateVariant(variantId, domain.ProductVariant{
+		Sku:      strings.TrimSpace(variantUpdate.Sku),
+		Price:    variantUpdate.Price,
+		Barcode:  variantUpdate.Barcode,
+		ImageUrl: variantUpdate.ImageUrl,
+		IsActive: variantUpdate.IsActive,
+	}, variantUpdate.OptionValueIds)
+	if err != nil {
+		return dto.ProductVariantResponse{}, _errors.NewBadRequest(err.Error())
+	}
+
+	variantService.refreshProduct(product)
+	return convertToProductVariantResponse(variant, product.Price), nil
+}
+
+func (variantService *ProductVariantService) DeleteVariant(userId int64, role string, productId int64, variantId int64) error {
+	product, err := variantService.managers.product(userId, role, productId)
+	if err != nil {
+		return err
+	}
+	if _, err := variantService.getProductVariant(productId, variantId); err != nil {
+		return err
+	}
+	if err := variantService.variantRepository.DeleteVariantById(variantId); err != nil {
+		return _errors.NewBadRequest(err.Error())
+	}
+
+	variantService.refreshProduct(product)
+	return nil
+}
+
+// publishedProduct loads a product for the public variant endpoints, which only show published products.
+func (variantService *ProductVariantService) publishedProduct(productId int64) (domain.Product, error) {
+	product, err := variantService.productRepository.GetProductById(productId)
+	if err != nil || product.Status != domain.ProductStatusPublished {
+		return domain.Product{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	return product, nil
+}
+
+func (variantService *ProductVariantService) getProductVariant(productId int64, variantId int64) (domain.ProductVariant, error) {
+	variant, err := variantService.variantRepository.GetVariantById(variantId)
+	if err != nil || variant.ProductId != productId {
+		return domain.ProductVariant{}, _errors.NewNotFound(common.ErrProductVariantNotFound.Error())
+	}
+	return variant, nil
+}
+
+// validateOptionCombination checks that the option values exist, use each option type at most once
+// and are not already used by another variant of the same product.
+func (variantService *ProductVariantService) validateOptionCombination(productId int64, variantId int64, optionValueIds []int64) error {
+	optionValues, err := variantService.variantRepository.GetOptionValuesByIds(optionValueIds)
+	if err != nil {
+		return _errors.NewInternalServerError(err)
+	}
+	if len(optionValues) != len(optionValueIds) {
+		return _errors.NewBadRequest(common.ErrOptionValueNotFound.Error())
+	}
+
+	seenTypes := make(map[int64]bool)
+	for _, optionValue := range optionValues {
+		if seenTypes[optionValue.OptionTypeId] {
+			return _errors.NewBadRequest("Variant can have only one value per option type")
+		}
+		seenTypes[optionValue.OptionTypeId] = true
+	}
+
+	siblings, err := variantService.variantRepository.GetVariantsByProductId(productId)
+	if err != nil {
+		return _errors.NewInternalServerError(err)
+	}
+	combination := optionCombinationKey(optionValueIds)
+	for _, sibling := range siblings {
+		if sibling.Id == variantId {
+			continue
+		}
+		siblingValueIds := make([]int64, 0, len(sibling.Options))
+		for _, option := range sibling.Options {
+			siblingValueIds = append(siblingValueIds, option.OptionValueId)
+		}
+		if optionCombinationKey(siblingValueIds) == combination {
+			return _errors.NewBadRequest(fmt.Sprintf("Variant %s already has these options", sibling.Sku))
+		}
+	}
+	return nil
+}
+
+// refreshProduct drops the cached product and re-indexes it so search results carry the current variants.
+func (variantService *ProductVariantService) refreshProduct(product domain.Product) {
+	variantService.redisClient.Del(context.Background(), fmt.Sprintf("product:%d", product.Id))
+
+	variants, err := variantService.variantRepository.GetVariantsByProductId(int64(product.Id))
+	if err != nil {
+		log.Error().Err(err).Uint("product_id", product.Id).Msg("Variants could not be loaded for indexing")
+		return
+	}
+	product.Variants = variants
+	if err := variantService.productRepository.IndexProduct(product); err != nil {
+		log.Error().Err(err).Uint("product_id", product.Id).Msg("Product could not be re-indexed")
+	}
+}
+
+func optionCombinationKey(optionValueIds []int64) string {
+	sorted := append([]int64(nil), optionValueIds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return fmt.Sprint(sorted)
+}
+
+func convertToOptionTypeResponse(optionType domain.OptionType) dto.OptionTypeResponse {
+	values := make([]dto.OptionValueResponse, 0, len(optionType.Values))
+	for _, value := range optionType.Values {
+		values = append(values, convertToOptionValueResponse(value))
+	}
+	return dto.OptionTypeResponse{
+		Id:          optionType.Id,
+		Name:        optionType.Name,
+		DisplayName: optionType.DisplayName,
+		Values:      values,
+	}
+}
+
+func convertToOptionValueResponse(optionValue domain.OptionValue) dto.OptionValueResponse {
+	return dto.OptionValueResponse{
+		Id:        optionValue.Id,
+		Value:     optionValue.Value,
+		SortOrder: optionValue.SortOrder,
+	}
+}
+
+func convertToProductVariantResponse(variant domain.ProductVariant, productPrice float64) dto.ProductVariantResponse {
+	options := make([]dto.VariantOptionResponse, 0, len(variant.Options))
+	for _, option := range variant.Options {
+		options = append(options, dto.VariantOptionResponse{
+			OptionTypeId:  option.OptionTypeId,
+			OptionType:    option.OptionType,
+			OptionValueId: option.OptionValueId,
+			Value:         option.Value,
+		})
+	}
+	return dto.ProductVariantResponse{
+		Id:             variant.Id,
+		ProductId:      variant.ProductId,
+		Sku:            variant.Sku,
+		Price:          variant.Price,
+		EffectivePrice: variant.EffectivePrice(productPrice),
+		StockQuantity:  variant.StockQuantity,
+		Barcode:        variant.Barcode,
+		ImageUrl:       variant.ImageUrl,
+		IsActive:       variant.IsActive,
+		Options:        options,
+		CreatedAt:      variant.CreatedAt,
+		UpdatedAt:      variant.UpdatedAt,
+	}
+}
+
+func convertToProductVariantsResponse(variants []domain.ProductVariant, productPrice float64) []dto.ProductVariantResponse {
+	variantsDto := make([]dto.ProductVariantResponse, 0, len(variants))
+	for _, variant := range variants {
+		variantsDto = append(variantsDto, convertToProductVariantResponse(variant, productPrice))
+	}
+	return variantsDto
+}
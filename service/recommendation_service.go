@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+type IRecommendationService interface {
+	GetRelatedProducts(productId int64, limit int) ([]dto.RelatedProductResponse, error)
+	GetCartRecommendations(cartId int64, limit int) ([]dto.RelatedProductResponse, error)
+	ComputeRelations() (domain.RelationRun, error)
+}
+
+type RecommendationService struct {
+	recommendationRepository persistence.IRecommendationRepository
+	productRepository        persistence.IProductRepository
+	cartRepository           persistence.ICartRepository
+	cartItemRepository       persistence.ICartItemRepository
+	redisClient              *redis.Client
+	validator                *rules.RecommendationRules
+	topN                     int
+	cacheTTL                 time.Duration
+}
+
+func NewRecommendationService(recommendationRepository persistence.IRecommendationRepository, productRepository persistence.IProductRepository,
+	cartRepository persistence.ICartRepository, cartItemRepository persistence.ICartItemRepository, rdb *redis.Client,
+	recommendationConfig config.RecommendationConfig) IRecommendationService {
+	return &RecommendationService{
+		recommendationRepository: recommendationRepository,
+		productRepository:        productRepository,
+		cartRepository:           cartRepository,
+		cartItemRepository:       cartItemRepository,
+		redisClient:              rdb,
+		validator:                rules.NewRecommendationRules(recommendationConfig.TopN),
+		topN:                     recommendationConfig.TopN,
+		cacheTTL:                 config.ParseDuration(recommendationConfig.CacheTTL, time.Hour),
+	}
+}
+
+// GetRelatedProducts returns the products frequently bought together with the product, topped up with
+// similar products of its category.
+func (recommendationService *RecommendationService) GetRelatedProducts(productId int64, limit int) ([]dto.RelatedProductResponse, error) {
+	if validationErr := recommendationService.validator.ValidateLimit(limit); validationErr != nil {
+		return nil, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := recommendationService.productRepository.GetProductById(productId); err != nil {
+		return nil, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+
+	key := fmt.Sprintf("related:%d", productId)
+	related, err := recommendationService.cached(key, func() ([]domain.RelatedProduct, error) {
+		return recommendationService.recommendationRepository.GetRelatedProducts(productId, recommendationService.topN)
+	})
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return recommendationService.firstN(related, limit), nil
+}
+
+// GetCartRecommendations suggests products related to the cart's contents that are not in the cart yet.
+func (recommendationService *RecommendationService) GetCartRecommendations(cartId int64, limit int) ([]dto.RelatedProductResponse, error) {
+	if validationErr := recommendationService.validator.ValidateLimit(limit); validationErr != nil {
+		return nil, _errors.NewBadRequest(validationErr.Error())
+	}
+	cart := recommendationService.cartRepository.GetCartById(cartId)
+	if cart.Id == 0 {
+		return nil, _errors.NewNotFound(common.ErrCartNotFound.Error())
+	}
+
+	productIds := make([]int64, 0)
+	for _, item := range recommendationService.cartItemRepository.GetItemsByCartId(cartId) {
+		if !slices.Contains(productIds, item.ProductId) {
+			productIds = append(productIds, item.ProductId)
+		}
+	}
+	if len(productIds) == 0 {
+		return []dto.RelatedProductResponse{}, nil
+	}
+	slices.Sort(productIds)
+
+	// Carts with the same products share the cached recommendations.
+	idStrings := make([]string, 0, len(productIds))
+	for _, productId := range productIds {
+		idStrings = append(idStrings, strconv.FormatInt(productId, 10))
+	}
+	key := "recommendations:products:" + strings.Join(idStrings, ",")
+	recommendations, err := recommendationService.cached(key, func() ([]domain.RelatedProduct, error) {
+		return recommendationService.recommendationRepository.GetRecommendationsFor(productIds, recommendationService.topN)
+	})
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return recommendationService.firstN(recommendations, limit), nil
+}
+
+// ComputeRelations recomputes the related products of every product from the order history and drops the
+// cached recommendations built from the previous run.
+func (recommendationService *RecommendationService) ComputeRelations() (domain.RelationRun, error) {
+	run, err := recommendationService.recommendationRepository.RebuildRelations(recommendationService.topN)
+	if err != nil {
+		return domain.RelationRun{}, err
+	}
+
+	ctx := context.Background()
+	for _, pattern := range []string{"related:*", "recommendations:*"} {
+		iter := recommendationService.redisClient.Scan(ctx, 0, pattern, 100).Iterator()
+		for iter.Next(ctx) {
+			recommendationService.redisClient.Del(ctx, iter.Val())
+		}
+		if iterErr := iter.Err(); iterErr != nil {
+			log.Error().Err(iterErr).Str("pattern", pattern).Msg("Cached recommendations could not be cleared")
+		}
+	}
+	return run, nil
+}
+
+// cached returns the relations stored under the key, loading and caching them on a miss.
+func (recommendationService *RecommendationService) cached(key string, load func() ([]domain.RelatedProduct, error)) ([]domain.RelatedProduct, error) {
+	ctx := context.Background()
+	result, redisErr := recommendationService.redisClient.Get(ctx, key).Result()
+	if redisErr == nil {
+		var cachedRelations []domain.RelatedProduct
+		if json.Unmarshal([]byte(result), &cachedRelations) == nil {
+			return cachedRelations, nil
+		}
+	}
+
+	relations, err := load()
+	if err != nil {
+		return nil, err
+	}
+	data, _ := json.Marshal(relations)
+	recommendationService.redisClient.Set(ctx, key, data, recommendationService.cacheTTL)
+	return relations, nil
+}
+
+func (recommendationService *RecommendationService) firstN(relations []domain.RelatedProduct, limit int) []dto.RelatedProductResponse {
+	if limit > 0 && len(relations) > limit {
+		relations = relations[:limit]
+	}
+	response := make([]dto.RelatedProductResponse, 0, len(relations))
+	for _, related := range relations {
+		response = append(response, dto.RelatedProductResponse{
+			Product: convertToProductResponse(related.Product),
+			Source:  related.Source,
+			Score:   related.Score,
+		})
+	}
+	return response
+}
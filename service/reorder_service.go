@@ -0,0 +1,191 @@
+package service
+
+import (
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"time"
+)
+
+type IReorderService interface {
+	Reorder(userId int64, orderId int64) (dto.ReorderResponse, error)
+}
+
+type ReorderService struct {
+	orderRepository     persistence.IOrderRepository
+	orderItemRepository persistence.IOrderItemRepository
+	productRepository   persistence.IProductRepository
+	cartRepository      persistence.ICartRepository
+	cartItemRepository  persistence.ICartItemRepository
+	variantRepository   persistence.IProductVariantRepository
+	pricer              pricer
+}
+
+func NewReorderService(orderRepository persistence.IOrderRepository, orderItemRepository persistence.IOrderItemRepository,
+	productRepository persistence.IProductRepository, cartRepository persistence.ICartRepository,
+	cartItemRepository persistence.ICartItemRepository, variantRepository persistence.IProductVariantRepository,
+	ruleRepository persistence.IPriceRuleRepository) IReorderService {
+	return &ReorderService{
+		orderRepository:     orderRepository,
+		orderItemRepository: orderItemRepository,
+		productRepository:   productRepository,
+		cartRepository:      cartRepository,
+		cartItemRepository:  cartItemRepository,
+		variantRepository:   variantRepository,
+		pricer:              pricer{ruleRepository: ruleRepository},
+	}
+}
+
+// reorderLineKey identifies a sellable unit: a product, or one of its variants.
+type reorderLineKey struct {
+	productId int64
+	variantId int64
+}
+
+func newReorderLineKey(productId int64, variantId *int64) reorderLineKey {
+	key := reorderLineKey{productId: productId}
+	if variantId != nil {
+		key.variantId = *variantId
+	}
+	return key
+}
+
+// Reorder copies the lines of one of the user's orders into their active cart at today's prices.
+// Lines for inactive products are dropped and quantities are capped to what is still in stock.
+func (reorderService *ReorderService) Reorder(userId int64, orderId int64) (dto.ReorderResponse, error) {
+	order := reorderService.orderRepository.GetOrderById(orderId)
+	if order.Id == 0 || order.UserId != userId {
+		return dto.ReorderResponse{}, _errors.NewNotFound(common.ErrOrderNotFound.Error())
+	}
+
+	orderItems, err := reorderService.orderItemRepository.GetOrderItemsByOrderId(orderId)
+	if err != nil {
+		return dto.ReorderResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	calculator, err := reorderService.pricer.calculator(userId)
+	if err != nil {
+		return dto.ReorderResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	cart, err := reorderService.getOrCreateActiveCart(userId)
+	if err != nil {
+		return dto.ReorderResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	inCart := make(map[reorderLineKey]int)
+	for _, cartItem := range reorderService.cartItemRepository.GetItemsByCartId(cart.Id) {
+		inCart[newReorderLineKey(cartItem.ProductId, cartItem.VariantId)] += cartItem.Quantity
+	}
+
+	response := dto.ReorderResponse{
+		OrderId:  orderId,
+		CartId:   cart.Id,
+		Added:    []dto.ReorderLineResponse{},
+		Adjusted: []dto.ReorderLineResponse{},
+		Dropped:  []dto.ReorderLineResponse{},
+	}
+
+	for _, line := range mergeOrderLines(orderItems) {
+		key := newReorderLineKey(line.ProductId, line.VariantId)
+		report := dto.ReorderLineResponse{
+			ProductId:         line.ProductId,
+			VariantId:         line.VariantId,
+			RequestedQuantity: line.Quantity,
+			PreviousPrice:     line.Price,
+		}
+
+		product, productErr := reorderService.productRepository.GetProductById(line.ProductId)
+		if productErr != nil || !product.IsActive {
+			report.Reasons = []string{dto.ReorderReasonUnavailable}
+			response.Dropped = append(response.Dropped, report)
+			continue
+		}
+		report.ProductName = product.Name
+		var variant *domain.ProductVariant
+		stockQuantity := product.StockQuantity
+
+		if line.VariantId != nil {
+			found, variantErr := reorderService.variantRepository.GetVariantById(*line.VariantId)
+			if variantErr != nil || !found.IsActive {
+				report.Reasons = []string{dto.ReorderReasonVariantGone}
+				response.Dropped = append(response.Dropped, report)
+				continue
+			}
+			variant = &found
+			report.Sku = found.Sku
+			stockQuantity = found.StockQuantity
+		}
+		currentPrice := calculator.UnitPrice(product, variant)
+		report.CurrentPrice = currentPrice
+
+		available := stockQuantity - inCart[key]
+		if available <= 0 {
+			report.Reasons = []string{dto.ReorderReasonOutOfStock}
+			response.Dropped = append(response.Dropped, report)
+			continue
+		}
+
+		quantity := line.Quantity
+		if quantity > available {
+			quantity = available
+			report.Reasons = append(report.Reasons, dto.ReorderReasonCappedToStock)
+		}
+		if float32(currentPrice) != line.Price {
+			report.Reasons = append(report.Reasons, dto.ReorderReasonPriceChanged)
+		}
+
+		if _, addErr := reorderService.cartItemRepository.AddItemToCart(domain.CartItem{
+			CartId:    cart.Id,
+			ProductId: line.ProductId,
+			VariantId: line.VariantId,
+			Quantity:  quantity,
+		}); addErr != nil {
+			return dto.ReorderResponse{}, _errors.NewInternalServerError(addErr)
+		}
+		inCart[key] += quantity
+		report.AddedQuantity = quantity
+
+		if quantity < line.Quantity {
+			response.Adjusted = append(response.Adjusted, report)
+		} else {
+			response.Added = append(response.Added, report)
+		}
+	}
+
+	return response, nil
+}
+
+func (reorderService *ReorderService) getOrCreateActiveCart(userId int64) (domain.Cart, error) {
+	var activeCart domain.Cart
+	for _, cart := range reorderService.cartRepository.GetCartsByUserId(userId) {
+		if cart.Status == domain.CartStatusActive && cart.UpdatedAt.After(activeCart.UpdatedAt) {
+			activeCart = cart
+		}
+	}
+	if activeCart.Id != 0 {
+		return activeCart, nil
+	}
+	return reorderService.cartRepository.CreateCart(domain.Cart{
+		UserId:    userId,
+		CreatedAt: time.Now(),
+	})
+}
+
+// mergeOrderLines collapses repeated lines of the same product variant while keeping the original line order.
+func mergeOrderLines(orderItems []domain.OrderItem) []domain.OrderItem {
+	merged := make([]domain.OrderItem, 0, len(orderItems))
+	positions := make(map[reorderLineKey]int)
+	for _, item := range orderItems {
+		key := newReorderLineKey(item.ProductId, item.VariantId)
+		if position, ok := positions[key]; ok {
+			merged[position].Quantity += item.Quantity
+			continue
+		}
+		positions[key] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/locale"
+	"math"
+)
+
+var sitemapEntityTypes = []string{domain.SitemapProducts, domain.SitemapCategories, domain.SitemapStores}
+
+type ISeoService interface {
+	GetSitemapIndex() ([]byte, error)
+	GetSitemap(entityType string, page int) ([]byte, error)
+	RefreshSitemaps() (domain.SitemapRun, error)
+	GetProductSeo(productId int64, locale string) (dto.ProductSeoResponse, error)
+}
+
+type SeoService struct {
+	sitemapRepository     persistence.ISitemapRepository
+	productRepository     persistence.IProductRepository
+	variantRepository     persistence.IProductVariantRepository
+	storeRepository       persistence.IStoreRepository
+	translationRepository persistence.ITranslationRepository
+	pricer                pricer
+	locales               locale.Locales
+	storefront            storefront
+	seoConfig             config.SeoConfig
+	currency              string
+}
+
+func NewSeoService(sitemapRepository persistence.ISitemapRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, storeRepository persistence.IStoreRepository,
+	translationRepository persistence.ITranslationRepository, ruleRepository persistence.IPriceRuleRepository, locales locale.Locales,
+	seoConfig config.SeoConfig, exportConfig config.ExportConfig) ISeoService {
+	// Every entity is listed once per locale, so a page holds at most maxSitemapUrls / locales entities.
+	maxPageSize := maxSitemapUrls / len(locales.Supported())
+	if seoConfig.SitemapPageSize <= 0 || seoConfig.SitemapPageSize > maxPageSize {
+		seoConfig.SitemapPageSize = maxPageSize
+	}
+	return &SeoService{
+		sitemapRepository:     sitemapRepository,
+		productRepository:     productRepository,
+		variantRepository:     variantRepository,
+		storeRepository:       storeRepository,
+		translationRepository: translationRepository,
+		pricer:                pricer{ruleRepository: ruleRepository},
+		locales:               locales,
+		storefront:            newStorefront(exportConfig.SiteBaseUrl, locales),
+		seoConfig:             seoConfig,
+		currency:              exportConfig.Currency,
+	}
+}
+
+func (seoService *SeoService) GetSitemapIndex() ([]byte, error) {
+	pages, err := seoService.sitemapRepository.GetPages()
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	content, err := writeSitemapIndex(seoService.seoConfig.SitemapBaseUrl, pages)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return content, nil
+}
+
+func (seoService *SeoService) GetSitemap(entityType string, page int) ([]byte, error) {
+	content, err := seoService.sitemapRepository.GetPageContent(entityType, page)
+	if err != nil {
+		if errors.Is(err, common.ErrSitemapNotFound) {
+			return nil, _errors.NewNotFound(err.Error())
+		}
+		return nil, _errors.NewInternalServerError(err)
+	}
+	return []byte(content), nil
+}
+
+// RefreshSitemaps brings the stored sitemaps in line with the catalog. Only pages whose entries changed
+// since they were generated are written again; pages left without entries are removed.
+func (seoService *SeoService) RefreshSitemaps() (domain.SitemapRun, error) {
+	run := domain.SitemapRun{}
+	stored, err := seoService.sitemapRepository.GetPages()
+	if err != nil {
+		return run, err
+	}
+	fingerprints := make(map[string]string, len(stored))
+	for _, page := range stored {
+		fingerprints[sitemapFile(page.EntityType, page.Page)] = page.Fingerprint
+	}
+
+	pageSize := seoService.seoConfig.SitemapPageSize
+	for _, entityType := range sitemapEntityTypes {
+		states, err := seoService.sitemapRepository.GetPageStates(entityType, pageSize)
+		if err != nil {
+			return run, err
+		}
+
+		pages := make([]int, 0, len(states))
+		for _, state := range states {
+			pages = append(pages, state.Page)
+			if fingerprints[sitemapFile(entityType, state.Page)] == state.Fingerprint {
+				continue
+			}
+			entries, err := seoService.sitemapRepository.GetEntries(entityType, state.Page, pageSize)
+			if err != nil {
+				return run, err
+			}
+			content, err := writeSitemap(seoService.storefront, entityType, entries)
+			if err != nil {
+				return run, err
+			}
+			if err := seoService.sitemapRepository.SavePage(state, string(content)); err != nil {
+				return run, err
+			}
+			run.Generated++
+		}
+
+		removed, err := seoService.sitemapRepository.DeletePagesExcept(entityType, pages)
+		if err != nil {
+			return run, err
+		}
+		run.Removed += int(removed)
+	}
+	return run, nil
+}
+
+// GetProductSeo returns the canonical URL, the locale alternates and the JSON-LD of an active product
+// in the locale. Prices are the ones an anonymous shopper pays.
+func (seoService *SeoService) GetProductSeo(productId int64, locale string) (dto.ProductSeoResponse, error) {
+	product, err := seoService.productRepository.GetProductById(productId)
+	if err != nil || !product.IsActive {
+		return dto.ProductSeoResponse{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	variants, err := seoService.variantRepository.GetVariantsByProductIds([]int64{productId})
+	if err != nil {
+		return dto.ProductSeoResponse{}, _errors.NewInternalServerError(err)
+	}
+	product.Variants = variants[productId]
+	translations, err := seoService.translationRepository.GetProductTranslations(productId)
+	if err != nil {
+		return dto.ProductSeoResponse{}, _errors.NewInternalServerError(err)
+	}
+	calculator, err := seoService.pricer.calculator(0)
+	if err != nil {
+		return dto.ProductSeoResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	slugs := map[string]string{seoService.locales.Default(): product.Slug}
+	for _, translation := range translations {
+		slugs[translation.Locale] = translation.Slug
+		if translation.Locale == locale {
+			product = translation.Apply(product)
+		}
+	}
+	response := dto.ProductSeoResponse{ProductId: productId, Locale: locale, Alternates: []dto.AlternateUrl{}}
+	for _, supported := range seoService.locales.Supported() {
+		slug, ok := slugs[supported]
+		if !ok {
+			slug = slugs[seoService.locales.Default()]
+		}
+		url := seoService.storefront.url(supported, "/products/"+slug)
+		if supported == locale {
+			response.CanonicalUrl = url
+		}
+		response.Alternates = append(response.Alternates, dto.AlternateUrl{Locale: supported, Url: url})
+	}
+
+	var seller *dto.Organization
+	if store, err := seoService.storeRepository.GetStoreById(product.StoreId); err == nil {
+		seller = &dto.Organization{Type: "Organization", Name: store.Name}
+	}
+	response.JsonLd = dto.ProductJsonLd{
+		Context:     "https://schema.org",
+		Type:        "Product",
+		Name:        product.Name,
+		Description: product.Description,
+		Sku:         stringValue(product.Sku),
+		Image:       product.ImageUrl,
+		Url:         response.CanonicalUrl,
+	}
+	if product.ReviewCount > 0 {
+		response.JsonLd.AggregateRating = &dto.AggregateRating{
+			Type: "AggregateRating", RatingValue: product.AverageRating, ReviewCount: product.ReviewCount,
+		}
+	}
+
+	variantList := activeVariants(product.Variants)
+	if len(variantList) == 0 {
+		response.JsonLd.Offers = dto.Offer{
+			Type:          "Offer",
+			Url:           response.CanonicalUrl,
+			Price:         formatPrice(calculator.UnitPrice(product, nil)),
+			PriceCurrency: seoService.currency,
+			Availability:  schemaAvailability(product.StockQuantity),
+			ItemCondition: "https://schema.org/NewCondition",
+			Seller:        seller,
+		}
+		return response, nil
+	}
+
+	offers := make([]dto.Offer, 0, len(variantList))
+	lowPrice, highPrice := math.MaxFloat64, 0.0
+	for i := range variantList {
+		price := calculator.UnitPrice(product, &variantList[i])
+		lowPrice, highPrice = math.Min(lowPrice, price), math.Max(highPrice, price)
+		offers = append(offers, dto.Offer{
+			Type:          "Offer",
+			Sku:           variantList[i].Sku,
+			Url:           fmt.Sprintf("%s?variant=%d", response.CanonicalUrl, variantList[i].Id),
+			Price:         formatPrice(price),
+			PriceCurrency: seoService.currency,
+			Availability:  schemaAvailability(variantList[i].StockQuantity),
+			ItemCondition: "https://schema.org/NewCondition",
+		})
+	}
+	response.JsonLd.Offers = dto.AggregateOffer{
+		Type:          "AggregateOffer",
+		LowPrice:      formatPrice(lowPrice),
+		HighPrice:     formatPrice(highPrice),
+		PriceCurrency: seoService.currency,
+		OfferCount:    len(offers),
+		Offers:        offers,
+		Seller:        seller,
+	}
+	return response, nil
+}
+
+func sitemapFile(entityType string, page int) string {
+	return fmt.Sprintf("%s-%d", entityType, page)
+}
+
+func schemaAvailability(stockQuantity int) string {
+	if stockQuantity > 0 {
+		return "https://schema.org/InStock"
+	}
+	return "https://schema.org/OutOfStock"
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/pkg/locale"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSitemapUrls is the limit of URLs a single sitemap file may list.
+const maxSitemapUrls = 50000
+
+// storefront builds the public URLs of catalog pages. Pages in the default locale have no locale prefix,
+// pages in a translated locale live under /<locale>.
+type storefront struct {
+	baseUrl string
+	locales locale.Locales
+}
+
+func newStorefront(baseUrl string, locales locale.Locales) storefront {
+	return storefront{baseUrl: strings.TrimRight(baseUrl, "/"), locales: locales}
+}
+
+func (storefront storefront) url(locale string, path string) string {
+	if locale == storefront.locales.Default() {
+		return storefront.baseUrl + path
+	}
+	return storefront.baseUrl + "/" + locale + path
+}
+
+// entryUrl returns the URL of a sitemap entry in the locale; a product uses its slug in the locale when
+// the locale has one.
+func (storefront storefront) entryUrl(entityType string, entry domain.SitemapEntry, locale string) string {
+	switch entityType {
+	case domain.SitemapProducts:
+		slug := entry.Slug
+		if translated, ok := entry.Alternates[locale]; ok {
+			slug = translated
+		}
+		return storefront.url(locale, "/products/"+slug)
+	case domain.SitemapStores:
+		return storefront.url(locale, "/stores/"+entry.Slug)
+	default:
+		return storefront.url(locale, "/categories/"+strconv.FormatInt(entry.EntityId, 10))
+	}
+}
+
+type sitemapUrlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Xhtml   string       `xml:"xmlns:xhtml,attr"`
+	Urls    []sitemapUrl `xml:"url"`
+}
+
+type sitemapUrl struct {
+	Loc        string             `xml:"loc"`
+	LastMod    string             `xml:"lastmod,omitempty"`
+	Alternates []sitemapAlternate `xml:"xhtml:link"`
+}
+
+type sitemapAlternate struct {
+	Rel      string `xml:"rel,attr"`
+	HrefLang string `xml:"hreflang,attr"`
+	Href     string `xml:"href,attr"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name         `xml:"sitemapindex"`
+	Xmlns    string           `xml:"xmlns,attr"`
+	Sitemaps []sitemapPointer `xml:"sitemap"`
+}
+
+type sitemapPointer struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// writeSitemap lists every entry once per supported locale, each URL pointing to the entry's pages in all
+// locales so search engines serve the page in the searcher's language.
+func writeSitemap(storefront storefront, entityType string, entries []domain.SitemapEntry) ([]byte, error) {
+	urlSet := sitemapUrlSet{
+		Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9",
+		Xhtml: "http://www.w3.org/1999/xhtml",
+		Urls:  make([]sitemapUrl, 0, len(entries)*len(storefront.locales.Supported())),
+	}
+	for _, entry := range entries {
+		alternates := []sitemapAlternate{}
+		if len(storefront.locales.Supported()) > 1 {
+			for _, locale := range storefront.locales.Supported() {
+				alternates = append(alternates, sitemapAlternate{
+					Rel: "alternate", HrefLang: locale, Href: storefront.entryUrl(entityType, entry, locale),
+				})
+			}
+		}
+		for _, locale := range storefront.locales.Supported() {
+			urlSet.Urls = append(urlSet.Urls, sitemapUrl{
+				Loc:        storefront.entryUrl(entityType, entry, locale),
+				LastMod:    formatLastMod(entry.LastModified),
+				Alternates: alternates,
+			})
+		}
+	}
+	return encodeSitemap(urlSet)
+}
+
+// writeSitemapIndex points to the generated sitemap files served under baseUrl.
+func writeSitemapIndex(baseUrl string, pages []domain.SitemapPage) ([]byte, error) {
+	index := sitemapIndex{Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9", Sitemaps: make([]sitemapPointer, 0, len(pages))}
+	for _, page := range pages {
+		index.Sitemaps = append(index.Sitemaps, sitemapPointer{
+			Loc:     fmt.Sprintf("%s/sitemaps/%s-%d.xml", strings.TrimRight(baseUrl, "/"), page.EntityType, page.Page),
+			LastMod: formatLastMod(page.LastModified),
+		})
+	}
+	return encodeSitemap(index)
+}
+
+func encodeSitemap(document any) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buffer).Encode(document); err != nil {
+		return nil, err
+	}
+	buffer.WriteString("\n")
+	return buffer.Bytes(), nil
+}
+
+func formatLastMod(lastModified *time.Time) string {
+	if lastModified == nil {
+		return ""
+	}
+	return lastModified.UTC().Format(time.RFC3339)
+}
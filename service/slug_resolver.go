@@ -0,0 +1,72 @@
+package service
+
+import (
+	"go-ecommerce-service/persistence"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/util"
+
+	"github.com/rs/zerolog/log"
+)
+
+// slugResolver keeps public slugs stable: a slug only changes when one is explicitly requested,
+// and replaced slugs are kept in the history so old URLs can be redirected. Slugs of translations
+// are generated with the rules of their locale.
+type slugResolver struct {
+	slugRepository persistence.ISlugHistoryRepository
+	entityType     string
+	locale         string
+}
+
+// resolve returns the slug an entity should use. Without a requested slug the current one is kept;
+// entityId is 0 for entities that are not created yet.
+func (resolver slugResolver) resolve(entityId int64, requested string, current string) (string, error) {
+	if requested == "" {
+		return current, nil
+	}
+
+	slug := resolver.generate(requested)
+	if slug == "" {
+		return "", _errors.NewBadRequest("Slug must contain at least one letter or digit")
+	}
+	if slug == current {
+		return current, nil
+	}
+
+	taken, err := resolver.slugRepository.IsSlugTaken(resolver.entityType, slug, entityId)
+	if err != nil {
+		return "", _errors.NewInternalServerError(err)
+	}
+	if taken {
+		return "", _errors.NewBadRequest("Slug is already in use")
+	}
+	return slug, nil
+}
+
+func (resolver slugResolver) generate(text string) string {
+	if resolver.locale == "" {
+		return util.GenerateSlug(text)
+	}
+	return util.GenerateLocaleSlug(text, resolver.locale)
+}
+
+// changed moves the previous slug into the history once the entity has been saved with the new one.
+func (resolver slugResolver) changed(entityId int64, previous string, current string) {
+	if previous == "" || previous == current {
+		return
+	}
+	if err := resolver.slugRepository.RecordSlug(resolver.entityType, entityId, previous); err != nil {
+		log.Error().Err(err).Int64("entity_id", entityId).Str("slug", previous).Msg("Previous slug could not be recorded")
+	}
+	if err := resolver.slugRepository.ReleaseSlug(resolver.entityType, entityId, current); err != nil {
+		log.Error().Err(err).Int64("entity_id", entityId).Str("slug", current).Msg("Reused slug could not be released")
+	}
+}
+
+// moved looks a slug up in the history and returns the id of the entity that used it.
+func (resolver slugResolver) moved(slug string) (int64, bool) {
+	history, err := resolver.slugRepository.GetBySlug(resolver.entityType, slug)
+	if err != nil {
+		return 0, false
+	}
+	return history.EntityId, true
+}
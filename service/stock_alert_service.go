@@ -0,0 +1,266 @@
+package service
+
+import (
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/infrastructure/rabbitmq"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const defaultReorderReportDays = 30
+
+type IStockAlertService interface {
+	GetThresholds(userId int64, role string, productId int64) ([]dto.StockThresholdResponse, error)
+	SetThreshold(userId int64, role string, productId int64, thresholdSet dto.SetStockThresholdRequest) (dto.StockThresholdResponse, error)
+	DeleteThreshold(userId int64, role string, productId int64, variantId *int64) error
+	GetLowStockItems(userId int64, role string, storeId *uint) ([]dto.LowStockItemResponse, error)
+	GetReorderReport(userId int64, role string, reportRequest dto.ReorderReportRequest) (dto.ReorderReportResponse, error)
+	DetectLowStock() (int, error)
+}
+
+type StockAlertService struct {
+	alertRepository   persistence.IStockAlertRepository
+	productRepository persistence.IProductRepository
+	variantRepository persistence.IProductVariantRepository
+	rabbitMQClient    rabbitmq.IRabbitMQClient
+	validator         *rules.StockAlertRules
+	inventoryConfig   config.InventoryConfig
+	managers          productManagers
+}
+
+func NewStockAlertService(alertRepository persistence.IStockAlertRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, storeRepository persistence.IStoreRepository, rabbit rabbitmq.IRabbitMQClient,
+	inventoryConfig config.InventoryConfig) IStockAlertService {
+	return &StockAlertService{
+		alertRepository:   alertRepository,
+		productRepository: productRepository,
+		variantRepository: variantRepository,
+		rabbitMQClient:    rabbit,
+		validator:         rules.NewStockAlertRules(),
+		inventoryConfig:   inventoryConfig,
+		managers:          newProductManagers(productRepository, storeRepository),
+	}
+}
+
+func (alertService *StockAlertService) GetThresholds(userId int64, role string, productId int64) ([]dto.StockThresholdResponse, error) {
+	if _, err := alertService.managers.product(userId, role, productId); err != nil {
+		return nil, err
+	}
+	thresholds, err := alertService.alertRepository.GetThresholds(productId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	response := make([]dto.StockThresholdResponse, 0, len(thresholds))
+	for _, threshold := range thresholds {
+		response = append(response, convertToStockThresholdResponse(threshold))
+	}
+	return response, nil
+}
+
+// SetThreshold sets the low-stock threshold of the product, or of one of its variants when a variant is given.
+func (alertService *StockAlertService) SetThreshold(userId int64, role string, productId int64, thresholdSet dto.SetStockThresholdRequest) (dto.StockThresholdResponse, error) {
+	if validationErr := alertService.validator.ValidateThreshold(thresholdSet); validationErr != nil {
+		return dto.StockThresholdResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := alertService.managers.product(userId, role, productId); err != nil {
+		return dto.StockThresholdResponse{}, err
+	}
+	if thresholdSet.VariantId != nil {
+		variant, err := alertService.variantRepository.GetVariantById(*thresholdSet.VariantId)
+		if err != nil || variant.ProductId != productId {
+			return dto.StockThresholdResponse{}, _errors.NewNotFound(common.ErrProductVariantNotFound.Error())
+		}
+	}
+	leadTimeDays := alertService.inventoryConfig.DefaultLeadTimeDays
+	if thresholdSet.LeadTimeDays != nil {
+		leadTimeDays = *thresholdSet.LeadTimeDays
+	}
+
+	threshold, err := alertService.alertRepository.SetThreshold(domain.StockThreshold{
+		ProductId:    productId,
+		VariantId:    thresholdSet.VariantId,
+		Threshold:    thresholdSet.Threshold,
+		LeadTimeDays: leadTimeDays,
+	})
+	if err != nil {
+		return dto.StockThresholdResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToStockThresholdResponse(threshold), nil
+}
+
+func (alertService *StockAlertService) DeleteThreshold(userId int64, role string, productId int64, variantId *int64) error {
+	if _, err := alertService.managers.product(userId, role, productId); err != nil {
+		return err
+	}
+	if err := alertService.alertRepository.DeleteThreshold(productId, variantId); err != nil {
+		return _errors.NewNotFound(common.ErrStockThresholdNotFound.Error())
+	}
+	return nil
+}
+
+func (alertService *StockAlertService) GetLowStockItems(userId int64, role string, storeId *uint) ([]dto.LowStockItemResponse, error) {
+	if err := alertService.authorizeReport(userId, role, storeId); err != nil {
+		return nil, err
+	}
+	items, err := alertService.alertRepository.GetLowStockItems(storeId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	response := make([]dto.LowStockItemResponse, 0, len(items))
+	for _, item := range items {
+		response = append(response, dto.LowStockItemResponse{
+			ProductId:     item.ProductId,
+			VariantId:     item.VariantId,
+			ProductName:   item.ProductName,
+			Sku:           item.Sku,
+			StoreId:       item.StoreId,
+			StockQuantity: item.StockQuantity,
+			Threshold:     item.Threshold,
+		})
+	}
+	return response, nil
+}
+
+// GetReorderReport estimates days of cover and reorder quantities from the sales velocity of the last days.
+// Items that need reordering come first, then the ones running out soonest.
+func (alertService *StockAlertService) GetReorderReport(userId int64, role string, reportRequest dto.ReorderReportRequest) (dto.ReorderReportResponse, error) {
+	if validationErr := alertService.validator.ValidateReport(reportRequest); validationErr != nil {
+		return dto.ReorderReportResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if err := alertService.authorizeReport(userId, role, reportRequest.StoreId); err != nil {
+		return dto.ReorderReportResponse{}, err
+	}
+	days := reportRequest.Days
+	if days == 0 {
+		days = defaultReorderReportDays
+	}
+	coverDays := reportRequest.CoverDays
+	if coverDays == 0 {
+		coverDays = alertService.inventoryConfig.ReorderCoverDays
+	}
+
+	now := time.Now()
+	velocities, err := alertService.alertRepository.GetStockVelocities(now.AddDate(0, 0, -days), reportRequest.StoreId)
+	if err != nil {
+		return dto.ReorderReportResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	items := make([]dto.ReorderSuggestionResponse, 0, len(velocities))
+	for _, velocity := range velocities {
+		items = append(items, alertService.suggestReorder(velocity, days, coverDays))
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if (items[i].SuggestedQuantity > 0) != (items[j].SuggestedQuantity > 0) {
+			return items[i].SuggestedQuantity > 0
+		}
+		if items[i].DaysOfCover == nil || items[j].DaysOfCover == nil {
+			return items[j].DaysOfCover == nil && items[i].DaysOfCover != nil
+		}
+		return *items[i].DaysOfCover < *items[j].DaysOfCover
+	})
+
+	return dto.ReorderReportResponse{Days: days, CoverDays: coverDays, GeneratedAt: now, Items: items}, nil
+}
+
+// DetectLowStock publishes a stock.low event for every product or variant that crossed its threshold since the
+// last alert, after re-arming the thresholds whose stock recovered.
+func (alertService *StockAlertService) DetectLowStock() (int, error) {
+	if _, err := alertService.alertRepository.ResetRecoveredAlerts(); err != nil {
+		return 0, _errors.NewInternalServerError(err)
+	}
+	items, err := alertService.alertRepository.GetUnalertedLowStockItems()
+	if err != nil {
+		return 0, _errors.NewInternalServerError(err)
+	}
+
+	alertedCount := 0
+	for _, item := range items {
+		payload := map[string]interface{}{
+			"event":          "stock.low",
+			"product_id":     item.ProductId,
+			"variant_id":     item.VariantId,
+			"product_name":   item.ProductName,
+			"sku":            item.Sku,
+			"store_id":       item.StoreId,
+			"stock_quantity": item.StockQuantity,
+			"threshold":      item.Threshold,
+		}
+		if publishErr := rabbitmq.PublishJSON(alertService.rabbitMQClient, rabbitmq.StockLowQueue, payload); publishErr != nil {
+			log.Error().Err(publishErr).Int64("threshold_id", item.ThresholdId).Msg("stock.low event could not be published")
+			continue
+		}
+		if markErr := alertService.alertRepository.MarkAlerted(item.ThresholdId); markErr != nil {
+			log.Error().Err(markErr).Int64("threshold_id", item.ThresholdId).Msg("Low stock could not be marked as alerted")
+			continue
+		}
+		alertedCount++
+	}
+	return alertedCount, nil
+}
+
+// authorizeReport lets admins report on every store and store owners on their own store.
+func (alertService *StockAlertService) authorizeReport(userId int64, role string, storeId *uint) error {
+	if role == domain.UserRoleAdmin {
+		return nil
+	}
+	if storeId == nil {
+		return _errors.NewForbidden("Only admins can report on every store; pass the store_id of your store")
+	}
+	return alertService.managers.authorizeStore(userId, role, *storeId)
+}
+
+func (alertService *StockAlertService) suggestReorder(velocity domain.StockVelocity, days int, coverDays int) dto.ReorderSuggestionResponse {
+	threshold := 0
+	if velocity.Threshold != nil {
+		threshold = *velocity.Threshold
+	}
+	leadTimeDays := alertService.inventoryConfig.DefaultLeadTimeDays
+	if velocity.LeadTimeDays != nil {
+		leadTimeDays = *velocity.LeadTimeDays
+	}
+
+	dailySales := float64(velocity.UnitsSold) / float64(days)
+	suggestion := dto.ReorderSuggestionResponse{
+		ProductId:     velocity.ProductId,
+		VariantId:     velocity.VariantId,
+		ProductName:   velocity.ProductName,
+		Sku:           velocity.Sku,
+		StoreId:       velocity.StoreId,
+		StockQuantity: velocity.StockQuantity,
+		Threshold:     threshold,
+		LeadTimeDays:  leadTimeDays,
+		UnitsSold:     velocity.UnitsSold,
+		DailySales:    math.Round(dailySales*100) / 100,
+		ReorderPoint:  int(math.Ceil(dailySales*float64(leadTimeDays))) + threshold,
+	}
+	if dailySales > 0 {
+		daysOfCover := math.Round(float64(velocity.StockQuantity)/dailySales*10) / 10
+		suggestion.DaysOfCover = &daysOfCover
+	}
+	if velocity.StockQuantity <= suggestion.ReorderPoint {
+		target := int(math.Ceil(dailySales*float64(leadTimeDays+coverDays))) + threshold
+		suggestion.SuggestedQuantity = max(target-velocity.StockQuantity, 0)
+	}
+	return suggestion
+}
+
+func convertToStockThresholdResponse(threshold domain.StockThreshold) dto.StockThresholdResponse {
+	return dto.StockThresholdResponse{
+		Id:           threshold.Id,
+		ProductId:    threshold.ProductId,
+		VariantId:    threshold.VariantId,
+		Threshold:    threshold.Threshold,
+		LeadTimeDays: threshold.LeadTimeDays,
+		AlertedAt:    threshold.AlertedAt,
+		UpdatedAt:    threshold.UpdatedAt,
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"fmt"
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/infrastructure/rabbitmq"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/util"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type IStockSubscriptionService interface {
+	Subscribe(userId *int64, subscriptionCreate dto.CreateStockSubscriptionRequest) (dto.StockSubscriptionResponse, error)
+	GetUserSubscriptions(userId int64) ([]dto.StockSubscriptionResponse, error)
+	CancelSubscription(userId int64, id int64) error
+	Unsubscribe(token string) (dto.StockSubscriptionResponse, error)
+	NotifyRestocked() (int, error)
+}
+
+type StockSubscriptionService struct {
+	subscriptionRepository persistence.IStockSubscriptionRepository
+	productRepository      persistence.IProductRepository
+	variantRepository      persistence.IProductVariantRepository
+	userRepository         persistence.IUserRepository
+	rabbitMQClient         rabbitmq.IRabbitMQClient
+	validator              *rules.StockSubscriptionRules
+	backInStockConfig      config.BackInStockConfig
+}
+
+func NewStockSubscriptionService(subscriptionRepository persistence.IStockSubscriptionRepository,
+	productRepository persistence.IProductRepository, variantRepository persistence.IProductVariantRepository,
+	userRepository persistence.IUserRepository, rabbit rabbitmq.IRabbitMQClient, backInStockConfig config.BackInStockConfig) IStockSubscriptionService {
+	return &StockSubscriptionService{
+		subscriptionRepository: subscriptionRepository,
+		productRepository:      productRepository,
+		variantRepository:      variantRepository,
+		userRepository:         userRepository,
+		rabbitMQClient:         rabbit,
+		validator:              rules.NewStockSubscriptionRules(),
+		backInStockConfig:      backInStockConfig,
+	}
+}
+
+// Subscribe registers a restock subscription for an out-of-stock product or variant. Guests pass userId as nil
+// and must give an email; signed-in users are subscribed with their account email.
+func (subscriptionService *StockSubscriptionService) Subscribe(userId *int64, subscriptionCreate dto.CreateStockSubscriptionRequest) (dto.StockSubscriptionResponse, error) {
+	var validationErr error
+	if userId == nil {
+		validationErr = subscriptionService.validator.ValidateGuest(subscriptionCreate)
+	} else {
+		validationErr = subscriptionService.validator.ValidateCreate(subscriptionCreate)
+	}
+	if validationErr != nil {
+		return dto.StockSubscriptionResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	email := strings.TrimSpace(subscriptionCreate.Email)
+	if userId != nil {
+		user, err := subscriptionService.userRepository.GetUserById(*userId)
+		if err != nil {
+			return dto.StockSubscriptionResponse{}, _errors.NewNotFound(err.Error())
+		}
+		email = user.Email
+	}
+
+	product, err := subscriptionService.productRepository.GetProductById(subscriptionCreate.ProductId)
+	if err != nil || !product.IsActive {
+		return dto.StockSubscriptionResponse{}, _errors.NewNotFound(common.ErrProductNotFound.Error())
+	}
+	stockQuantity := product.StockQuantity
+	if subscriptionCreate.VariantId != nil {
+		variant, variantErr := subscriptionService.variantRepository.GetVariantById(*subscriptionCreate.VariantId)
+		if variantErr != nil || variant.ProductId != subscriptionCreate.ProductId || !variant.IsActive {
+			return dto.StockSubscriptionResponse{}, _errors.NewNotFound(common.ErrProductVariantNotFound.Error())
+		}
+		stockQuantity = variant.StockQuantity
+	}
+	if stockQuantity > 0 {
+		return dto.StockSubscriptionResponse{}, _errors.NewBadRequest("Product is in stock")
+	}
+
+	subscription, err := subscriptionService.subscriptionRepository.Subscribe(domain.StockSubscription{
+		ProductId: subscriptionCreate.ProductId,
+		VariantId: subscriptionCreate.VariantId,
+		UserId:    userId,
+		Email:     email,
+	})
+	if err != nil {
+		return dto.StockSubscriptionResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToStockSubscriptionResponse(subscription), nil
+}
+
+func (subscriptionService *StockSubscriptionService) GetUserSubscriptions(userId int64) ([]dto.StockSubscriptionResponse, error) {
+	subscriptions, err := subscriptionService.subscriptionRepository.GetUserSubscriptions(userId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+	response := make([]dto.StockSubscriptionResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		response = append(response, convertToStockSubscriptionResponse(subscription))
+	}
+	return response, nil
+}
+
+func (subscriptionService *StockSubscriptionService) CancelSubscription(userId int64, id int64) error {
+	subscription, err := subscriptionService.subscriptionRepository.GetSubscriptionById(id)
+	if err != nil || subscription.UserId == nil || *subscription.UserId != userId {
+		return _errors.NewNotFound(common.ErrStockSubscriptionNotFound.Error())
+	}
+	if _, err := subscriptionService.subscriptionRepository.Unsubscribe(id); err != nil {
+		return _errors.NewInternalServerError(err)
+	}
+	return nil
+}
+
+// Unsubscribe cancels the subscription named by the signed token of an unsubscribe link.
+func (subscriptionService *StockSubscriptionService) Unsubscribe(token string) (dto.StockSubscriptionResponse, error) {
+	subject, tokenErr := util.VerifyToken(subscriptionService.backInStockConfig.UnsubscribeSecret, token)
+	if tokenErr != nil {
+		return dto.StockSubscriptionResponse{}, _errors.NewBadRequest(tokenErr.Error())
+	}
+	subscriptionId, parseErr := strconv.ParseInt(subject, 10, 64)
+	if parseErr != nil {
+		return dto.StockSubscriptionResponse{}, _errors.NewBadRequest(util.ErrInvalidToken.Error())
+	}
+
+	subscription, err := subscriptionService.subscriptionRepository.Unsubscribe(subscriptionId)
+	if err != nil {
+		return dto.StockSubscriptionResponse{}, _errors.NewNotFound(err.Error())
+	}
+	return convertToStockSubscriptionResponse(subscription), nil
+}
+
+// NotifyRestocked queues a product.back_in_stock notification for subscriptions whose product or variant has
+// stock again. A run sends at most the configured batch size and one notification per email, and skips emails
+// notified within the cooldown; the rest are picked up by later runs.
+func (subscriptionService *StockSubscriptionService) NotifyRestocked() (int, error) {
+	cooldown := config.ParseDuration(subscriptionService.backInStockConfig.EmailCooldown, time.Hour)
+	notifications, err := subscriptionService.subscriptionRepository.GetPendingNotifications(time.Now().Add(-cooldown),
+		subscriptionService.backInStockConfig.BatchSize)
+	if err != nil {
+		return 0, _errors.NewInternalServerError(err)
+	}
+
+	notifiedCount := 0
+	for _, notification := range notifications {
+		if publishErr := subscriptionService.publishBackInStock(notification); publishErr != nil {
+			log.Error().Err(publishErr).Int64("subscription_id", notification.SubscriptionId).Msg("product.back_in_stock event could not be published")
+			continue
+		}
+		if markErr := subscriptionService.subscriptionRepository.MarkNotified(notification.SubscriptionId); markErr != nil {
+			log.Error().Err(markErr).Int64("subscription_id", notification.SubscriptionId).Msg("Stock subscription could not be marked as notified")
+			continue
+		}
+		notifiedCount++
+	}
+	return notifiedCount, nil
+}
+
+func (subscriptionService *StockSubscriptionService) publishBackInStock(notification domain.RestockNotification) error {
+	unsubscribeTTL := config.ParseDuration(subscriptionService.backInStockConfig.UnsubscribeTTL, 30*24*time.Hour)
+	token := util.SignToken(subscriptionService.backInStockConfig.UnsubscribeSecret,
+		strconv.FormatInt(notification.SubscriptionId, 10), time.Now().Add(unsubscribeTTL))
+
+	payload := map[string]interface{}{
+		"event":           "product.back_in_stock",
+		"channel":         "email",
+		"subscription_id": notification.SubscriptionId,
+		"email":           notification.Email,
+		"user_id":         notification.UserId,
+		"product_id":      notification.ProductId,
+		"variant_id":      notification.VariantId,
+		"product_name":    notification.ProductName,
+		"slug":            notification.Slug,
+		"sku":             notification.Sku,
+		"stock_quantity":  notification.StockQuantity,
+		"unsubscribe_url": fmt.Sprintf("%s?token=%s", subscriptionService.backInStockConfig.UnsubscribeBaseUrl, url.QueryEscape(token)),
+	}
+	return rabbitmq.PublishJSON(subscriptionService.rabbitMQClient, rabbitmq.BackInStockQueue, payload)
+}
+
+func convertToStockSubscriptionResponse(subscription domain.StockSubscription) dto.StockSubscriptionResponse {
+	return dto.StockSubscriptionResponse{
+		Id:         subscription.Id,
+		ProductId:  subscription.ProductId,
+		VariantId:  subscription.VariantId,
+		Email:      subscription.Email,
+		Status:     subscription.Status,
+		NotifiedAt: subscription.NotifiedAt,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
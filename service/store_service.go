@@ -1,55 +1,96 @@
 package service
 
 import (
+	"errors"
 	"go-ecommerce-service/domain"
 	"go-ecommerce-service/internal/dto"
 	"go-ecommerce-service/internal/rules"
 	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
 	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/locale"
 	"go-ecommerce-service/pkg/util"
 	"time"
+
+	"github.com/redis/go-redis/v9"
 )
 
 type IStoreService interface {
-	GetAllStores() []dto.StoreResponse
-	GetStoreById(storeId uint) (dto.StoreResponse, error)
+	GetAllStores(locale string) []dto.StoreResponse
+	GetStoreById(storeId uint, locale string) (dto.StoreResponse, error)
+	GetStoreBySlug(slug string, locale string) (dto.StoreResponse, string, error)
 	AddStore(store dto.CreateStoreRequest) (dto.StoreResponse, error)
 	DeleteStoreById(storeId uint) error
 	UpdateStoreById(id uint, store dto.CreateStoreRequest) (dto.StoreResponse, error)
+	AddStoreOwner(storeId uint, userId int64) error
+	RemoveStoreOwner(storeId uint, userId int64) error
 }
 
 type StoreService struct {
-	storeRepository persistence.IStoreRepository
-	validator       *rules.StoreRules
+	storeRepository   persistence.IStoreRepository
+	productRepository persistence.IProductRepository
+	validator         *rules.StoreRules
+	redisClient       *redis.Client
+	slugs             slugResolver
+	translator        translator
 }
 
-func NewStoreService(storeRepository persistence.IStoreRepository) IStoreService {
+func NewStoreService(storeRepository persistence.IStoreRepository, productRepository persistence.IProductRepository,
+	slugRepository persistence.ISlugHistoryRepository, translationRepository persistence.ITranslationRepository,
+	locales locale.Locales, rdb *redis.Client) IStoreService {
 	return &StoreService{
-		storeRepository: storeRepository,
-		validator:       rules.NewStoreRules(),
+		storeRepository:   storeRepository,
+		productRepository: productRepository,
+		validator:         rules.NewStoreRules(),
+		redisClient:       rdb,
+		slugs:             slugResolver{slugRepository: slugRepository, entityType: domain.SlugEntityStore},
+		translator:        translator{translationRepository: translationRepository, locales: locales},
 	}
 }
 
-func (s *StoreService) GetAllStores() []dto.StoreResponse {
+func (s *StoreService) GetAllStores(locale string) []dto.StoreResponse {
 	stores := s.storeRepository.GetAllStores()
-	return convertToStoresResponse(stores)
+	return convertToStoresResponse(s.translator.stores(locale, stores))
 }
-func (s *StoreService) GetStoreById(storeId uint) (dto.StoreResponse, error) {
+func (s *StoreService) GetStoreById(storeId uint, locale string) (dto.StoreResponse, error) {
 	store, err := s.storeRepository.GetStoreById(storeId)
 	if err != nil {
 		return dto.StoreResponse{}, _errors.NewInternalServerError(err)
 	}
 
-	return convertToStoreResponse(store), nil
+	return convertToStoreResponse(s.translator.stores(locale, []domain.Store{store})[0]), nil
+}
+
+// GetStoreBySlug returns the store using the slug, plus the current slug when a previous one was requested.
+func (s *StoreService) GetStoreBySlug(slug string, locale string) (dto.StoreResponse, string, error) {
+	store, err := s.storeRepository.GetStoreBySlug(slug)
+	if err == nil {
+		return convertToStoreResponse(s.translator.stores(locale, []domain.Store{store})[0]), "", nil
+	}
+
+	storeId, moved := s.slugs.moved(slug)
+	if !moved {
+		return dto.StoreResponse{}, "", _errors.NewNotFound(err.Error())
+	}
+	store, err = s.storeRepository.GetStoreById(uint(storeId))
+	if err != nil {
+		return dto.StoreResponse{}, "", _errors.NewNotFound(err.Error())
+	}
+	return convertToStoreResponse(s.translator.stores(locale, []domain.Store{store})[0]), store.Slug, nil
 }
 func (s *StoreService) AddStore(store dto.CreateStoreRequest) (dto.StoreResponse, error) {
 	if validationErr := s.validator.ValidateStructure(store); validationErr != nil {
 		return dto.StoreResponse{}, _errors.NewBadRequest(validationErr.Error())
 	}
 
+	slug, slugErr := s.slugs.resolve(0, store.Slug, util.GenerateUniqueSlug(store.Name))
+	if slugErr != nil {
+		return dto.StoreResponse{}, slugErr
+	}
+
 	addedStore, err := s.storeRepository.AddStore(domain.Store{
 		Name:           store.Name,
-		Slug:           util.GenerateUniqueSlug(store.Name),
+		Slug:           slug,
 		LogoUrl:        store.LogoUrl,
 		ContactAddress: store.ContactAddress,
 		ContactEmail:   store.ContactEmail,
@@ -65,33 +106,77 @@ func (s *StoreService) AddStore(store dto.CreateStoreRequest) (dto.StoreResponse
 
 	return convertToStoreResponse(addedStore), nil
 }
+
+// DeleteStoreById moves the store to the trash together with its products.
 func (s *StoreService) DeleteStoreById(storeId uint) error {
-	return s.storeRepository.DeleteStoreById(storeId)
+	productIds, err := s.storeRepository.DeleteStoreById(storeId)
+	if err != nil {
+		if errors.Is(err, common.ErrStoreNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	evictProducts(s.productRepository, s.redisClient, productIds)
+	return nil
 }
 func (s *StoreService) UpdateStoreById(id uint, store dto.CreateStoreRequest) (dto.StoreResponse, error) {
 	if validationErr := s.validator.ValidateStructure(store); validationErr != nil {
 		return dto.StoreResponse{}, _errors.NewBadRequest(validationErr.Error())
 	}
 
+	existingStore, err := s.storeRepository.GetStoreById(id)
+	if err != nil {
+		return dto.StoreResponse{}, _errors.NewNotFound(err.Error())
+	}
+	slug, slugErr := s.slugs.resolve(int64(id), store.Slug, existingStore.Slug)
+	if slugErr != nil {
+		return dto.StoreResponse{}, slugErr
+	}
+
 	updatedStore, err := s.storeRepository.UpdateStoreById(id, domain.Store{
 		Name:           store.Name,
-		Slug:           util.GenerateUniqueSlug(store.Name),
+		Slug:           slug,
 		LogoUrl:        store.LogoUrl,
 		ContactAddress: store.ContactAddress,
 		ContactEmail:   store.ContactEmail,
 		ContactPhone:   store.ContactPhone,
 		IsActive:       store.IsActive,
 		Description:    store.Description,
-		CreatedAt:      time.Now(),
+		CreatedAt:      existingStore.CreatedAt,
 		UpdatedAt:      time.Now(),
 	})
 	if err != nil {
 		return dto.StoreResponse{}, _errors.NewInternalServerError(err)
 	}
+	s.slugs.changed(int64(id), existingStore.Slug, updatedStore.Slug)
 
 	return convertToStoreResponse(updatedStore), nil
 }
 
+// AddStoreOwner lets the user manage the store's catalog.
+func (s *StoreService) AddStoreOwner(storeId uint, userId int64) error {
+	if _, err := s.storeRepository.GetStoreById(storeId); err != nil {
+		return _errors.NewNotFound(common.ErrStoreNotFound.Error())
+	}
+	if err := s.storeRepository.AddStoreOwner(storeId, userId); err != nil {
+		if common.IsForeignKeyViolation(err) {
+			return _errors.NewNotFound(common.ErrUserNotFound.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func (s *StoreService) RemoveStoreOwner(storeId uint, userId int64) error {
+	if err := s.storeRepository.RemoveStoreOwner(storeId, userId); err != nil {
+		if errors.Is(err, common.ErrStoreOwnerNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	return nil
+}
+
 func convertToStoreResponse(store domain.Store) dto.StoreResponse {
 	return dto.StoreResponse{
 		Id:          store.Id,
@@ -99,6 +184,7 @@ func convertToStoreResponse(store domain.Store) dto.StoreResponse {
 		Slug:        store.Slug,
 		Description: store.Description,
 		IsActive:    store.IsActive,
+		DeletedAt:   store.DeletedAt,
 	}
 }
 
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"go-ecommerce-service/pkg/locale"
+	"go-ecommerce-service/pkg/util"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type ITranslationService interface {
+	GetProductTranslations(userId int64, role string, productId int64) ([]dto.ProductTranslationResponse, error)
+	SaveProductTranslation(userId int64, role string, productId int64, locale string, translationRequest dto.SaveProductTranslationRequest) (dto.ProductTranslationResponse, error)
+	DeleteProductTranslation(userId int64, role string, productId int64, locale string) error
+	GetCategoryTranslations(categoryId int64) ([]dto.CategoryTranslationResponse, error)
+	SaveCategoryTranslation(categoryId int64, locale string, translationRequest dto.SaveCategoryTranslationRequest) (dto.CategoryTranslationResponse, error)
+	DeleteCategoryTranslation(categoryId int64, locale string) error
+	GetStoreTranslations(userId int64, role string, storeId int64) ([]dto.StoreTranslationResponse, error)
+	SaveStoreTranslation(userId int64, role string, storeId int64, locale string, translationRequest dto.SaveStoreTranslationRequest) (dto.StoreTranslationResponse, error)
+	DeleteStoreTranslation(userId int64, role string, storeId int64, locale string) error
+}
+
+type TranslationService struct {
+	translationRepository persistence.ITranslationRepository
+	productRepository     persistence.IProductRepository
+	variantRepository     persistence.IProductVariantRepository
+	categoryRepository    persistence.ICategoryRepository
+	storeRepository       persistence.IStoreRepository
+	slugRepository        persistence.ISlugHistoryRepository
+	validator             *rules.TranslationRules
+	redisClient           *redis.Client
+	managers              productManagers
+}
+
+func NewTranslationService(translationRepository persistence.ITranslationRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, categoryRepository persistence.ICategoryRepository,
+	storeRepository persistence.IStoreRepository, slugRepository persistence.ISlugHistoryRepository, locales locale.Locales,
+	rdb *redis.Client) ITranslationService {
+	return &TranslationService{
+		translationRepository: translationRepository,
+		productRepository:     productRepository,
+		variantRepository:     variantRepository,
+		categoryRepository:    categoryRepository,
+		storeRepository:       storeRepository,
+		slugRepository:        slugRepository,
+		validator:             rules.NewTranslationRules(locales),
+		redisClient:           rdb,
+		managers:              newProductManagers(productRepository, storeRepository),
+	}
+}
+
+// GetProductTranslations lists the product's translations to admins and the owners of its store, since they
+// include the content of products that are not published yet.
+func (translationService *TranslationService) GetProductTranslations(userId int64, role string, productId int64) ([]dto.ProductTranslationResponse, error) {
+	if _, err := translationService.managers.product(userId, role, productId); err != nil {
+		return nil, err
+	}
+	translations, err := translationService.translationRepository.GetProductTranslations(productId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+
+	response := make([]dto.ProductTranslationResponse, 0, len(translations))
+	for _, translation := range translations {
+		response = append(response, convertToProductTranslationResponse(translation))
+	}
+	return response, nil
+}
+
+// SaveProductTranslation creates or replaces the product's content in the locale. The translation keeps
+// its slug unless another one is requested; a replaced slug redirects like a replaced product slug. The
+// product's search document in the locale is rebuilt right away.
+func (translationService *TranslationService) SaveProductTranslation(userId int64, role string, productId int64, locale string,
+	translationRequest dto.SaveProductTranslationRequest) (dto.ProductTranslationResponse, error) {
+	if validationErr := translationService.validator.ValidateSave(locale, translationRequest); validationErr != nil {
+		return dto.ProductTranslationResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	product, err := translationService.managers.product(userId, role, productId)
+	if err != nil {
+		return dto.ProductTranslationResponse{}, err
+	}
+	translations, err := translationService.translationRepository.GetProductTranslations(productId)
+	if err != nil {
+		return dto.ProductTranslationResponse{}, _errors.NewInternalServerError(err)
+	}
+	previousSlug := ""
+	for _, translation := range translations {
+		if translation.Locale == locale {
+			previousSlug = translation.Slug
+		}
+	}
+
+	slugs := translationService.productSlugs(locale)
+	currentSlug := previousSlug
+	if currentSlug == "" {
+		currentSlug = util.GenerateUniqueLocaleSlug(translationRequest.Name, locale)
+	}
+	slug, slugErr := slugs.resolve(productId, translationRequest.Slug, currentSlug)
+	if slugErr != nil {
+		return dto.ProductTranslationResponse{}, slugErr
+	}
+
+	saved, err := translationService.translationRepository.SaveProductTranslation(domain.ProductTranslation{
+		ProductId:       productId,
+		Locale:          locale,
+		Name:            strings.TrimSpace(translationRequest.Name),
+		Slug:            slug,
+		Description:     translationRequest.Description,
+		MetaDescription: translationRequest.MetaDescription,
+	})
+	if err != nil {
+		return dto.ProductTranslationResponse{}, _errors.NewInternalServerError(err)
+	}
+	slugs.changed(productId, previousSlug, saved.Slug)
+
+	refreshProducts(translationService.productRepository, translationService.variantRepository, translationService.redisClient,
+		[]domain.Product{product})
+	return convertToProductTranslationResponse(saved), nil
+}
+
+// DeleteProductTranslation drops the product's content in the locale, which then falls back to the default
+// content. The translation's slug keeps redirecting to the product.
+func (translationService *TranslationService) DeleteProductTranslation(userId int64, role string, productId int64, locale string) error {
+	if validationErr := translationService.validator.ValidateLocale(locale); validationErr != nil {
+		return _errors.NewBadRequest(validationErr.Error())
+	}
+	product, err := translationService.managers.product(userId, role, productId)
+	if err != nil {
+		return err
+	}
+	deleted, err := translationService.translationRepository.DeleteProductTranslation(productId, locale)
+	if err != nil {
+		if errors.Is(err, common.ErrTranslationNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	translationService.productSlugs(locale).changed(productId, deleted.Slug, product.Slug)
+
+	refreshProducts(translationService.productRepository, translationService.variantRepository, translationService.redisClient,
+		[]domain.Product{product})
+	return nil
+}
+
+func (translationService *TranslationService) GetCategoryTranslations(categoryId int64) ([]dto.CategoryTranslationResponse, error) {
+	if _, err := translationService.categoryRepository.GetCategoryById(int(categoryId)); err != nil {
+		return nil, _errors.NewNotFound(common.ErrCategoryNotFound.Error())
+	}
+	translations, err := translationService.translationRepository.GetCategoryTranslations(categoryId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+
+	response := make([]dto.CategoryTranslationResponse, 0, len(translations))
+	for _, translation := range translations {
+		response = append(response, convertToCategoryTranslationResponse(translation))
+	}
+	return response, nil
+}
+
+func (translationService *TranslationService) SaveCategoryTranslation(categoryId int64, locale string,
+	translationRequest dto.SaveCategoryTranslationRequest) (dto.CategoryTranslationResponse, error) {
+	if validationErr := translationService.validator.ValidateSave(locale, translationRequest); validationErr != nil {
+		return dto.CategoryTranslationResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if _, err := translationService.categoryRepository.GetCategoryById(int(categoryId)); err != nil {
+		return dto.CategoryTranslationResponse{}, _errors.NewNotFound(common.ErrCategoryNotFound.Error())
+	}
+
+	saved, err := translationService.translationRepository.SaveCategoryTranslation(domain.CategoryTranslation{
+		CategoryId:  categoryId,
+		Locale:      locale,
+		Name:        strings.TrimSpace(translationRequest.Name),
+		Description: translationRequest.Description,
+	})
+	if err != nil {
+		return dto.CategoryTranslationResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToCategoryTranslationResponse(saved), nil
+}
+
+func (translationService *TranslationService) DeleteCategoryTranslation(categoryId int64, locale string) error {
+	if validationErr := translationService.validator.ValidateLocale(locale); validationErr != nil {
+		return _errors.NewBadRequest(validationErr.Error())
+	}
+	if err := translationService.translationRepository.DeleteCategoryTranslation(categoryId, locale); err != nil {
+		if errors.Is(err, common.ErrTranslationNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func (translationService *TranslationService) GetStoreTranslations(userId int64, role string, storeId int64) ([]dto.StoreTranslationResponse, error) {
+	if err := translationService.managedStore(userId, role, storeId); err != nil {
+		return nil, err
+	}
+	translations, err := translationService.translationRepository.GetStoreTranslations(storeId)
+	if err != nil {
+		return nil, _errors.NewInternalServerError(err)
+	}
+
+	response := make([]dto.StoreTranslationResponse, 0, len(translations))
+	for _, translation := range translations {
+		response = append(response, convertToStoreTranslationResponse(translation))
+	}
+	return response, nil
+}
+
+func (translationService *TranslationService) SaveStoreTranslation(userId int64, role string, storeId int64, locale string,
+	translationRequest dto.SaveStoreTranslationRequest) (dto.StoreTranslationResponse, error) {
+	if validationErr := translationService.validator.ValidateSave(locale, translationRequest); validationErr != nil {
+		return dto.StoreTranslationResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+	if err := translationService.managedStore(userId, role, storeId); err != nil {
+		return dto.StoreTranslationResponse{}, err
+	}
+
+	saved, err := translationService.translationRepository.SaveStoreTranslation(domain.StoreTranslation{
+		StoreId:     storeId,
+		Locale:      locale,
+		Description: translationRequest.Description,
+	})
+	if err != nil {
+		return dto.StoreTranslationResponse{}, _errors.NewInternalServerError(err)
+	}
+	return convertToStoreTranslationResponse(saved), nil
+}
+
+func (translationService *TranslationService) DeleteStoreTranslation(userId int64, role string, storeId int64, locale string) error {
+	if validationErr := translationService.validator.ValidateLocale(locale); validationErr != nil {
+		return _errors.NewBadRequest(validationErr.Error())
+	}
+	if err := translationService.managedStore(userId, role, storeId); err != nil {
+		return err
+	}
+	if err := translationService.translationRepository.DeleteStoreTranslation(storeId, locale); err != nil {
+		if errors.Is(err, common.ErrTranslationNotFound) {
+			return _errors.NewNotFound(err.Error())
+		}
+		return _errors.NewInternalServerError(err)
+	}
+	return nil
+}
+
+// managedStore checks that the store exists and that the user is an admin or one of its owners.
+func (translationService *TranslationService) managedStore(userId int64, role string, storeId int64) error {
+	if _, err := translationService.storeRepository.GetStoreById(uint(storeId)); err != nil {
+		return _errors.NewNotFound(common.ErrStoreNotFound.Error())
+	}
+	return translationService.managers.authorizeStore(userId, role, uint(storeId))
+}
+
+func (translationService *TranslationService) productSlugs(locale string) slugResolver {
+	return slugResolver{slugRepository: translationService.slugRepository, entityType: domain.SlugEntityProduct, locale: locale}
+}
+
+func convertToProductTranslationResponse(translation domain.ProductTranslation) dto.ProductTranslationResponse {
+	return dto.ProductTranslationResponse{
+		ProductId:       translation.ProductId,
+		Locale:          translation.Locale,
+		Name:            translation.Name,
+		Slug:            translation.Slug,
+		Description:     translation.Description,
+		MetaDescription: translation.MetaDescription,
+		UpdatedAt:       translation.UpdatedAt,
+	}
+}
+
+func convertToCategoryTranslationResponse(translation domain.CategoryTranslation) dto.CategoryTranslationResponse {
+	return dto.CategoryTranslationResponse{
+		CategoryId:  translation.CategoryId,
+		Locale:      translation.Locale,
+		Name:        translation.Name,
+		Description: translation.Description,
+		UpdatedAt:   translation.UpdatedAt,
+	}
+}
+
+func convertToStoreTranslationResponse(translation domain.StoreTranslation) dto.StoreTranslationResponse {
+	return dto.StoreTranslationResponse{
+		StoreId:     translation.StoreId,
+		Locale:      translation.Locale,
+		Description: translation.Description,
+		UpdatedAt:   translation.UpdatedAt,
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/pkg/locale"
+
+	"github.com/rs/zerolog/log"
+)
+
+// translator localizes catalog content for a request's locale. Content without a translation, and all
+// content in the default locale, is returned as it is stored. A failed lookup is logged and falls back
+// to the default content rather than failing the read.
+type translator struct {
+	translationRepository persistence.ITranslationRepository
+	locales               locale.Locales
+}
+
+func (translator translator) products(locale string, products []domain.Product) []domain.Product {
+	if !translator.locales.IsTranslated(locale) || len(products) == 0 {
+		return products
+	}
+	productIds := make([]int64, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, int64(product.Id))
+	}
+	translations, err := translator.translationRepository.GetProductTranslationsByLocale(locale, productIds)
+	if err != nil {
+		log.Error().Err(err).Str("locale", locale).Msg("Product translations could not be loaded")
+		return products
+	}
+	for i := range products {
+		if translation, ok := translations[int64(products[i].Id)]; ok {
+			products[i] = translation.Apply(products[i])
+		}
+	}
+	return products
+}
+
+// productResponse localizes a product response, used for the cached copy of the default content.
+func (translator translator) productResponse(locale string, response dto.ProductResponse) dto.ProductResponse {
+	if !translator.locales.IsTranslated(locale) {
+		return response
+	}
+	translations, err := translator.translationRepository.GetProductTranslationsByLocale(locale, []int64{int64(response.Id)})
+	if err != nil {
+		log.Error().Err(err).Str("locale", locale).Msg("Product translations could not be loaded")
+		return response
+	}
+	if translation, ok := translations[int64(response.Id)]; ok {
+		response.Name = translation.Name
+		response.Slug = translation.Slug
+		response.Description = translation.Description
+		response.MetaDescription = translation.MetaDescription
+	}
+	return response
+}
+
+func (translator translator) categories(locale string, categories []domain.Category) []domain.Category {
+	if !translator.locales.IsTranslated(locale) || len(categories) == 0 {
+		return categories
+	}
+	categoryIds := make([]int64, 0, len(categories))
+	for _, category := range categories {
+		categoryIds = append(categoryIds, int64(category.Id))
+	}
+	translations, err := translator.translationRepository.GetCategoryTranslationsByLocale(locale, categoryIds)
+	if err != nil {
+		log.Error().Err(err).Str("locale", locale).Msg("Category translations could not be loaded")
+		return categories
+	}
+	for i := range categories {
+		if translation, ok := translations[int64(categories[i].Id)]; ok {
+			categories[i] = translation.Apply(categories[i])
+		}
+	}
+	return categories
+}
+
+func (translator translator) stores(locale string, stores []domain.Store) []domain.Store {
+	if !translator.locales.IsTranslated(locale) || len(stores) == 0 {
+		return stores
+	}
+	storeIds := make([]int64, 0, len(stores))
+	for _, store := range stores {
+		storeIds = append(storeIds, int64(store.Id))
+	}
+	translations, err := translator.translationRepository.GetStoreTranslationsByLocale(locale, storeIds)
+	if err != nil {
+		log.Error().Err(err).Str("locale", locale).Msg("Store translations could not be loaded")
+		return stores
+	}
+	for i := range stores {
+		if translation, ok := translations[int64(stores[i].Id)]; ok {
+			stores[i] = translation.Apply(stores[i])
+		}
+	}
+	return stores
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"errors"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type ITrashService interface {
+	GetTrash() (dto.TrashResponse, error)
+	RestoreProduct(productId int64) (dto.ProductResponse, error)
+	RestoreStore(storeId uint) (dto.StoreResponse, error)
+	RestoreCategory(categoryId uint) (dto.CategoryResponse, error)
+	PurgeExpired() (domain.TrashPurge, error)
+}
+
+type TrashService struct {
+	productRepository  persistence.IProductRepository
+	variantRepository  persistence.IProductVariantRepository
+	storeRepository    persistence.IStoreRepository
+	categoryRepository persistence.ICategoryRepository
+	redisClient        *redis.Client
+	retention          time.Duration
+}
+
+func NewTrashService(productRepository persistence.IProductRepository, variantRepository persistence.IProductVariantRepository,
+	storeRepository persistence.IStoreRepository, categoryRepository persistence.ICategoryRepository, rdb *redis.Client,
+	retention time.Duration) ITrashService {
+	return &TrashService{
+		productRepository:  productRepository,
+		variantRepository:  variantRepository,
+		storeRepository:    storeRepository,
+		categoryRepository: categoryRepository,
+		redisClient:        rdb,
+		retention:          retention,
+	}
+}
+
+func (trashService *TrashService) GetTrash() (dto.TrashResponse, error) {
+	products, err := trashService.productRepository.GetDeletedProducts()
+	if err != nil {
+		return dto.TrashResponse{}, _errors.NewInternalServerError(err)
+	}
+	stores, err := trashService.storeRepository.GetDeletedStores()
+	if err != nil {
+		return dto.TrashResponse{}, _errors.NewInternalServerError(err)
+	}
+	categories, err := trashService.categoryRepository.GetDeletedCategories()
+	if err != nil {
+		return dto.TrashResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	return dto.TrashResponse{
+		Products:   convertToProductsResponse(products),
+		Stores:     convertToStoresResponse(stores),
+		Categories: convertCategoriesResponse(categories),
+	}, nil
+}
+
+// RestoreProduct brings a trashed product back. A product whose store is still in the trash has to be
+// restored through its store.
+func (trashService *TrashService) RestoreProduct(productId int64) (dto.ProductResponse, error) {
+	product, err := trashService.productRepository.RestoreProductById(productId)
+	if err != nil {
+		if errors.Is(err, common.ErrProductNotFound) {
+			return dto.ProductResponse{}, _errors.NewNotFound("Product is not in the trash or its store is deleted")
+		}
+		return dto.ProductResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	refreshProducts(trashService.productRepository, trashService.variantRepository, trashService.redisClient, []domain.Product{product})
+	return convertToProductResponse(product), nil
+}
+
+// RestoreStore brings a trashed store back with the products that were trashed together with it.
+func (trashService *TrashService) RestoreStore(storeId uint) (dto.StoreResponse, error) {
+	store, productIds, err := trashService.storeRepository.RestoreStoreById(storeId)
+	if err != nil {
+		if errors.Is(err, common.ErrStoreNotFound) {
+			return dto.StoreResponse{}, _errors.NewNotFound("Store is not in the trash")
+		}
+		return dto.StoreResponse{}, _errors.NewBadRequest(err.Error())
+	}
+
+	products := make([]domain.Product, 0, len(productIds))
+	for _, productId := range productIds {
+		product, err := trashService.productRepository.GetProductById(productId)
+		if err != nil {
+			continue
+		}
+		products = append(products, product)
+	}
+	refreshProducts(trashService.productRepository, trashService.variantRepository, trashService.redisClient, products)
+
+	return convertToStoreResponse(store), nil
+}
+
+// RestoreCategory brings a trashed category back. A subcategory has to wait until its parent is restored.
+func (trashService *TrashService) RestoreCategory(categoryId uint) (dto.CategoryResponse, error) {
+	category, err := trashService.categoryRepository.RestoreCategoryById(categoryId)
+	if err != nil {
+		if errors.Is(err, common.ErrCategoryNotFound) {
+			return dto.CategoryResponse{}, _errors.NewNotFound("Category is not in the trash or its parent category is deleted")
+		}
+		return dto.CategoryResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	return convertToCategoryResponse(category), nil
+}
+
+// PurgeExpired permanently removes records that have been in the trash longer than the retention.
+// Products go first so the stores and categories they were holding can be removed in the same run.
+func (trashService *TrashService) PurgeExpired() (domain.TrashPurge, error) {
+	before := time.Now().Add(-trashService.retention)
+
+	var purge domain.TrashPurge
+	var err error
+	if purge.Products, err = trashService.productRepository.PurgeDeletedProducts(before); err != nil {
+		return purge, err
+	}
+	if purge.Stores, err = trashService.storeRepository.PurgeDeletedStores(before); err != nil {
+		return purge, err
+	}
+	if purge.Categories, err = trashService.categoryRepository.PurgeDeletedCategories(before); err != nil {
+		return purge, err
+	}
+	return purge, nil
+}
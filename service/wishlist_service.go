@@ -0,0 +1,341 @@
+package service
+
+import (
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/infrastructure/rabbitmq"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/internal/rules"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	_errors "go-ecommerce-service/pkg/errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type IWishlistService interface {
+	GetUserWishlists(userId int64) ([]dto.WishlistResponse, error)
+	GetWishlist(userId int64, wishlistId int64) (dto.WishlistResponse, error)
+	GetSharedWishlist(shareToken string) (dto.WishlistResponse, error)
+	CreateWishlist(userId int64, wishlistCreate dto.CreateWishlistRequest) (dto.WishlistResponse, error)
+	UpdateWishlist(userId int64, wishlistId int64, wishlistUpdate dto.CreateWishlistRequest) (dto.WishlistResponse, error)
+	DeleteWishlist(userId int64, wishlistId int64) error
+	AddItem(userId int64, wishlistId int64, item dto.AddWishlistItemRequest) (dto.WishlistItemResponse, error)
+	RemoveItem(userId int64, wishlistId int64, itemId int64) error
+	MoveCartItemToWishlist(userId int64, wishlistId int64, move dto.MoveCartItemToWishlistRequest) (dto.WishlistItemResponse, error)
+	MoveItemToCart(userId int64, wishlistId int64, itemId int64, move dto.MoveWishlistItemToCartRequest) (dto.CartItemResponse, error)
+	DetectPriceDrops() (int, error)
+}
+
+type WishlistService struct {
+	wishlistRepository persistence.IWishlistRepository
+	productRepository  persistence.IProductRepository
+	variantRepository  persistence.IProductVariantRepository
+	cartRepository     persistence.ICartRepository
+	cartItemRepository persistence.ICartItemRepository
+	rabbitMQClient     rabbitmq.IRabbitMQClient
+	validator          *rules.WishlistRules
+}
+
+func NewWishlistService(wishlistRepository persistence.IWishlistRepository, productRepository persistence.IProductRepository,
+	variantRepository persistence.IProductVariantRepository, cartRepository persistence.ICartRepository,
+	cartItemRepository persistence.ICartItemRepository, rabbit rabbitmq.IRabbitMQClient) IWishlistService {
+	return &WishlistService{
+		wishlistRepository: wishlistRepository,
+		productRepository:  productRepository,
+		variantRepository:  variantRepository,
+		cartRepository:     cartRepository,
+		cartItemRepository: cartItemRepository,
+		rabbitMQClient:     rabbit,
+		validator:          rules.NewWishlistRules(),
+	}
+}
+
+func (wishlistService *WishlistService) GetUserWishlists(userId int64) ([]dto.WishlistResponse, error) {
+	wishlists, err := wishlistService.wishlistRepository.GetWishlistsByUserId(userId)
+	if err != nil {
+		return []dto.WishlistResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	wishlistsDto := make([]dto.WishlistResponse, 0, len(wishlists))
+	for _, wishlist := range wishlists {
+		wishlistsDto = append(wishlistsDto, convertToWishlistResponse(wishlist))
+	}
+	return wishlistsDto, nil
+}
+
+func (wishlistService *WishlistService) GetWishlist(userId int64, wishlistId int64) (dto.WishlistResponse, error) {
+	wishlist, err := wishlistService.getOwnedWishlist(userId, wishlistId)
+	if err != nil {
+		return dto.WishlistResponse{}, err
+	}
+	return wishlistService.withItems(wishlist, false)
+}
+
+// GetSharedWishlist returns a public wishlist by its share token. Owner-only data such as the token itself and
+// products that are not published are hidden.
+func (wishlistService *WishlistService) GetSharedWishlist(shareToken string) (dto.WishlistResponse, error) {
+	wishlist, err := wishlistService.wishlistRepository.GetWishlistByShareToken(shareToken)
+	if err != nil {
+		return dto.WishlistResponse{}, _errors.NewNotFound(common.ErrWishlistNotFound.Error())
+	}
+
+	response, err := wishlistService.withItems(wishlist, true)
+	if err != nil {
+		return dto.WishlistResponse{}, err
+	}
+	response.ShareToken = nil
+	return response, nil
+}
+
+func (wishlistService *WishlistService) CreateWishlist(userId int64, wishlistCreate dto.CreateWishlistRequest) (dto.WishlistResponse, error) {
+	if validationErr := wishlistService.validator.ValidateStructure(wishlistCreate); validationErr != nil {
+		return dto.WishlistResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	wishlist := domain.Wishlist{
+		UserId:   userId,
+		Name:     strings.TrimSpace(wishlistCreate.Name),
+		IsPublic: wishlistCreate.IsPublic,
+	}
+	if wishlist.IsPublic {
+		wishlist.ShareToken = newShareToken()
+	}
+
+	createdWishlist, err := wishlistService.wishlistRepository.CreateWishlist(wishlist)
+	if err != nil {
+		return dto.WishlistResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	return convertToWishlistResponse(createdWishlist), nil
+}
+
+func (wishlistService *WishlistService) UpdateWishlist(userId int64, wishlistId int64, wishlistUpdate dto.CreateWishlistRequest) (dto.WishlistResponse, error) {
+	if validationErr := wishlistService.validator.ValidateStructure(wishlistUpdate); validationErr != nil {
+		return dto.WishlistResponse{}, _errors.NewBadRequest(validationErr.Error())
+	}
+
+	wishlist, err := wishlistService.getOwnedWishlist(userId, wishlistId)
+	if err != nil {
+		return dto.WishlistResponse{}, err
+	}
+
+	wishlist.Name = strings.TrimSpace(wishlistUpdate.Name)
+	wishlist.IsPublic = wishlistUpdate.IsPublic
+	// Making a list private revokes its share link; publishing it again issues a new one.
+	if !wishlist.IsPublic {
+		wishlist.ShareToken = nil
+	} else if wishlist.ShareToken == nil {
+		wishlist.ShareToken = newShareToken()
+	}
+
+	updatedWishlist, err := wishlistService.wishlistRepository.UpdateWishlist(wishlistId, wishlist)
+	if err != nil {
+		return dto.WishlistResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	return convertToWishlistResponse(updatedWishlist), nil
+}
+
+func (wishlistService *WishlistService) DeleteWishlist(userId int64, wishlistId int64) error {
+	if _, err := wishlistService.getOwnedWishlist(userId, wishlistId); err != nil {
+		return err
+	}
+	return wishlistService.wishlistRepository.DeleteWishlistById(wishlistId)
+}
+
+func (wishlistService *WishlistService) AddItem(userId int64, wishlistId int64, item dto.AddWishlistItemRequest) (dto.WishlistItemResponse, error) {
+	if item.ProductId == 0 {
+		return dto.WishlistItemResponse{}, _errors.NewBadRequest("product_id is required")
+	}
+	if _, err := wishlistService.getOwnedWishlist(userId, wishlistId); err != nil {
+		return dto.WishlistItemResponse{}, err
+	}
+	return wishlistService.addProduct(wishlistId, item.ProductId, item.VariantId)
+}
+
+func (wishlistService *WishlistService) RemoveItem(userId int64, wishlistId int64, itemId int64) error {
+	if _, err := wishlistService.getOwnedItem(userId, wishlistId, itemId); err != nil {
+		return err
+	}
+	return wishlistService.wishlistRepository.RemoveItem(itemId)
+}
+
+func (wishlistService *WishlistService) MoveCartItemToWishlist(userId int64, wishlistId int64, move dto.MoveCartItemToWishlistRequest) (dto.WishlistItemResponse, error) {
+	if _, err := wishlistService.getOwnedWishlist(userId, wishlistId); err != nil {
+		return dto.WishlistItemResponse{}, err
+	}
+
+	cartItem, err := wishlistService.cartItemRepository.GetItemById(move.CartItemId)
+	if err != nil {
+		return dto.WishlistItemResponse{}, _errors.NewNotFound(common.ErrCartItemNotFound.Error())
+	}
+	if cart := wishlistService.cartRepository.GetCartById(cartItem.CartId); cart.UserId != userId {
+		return dto.WishlistItemResponse{}, _errors.NewNotFound(common.ErrCartItemNotFound.Error())
+	}
+
+	addedItem, err := wishlistService.addProduct(wishlistId, cartItem.ProductId, cartItem.VariantId)
+	if err != nil {
+		return dto.WishlistItemResponse{}, err
+	}
+	if removeErr := wishlistService.cartItemRepository.RemoveItemFromCart(cartItem.Id); removeErr != nil {
+		return dto.WishlistItemResponse{}, _errors.NewInternalServerError(removeErr)
+	}
+	return addedItem, nil
+}
+
+func (wishlistService *WishlistService) MoveItemToCart(userId int64, wishlistId int64, itemId int64, move dto.MoveWishlistItemToCartRequest) (dto.CartItemResponse, error) {
+	item, err := wishlistService.getOwnedItem(userId, wishlistId, itemId)
+	if err != nil {
+		return dto.CartItemResponse{}, err
+	}
+	if cart := wishlistService.cartRepository.GetCartById(move.CartId); cart.UserId != userId {
+		return dto.CartItemResponse{}, _errors.NewNotFound(common.ErrCartNotFound.Error())
+	}
+
+	quantity := move.Quantity
+	if quantity <= 0 {
+		quantity = 1
+	}
+	cartItem, err := wishlistService.cartItemRepository.AddItemToCart(domain.CartItem{
+		CartId:    move.CartId,
+		ProductId: item.ProductId,
+		VariantId: item.VariantId,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return dto.CartItemResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	if removeErr := wishlistService.wishlistRepository.RemoveItem(item.Id); removeErr != nil {
+		return dto.CartItemResponse{}, _errors.NewInternalServerError(removeErr)
+	}
+	return convertToCartItemResponse(cartItem), nil
+}
+
+// DetectPriceDrops publishes a wishlist.price_dropped event for every wishlisted product whose price fell below
+// the price the user saw when adding it (or the price of the last notification).
+func (wishlistService *WishlistService) DetectPriceDrops() (int, error) {
+	drops, err := wishlistService.wishlistRepository.GetPriceDrops()
+	if err != nil {
+		return 0, _errors.NewInternalServerError(err)
+	}
+
+	notifiedCount := 0
+	for _, drop := range drops {
+		payload := map[string]interface{}{
+			"event":          "wishlist.price_dropped",
+			"user_id":        drop.UserId,
+			"wishlist_id":    drop.WishlistId,
+			"product_id":     drop.ProductId,
+			"product_name":   drop.ProductName,
+			"previous_price": drop.PreviousPrice,
+			"current_price":  drop.CurrentPrice,
+		}
+		if publishErr := rabbitmq.PublishJSON(wishlistService.rabbitMQClient, rabbitmq.WishlistPriceDroppedQueue, payload); publishErr != nil {
+			log.Error().Err(publishErr).Int64("wishlist_item_id", drop.WishlistItemId).Msg("wishlist.price_dropped event could not be published")
+			continue
+		}
+		if markErr := wishlistService.wishlistRepository.MarkPriceDropNotified(drop.WishlistItemId, drop.CurrentPrice); markErr != nil {
+			log.Error().Err(markErr).Int64("wishlist_item_id", drop.WishlistItemId).Msg("Price drop could not be marked as notified")
+			continue
+		}
+		notifiedCount++
+	}
+	return notifiedCount, nil
+}
+
+func (wishlistService *WishlistService) getOwnedWishlist(userId int64, wishlistId int64) (domain.Wishlist, error) {
+	wishlist, err := wishlistService.wishlistRepository.GetWishlistById(wishlistId)
+	if err != nil || wishlist.UserId != userId {
+		return domain.Wishlist{}, _errors.NewNotFound(common.ErrWishlistNotFound.Error())
+	}
+	return wishlist, nil
+}
+
+func (wishlistService *WishlistService) getOwnedItem(userId int64, wishlistId int64, itemId int64) (domain.WishlistItem, error) {
+	if _, err := wishlistService.getOwnedWishlist(userId, wishlistId); err != nil {
+		return domain.WishlistItem{}, err
+	}
+	item, err := wishlistService.wishlistRepository.GetItemById(itemId)
+	if err != nil || item.WishlistId != wishlistId {
+		return domain.WishlistItem{}, _errors.NewNotFound(common.ErrWishlistItemNotFound.Error())
+	}
+	return item, nil
+}
+
+func (wishlistService *WishlistService) addProduct(wishlistId int64, productId int64, variantId *int64) (dto.WishlistItemResponse, error) {
+	product, err := wishlistService.productRepository.GetProductById(productId)
+	if err != nil {
+		return dto.WishlistItemResponse{}, _errors.NewNotFound(err.Error())
+	}
+	if variantId != nil {
+		variant, variantErr := wishlistService.variantRepository.GetVariantById(*variantId)
+		if variantErr != nil {
+			return dto.WishlistItemResponse{}, _errors.NewNotFound(variantErr.Error())
+		}
+		if variant.ProductId != productId {
+			return dto.WishlistItemResponse{}, _errors.NewBadRequest("Variant does not belong to the product")
+		}
+	}
+
+	addedItem, err := wishlistService.wishlistRepository.AddItem(domain.WishlistItem{
+		WishlistId: wishlistId,
+		ProductId:  productId,
+		VariantId:  variantId,
+		AddedPrice: product.Price,
+	})
+	if err != nil {
+		return dto.WishlistItemResponse{}, _errors.NewBadRequest(err.Error())
+	}
+	return convertToWishlistItemResponse(addedItem, product), nil
+}
+
+// withItems attaches the items whose product is still live; publishedOnly also drops products that are not
+// published, for lists seen by other people.
+func (wishlistService *WishlistService) withItems(wishlist domain.Wishlist, publishedOnly bool) (dto.WishlistResponse, error) {
+	items, err := wishlistService.wishlistRepository.GetItemsByWishlistId(wishlist.Id)
+	if err != nil {
+		return dto.WishlistResponse{}, _errors.NewInternalServerError(err)
+	}
+
+	response := convertToWishlistResponse(wishlist)
+	response.Items = make([]dto.WishlistItemResponse, 0, len(items))
+	for _, item := range items {
+		product, productErr := wishlistService.productRepository.GetProductById(item.ProductId)
+		if productErr != nil || (publishedOnly && product.Status != domain.ProductStatusPublished) {
+			continue
+		}
+		response.Items = append(response.Items, convertToWishlistItemResponse(item, product))
+	}
+	return response, nil
+}
+
+func newShareToken() *string {
+	token := strings.ReplaceAll(uuid.New().String(), "-", "")
+	return &token
+}
+
+func convertToWishlistResponse(wishlist domain.Wishlist) dto.WishlistResponse {
+	return dto.WishlistResponse{
+		Id:         wishlist.Id,
+		UserId:     wishlist.UserId,
+		Name:       wishlist.Name,
+		IsPublic:   wishlist.IsPublic,
+		ShareToken: wishlist.ShareToken,
+		CreatedAt:  wishlist.CreatedAt,
+		UpdatedAt:  wishlist.UpdatedAt,
+	}
+}
+
+func convertToWishlistItemResponse(item domain.WishlistItem, product domain.Product) dto.WishlistItemResponse {
+	return dto.WishlistItemResponse{
+		Id:           item.Id,
+		WishlistId:   item.WishlistId,
+		ProductId:    item.ProductId,
+		VariantId:    item.VariantId,
+		ProductName:  product.Name,
+		AddedPrice:   item.AddedPrice,
+		CurrentPrice: product.Price,
+		PriceDropped: product.Price < item.AddedPrice,
+		CreatedAt:    item.CreatedAt,
+	}
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type BackInStockWorker struct {
+	subscriptionService service.IStockSubscriptionService
+	interval            time.Duration
+}
+
+func NewBackInStockWorker(subscriptionService service.IStockSubscriptionService, interval time.Duration) *BackInStockWorker {
+	return &BackInStockWorker{
+		subscriptionService: subscriptionService,
+		interval:            interval,
+	}
+}
+
+func (w *BackInStockWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("🔔 Back in stock worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			count, err := w.subscriptionService.NotifyRestocked()
+			if err != nil {
+				log.Error().Err(err).Msg("Back in stock notification failed")
+				continue
+			}
+			if count > 0 {
+				log.Info().Int("count", count).Msg("Back in stock notifications queued")
+			}
+		}
+	}()
+}
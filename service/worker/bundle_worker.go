@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type BundleWorker struct {
+	bundleService service.IBundleService
+	interval      time.Duration
+}
+
+func NewBundleWorker(bundleService service.IBundleService, interval time.Duration) *BundleWorker {
+	return &BundleWorker{
+		bundleService: bundleService,
+		interval:      interval,
+	}
+}
+
+// Start reprices the bundles and derives their stock once on startup and then on every tick, so bundles follow
+// component price changes and stock movements made outside of orders.
+func (w *BundleWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("📦 Bundle worker started")
+
+		w.refresh()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			w.refresh()
+		}
+	}()
+}
+
+func (w *BundleWorker) refresh() {
+	changed, err := w.bundleService.RefreshBundles()
+	if err != nil {
+		log.Error().Err(err).Msg("Bundles could not be refreshed")
+		return
+	}
+	if changed > 0 {
+		log.Info().Int("count", changed).Msg("Bundles refreshed")
+	}
+}
@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type CartAbandonmentWorker struct {
+	cartService service.ICartService
+	interval    time.Duration
+}
+
+func NewCartAbandonmentWorker(cartService service.ICartService, interval time.Duration) *CartAbandonmentWorker {
+	return &CartAbandonmentWorker{
+		cartService: cartService,
+		interval:    interval,
+	}
+}
+
+func (w *CartAbandonmentWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("🛒 Cart abandonment worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			w.Run()
+		}
+	}()
+}
+
+// Run marks the carts idle for too long as abandoned once.
+func (w *CartAbandonmentWorker) Run() {
+	count, err := w.cartService.DetectAbandonedCarts()
+	if err != nil {
+		log.Error().Err(err).Msg("Abandoned cart detection failed")
+		return
+	}
+	if count > 0 {
+		log.Info().Int("count", count).Msg("Carts marked as abandoned")
+	}
+}
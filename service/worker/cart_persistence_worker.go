@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"go-ecommerce-service/persistence"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// CartPersistenceWorker flushes carts changed in Redis to Postgres and rebuilds the Redis cart cache
+// from Postgres when it detects that Redis was emptied.
+type CartPersistenceWorker struct {
+	repository persistence.IHotCartItemRepository
+	interval   time.Duration
+}
+
+func NewCartPersistenceWorker(repository persistence.IHotCartItemRepository, interval time.Duration) *CartPersistenceWorker {
+	return &CartPersistenceWorker{
+		repository: repository,
+		interval:   interval,
+	}
+}
+
+func (w *CartPersistenceWorker) Start() {
+	w.rebuildIfCold()
+
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("💾 Cart persistence worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			w.rebuildIfCold()
+			w.Flush()
+		}
+	}()
+}
+
+// Flush persists all pending cart changes; it is also called on shutdown.
+func (w *CartPersistenceWorker) Flush() {
+	count, err := w.repository.FlushDirtyCarts()
+	if err != nil {
+		log.Error().Err(err).Msg("Cart write-behind flush failed")
+		return
+	}
+	if count > 0 {
+		log.Debug().Int("count", count).Msg("Carts persisted to Postgres")
+	}
+}
+
+func (w *CartPersistenceWorker) rebuildIfCold() {
+	if w.repository.IsCacheWarm() {
+		return
+	}
+	count, err := w.repository.RebuildCache()
+	if err != nil {
+		log.Error().Err(err).Msg("Cart cache rebuild failed")
+		return
+	}
+	log.Info().Int("count", count).Msg("♻️ Cart cache rebuilt from Postgres")
+}
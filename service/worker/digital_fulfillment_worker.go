@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type DigitalFulfillmentWorker struct {
+	digitalService service.IDigitalService
+	interval       time.Duration
+}
+
+func NewDigitalFulfillmentWorker(digitalService service.IDigitalService, interval time.Duration) *DigitalFulfillmentWorker {
+	return &DigitalFulfillmentWorker{
+		digitalService: digitalService,
+		interval:       interval,
+	}
+}
+
+// Start fulfills paid digital order lines on every tick. Lines waiting for licence keys are retried until
+// keys are added to their product's pool.
+func (w *DigitalFulfillmentWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("🔑 Digital fulfillment worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			run, err := w.digitalService.FulfillOrders()
+			if err != nil {
+				log.Error().Err(err).Msg("Digital orders could not be fulfilled")
+				continue
+			}
+			if run.Grants > 0 || run.Keys > 0 {
+				log.Info().Int("grants", run.Grants).Int("keys", run.Keys).Msg("Digital orders fulfilled")
+			}
+			if run.WaitingLines > 0 {
+				log.Warn().Int("lines", run.WaitingLines).Msg("Order lines are waiting for licence keys")
+			}
+		}
+	}()
+}
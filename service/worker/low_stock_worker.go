@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type LowStockWorker struct {
+	alertService service.IStockAlertService
+	interval     time.Duration
+}
+
+func NewLowStockWorker(alertService service.IStockAlertService, interval time.Duration) *LowStockWorker {
+	return &LowStockWorker{
+		alertService: alertService,
+		interval:     interval,
+	}
+}
+
+func (w *LowStockWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("📉 Low stock worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			count, err := w.alertService.DetectLowStock()
+			if err != nil {
+				log.Error().Err(err).Msg("Low stock detection failed")
+				continue
+			}
+			if count > 0 {
+				log.Info().Int("count", count).Msg("Low stock alerts published")
+			}
+		}
+	}()
+}
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"encoding/json"
+	"go-ecommerce-service/domain"
 	"go-ecommerce-service/infrastructure/rabbitmq"
 	"go-ecommerce-service/persistence"
 
@@ -9,14 +10,17 @@ import (
 )
 
 type OrderWorker struct {
-	client     *rabbitmq.RabbitMQClient
-	repository persistence.IOrderRepository
+	client            *rabbitmq.RabbitMQClient
+	repository        persistence.IOrderRepository
+	digitalRepository persistence.IDigitalRepository
 }
 
-func NewOrderWorker(client *rabbitmq.RabbitMQClient, repository persistence.IOrderRepository) *OrderWorker {
+func NewOrderWorker(client *rabbitmq.RabbitMQClient, repository persistence.IOrderRepository,
+	digitalRepository persistence.IDigitalRepository) *OrderWorker {
 	return &OrderWorker{
-		client:     client,
-		repository: repository,
+		client:            client,
+		repository:        repository,
+		digitalRepository: digitalRepository,
 	}
 }
 
@@ -44,7 +48,7 @@ func (w *OrderWorker) Start() {
 
 			log.Info().Int64("order_id", order.OrderId).Msg("📩 New job received")
 
-			_, err := w.repository.UpdateOrderStatus(order.OrderId, "Shipped")
+			_, err := w.repository.UpdateOrderStatus(order.OrderId, w.nextStatus(order.OrderId))
 
 			if err != nil {
 				log.Error().Err(err).Msg("Order update failed")
@@ -56,6 +60,19 @@ func (w *OrderWorker) Start() {
 	}()
 }
 
+// nextStatus ships the order, unless it only holds digital products: those skip shipping and are delivered
+// through downloads and licence keys.
+func (w *OrderWorker) nextStatus(orderId int64) string {
+	digital, err := w.digitalRepository.IsDigitalOrder(orderId)
+	if err != nil {
+		log.Error().Err(err).Int64("order_id", orderId).Msg("Order lines could not be checked for digital products")
+	}
+	if digital {
+		return domain.OrderStatusDelivered
+	}
+	return domain.OrderStatusShipped
+}
+
 type OrderMessage struct {
 	OrderId int64 `json:"order_id"`
 }
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type OrphanMediaWorker struct {
+	imageService service.IProductImageService
+	interval     time.Duration
+}
+
+func NewOrphanMediaWorker(imageService service.IProductImageService, interval time.Duration) *OrphanMediaWorker {
+	return &OrphanMediaWorker{
+		imageService: imageService,
+		interval:     interval,
+	}
+}
+
+func (w *OrphanMediaWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("🖼️ Orphan media worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			count, err := w.imageService.CleanupOrphanedFiles()
+			if err != nil {
+				log.Error().Err(err).Msg("Orphaned media cleanup failed")
+				continue
+			}
+			if count > 0 {
+				log.Info().Int("count", count).Msg("Orphaned media files deleted")
+			}
+		}
+	}()
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type PriceScheduleWorker struct {
+	priceService service.IProductPriceService
+	interval     time.Duration
+}
+
+func NewPriceScheduleWorker(priceService service.IProductPriceService, interval time.Duration) *PriceScheduleWorker {
+	return &PriceScheduleWorker{
+		priceService: priceService,
+		interval:     interval,
+	}
+}
+
+func (w *PriceScheduleWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("🏷️ Price schedule worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			run, err := w.priceService.ApplySchedules()
+			if err != nil {
+				log.Error().Err(err).Msg("Price schedules could not be applied")
+				continue
+			}
+			if run.Started+run.Finished > 0 {
+				log.Info().Int("started", run.Started).Int("finished", run.Finished).Msg("Price schedules applied")
+			}
+		}
+	}()
+}
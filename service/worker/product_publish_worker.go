@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ProductPublishWorker struct {
+	statusService service.IProductStatusService
+	interval      time.Duration
+}
+
+func NewProductPublishWorker(statusService service.IProductStatusService, interval time.Duration) *ProductPublishWorker {
+	return &ProductPublishWorker{
+		statusService: statusService,
+		interval:      interval,
+	}
+}
+
+func (w *ProductPublishWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("🗓️ Product publish worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			run, err := w.statusService.ApplySchedules()
+			if err != nil {
+				log.Error().Err(err).Msg("Publish schedules could not be applied")
+				continue
+			}
+			if run.Published+run.Unpublished > 0 {
+				log.Info().Int("published", run.Published).Int("unpublished", run.Unpublished).Msg("Publish schedules applied")
+			}
+		}
+	}()
+}
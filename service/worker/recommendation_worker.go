@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type RecommendationWorker struct {
+	recommendationService service.IRecommendationService
+	interval              time.Duration
+}
+
+func NewRecommendationWorker(recommendationService service.IRecommendationService, interval time.Duration) *RecommendationWorker {
+	return &RecommendationWorker{
+		recommendationService: recommendationService,
+		interval:              interval,
+	}
+}
+
+// Start computes the relations once on startup, so a fresh deployment has recommendations, and then on every tick.
+func (w *RecommendationWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("🧩 Recommendation worker started")
+
+		w.compute()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			w.compute()
+		}
+	}()
+}
+
+func (w *RecommendationWorker) compute() {
+	run, err := w.recommendationService.ComputeRelations()
+	if err != nil {
+		log.Error().Err(err).Msg("Related products could not be computed")
+		return
+	}
+	log.Info().Int("bought_together", run.BoughtTogether).Int("category", run.Category).Msg("Related products computed")
+}
@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type SitemapWorker struct {
+	seoService service.ISeoService
+	interval   time.Duration
+}
+
+func NewSitemapWorker(seoService service.ISeoService, interval time.Duration) *SitemapWorker {
+	return &SitemapWorker{
+		seoService: seoService,
+		interval:   interval,
+	}
+}
+
+// Start generates the sitemaps once on startup, so a fresh deployment serves them, and then refreshes the
+// changed pages on every tick.
+func (w *SitemapWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("🗺️ Sitemap worker started")
+
+		w.refresh()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			w.refresh()
+		}
+	}()
+}
+
+func (w *SitemapWorker) refresh() {
+	run, err := w.seoService.RefreshSitemaps()
+	if err != nil {
+		log.Error().Err(err).Msg("Sitemaps could not be refreshed")
+		return
+	}
+	if run.Generated > 0 || run.Removed > 0 {
+		log.Info().Int("generated", run.Generated).Int("removed", run.Removed).Msg("Sitemaps refreshed")
+	}
+}
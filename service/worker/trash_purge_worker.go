@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type TrashPurgeWorker struct {
+	trashService service.ITrashService
+	interval     time.Duration
+}
+
+func NewTrashPurgeWorker(trashService service.ITrashService, interval time.Duration) *TrashPurgeWorker {
+	return &TrashPurgeWorker{
+		trashService: trashService,
+		interval:     interval,
+	}
+}
+
+func (w *TrashPurgeWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("🗑️ Trash purge worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			purge, err := w.trashService.PurgeExpired()
+			if err != nil {
+				log.Error().Err(err).Msg("Trash purge failed")
+				continue
+			}
+			if purge.Products+purge.Stores+purge.Categories > 0 {
+				log.Info().
+					Int64("products", purge.Products).
+					Int64("stores", purge.Stores).
+					Int64("categories", purge.Categories).
+					Msg("Expired trash purged")
+			}
+		}
+	}()
+}
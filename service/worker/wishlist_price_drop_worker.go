@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"go-ecommerce-service/service"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type WishlistPriceDropWorker struct {
+	wishlistService service.IWishlistService
+	interval        time.Duration
+}
+
+func NewWishlistPriceDropWorker(wishlistService service.IWishlistService, interval time.Duration) *WishlistPriceDropWorker {
+	return &WishlistPriceDropWorker{
+		wishlistService: wishlistService,
+		interval:        interval,
+	}
+}
+
+func (w *WishlistPriceDropWorker) Start() {
+	go func() {
+		log.Info().Dur("interval", w.interval).Msg("💸 Wishlist price drop worker started")
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			count, err := w.wishlistService.DetectPriceDrops()
+			if err != nil {
+				log.Error().Err(err).Msg("Wishlist price drop detection failed")
+				continue
+			}
+			if count > 0 {
+				log.Info().Int("count", count).Msg("Wishlist price drops published")
+			}
+		}
+	}()
+}
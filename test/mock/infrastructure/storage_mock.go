@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: infrastructure/storage/storage.go
+//
+// Generated by this command:
+//
+//	mockgen -source=infrastructure/storage/storage.go -destination=test/mock/infrastructure/storage_mock.go -package=mock_infra
+//
+
+// Package mock_infra is a generated GoMock package.
+package mock_infra
+
+import (
+	storage "go-ecommerce-service/infrastructure/storage"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIObjectStorage is a mock of IObjectStorage interface.
+type MockIObjectStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockIObjectStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockIObjectStorageMockRecorder is the mock recorder for MockIObjectStorage.
+type MockIObjectStorageMockRecorder struct {
+	mock *MockIObjectStorage
+}
+
+// NewMockIObjectStorage creates a new mock instance.
+func NewMockIObjectStorage(ctrl *gomock.Controller) *MockIObjectStorage {
+	mock := &MockIObjectStorage{ctrl: ctrl}
+	mock.recorder = &MockIObjectStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIObjectStorage) EXPECT() *MockIObjectStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIObjectStorage) Delete(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIObjectStorageMockRecorder) Delete(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIObjectStorage)(nil).Delete), key)
+}
+
+// List mocks base method.
+func (m *MockIObjectStorage) List(prefix string) ([]storage.StoredObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", prefix)
+	ret0, _ := ret[0].([]storage.StoredObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIObjectStorageMockRecorder) List(prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIObjectStorage)(nil).List), prefix)
+}
+
+// Open mocks base method.
+func (m *MockIObjectStorage) Open(key string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", key)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockIObjectStorageMockRecorder) Open(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockIObjectStorage)(nil).Open), key)
+}
+
+// Put mocks base method.
+func (m *MockIObjectStorage) Put(key string, content []byte, contentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, content, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockIObjectStorageMockRecorder) Put(key, content, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockIObjectStorage)(nil).Put), key, content, contentType)
+}
+
+// PutStream mocks base method.
+func (m *MockIObjectStorage) PutStream(key string, content io.Reader, size int64, contentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutStream", key, content, size, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutStream indicates an expected call of PutStream.
+func (mr *MockIObjectStorageMockRecorder) PutStream(key, content, size, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStream", reflect.TypeOf((*MockIObjectStorage)(nil).PutStream), key, content, size, contentType)
+}
+
+// URL mocks base method.
+func (m *MockIObjectStorage) URL(key string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URL", key)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// URL indicates an expected call of URL.
+func (mr *MockIObjectStorageMockRecorder) URL(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URL", reflect.TypeOf((*MockIObjectStorage)(nil).URL), key)
+}
@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/bundle_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/bundle_repository.go -destination=test/mock/repository/bundle_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBundleRepository is a mock of IBundleRepository interface.
+type MockIBundleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBundleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIBundleRepositoryMockRecorder is the mock recorder for MockIBundleRepository.
+type MockIBundleRepositoryMockRecorder struct {
+	mock *MockIBundleRepository
+}
+
+// NewMockIBundleRepository creates a new mock instance.
+func NewMockIBundleRepository(ctrl *gomock.Controller) *MockIBundleRepository {
+	mock := &MockIBundleRepository{ctrl: ctrl}
+	mock.recorder = &MockIBundleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBundleRepository) EXPECT() *MockIBundleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddBundleOrderItem mocks base method.
+func (m *MockIBundleRepository) AddBundleOrderItem(orderItem domain.OrderItem, components []domain.OrderItemComponent) (domain.OrderItem, []domain.OrderItemComponent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBundleOrderItem", orderItem, components)
+	ret0, _ := ret[0].(domain.OrderItem)
+	ret1, _ := ret[1].([]domain.OrderItemComponent)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddBundleOrderItem indicates an expected call of AddBundleOrderItem.
+func (mr *MockIBundleRepositoryMockRecorder) AddBundleOrderItem(orderItem, components any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBundleOrderItem", reflect.TypeOf((*MockIBundleRepository)(nil).AddBundleOrderItem), orderItem, components)
+}
+
+// DeleteBundle mocks base method.
+func (m *MockIBundleRepository) DeleteBundle(productId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBundle", productId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBundle indicates an expected call of DeleteBundle.
+func (mr *MockIBundleRepositoryMockRecorder) DeleteBundle(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBundle", reflect.TypeOf((*MockIBundleRepository)(nil).DeleteBundle), productId)
+}
+
+// DeleteBundleOrderItems mocks base method.
+func (m *MockIBundleRepository) DeleteBundleOrderItems(orderItemIds []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBundleOrderItems", orderItemIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBundleOrderItems indicates an expected call of DeleteBundleOrderItems.
+func (mr *MockIBundleRepositoryMockRecorder) DeleteBundleOrderItems(orderItemIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBundleOrderItems", reflect.TypeOf((*MockIBundleRepository)(nil).DeleteBundleOrderItems), orderItemIds)
+}
+
+// GetBundle mocks base method.
+func (m *MockIBundleRepository) GetBundle(productId int64) (domain.ProductBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBundle", productId)
+	ret0, _ := ret[0].(domain.ProductBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBundle indicates an expected call of GetBundle.
+func (mr *MockIBundleRepositoryMockRecorder) GetBundle(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBundle", reflect.TypeOf((*MockIBundleRepository)(nil).GetBundle), productId)
+}
+
+// GetOrderItemComponents mocks base method.
+func (m *MockIBundleRepository) GetOrderItemComponents(orderItemIds []int64) (map[int64][]domain.OrderItemComponent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderItemComponents", orderItemIds)
+	ret0, _ := ret[0].(map[int64][]domain.OrderItemComponent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderItemComponents indicates an expected call of GetOrderItemComponents.
+func (mr *MockIBundleRepositoryMockRecorder) GetOrderItemComponents(orderItemIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemComponents", reflect.TypeOf((*MockIBundleRepository)(nil).GetOrderItemComponents), orderItemIds)
+}
+
+// IsBundleComponent mocks base method.
+func (m *MockIBundleRepository) IsBundleComponent(productId int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBundleComponent", productId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBundleComponent indicates an expected call of IsBundleComponent.
+func (mr *MockIBundleRepositoryMockRecorder) IsBundleComponent(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBundleComponent", reflect.TypeOf((*MockIBundleRepository)(nil).IsBundleComponent), productId)
+}
+
+// RefreshBundles mocks base method.
+func (m *MockIBundleRepository) RefreshBundles() ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshBundles")
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshBundles indicates an expected call of RefreshBundles.
+func (mr *MockIBundleRepositoryMockRecorder) RefreshBundles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshBundles", reflect.TypeOf((*MockIBundleRepository)(nil).RefreshBundles))
+}
+
+// SaveBundle mocks base method.
+func (m *MockIBundleRepository) SaveBundle(bundle domain.ProductBundle, userId int64) (domain.ProductBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBundle", bundle, userId)
+	ret0, _ := ret[0].(domain.ProductBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveBundle indicates an expected call of SaveBundle.
+func (mr *MockIBundleRepositoryMockRecorder) SaveBundle(bundle, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBundle", reflect.TypeOf((*MockIBundleRepository)(nil).SaveBundle), bundle, userId)
+}
@@ -0,0 +1,354 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/cart_item_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/cart_item_repository.go -destination=test/mock/repository/cart_item_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICartItemRepository is a mock of ICartItemRepository interface.
+type MockICartItemRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICartItemRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockICartItemRepositoryMockRecorder is the mock recorder for MockICartItemRepository.
+type MockICartItemRepositoryMockRecorder struct {
+	mock *MockICartItemRepository
+}
+
+// NewMockICartItemRepository creates a new mock instance.
+func NewMockICartItemRepository(ctrl *gomock.Controller) *MockICartItemRepository {
+	mock := &MockICartItemRepository{ctrl: ctrl}
+	mock.recorder = &MockICartItemRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICartItemRepository) EXPECT() *MockICartItemRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddItemToCart mocks base method.
+func (m *MockICartItemRepository) AddItemToCart(cartItem domain.CartItem) (domain.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItemToCart", cartItem)
+	ret0, _ := ret[0].(domain.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItemToCart indicates an expected call of AddItemToCart.
+func (mr *MockICartItemRepositoryMockRecorder) AddItemToCart(cartItem any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItemToCart", reflect.TypeOf((*MockICartItemRepository)(nil).AddItemToCart), cartItem)
+}
+
+// ClearCartItems mocks base method.
+func (m *MockICartItemRepository) ClearCartItems(cartId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCartItems", cartId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearCartItems indicates an expected call of ClearCartItems.
+func (mr *MockICartItemRepositoryMockRecorder) ClearCartItems(cartId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCartItems", reflect.TypeOf((*MockICartItemRepository)(nil).ClearCartItems), cartId)
+}
+
+// DecreaseItemQuantity mocks base method.
+func (m *MockICartItemRepository) DecreaseItemQuantity(cartItemId int64, amount int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecreaseItemQuantity", cartItemId, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecreaseItemQuantity indicates an expected call of DecreaseItemQuantity.
+func (mr *MockICartItemRepositoryMockRecorder) DecreaseItemQuantity(cartItemId, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecreaseItemQuantity", reflect.TypeOf((*MockICartItemRepository)(nil).DecreaseItemQuantity), cartItemId, amount)
+}
+
+// GetItemById mocks base method.
+func (m *MockICartItemRepository) GetItemById(cartItemId int64) (domain.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemById", cartItemId)
+	ret0, _ := ret[0].(domain.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemById indicates an expected call of GetItemById.
+func (mr *MockICartItemRepositoryMockRecorder) GetItemById(cartItemId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemById", reflect.TypeOf((*MockICartItemRepository)(nil).GetItemById), cartItemId)
+}
+
+// GetItemsByCartId mocks base method.
+func (m *MockICartItemRepository) GetItemsByCartId(cartId int64) []domain.CartItem {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemsByCartId", cartId)
+	ret0, _ := ret[0].([]domain.CartItem)
+	return ret0
+}
+
+// GetItemsByCartId indicates an expected call of GetItemsByCartId.
+func (mr *MockICartItemRepositoryMockRecorder) GetItemsByCartId(cartId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsByCartId", reflect.TypeOf((*MockICartItemRepository)(nil).GetItemsByCartId), cartId)
+}
+
+// IncreaseItemQuantity mocks base method.
+func (m *MockICartItemRepository) IncreaseItemQuantity(cartItemId int64, amount int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseItemQuantity", cartItemId, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseItemQuantity indicates an expected call of IncreaseItemQuantity.
+func (mr *MockICartItemRepositoryMockRecorder) IncreaseItemQuantity(cartItemId, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseItemQuantity", reflect.TypeOf((*MockICartItemRepository)(nil).IncreaseItemQuantity), cartItemId, amount)
+}
+
+// RemoveItemFromCart mocks base method.
+func (m *MockICartItemRepository) RemoveItemFromCart(cartItemId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItemFromCart", cartItemId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItemFromCart indicates an expected call of RemoveItemFromCart.
+func (mr *MockICartItemRepositoryMockRecorder) RemoveItemFromCart(cartItemId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItemFromCart", reflect.TypeOf((*MockICartItemRepository)(nil).RemoveItemFromCart), cartItemId)
+}
+
+// UpdateItemQuantity mocks base method.
+func (m *MockICartItemRepository) UpdateItemQuantity(cartItemId int64, newQuantity int) (domain.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemQuantity", cartItemId, newQuantity)
+	ret0, _ := ret[0].(domain.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItemQuantity indicates an expected call of UpdateItemQuantity.
+func (mr *MockICartItemRepositoryMockRecorder) UpdateItemQuantity(cartItemId, newQuantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemQuantity", reflect.TypeOf((*MockICartItemRepository)(nil).UpdateItemQuantity), cartItemId, newQuantity)
+}
+
+// MockICartItemSnapshotRepository is a mock of ICartItemSnapshotRepository interface.
+type MockICartItemSnapshotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICartItemSnapshotRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockICartItemSnapshotRepositoryMockRecorder is the mock recorder for MockICartItemSnapshotRepository.
+type MockICartItemSnapshotRepositoryMockRecorder struct {
+	mock *MockICartItemSnapshotRepository
+}
+
+// NewMockICartItemSnapshotRepository creates a new mock instance.
+func NewMockICartItemSnapshotRepository(ctrl *gomock.Controller) *MockICartItemSnapshotRepository {
+	mock := &MockICartItemSnapshotRepository{ctrl: ctrl}
+	mock.recorder = &MockICartItemSnapshotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICartItemSnapshotRepository) EXPECT() *MockICartItemSnapshotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddItemToCart mocks base method.
+func (m *MockICartItemSnapshotRepository) AddItemToCart(cartItem domain.CartItem) (domain.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItemToCart", cartItem)
+	ret0, _ := ret[0].(domain.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItemToCart indicates an expected call of AddItemToCart.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) AddItemToCart(cartItem any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItemToCart", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).AddItemToCart), cartItem)
+}
+
+// ClearCartItems mocks base method.
+func (m *MockICartItemSnapshotRepository) ClearCartItems(cartId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCartItems", cartId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearCartItems indicates an expected call of ClearCartItems.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) ClearCartItems(cartId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCartItems", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).ClearCartItems), cartId)
+}
+
+// DecreaseItemQuantity mocks base method.
+func (m *MockICartItemSnapshotRepository) DecreaseItemQuantity(cartItemId int64, amount int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecreaseItemQuantity", cartItemId, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecreaseItemQuantity indicates an expected call of DecreaseItemQuantity.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) DecreaseItemQuantity(cartItemId, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecreaseItemQuantity", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).DecreaseItemQuantity), cartItemId, amount)
+}
+
+// GetCartIdsUpdatedSince mocks base method.
+func (m *MockICartItemSnapshotRepository) GetCartIdsUpdatedSince(since time.Time) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartIdsUpdatedSince", since)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartIdsUpdatedSince indicates an expected call of GetCartIdsUpdatedSince.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) GetCartIdsUpdatedSince(since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartIdsUpdatedSince", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).GetCartIdsUpdatedSince), since)
+}
+
+// GetItemById mocks base method.
+func (m *MockICartItemSnapshotRepository) GetItemById(cartItemId int64) (domain.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemById", cartItemId)
+	ret0, _ := ret[0].(domain.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemById indicates an expected call of GetItemById.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) GetItemById(cartItemId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemById", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).GetItemById), cartItemId)
+}
+
+// GetItemsByCartId mocks base method.
+func (m *MockICartItemSnapshotRepository) GetItemsByCartId(cartId int64) []domain.CartItem {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemsByCartId", cartId)
+	ret0, _ := ret[0].([]domain.CartItem)
+	return ret0
+}
+
+// GetItemsByCartId indicates an expected call of GetItemsByCartId.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) GetItemsByCartId(cartId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsByCartId", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).GetItemsByCartId), cartId)
+}
+
+// GetLastItemId mocks base method.
+func (m *MockICartItemSnapshotRepository) GetLastItemId() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastItemId")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastItemId indicates an expected call of GetLastItemId.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) GetLastItemId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastItemId", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).GetLastItemId))
+}
+
+// IncreaseItemQuantity mocks base method.
+func (m *MockICartItemSnapshotRepository) IncreaseItemQuantity(cartItemId int64, amount int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseItemQuantity", cartItemId, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseItemQuantity indicates an expected call of IncreaseItemQuantity.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) IncreaseItemQuantity(cartItemId, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseItemQuantity", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).IncreaseItemQuantity), cartItemId, amount)
+}
+
+// RemoveItemFromCart mocks base method.
+func (m *MockICartItemSnapshotRepository) RemoveItemFromCart(cartItemId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItemFromCart", cartItemId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItemFromCart indicates an expected call of RemoveItemFromCart.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) RemoveItemFromCart(cartItemId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItemFromCart", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).RemoveItemFromCart), cartItemId)
+}
+
+// SaveCartSnapshot mocks base method.
+func (m *MockICartItemSnapshotRepository) SaveCartSnapshot(cartId int64, items []domain.CartItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCartSnapshot", cartId, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCartSnapshot indicates an expected call of SaveCartSnapshot.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) SaveCartSnapshot(cartId, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCartSnapshot", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).SaveCartSnapshot), cartId, items)
+}
+
+// UpdateItemQuantity mocks base method.
+func (m *MockICartItemSnapshotRepository) UpdateItemQuantity(cartItemId int64, newQuantity int) (domain.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemQuantity", cartItemId, newQuantity)
+	ret0, _ := ret[0].(domain.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItemQuantity indicates an expected call of UpdateItemQuantity.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) UpdateItemQuantity(cartItemId, newQuantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemQuantity", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).UpdateItemQuantity), cartItemId, newQuantity)
+}
+
+// ValidateItemReferences mocks base method.
+func (m *MockICartItemSnapshotRepository) ValidateItemReferences(cartId, productId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateItemReferences", cartId, productId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateItemReferences indicates an expected call of ValidateItemReferences.
+func (mr *MockICartItemSnapshotRepositoryMockRecorder) ValidateItemReferences(cartId, productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateItemReferences", reflect.TypeOf((*MockICartItemSnapshotRepository)(nil).ValidateItemReferences), cartId, productId)
+}
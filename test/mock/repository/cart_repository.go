@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/cart_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/cart_repository.go -destination=test/mock/repository/cart_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICartRepository is a mock of ICartRepository interface.
+type MockICartRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICartRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockICartRepositoryMockRecorder is the mock recorder for MockICartRepository.
+type MockICartRepositoryMockRecorder struct {
+	mock *MockICartRepository
+}
+
+// NewMockICartRepository creates a new mock instance.
+func NewMockICartRepository(ctrl *gomock.Controller) *MockICartRepository {
+	mock := &MockICartRepository{ctrl: ctrl}
+	mock.recorder = &MockICartRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICartRepository) EXPECT() *MockICartRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClearUserCart mocks base method.
+func (m *MockICartRepository) ClearUserCart(userId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearUserCart", userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearUserCart indicates an expected call of ClearUserCart.
+func (mr *MockICartRepositoryMockRecorder) ClearUserCart(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearUserCart", reflect.TypeOf((*MockICartRepository)(nil).ClearUserCart), userId)
+}
+
+// CreateCart mocks base method.
+func (m *MockICartRepository) CreateCart(cart domain.Cart) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCart", cart)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCart indicates an expected call of CreateCart.
+func (mr *MockICartRepositoryMockRecorder) CreateCart(cart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCart", reflect.TypeOf((*MockICartRepository)(nil).CreateCart), cart)
+}
+
+// DeleteCartById mocks base method.
+func (m *MockICartRepository) DeleteCartById(cartId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCartById", cartId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCartById indicates an expected call of DeleteCartById.
+func (mr *MockICartRepositoryMockRecorder) DeleteCartById(cartId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCartById", reflect.TypeOf((*MockICartRepository)(nil).DeleteCartById), cartId)
+}
+
+// GetAbandonmentStats mocks base method.
+func (m *MockICartRepository) GetAbandonmentStats(since time.Time, storeId *uint) ([]domain.CartAbandonmentStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbandonmentStats", since, storeId)
+	ret0, _ := ret[0].([]domain.CartAbandonmentStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbandonmentStats indicates an expected call of GetAbandonmentStats.
+func (mr *MockICartRepositoryMockRecorder) GetAbandonmentStats(since, storeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbandonmentStats", reflect.TypeOf((*MockICartRepository)(nil).GetAbandonmentStats), since, storeId)
+}
+
+// GetCartById mocks base method.
+func (m *MockICartRepository) GetCartById(cartId int64) domain.Cart {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartById", cartId)
+	ret0, _ := ret[0].(domain.Cart)
+	return ret0
+}
+
+// GetCartById indicates an expected call of GetCartById.
+func (mr *MockICartRepositoryMockRecorder) GetCartById(cartId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartById", reflect.TypeOf((*MockICartRepository)(nil).GetCartById), cartId)
+}
+
+// GetCartsByUserId mocks base method.
+func (m *MockICartRepository) GetCartsByUserId(userId int64) []domain.Cart {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartsByUserId", userId)
+	ret0, _ := ret[0].([]domain.Cart)
+	return ret0
+}
+
+// GetCartsByUserId indicates an expected call of GetCartsByUserId.
+func (mr *MockICartRepositoryMockRecorder) GetCartsByUserId(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartsByUserId", reflect.TypeOf((*MockICartRepository)(nil).GetCartsByUserId), userId)
+}
+
+// GetInactiveCarts mocks base method.
+func (m *MockICartRepository) GetInactiveCarts(inactiveSince time.Time) ([]domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInactiveCarts", inactiveSince)
+	ret0, _ := ret[0].([]domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInactiveCarts indicates an expected call of GetInactiveCarts.
+func (mr *MockICartRepositoryMockRecorder) GetInactiveCarts(inactiveSince any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInactiveCarts", reflect.TypeOf((*MockICartRepository)(nil).GetInactiveCarts), inactiveSince)
+}
+
+// MarkCartAbandoned mocks base method.
+func (m *MockICartRepository) MarkCartAbandoned(cartId int64) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkCartAbandoned", cartId)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkCartAbandoned indicates an expected call of MarkCartAbandoned.
+func (mr *MockICartRepositoryMockRecorder) MarkCartAbandoned(cartId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCartAbandoned", reflect.TypeOf((*MockICartRepository)(nil).MarkCartAbandoned), cartId)
+}
+
+// RestoreCart mocks base method.
+func (m *MockICartRepository) RestoreCart(cartId, abandonedAt int64) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCart", cartId, abandonedAt)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCart indicates an expected call of RestoreCart.
+func (mr *MockICartRepositoryMockRecorder) RestoreCart(cartId, abandonedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCart", reflect.TypeOf((*MockICartRepository)(nil).RestoreCart), cartId, abandonedAt)
+}
+
+// TouchCart mocks base method.
+func (m *MockICartRepository) TouchCart(cartId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchCart", cartId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchCart indicates an expected call of TouchCart.
+func (mr *MockICartRepositoryMockRecorder) TouchCart(cartId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchCart", reflect.TypeOf((*MockICartRepository)(nil).TouchCart), cartId)
+}
@@ -0,0 +1,220 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/category_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/category_repository.go -destination=test/mock/repository/category_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICategoryRepository is a mock of ICategoryRepository interface.
+type MockICategoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICategoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockICategoryRepositoryMockRecorder is the mock recorder for MockICategoryRepository.
+type MockICategoryRepositoryMockRecorder struct {
+	mock *MockICategoryRepository
+}
+
+// NewMockICategoryRepository creates a new mock instance.
+func NewMockICategoryRepository(ctrl *gomock.Controller) *MockICategoryRepository {
+	mock := &MockICategoryRepository{ctrl: ctrl}
+	mock.recorder = &MockICategoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICategoryRepository) EXPECT() *MockICategoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddCategory mocks base method.
+func (m *MockICategoryRepository) AddCategory(category domain.Category) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCategory", category)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCategory indicates an expected call of AddCategory.
+func (mr *MockICategoryRepositoryMockRecorder) AddCategory(category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCategory", reflect.TypeOf((*MockICategoryRepository)(nil).AddCategory), category)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockICategoryRepository) DeleteCategory(id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockICategoryRepositoryMockRecorder) DeleteCategory(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICategoryRepository)(nil).DeleteCategory), id)
+}
+
+// GetAllCategories mocks base method.
+func (m *MockICategoryRepository) GetAllCategories() []domain.Category {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllCategories")
+	ret0, _ := ret[0].([]domain.Category)
+	return ret0
+}
+
+// GetAllCategories indicates an expected call of GetAllCategories.
+func (mr *MockICategoryRepositoryMockRecorder) GetAllCategories() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCategories", reflect.TypeOf((*MockICategoryRepository)(nil).GetAllCategories))
+}
+
+// GetCategoriesByIsActive mocks base method.
+func (m *MockICategoryRepository) GetCategoriesByIsActive(isActive bool) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoriesByIsActive", isActive)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoriesByIsActive indicates an expected call of GetCategoriesByIsActive.
+func (mr *MockICategoryRepositoryMockRecorder) GetCategoriesByIsActive(isActive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesByIsActive", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategoriesByIsActive), isActive)
+}
+
+// GetCategoriesWithAncestors mocks base method.
+func (m *MockICategoryRepository) GetCategoriesWithAncestors(ids []uint) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoriesWithAncestors", ids)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoriesWithAncestors indicates an expected call of GetCategoriesWithAncestors.
+func (mr *MockICategoryRepositoryMockRecorder) GetCategoriesWithAncestors(ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesWithAncestors", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategoriesWithAncestors), ids)
+}
+
+// GetCategoryById mocks base method.
+func (m *MockICategoryRepository) GetCategoryById(id int) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryById", id)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryById indicates an expected call of GetCategoryById.
+func (mr *MockICategoryRepositoryMockRecorder) GetCategoryById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryById", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategoryById), id)
+}
+
+// GetDeletedCategories mocks base method.
+func (m *MockICategoryRepository) GetDeletedCategories() ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedCategories")
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedCategories indicates an expected call of GetDeletedCategories.
+func (mr *MockICategoryRepositoryMockRecorder) GetDeletedCategories() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedCategories", reflect.TypeOf((*MockICategoryRepository)(nil).GetDeletedCategories))
+}
+
+// GetSubtree mocks base method.
+func (m *MockICategoryRepository) GetSubtree(id uint) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubtree", id)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubtree indicates an expected call of GetSubtree.
+func (mr *MockICategoryRepositoryMockRecorder) GetSubtree(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubtree", reflect.TypeOf((*MockICategoryRepository)(nil).GetSubtree), id)
+}
+
+// MoveCategory mocks base method.
+func (m *MockICategoryRepository) MoveCategory(id uint, parentId *uint, sortOrder int) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCategory", id, parentId, sortOrder)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCategory indicates an expected call of MoveCategory.
+func (mr *MockICategoryRepositoryMockRecorder) MoveCategory(id, parentId, sortOrder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCategory", reflect.TypeOf((*MockICategoryRepository)(nil).MoveCategory), id, parentId, sortOrder)
+}
+
+// PurgeDeletedCategories mocks base method.
+func (m *MockICategoryRepository) PurgeDeletedCategories(before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedCategories", before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedCategories indicates an expected call of PurgeDeletedCategories.
+func (mr *MockICategoryRepositoryMockRecorder) PurgeDeletedCategories(before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedCategories", reflect.TypeOf((*MockICategoryRepository)(nil).PurgeDeletedCategories), before)
+}
+
+// RestoreCategoryById mocks base method.
+func (m *MockICategoryRepository) RestoreCategoryById(id uint) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCategoryById", id)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCategoryById indicates an expected call of RestoreCategoryById.
+func (mr *MockICategoryRepositoryMockRecorder) RestoreCategoryById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCategoryById", reflect.TypeOf((*MockICategoryRepository)(nil).RestoreCategoryById), id)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockICategoryRepository) UpdateCategory(categoryId uint, category domain.Category) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", categoryId, category)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockICategoryRepositoryMockRecorder) UpdateCategory(categoryId, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockICategoryRepository)(nil).UpdateCategory), categoryId, category)
+}
@@ -0,0 +1,223 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/digital_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/digital_repository.go -destination=test/mock/repository/digital_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIDigitalRepository is a mock of IDigitalRepository interface.
+type MockIDigitalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDigitalRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIDigitalRepositoryMockRecorder is the mock recorder for MockIDigitalRepository.
+type MockIDigitalRepositoryMockRecorder struct {
+	mock *MockIDigitalRepository
+}
+
+// NewMockIDigitalRepository creates a new mock instance.
+func NewMockIDigitalRepository(ctrl *gomock.Controller) *MockIDigitalRepository {
+	mock := &MockIDigitalRepository{ctrl: ctrl}
+	mock.recorder = &MockIDigitalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDigitalRepository) EXPECT() *MockIDigitalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddFile mocks base method.
+func (m *MockIDigitalRepository) AddFile(file domain.DigitalFile) (domain.DigitalFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFile", file)
+	ret0, _ := ret[0].(domain.DigitalFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFile indicates an expected call of AddFile.
+func (mr *MockIDigitalRepositoryMockRecorder) AddFile(file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFile", reflect.TypeOf((*MockIDigitalRepository)(nil).AddFile), file)
+}
+
+// AddLicenseKeys mocks base method.
+func (m *MockIDigitalRepository) AddLicenseKeys(productId int64, keys []string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLicenseKeys", productId, keys)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddLicenseKeys indicates an expected call of AddLicenseKeys.
+func (mr *MockIDigitalRepositoryMockRecorder) AddLicenseKeys(productId, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLicenseKeys", reflect.TypeOf((*MockIDigitalRepository)(nil).AddLicenseKeys), productId, keys)
+}
+
+// AssignLicenseKeys mocks base method.
+func (m *MockIDigitalRepository) AssignLicenseKeys() (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignLicenseKeys")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AssignLicenseKeys indicates an expected call of AssignLicenseKeys.
+func (mr *MockIDigitalRepositoryMockRecorder) AssignLicenseKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignLicenseKeys", reflect.TypeOf((*MockIDigitalRepository)(nil).AssignLicenseKeys))
+}
+
+// DeleteFile mocks base method.
+func (m *MockIDigitalRepository) DeleteFile(productId, fileId int64) (domain.DigitalFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", productId, fileId)
+	ret0, _ := ret[0].(domain.DigitalFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockIDigitalRepositoryMockRecorder) DeleteFile(productId, fileId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockIDigitalRepository)(nil).DeleteFile), productId, fileId)
+}
+
+// GetDownloadGrant mocks base method.
+func (m *MockIDigitalRepository) GetDownloadGrant(grantId int64) (domain.DownloadGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDownloadGrant", grantId)
+	ret0, _ := ret[0].(domain.DownloadGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDownloadGrant indicates an expected call of GetDownloadGrant.
+func (mr *MockIDigitalRepositoryMockRecorder) GetDownloadGrant(grantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDownloadGrant", reflect.TypeOf((*MockIDigitalRepository)(nil).GetDownloadGrant), grantId)
+}
+
+// GetFiles mocks base method.
+func (m *MockIDigitalRepository) GetFiles(productId int64) ([]domain.DigitalFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFiles", productId)
+	ret0, _ := ret[0].([]domain.DigitalFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFiles indicates an expected call of GetFiles.
+func (mr *MockIDigitalRepositoryMockRecorder) GetFiles(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiles", reflect.TypeOf((*MockIDigitalRepository)(nil).GetFiles), productId)
+}
+
+// GetLicenseKeyPool mocks base method.
+func (m *MockIDigitalRepository) GetLicenseKeyPool(productId int64) (domain.LicenseKeyPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLicenseKeyPool", productId)
+	ret0, _ := ret[0].(domain.LicenseKeyPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLicenseKeyPool indicates an expected call of GetLicenseKeyPool.
+func (mr *MockIDigitalRepositoryMockRecorder) GetLicenseKeyPool(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLicenseKeyPool", reflect.TypeOf((*MockIDigitalRepository)(nil).GetLicenseKeyPool), productId)
+}
+
+// GetOrderDownloads mocks base method.
+func (m *MockIDigitalRepository) GetOrderDownloads(orderId int64) ([]domain.DownloadGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderDownloads", orderId)
+	ret0, _ := ret[0].([]domain.DownloadGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderDownloads indicates an expected call of GetOrderDownloads.
+func (mr *MockIDigitalRepositoryMockRecorder) GetOrderDownloads(orderId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderDownloads", reflect.TypeOf((*MockIDigitalRepository)(nil).GetOrderDownloads), orderId)
+}
+
+// GetOrderLicenseKeys mocks base method.
+func (m *MockIDigitalRepository) GetOrderLicenseKeys(orderId int64) ([]domain.LicenseKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderLicenseKeys", orderId)
+	ret0, _ := ret[0].([]domain.LicenseKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderLicenseKeys indicates an expected call of GetOrderLicenseKeys.
+func (mr *MockIDigitalRepositoryMockRecorder) GetOrderLicenseKeys(orderId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderLicenseKeys", reflect.TypeOf((*MockIDigitalRepository)(nil).GetOrderLicenseKeys), orderId)
+}
+
+// GrantDownloads mocks base method.
+func (m *MockIDigitalRepository) GrantDownloads(downloadLimit int, expiresAt time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantDownloads", downloadLimit, expiresAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantDownloads indicates an expected call of GrantDownloads.
+func (mr *MockIDigitalRepositoryMockRecorder) GrantDownloads(downloadLimit, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantDownloads", reflect.TypeOf((*MockIDigitalRepository)(nil).GrantDownloads), downloadLimit, expiresAt)
+}
+
+// IsDigitalOrder mocks base method.
+func (m *MockIDigitalRepository) IsDigitalOrder(orderId int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDigitalOrder", orderId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDigitalOrder indicates an expected call of IsDigitalOrder.
+func (mr *MockIDigitalRepositoryMockRecorder) IsDigitalOrder(orderId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDigitalOrder", reflect.TypeOf((*MockIDigitalRepository)(nil).IsDigitalOrder), orderId)
+}
+
+// RecordDownload mocks base method.
+func (m *MockIDigitalRepository) RecordDownload(grantId int64) (domain.DownloadGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordDownload", grantId)
+	ret0, _ := ret[0].(domain.DownloadGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordDownload indicates an expected call of RecordDownload.
+func (mr *MockIDigitalRepositoryMockRecorder) RecordDownload(grantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDownload", reflect.TypeOf((*MockIDigitalRepository)(nil).RecordDownload), grantId)
+}
@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/inventory_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/inventory_repository.go -destination=test/mock/repository/inventory_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIInventoryRepository is a mock of IInventoryRepository interface.
+type MockIInventoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIInventoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIInventoryRepositoryMockRecorder is the mock recorder for MockIInventoryRepository.
+type MockIInventoryRepositoryMockRecorder struct {
+	mock *MockIInventoryRepository
+}
+
+// NewMockIInventoryRepository creates a new mock instance.
+func NewMockIInventoryRepository(ctrl *gomock.Controller) *MockIInventoryRepository {
+	mock := &MockIInventoryRepository{ctrl: ctrl}
+	mock.recorder = &MockIInventoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIInventoryRepository) EXPECT() *MockIInventoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddWarehouse mocks base method.
+func (m *MockIInventoryRepository) AddWarehouse(warehouse domain.Warehouse) (domain.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWarehouse", warehouse)
+	ret0, _ := ret[0].(domain.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWarehouse indicates an expected call of AddWarehouse.
+func (mr *MockIInventoryRepositoryMockRecorder) AddWarehouse(warehouse any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWarehouse", reflect.TypeOf((*MockIInventoryRepository)(nil).AddWarehouse), warehouse)
+}
+
+// GetMovements mocks base method.
+func (m *MockIInventoryRepository) GetMovements(filter domain.StockMovementFilter) ([]domain.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovements", filter)
+	ret0, _ := ret[0].([]domain.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovements indicates an expected call of GetMovements.
+func (mr *MockIInventoryRepositoryMockRecorder) GetMovements(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovements", reflect.TypeOf((*MockIInventoryRepository)(nil).GetMovements), filter)
+}
+
+// GetStockLevels mocks base method.
+func (m *MockIInventoryRepository) GetStockLevels(productId int64) ([]domain.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStockLevels", productId)
+	ret0, _ := ret[0].([]domain.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStockLevels indicates an expected call of GetStockLevels.
+func (mr *MockIInventoryRepositoryMockRecorder) GetStockLevels(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStockLevels", reflect.TypeOf((*MockIInventoryRepository)(nil).GetStockLevels), productId)
+}
+
+// GetWarehouseById mocks base method.
+func (m *MockIInventoryRepository) GetWarehouseById(warehouseId int64) (domain.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouseById", warehouseId)
+	ret0, _ := ret[0].(domain.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouseById indicates an expected call of GetWarehouseById.
+func (mr *MockIInventoryRepositoryMockRecorder) GetWarehouseById(warehouseId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouseById", reflect.TypeOf((*MockIInventoryRepository)(nil).GetWarehouseById), warehouseId)
+}
+
+// GetWarehouses mocks base method.
+func (m *MockIInventoryRepository) GetWarehouses() ([]domain.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouses")
+	ret0, _ := ret[0].([]domain.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouses indicates an expected call of GetWarehouses.
+func (mr *MockIInventoryRepositoryMockRecorder) GetWarehouses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouses", reflect.TypeOf((*MockIInventoryRepository)(nil).GetWarehouses))
+}
+
+// RecordMovements mocks base method.
+func (m *MockIInventoryRepository) RecordMovements(movements []domain.StockMovement) ([]domain.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordMovements", movements)
+	ret0, _ := ret[0].([]domain.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordMovements indicates an expected call of RecordMovements.
+func (mr *MockIInventoryRepositoryMockRecorder) RecordMovements(movements any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMovements", reflect.TypeOf((*MockIInventoryRepository)(nil).RecordMovements), movements)
+}
+
+// UpdateWarehouse mocks base method.
+func (m *MockIInventoryRepository) UpdateWarehouse(warehouseId int64, warehouse domain.Warehouse) (domain.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWarehouse", warehouseId, warehouse)
+	ret0, _ := ret[0].(domain.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWarehouse indicates an expected call of UpdateWarehouse.
+func (mr *MockIInventoryRepositoryMockRecorder) UpdateWarehouse(warehouseId, warehouse any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWarehouse", reflect.TypeOf((*MockIInventoryRepository)(nil).UpdateWarehouse), warehouseId, warehouse)
+}
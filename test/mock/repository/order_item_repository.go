@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/order_item_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/order_item_repository.go -destination=test/mock/repository/order_item_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOrderItemRepository is a mock of IOrderItemRepository interface.
+type MockIOrderItemRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOrderItemRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIOrderItemRepositoryMockRecorder is the mock recorder for MockIOrderItemRepository.
+type MockIOrderItemRepositoryMockRecorder struct {
+	mock *MockIOrderItemRepository
+}
+
+// NewMockIOrderItemRepository creates a new mock instance.
+func NewMockIOrderItemRepository(ctrl *gomock.Controller) *MockIOrderItemRepository {
+	mock := &MockIOrderItemRepository{ctrl: ctrl}
+	mock.recorder = &MockIOrderItemRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOrderItemRepository) EXPECT() *MockIOrderItemRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddOrderItem mocks base method.
+func (m *MockIOrderItemRepository) AddOrderItem(orderItem domain.OrderItem) (domain.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrderItem", orderItem)
+	ret0, _ := ret[0].(domain.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrderItem indicates an expected call of AddOrderItem.
+func (mr *MockIOrderItemRepositoryMockRecorder) AddOrderItem(orderItem any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrderItem", reflect.TypeOf((*MockIOrderItemRepository)(nil).AddOrderItem), orderItem)
+}
+
+// DeleteAllOrderItemsByOrderId mocks base method.
+func (m *MockIOrderItemRepository) DeleteAllOrderItemsByOrderId(orderId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllOrderItemsByOrderId", orderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOrderItemsByOrderId indicates an expected call of DeleteAllOrderItemsByOrderId.
+func (mr *MockIOrderItemRepositoryMockRecorder) DeleteAllOrderItemsByOrderId(orderId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOrderItemsByOrderId", reflect.TypeOf((*MockIOrderItemRepository)(nil).DeleteAllOrderItemsByOrderId), orderId)
+}
+
+// DeleteOrderItemById mocks base method.
+func (m *MockIOrderItemRepository) DeleteOrderItemById(orderItemId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrderItemById", orderItemId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrderItemById indicates an expected call of DeleteOrderItemById.
+func (mr *MockIOrderItemRepositoryMockRecorder) DeleteOrderItemById(orderItemId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrderItemById", reflect.TypeOf((*MockIOrderItemRepository)(nil).DeleteOrderItemById), orderItemId)
+}
+
+// GetOrderItemById mocks base method.
+func (m *MockIOrderItemRepository) GetOrderItemById(orderItemId int64) (domain.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderItemById", orderItemId)
+	ret0, _ := ret[0].(domain.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderItemById indicates an expected call of GetOrderItemById.
+func (mr *MockIOrderItemRepositoryMockRecorder) GetOrderItemById(orderItemId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemById", reflect.TypeOf((*MockIOrderItemRepository)(nil).GetOrderItemById), orderItemId)
+}
+
+// GetOrderItemsByOrderId mocks base method.
+func (m *MockIOrderItemRepository) GetOrderItemsByOrderId(orderId int64) ([]domain.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderItemsByOrderId", orderId)
+	ret0, _ := ret[0].([]domain.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderItemsByOrderId indicates an expected call of GetOrderItemsByOrderId.
+func (mr *MockIOrderItemRepositoryMockRecorder) GetOrderItemsByOrderId(orderId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemsByOrderId", reflect.TypeOf((*MockIOrderItemRepository)(nil).GetOrderItemsByOrderId), orderId)
+}
+
+// GetOrderItemsByProductId mocks base method.
+func (m *MockIOrderItemRepository) GetOrderItemsByProductId(productId int64) ([]domain.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderItemsByProductId", productId)
+	ret0, _ := ret[0].([]domain.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderItemsByProductId indicates an expected call of GetOrderItemsByProductId.
+func (mr *MockIOrderItemRepositoryMockRecorder) GetOrderItemsByProductId(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemsByProductId", reflect.TypeOf((*MockIOrderItemRepository)(nil).GetOrderItemsByProductId), productId)
+}
+
+// UpdateOrderItem mocks base method.
+func (m *MockIOrderItemRepository) UpdateOrderItem(orderItemId int64, orderItem domain.OrderItem) (domain.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderItem", orderItemId, orderItem)
+	ret0, _ := ret[0].(domain.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderItem indicates an expected call of UpdateOrderItem.
+func (mr *MockIOrderItemRepositoryMockRecorder) UpdateOrderItem(orderItemId, orderItem any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderItem", reflect.TypeOf((*MockIOrderItemRepository)(nil).UpdateOrderItem), orderItemId, orderItem)
+}
+
+// UpdateOrderItemQuantity mocks base method.
+func (m *MockIOrderItemRepository) UpdateOrderItemQuantity(orderItemId int64, quantity int) (domain.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderItemQuantity", orderItemId, quantity)
+	ret0, _ := ret[0].(domain.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderItemQuantity indicates an expected call of UpdateOrderItemQuantity.
+func (mr *MockIOrderItemRepositoryMockRecorder) UpdateOrderItemQuantity(orderItemId, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderItemQuantity", reflect.TypeOf((*MockIOrderItemRepository)(nil).UpdateOrderItemQuantity), orderItemId, quantity)
+}
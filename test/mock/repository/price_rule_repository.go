@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/price_rule_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/price_rule_repository.go -destination=test/mock/repository/price_rule_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIPriceRuleRepository is a mock of IPriceRuleRepository interface.
+type MockIPriceRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPriceRuleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIPriceRuleRepositoryMockRecorder is the mock recorder for MockIPriceRuleRepository.
+type MockIPriceRuleRepositoryMockRecorder struct {
+	mock *MockIPriceRuleRepository
+}
+
+// NewMockIPriceRuleRepository creates a new mock instance.
+func NewMockIPriceRuleRepository(ctrl *gomock.Controller) *MockIPriceRuleRepository {
+	mock := &MockIPriceRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockIPriceRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPriceRuleRepository) EXPECT() *MockIPriceRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPriceRule mocks base method.
+func (m *MockIPriceRuleRepository) AddPriceRule(rule domain.PriceRule) (domain.PriceRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPriceRule", rule)
+	ret0, _ := ret[0].(domain.PriceRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPriceRule indicates an expected call of AddPriceRule.
+func (mr *MockIPriceRuleRepositoryMockRecorder) AddPriceRule(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPriceRule", reflect.TypeOf((*MockIPriceRuleRepository)(nil).AddPriceRule), rule)
+}
+
+// DeletePriceRule mocks base method.
+func (m *MockIPriceRuleRepository) DeletePriceRule(ruleId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePriceRule", ruleId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePriceRule indicates an expected call of DeletePriceRule.
+func (mr *MockIPriceRuleRepositoryMockRecorder) DeletePriceRule(ruleId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePriceRule", reflect.TypeOf((*MockIPriceRuleRepository)(nil).DeletePriceRule), ruleId)
+}
+
+// GetActiveRules mocks base method.
+func (m *MockIPriceRuleRepository) GetActiveRules(now time.Time) ([]domain.PriceRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveRules", now)
+	ret0, _ := ret[0].([]domain.PriceRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveRules indicates an expected call of GetActiveRules.
+func (mr *MockIPriceRuleRepositoryMockRecorder) GetActiveRules(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveRules", reflect.TypeOf((*MockIPriceRuleRepository)(nil).GetActiveRules), now)
+}
+
+// GetCategoryPaths mocks base method.
+func (m *MockIPriceRuleRepository) GetCategoryPaths(categoryIds []uint) (map[uint]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryPaths", categoryIds)
+	ret0, _ := ret[0].(map[uint]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryPaths indicates an expected call of GetCategoryPaths.
+func (mr *MockIPriceRuleRepositoryMockRecorder) GetCategoryPaths(categoryIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryPaths", reflect.TypeOf((*MockIPriceRuleRepository)(nil).GetCategoryPaths), categoryIds)
+}
+
+// GetCustomerGroup mocks base method.
+func (m *MockIPriceRuleRepository) GetCustomerGroup(userId int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerGroup", userId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerGroup indicates an expected call of GetCustomerGroup.
+func (mr *MockIPriceRuleRepositoryMockRecorder) GetCustomerGroup(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerGroup", reflect.TypeOf((*MockIPriceRuleRepository)(nil).GetCustomerGroup), userId)
+}
+
+// GetPriceRules mocks base method.
+func (m *MockIPriceRuleRepository) GetPriceRules() ([]domain.PriceRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceRules")
+	ret0, _ := ret[0].([]domain.PriceRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceRules indicates an expected call of GetPriceRules.
+func (mr *MockIPriceRuleRepositoryMockRecorder) GetPriceRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceRules", reflect.TypeOf((*MockIPriceRuleRepository)(nil).GetPriceRules))
+}
+
+// SetCustomerGroup mocks base method.
+func (m *MockIPriceRuleRepository) SetCustomerGroup(userId int64, customerGroup string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCustomerGroup", userId, customerGroup)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCustomerGroup indicates an expected call of SetCustomerGroup.
+func (mr *MockIPriceRuleRepositoryMockRecorder) SetCustomerGroup(userId, customerGroup any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCustomerGroup", reflect.TypeOf((*MockIPriceRuleRepository)(nil).SetCustomerGroup), userId, customerGroup)
+}
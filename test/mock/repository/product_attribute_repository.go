@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/product_attribute_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/product_attribute_repository.go -destination=test/mock/repository/product_attribute_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProductAttributeRepository is a mock of IProductAttributeRepository interface.
+type MockIProductAttributeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductAttributeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProductAttributeRepositoryMockRecorder is the mock recorder for MockIProductAttributeRepository.
+type MockIProductAttributeRepositoryMockRecorder struct {
+	mock *MockIProductAttributeRepository
+}
+
+// NewMockIProductAttributeRepository creates a new mock instance.
+func NewMockIProductAttributeRepository(ctrl *gomock.Controller) *MockIProductAttributeRepository {
+	mock := &MockIProductAttributeRepository{ctrl: ctrl}
+	mock.recorder = &MockIProductAttributeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductAttributeRepository) EXPECT() *MockIProductAttributeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAttribute mocks base method.
+func (m *MockIProductAttributeRepository) AddAttribute(attribute domain.CategoryAttribute) (domain.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAttribute", attribute)
+	ret0, _ := ret[0].(domain.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAttribute indicates an expected call of AddAttribute.
+func (mr *MockIProductAttributeRepositoryMockRecorder) AddAttribute(attribute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttribute", reflect.TypeOf((*MockIProductAttributeRepository)(nil).AddAttribute), attribute)
+}
+
+// DeleteAttribute mocks base method.
+func (m *MockIProductAttributeRepository) DeleteAttribute(attributeId int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttribute", attributeId)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAttribute indicates an expected call of DeleteAttribute.
+func (mr *MockIProductAttributeRepositoryMockRecorder) DeleteAttribute(attributeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttribute", reflect.TypeOf((*MockIProductAttributeRepository)(nil).DeleteAttribute), attributeId)
+}
+
+// GetAttributeById mocks base method.
+func (m *MockIProductAttributeRepository) GetAttributeById(attributeId int64) (domain.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeById", attributeId)
+	ret0, _ := ret[0].(domain.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeById indicates an expected call of GetAttributeById.
+func (mr *MockIProductAttributeRepositoryMockRecorder) GetAttributeById(attributeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeById", reflect.TypeOf((*MockIProductAttributeRepository)(nil).GetAttributeById), attributeId)
+}
+
+// GetAttributeValues mocks base method.
+func (m *MockIProductAttributeRepository) GetAttributeValues(productIds []int64) (map[int64][]domain.ProductAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeValues", productIds)
+	ret0, _ := ret[0].(map[int64][]domain.ProductAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeValues indicates an expected call of GetAttributeValues.
+func (mr *MockIProductAttributeRepositoryMockRecorder) GetAttributeValues(productIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeValues", reflect.TypeOf((*MockIProductAttributeRepository)(nil).GetAttributeValues), productIds)
+}
+
+// GetCategoryAttributes mocks base method.
+func (m *MockIProductAttributeRepository) GetCategoryAttributes(categoryId int64) ([]domain.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryAttributes", categoryId)
+	ret0, _ := ret[0].([]domain.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryAttributes indicates an expected call of GetCategoryAttributes.
+func (mr *MockIProductAttributeRepositoryMockRecorder) GetCategoryAttributes(categoryId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryAttributes", reflect.TypeOf((*MockIProductAttributeRepository)(nil).GetCategoryAttributes), categoryId)
+}
+
+// GetFacets mocks base method.
+func (m *MockIProductAttributeRepository) GetFacets(categoryId int64) ([]domain.AttributeFacet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacets", categoryId)
+	ret0, _ := ret[0].([]domain.AttributeFacet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacets indicates an expected call of GetFacets.
+func (mr *MockIProductAttributeRepositoryMockRecorder) GetFacets(categoryId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacets", reflect.TypeOf((*MockIProductAttributeRepository)(nil).GetFacets), categoryId)
+}
+
+// GetUsedValues mocks base method.
+func (m *MockIProductAttributeRepository) GetUsedValues(attributeId int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsedValues", attributeId)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsedValues indicates an expected call of GetUsedValues.
+func (mr *MockIProductAttributeRepositoryMockRecorder) GetUsedValues(attributeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsedValues", reflect.TypeOf((*MockIProductAttributeRepository)(nil).GetUsedValues), attributeId)
+}
+
+// UpdateAttribute mocks base method.
+func (m *MockIProductAttributeRepository) UpdateAttribute(attributeId int64, attribute domain.CategoryAttribute) (domain.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttribute", attributeId, attribute)
+	ret0, _ := ret[0].(domain.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttribute indicates an expected call of UpdateAttribute.
+func (mr *MockIProductAttributeRepositoryMockRecorder) UpdateAttribute(attributeId, attribute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttribute", reflect.TypeOf((*MockIProductAttributeRepository)(nil).UpdateAttribute), attributeId, attribute)
+}
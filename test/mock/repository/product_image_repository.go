@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/product_image_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/product_image_repository.go -destination=test/mock/repository/product_image_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProductImageRepository is a mock of IProductImageRepository interface.
+type MockIProductImageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductImageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProductImageRepositoryMockRecorder is the mock recorder for MockIProductImageRepository.
+type MockIProductImageRepositoryMockRecorder struct {
+	mock *MockIProductImageRepository
+}
+
+// NewMockIProductImageRepository creates a new mock instance.
+func NewMockIProductImageRepository(ctrl *gomock.Controller) *MockIProductImageRepository {
+	mock := &MockIProductImageRepository{ctrl: ctrl}
+	mock.recorder = &MockIProductImageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductImageRepository) EXPECT() *MockIProductImageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddImage mocks base method.
+func (m *MockIProductImageRepository) AddImage(image domain.ProductImage) (domain.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImage", image)
+	ret0, _ := ret[0].(domain.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddImage indicates an expected call of AddImage.
+func (mr *MockIProductImageRepositoryMockRecorder) AddImage(image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImage", reflect.TypeOf((*MockIProductImageRepository)(nil).AddImage), image)
+}
+
+// DeleteImage mocks base method.
+func (m *MockIProductImageRepository) DeleteImage(imageId int64) (domain.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", imageId)
+	ret0, _ := ret[0].(domain.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockIProductImageRepositoryMockRecorder) DeleteImage(imageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockIProductImageRepository)(nil).DeleteImage), imageId)
+}
+
+// GetImageById mocks base method.
+func (m *MockIProductImageRepository) GetImageById(imageId int64) (domain.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageById", imageId)
+	ret0, _ := ret[0].(domain.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageById indicates an expected call of GetImageById.
+func (mr *MockIProductImageRepositoryMockRecorder) GetImageById(imageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageById", reflect.TypeOf((*MockIProductImageRepository)(nil).GetImageById), imageId)
+}
+
+// GetImages mocks base method.
+func (m *MockIProductImageRepository) GetImages(productId int64) ([]domain.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImages", productId)
+	ret0, _ := ret[0].([]domain.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImages indicates an expected call of GetImages.
+func (mr *MockIProductImageRepositoryMockRecorder) GetImages(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImages", reflect.TypeOf((*MockIProductImageRepository)(nil).GetImages), productId)
+}
+
+// GetStorageKeys mocks base method.
+func (m *MockIProductImageRepository) GetStorageKeys() (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageKeys")
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageKeys indicates an expected call of GetStorageKeys.
+func (mr *MockIProductImageRepositoryMockRecorder) GetStorageKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageKeys", reflect.TypeOf((*MockIProductImageRepository)(nil).GetStorageKeys))
+}
+
+// ReorderImages mocks base method.
+func (m *MockIProductImageRepository) ReorderImages(productId int64, imageIds []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderImages", productId, imageIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderImages indicates an expected call of ReorderImages.
+func (mr *MockIProductImageRepositoryMockRecorder) ReorderImages(productId, imageIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderImages", reflect.TypeOf((*MockIProductImageRepository)(nil).ReorderImages), productId, imageIds)
+}
+
+// UpdateAltText mocks base method.
+func (m *MockIProductImageRepository) UpdateAltText(imageId int64, altText string) (domain.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAltText", imageId, altText)
+	ret0, _ := ret[0].(domain.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAltText indicates an expected call of UpdateAltText.
+func (mr *MockIProductImageRepositoryMockRecorder) UpdateAltText(imageId, altText any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAltText", reflect.TypeOf((*MockIProductImageRepository)(nil).UpdateAltText), imageId, altText)
+}
@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/product_import_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/product_import_repository.go -destination=test/mock/repository/product_import_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProductImportRepository is a mock of IProductImportRepository interface.
+type MockIProductImportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductImportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProductImportRepositoryMockRecorder is the mock recorder for MockIProductImportRepository.
+type MockIProductImportRepositoryMockRecorder struct {
+	mock *MockIProductImportRepository
+}
+
+// NewMockIProductImportRepository creates a new mock instance.
+func NewMockIProductImportRepository(ctrl *gomock.Controller) *MockIProductImportRepository {
+	mock := &MockIProductImportRepository{ctrl: ctrl}
+	mock.recorder = &MockIProductImportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductImportRepository) EXPECT() *MockIProductImportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddResults mocks base method.
+func (m *MockIProductImportRepository) AddResults(results []domain.ProductImportResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddResults", results)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddResults indicates an expected call of AddResults.
+func (mr *MockIProductImportRepositoryMockRecorder) AddResults(results any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResults", reflect.TypeOf((*MockIProductImportRepository)(nil).AddResults), results)
+}
+
+// CreateJob mocks base method.
+func (m *MockIProductImportRepository) CreateJob(job domain.ProductImportJob) (domain.ProductImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", job)
+	ret0, _ := ret[0].(domain.ProductImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockIProductImportRepositoryMockRecorder) CreateJob(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockIProductImportRepository)(nil).CreateJob), job)
+}
+
+// GetJobById mocks base method.
+func (m *MockIProductImportRepository) GetJobById(jobId int64) (domain.ProductImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobById", jobId)
+	ret0, _ := ret[0].(domain.ProductImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobById indicates an expected call of GetJobById.
+func (mr *MockIProductImportRepositoryMockRecorder) GetJobById(jobId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobById", reflect.TypeOf((*MockIProductImportRepository)(nil).GetJobById), jobId)
+}
+
+// GetResultsByJobId mocks base method.
+func (m *MockIProductImportRepository) GetResultsByJobId(jobId int64) ([]domain.ProductImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResultsByJobId", jobId)
+	ret0, _ := ret[0].([]domain.ProductImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultsByJobId indicates an expected call of GetResultsByJobId.
+func (mr *MockIProductImportRepositoryMockRecorder) GetResultsByJobId(jobId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultsByJobId", reflect.TypeOf((*MockIProductImportRepository)(nil).GetResultsByJobId), jobId)
+}
+
+// UpdateJob mocks base method.
+func (m *MockIProductImportRepository) UpdateJob(job domain.ProductImportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJob", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateJob indicates an expected call of UpdateJob.
+func (mr *MockIProductImportRepositoryMockRecorder) UpdateJob(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockIProductImportRepository)(nil).UpdateJob), job)
+}
+
+// UpsertProducts mocks base method.
+func (m *MockIProductImportRepository) UpsertProducts(matchBy string, products []domain.Product, userId int64) ([]domain.ProductUpsert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProducts", matchBy, products, userId)
+	ret0, _ := ret[0].([]domain.ProductUpsert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProducts indicates an expected call of UpsertProducts.
+func (mr *MockIProductImportRepositoryMockRecorder) UpsertProducts(matchBy, products, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProducts", reflect.TypeOf((*MockIProductImportRepository)(nil).UpsertProducts), matchBy, products, userId)
+}
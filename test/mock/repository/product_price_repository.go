@@ -0,0 +1,193 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/product_price_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/product_price_repository.go -destination=test/mock/repository/product_price_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProductPriceRepository is a mock of IProductPriceRepository interface.
+type MockIProductPriceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductPriceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProductPriceRepositoryMockRecorder is the mock recorder for MockIProductPriceRepository.
+type MockIProductPriceRepositoryMockRecorder struct {
+	mock *MockIProductPriceRepository
+}
+
+// NewMockIProductPriceRepository creates a new mock instance.
+func NewMockIProductPriceRepository(ctrl *gomock.Controller) *MockIProductPriceRepository {
+	mock := &MockIProductPriceRepository{ctrl: ctrl}
+	mock.recorder = &MockIProductPriceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductPriceRepository) EXPECT() *MockIProductPriceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddSchedule mocks base method.
+func (m *MockIProductPriceRepository) AddSchedule(schedule domain.PriceSchedule) (domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSchedule", schedule)
+	ret0, _ := ret[0].(domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSchedule indicates an expected call of AddSchedule.
+func (mr *MockIProductPriceRepositoryMockRecorder) AddSchedule(schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSchedule", reflect.TypeOf((*MockIProductPriceRepository)(nil).AddSchedule), schedule)
+}
+
+// FinishSchedule mocks base method.
+func (m *MockIProductPriceRepository) FinishSchedule(schedule domain.PriceSchedule, status string) (domain.Product, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishSchedule", schedule, status)
+	ret0, _ := ret[0].(domain.Product)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FinishSchedule indicates an expected call of FinishSchedule.
+func (mr *MockIProductPriceRepositoryMockRecorder) FinishSchedule(schedule, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishSchedule", reflect.TypeOf((*MockIProductPriceRepository)(nil).FinishSchedule), schedule, status)
+}
+
+// GetLowestPrice mocks base method.
+func (m *MockIProductPriceRepository) GetLowestPrice(productId int64, since time.Time) (*float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLowestPrice", productId, since)
+	ret0, _ := ret[0].(*float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLowestPrice indicates an expected call of GetLowestPrice.
+func (mr *MockIProductPriceRepositoryMockRecorder) GetLowestPrice(productId, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLowestPrice", reflect.TypeOf((*MockIProductPriceRepository)(nil).GetLowestPrice), productId, since)
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockIProductPriceRepository) GetPriceHistory(productId int64, limit int) ([]domain.PriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", productId, limit)
+	ret0, _ := ret[0].([]domain.PriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockIProductPriceRepositoryMockRecorder) GetPriceHistory(productId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockIProductPriceRepository)(nil).GetPriceHistory), productId, limit)
+}
+
+// GetScheduleById mocks base method.
+func (m *MockIProductPriceRepository) GetScheduleById(scheduleId int64) (domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduleById", scheduleId)
+	ret0, _ := ret[0].(domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduleById indicates an expected call of GetScheduleById.
+func (mr *MockIProductPriceRepositoryMockRecorder) GetScheduleById(scheduleId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleById", reflect.TypeOf((*MockIProductPriceRepository)(nil).GetScheduleById), scheduleId)
+}
+
+// GetSchedulesByProductId mocks base method.
+func (m *MockIProductPriceRepository) GetSchedulesByProductId(productId int64) ([]domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedulesByProductId", productId)
+	ret0, _ := ret[0].([]domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedulesByProductId indicates an expected call of GetSchedulesByProductId.
+func (mr *MockIProductPriceRepositoryMockRecorder) GetSchedulesByProductId(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedulesByProductId", reflect.TypeOf((*MockIProductPriceRepository)(nil).GetSchedulesByProductId), productId)
+}
+
+// GetSchedulesToFinish mocks base method.
+func (m *MockIProductPriceRepository) GetSchedulesToFinish(now time.Time) ([]domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedulesToFinish", now)
+	ret0, _ := ret[0].([]domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedulesToFinish indicates an expected call of GetSchedulesToFinish.
+func (mr *MockIProductPriceRepositoryMockRecorder) GetSchedulesToFinish(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedulesToFinish", reflect.TypeOf((*MockIProductPriceRepository)(nil).GetSchedulesToFinish), now)
+}
+
+// GetSchedulesToStart mocks base method.
+func (m *MockIProductPriceRepository) GetSchedulesToStart(now time.Time) ([]domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedulesToStart", now)
+	ret0, _ := ret[0].([]domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedulesToStart indicates an expected call of GetSchedulesToStart.
+func (mr *MockIProductPriceRepositoryMockRecorder) GetSchedulesToStart(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedulesToStart", reflect.TypeOf((*MockIProductPriceRepository)(nil).GetSchedulesToStart), now)
+}
+
+// HasOverlappingSchedule mocks base method.
+func (m *MockIProductPriceRepository) HasOverlappingSchedule(productId int64, startsAt time.Time, endsAt *time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasOverlappingSchedule", productId, startsAt, endsAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasOverlappingSchedule indicates an expected call of HasOverlappingSchedule.
+func (mr *MockIProductPriceRepositoryMockRecorder) HasOverlappingSchedule(productId, startsAt, endsAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasOverlappingSchedule", reflect.TypeOf((*MockIProductPriceRepository)(nil).HasOverlappingSchedule), productId, startsAt, endsAt)
+}
+
+// StartSchedule mocks base method.
+func (m *MockIProductPriceRepository) StartSchedule(schedule domain.PriceSchedule) (domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSchedule", schedule)
+	ret0, _ := ret[0].(domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSchedule indicates an expected call of StartSchedule.
+func (mr *MockIProductPriceRepositoryMockRecorder) StartSchedule(schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSchedule", reflect.TypeOf((*MockIProductPriceRepository)(nil).StartSchedule), schedule)
+}
@@ -1,17 +1,18 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: persistence\product_repository.go
+// Source: persistence/product_repository.go
 //
 // Generated by this command:
 //
-//	mockgen -source=persistence\product_repository.go -destination=test\mock\repository\product_repository.go -package=mock_repository
+//	mockgen -source=persistence/product_repository.go -destination=test/mock/repository/product_repository.go -package=repository
 //
 
-// Package mock_repository is a generated GoMock package.
+// Package repository is a generated GoMock package.
 package repository
 
 import (
 	domain "go-ecommerce-service/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,18 +42,33 @@ func (m *MockIProductRepository) EXPECT() *MockIProductRepositoryMockRecorder {
 }
 
 // AddProduct mocks base method.
-func (m *MockIProductRepository) AddProduct(product domain.Product) (domain.Product, error) {
+func (m *MockIProductRepository) AddProduct(product domain.Product, userId int64) (domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddProduct", product)
+	ret := m.ctrl.Call(m, "AddProduct", product, userId)
 	ret0, _ := ret[0].(domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddProduct indicates an expected call of AddProduct.
-func (mr *MockIProductRepositoryMockRecorder) AddProduct(product any) *gomock.Call {
+func (mr *MockIProductRepositoryMockRecorder) AddProduct(product, userId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockIProductRepository)(nil).AddProduct), product)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockIProductRepository)(nil).AddProduct), product, userId)
+}
+
+// CountProducts mocks base method.
+func (m *MockIProductRepository) CountProducts(filter domain.ProductFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountProducts", filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountProducts indicates an expected call of CountProducts.
+func (mr *MockIProductRepositoryMockRecorder) CountProducts(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProducts", reflect.TypeOf((*MockIProductRepository)(nil).CountProducts), filter)
 }
 
 // DeleteProductById mocks base method.
@@ -69,6 +85,20 @@ func (mr *MockIProductRepositoryMockRecorder) DeleteProductById(productId any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductById", reflect.TypeOf((*MockIProductRepository)(nil).DeleteProductById), productId)
 }
 
+// EnsureIndex mocks base method.
+func (m *MockIProductRepository) EnsureIndex() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureIndex")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureIndex indicates an expected call of EnsureIndex.
+func (mr *MockIProductRepositoryMockRecorder) EnsureIndex() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureIndex", reflect.TypeOf((*MockIProductRepository)(nil).EnsureIndex))
+}
+
 // GetAllProducts mocks base method.
 func (m *MockIProductRepository) GetAllProducts() []domain.Product {
 	m.ctrl.T.Helper()
@@ -83,6 +113,21 @@ func (mr *MockIProductRepositoryMockRecorder) GetAllProducts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProducts", reflect.TypeOf((*MockIProductRepository)(nil).GetAllProducts))
 }
 
+// GetDeletedProducts mocks base method.
+func (m *MockIProductRepository) GetDeletedProducts() ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedProducts")
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedProducts indicates an expected call of GetDeletedProducts.
+func (mr *MockIProductRepositoryMockRecorder) GetDeletedProducts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedProducts", reflect.TypeOf((*MockIProductRepository)(nil).GetDeletedProducts))
+}
+
 // GetProductById mocks base method.
 func (m *MockIProductRepository) GetProductById(productId int64) (domain.Product, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +143,36 @@ func (mr *MockIProductRepositoryMockRecorder) GetProductById(productId any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductById", reflect.TypeOf((*MockIProductRepository)(nil).GetProductById), productId)
 }
 
+// GetProductBySlug mocks base method.
+func (m *MockIProductRepository) GetProductBySlug(slug string) (domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductBySlug", slug)
+	ret0, _ := ret[0].(domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductBySlug indicates an expected call of GetProductBySlug.
+func (mr *MockIProductRepositoryMockRecorder) GetProductBySlug(slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductBySlug", reflect.TypeOf((*MockIProductRepository)(nil).GetProductBySlug), slug)
+}
+
+// GetProducts mocks base method.
+func (m *MockIProductRepository) GetProducts(filter domain.ProductFilter) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProducts", filter)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProducts indicates an expected call of GetProducts.
+func (mr *MockIProductRepositoryMockRecorder) GetProducts(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockIProductRepository)(nil).GetProducts), filter)
+}
+
 // IndexProduct mocks base method.
 func (m *MockIProductRepository) IndexProduct(product domain.Product) error {
 	m.ctrl.T.Helper()
@@ -112,32 +187,76 @@ func (mr *MockIProductRepositoryMockRecorder) IndexProduct(product any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexProduct", reflect.TypeOf((*MockIProductRepository)(nil).IndexProduct), product)
 }
 
+// PurgeDeletedProducts mocks base method.
+func (m *MockIProductRepository) PurgeDeletedProducts(before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedProducts", before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedProducts indicates an expected call of PurgeDeletedProducts.
+func (mr *MockIProductRepositoryMockRecorder) PurgeDeletedProducts(before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedProducts", reflect.TypeOf((*MockIProductRepository)(nil).PurgeDeletedProducts), before)
+}
+
+// RemoveFromIndex mocks base method.
+func (m *MockIProductRepository) RemoveFromIndex(productId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromIndex", productId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromIndex indicates an expected call of RemoveFromIndex.
+func (mr *MockIProductRepositoryMockRecorder) RemoveFromIndex(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromIndex", reflect.TypeOf((*MockIProductRepository)(nil).RemoveFromIndex), productId)
+}
+
+// RestoreProductById mocks base method.
+func (m *MockIProductRepository) RestoreProductById(productId int64) (domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProductById", productId)
+	ret0, _ := ret[0].(domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProductById indicates an expected call of RestoreProductById.
+func (mr *MockIProductRepositoryMockRecorder) RestoreProductById(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProductById", reflect.TypeOf((*MockIProductRepository)(nil).RestoreProductById), productId)
+}
+
 // SearchProducts mocks base method.
-func (m *MockIProductRepository) SearchProducts(query string) ([]domain.Product, error) {
+func (m *MockIProductRepository) SearchProducts(query, sort, locale string) ([]domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchProducts", query)
+	ret := m.ctrl.Call(m, "SearchProducts", query, sort, locale)
 	ret0, _ := ret[0].([]domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchProducts indicates an expected call of SearchProducts.
-func (mr *MockIProductRepositoryMockRecorder) SearchProducts(query any) *gomock.Call {
+func (mr *MockIProductRepositoryMockRecorder) SearchProducts(query, sort, locale any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProducts", reflect.TypeOf((*MockIProductRepository)(nil).SearchProducts), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProducts", reflect.TypeOf((*MockIProductRepository)(nil).SearchProducts), query, sort, locale)
 }
 
 // UpdateProduct mocks base method.
-func (m *MockIProductRepository) UpdateProduct(productId uint, product domain.Product) (domain.Product, error) {
+func (m *MockIProductRepository) UpdateProduct(productId uint, product domain.Product, userId int64) (domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProduct", productId, product)
+	ret := m.ctrl.Call(m, "UpdateProduct", productId, product, userId)
 	ret0, _ := ret[0].(domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateProduct indicates an expected call of UpdateProduct.
-func (mr *MockIProductRepositoryMockRecorder) UpdateProduct(productId, product any) *gomock.Call {
+func (mr *MockIProductRepositoryMockRecorder) UpdateProduct(productId, product, userId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockIProductRepository)(nil).UpdateProduct), productId, product)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockIProductRepository)(nil).UpdateProduct), productId, product, userId)
 }
@@ -0,0 +1,190 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/product_review_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/product_review_repository.go -destination=test/mock/repository/product_review_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProductReviewRepository is a mock of IProductReviewRepository interface.
+type MockIProductReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductReviewRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProductReviewRepositoryMockRecorder is the mock recorder for MockIProductReviewRepository.
+type MockIProductReviewRepositoryMockRecorder struct {
+	mock *MockIProductReviewRepository
+}
+
+// NewMockIProductReviewRepository creates a new mock instance.
+func NewMockIProductReviewRepository(ctrl *gomock.Controller) *MockIProductReviewRepository {
+	mock := &MockIProductReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockIProductReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductReviewRepository) EXPECT() *MockIProductReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReview mocks base method.
+func (m *MockIProductReviewRepository) AddReview(review domain.ProductReview) (domain.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReview", review)
+	ret0, _ := ret[0].(domain.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReview indicates an expected call of AddReview.
+func (mr *MockIProductReviewRepositoryMockRecorder) AddReview(review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockIProductReviewRepository)(nil).AddReview), review)
+}
+
+// GetApprovedReviews mocks base method.
+func (m *MockIProductReviewRepository) GetApprovedReviews(productId int64, sort string, limit, offset int) ([]domain.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovedReviews", productId, sort, limit, offset)
+	ret0, _ := ret[0].([]domain.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApprovedReviews indicates an expected call of GetApprovedReviews.
+func (mr *MockIProductReviewRepositoryMockRecorder) GetApprovedReviews(productId, sort, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovedReviews", reflect.TypeOf((*MockIProductReviewRepository)(nil).GetApprovedReviews), productId, sort, limit, offset)
+}
+
+// GetRatingSummary mocks base method.
+func (m *MockIProductReviewRepository) GetRatingSummary(productId int64) (domain.ReviewRatingSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingSummary", productId)
+	ret0, _ := ret[0].(domain.ReviewRatingSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingSummary indicates an expected call of GetRatingSummary.
+func (mr *MockIProductReviewRepositoryMockRecorder) GetRatingSummary(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingSummary", reflect.TypeOf((*MockIProductReviewRepository)(nil).GetRatingSummary), productId)
+}
+
+// GetReviewById mocks base method.
+func (m *MockIProductReviewRepository) GetReviewById(reviewId int64) (domain.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewById", reviewId)
+	ret0, _ := ret[0].(domain.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewById indicates an expected call of GetReviewById.
+func (mr *MockIProductReviewRepositoryMockRecorder) GetReviewById(reviewId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewById", reflect.TypeOf((*MockIProductReviewRepository)(nil).GetReviewById), reviewId)
+}
+
+// GetReviewByProductAndUser mocks base method.
+func (m *MockIProductReviewRepository) GetReviewByProductAndUser(productId, userId int64) (domain.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewByProductAndUser", productId, userId)
+	ret0, _ := ret[0].(domain.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewByProductAndUser indicates an expected call of GetReviewByProductAndUser.
+func (mr *MockIProductReviewRepositoryMockRecorder) GetReviewByProductAndUser(productId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewByProductAndUser", reflect.TypeOf((*MockIProductReviewRepository)(nil).GetReviewByProductAndUser), productId, userId)
+}
+
+// GetReviewsByStatus mocks base method.
+func (m *MockIProductReviewRepository) GetReviewsByStatus(status string, limit int) ([]domain.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewsByStatus", status, limit)
+	ret0, _ := ret[0].([]domain.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewsByStatus indicates an expected call of GetReviewsByStatus.
+func (mr *MockIProductReviewRepositoryMockRecorder) GetReviewsByStatus(status, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsByStatus", reflect.TypeOf((*MockIProductReviewRepository)(nil).GetReviewsByStatus), status, limit)
+}
+
+// HasPurchased mocks base method.
+func (m *MockIProductReviewRepository) HasPurchased(userId, productId int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPurchased", userId, productId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPurchased indicates an expected call of HasPurchased.
+func (mr *MockIProductReviewRepositoryMockRecorder) HasPurchased(userId, productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPurchased", reflect.TypeOf((*MockIProductReviewRepository)(nil).HasPurchased), userId, productId)
+}
+
+// ModerateReview mocks base method.
+func (m *MockIProductReviewRepository) ModerateReview(reviewId int64, status string, note *string) (domain.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateReview", reviewId, status, note)
+	ret0, _ := ret[0].(domain.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateReview indicates an expected call of ModerateReview.
+func (mr *MockIProductReviewRepositoryMockRecorder) ModerateReview(reviewId, status, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateReview", reflect.TypeOf((*MockIProductReviewRepository)(nil).ModerateReview), reviewId, status, note)
+}
+
+// RefreshProductRating mocks base method.
+func (m *MockIProductReviewRepository) RefreshProductRating(productId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshProductRating", productId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshProductRating indicates an expected call of RefreshProductRating.
+func (mr *MockIProductReviewRepositoryMockRecorder) RefreshProductRating(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshProductRating", reflect.TypeOf((*MockIProductReviewRepository)(nil).RefreshProductRating), productId)
+}
+
+// VoteReview mocks base method.
+func (m *MockIProductReviewRepository) VoteReview(reviewId, userId int64, isHelpful bool) (domain.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteReview", reviewId, userId, isHelpful)
+	ret0, _ := ret[0].(domain.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoteReview indicates an expected call of VoteReview.
+func (mr *MockIProductReviewRepositoryMockRecorder) VoteReview(reviewId, userId, isHelpful any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteReview", reflect.TypeOf((*MockIProductReviewRepository)(nil).VoteReview), reviewId, userId, isHelpful)
+}
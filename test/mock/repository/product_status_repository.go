@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/product_status_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/product_status_repository.go -destination=test/mock/repository/product_status_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProductStatusRepository is a mock of IProductStatusRepository interface.
+type MockIProductStatusRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductStatusRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProductStatusRepositoryMockRecorder is the mock recorder for MockIProductStatusRepository.
+type MockIProductStatusRepositoryMockRecorder struct {
+	mock *MockIProductStatusRepository
+}
+
+// NewMockIProductStatusRepository creates a new mock instance.
+func NewMockIProductStatusRepository(ctrl *gomock.Controller) *MockIProductStatusRepository {
+	mock := &MockIProductStatusRepository{ctrl: ctrl}
+	mock.recorder = &MockIProductStatusRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductStatusRepository) EXPECT() *MockIProductStatusRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ChangeStatus mocks base method.
+func (m *MockIProductStatusRepository) ChangeStatus(change domain.ProductStatusChange, schedule domain.PublishSchedule) (domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", change, schedule)
+	ret0, _ := ret[0].(domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockIProductStatusRepositoryMockRecorder) ChangeStatus(change, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockIProductStatusRepository)(nil).ChangeStatus), change, schedule)
+}
+
+// GetProductsToPublish mocks base method.
+func (m *MockIProductStatusRepository) GetProductsToPublish(now time.Time) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsToPublish", now)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsToPublish indicates an expected call of GetProductsToPublish.
+func (mr *MockIProductStatusRepositoryMockRecorder) GetProductsToPublish(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsToPublish", reflect.TypeOf((*MockIProductStatusRepository)(nil).GetProductsToPublish), now)
+}
+
+// GetProductsToUnpublish mocks base method.
+func (m *MockIProductStatusRepository) GetProductsToUnpublish(now time.Time) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsToUnpublish", now)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsToUnpublish indicates an expected call of GetProductsToUnpublish.
+func (mr *MockIProductStatusRepositoryMockRecorder) GetProductsToUnpublish(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsToUnpublish", reflect.TypeOf((*MockIProductStatusRepository)(nil).GetProductsToUnpublish), now)
+}
+
+// GetReviewQueue mocks base method.
+func (m *MockIProductStatusRepository) GetReviewQueue() ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewQueue")
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewQueue indicates an expected call of GetReviewQueue.
+func (mr *MockIProductStatusRepositoryMockRecorder) GetReviewQueue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewQueue", reflect.TypeOf((*MockIProductStatusRepository)(nil).GetReviewQueue))
+}
+
+// GetStatusHistory mocks base method.
+func (m *MockIProductStatusRepository) GetStatusHistory(productId int64) ([]domain.ProductStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", productId)
+	ret0, _ := ret[0].([]domain.ProductStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockIProductStatusRepositoryMockRecorder) GetStatusHistory(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockIProductStatusRepository)(nil).GetStatusHistory), productId)
+}
+
+// SetSchedule mocks base method.
+func (m *MockIProductStatusRepository) SetSchedule(change domain.ProductStatusChange, schedule domain.PublishSchedule) (domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSchedule", change, schedule)
+	ret0, _ := ret[0].(domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSchedule indicates an expected call of SetSchedule.
+func (mr *MockIProductStatusRepositoryMockRecorder) SetSchedule(change, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchedule", reflect.TypeOf((*MockIProductStatusRepository)(nil).SetSchedule), change, schedule)
+}
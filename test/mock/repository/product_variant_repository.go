@@ -0,0 +1,220 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/product_variant_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/product_variant_repository.go -destination=test/mock/repository/product_variant_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProductVariantRepository is a mock of IProductVariantRepository interface.
+type MockIProductVariantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProductVariantRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProductVariantRepositoryMockRecorder is the mock recorder for MockIProductVariantRepository.
+type MockIProductVariantRepositoryMockRecorder struct {
+	mock *MockIProductVariantRepository
+}
+
+// NewMockIProductVariantRepository creates a new mock instance.
+func NewMockIProductVariantRepository(ctrl *gomock.Controller) *MockIProductVariantRepository {
+	mock := &MockIProductVariantRepository{ctrl: ctrl}
+	mock.recorder = &MockIProductVariantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProductVariantRepository) EXPECT() *MockIProductVariantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddOptionType mocks base method.
+func (m *MockIProductVariantRepository) AddOptionType(optionType domain.OptionType) (domain.OptionType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOptionType", optionType)
+	ret0, _ := ret[0].(domain.OptionType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOptionType indicates an expected call of AddOptionType.
+func (mr *MockIProductVariantRepositoryMockRecorder) AddOptionType(optionType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOptionType", reflect.TypeOf((*MockIProductVariantRepository)(nil).AddOptionType), optionType)
+}
+
+// AddOptionValue mocks base method.
+func (m *MockIProductVariantRepository) AddOptionValue(optionValue domain.OptionValue) (domain.OptionValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOptionValue", optionValue)
+	ret0, _ := ret[0].(domain.OptionValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOptionValue indicates an expected call of AddOptionValue.
+func (mr *MockIProductVariantRepositoryMockRecorder) AddOptionValue(optionValue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOptionValue", reflect.TypeOf((*MockIProductVariantRepository)(nil).AddOptionValue), optionValue)
+}
+
+// AddVariant mocks base method.
+func (m *MockIProductVariantRepository) AddVariant(variant domain.ProductVariant, optionValueIds []int64) (domain.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVariant", variant, optionValueIds)
+	ret0, _ := ret[0].(domain.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVariant indicates an expected call of AddVariant.
+func (mr *MockIProductVariantRepositoryMockRecorder) AddVariant(variant, optionValueIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVariant", reflect.TypeOf((*MockIProductVariantRepository)(nil).AddVariant), variant, optionValueIds)
+}
+
+// DeleteVariantById mocks base method.
+func (m *MockIProductVariantRepository) DeleteVariantById(variantId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVariantById", variantId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVariantById indicates an expected call of DeleteVariantById.
+func (mr *MockIProductVariantRepositoryMockRecorder) DeleteVariantById(variantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariantById", reflect.TypeOf((*MockIProductVariantRepository)(nil).DeleteVariantById), variantId)
+}
+
+// GetOptionTypeById mocks base method.
+func (m *MockIProductVariantRepository) GetOptionTypeById(optionTypeId int64) (domain.OptionType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptionTypeById", optionTypeId)
+	ret0, _ := ret[0].(domain.OptionType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptionTypeById indicates an expected call of GetOptionTypeById.
+func (mr *MockIProductVariantRepositoryMockRecorder) GetOptionTypeById(optionTypeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionTypeById", reflect.TypeOf((*MockIProductVariantRepository)(nil).GetOptionTypeById), optionTypeId)
+}
+
+// GetOptionTypes mocks base method.
+func (m *MockIProductVariantRepository) GetOptionTypes() ([]domain.OptionType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptionTypes")
+	ret0, _ := ret[0].([]domain.OptionType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptionTypes indicates an expected call of GetOptionTypes.
+func (mr *MockIProductVariantRepositoryMockRecorder) GetOptionTypes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionTypes", reflect.TypeOf((*MockIProductVariantRepository)(nil).GetOptionTypes))
+}
+
+// GetOptionValuesByIds mocks base method.
+func (m *MockIProductVariantRepository) GetOptionValuesByIds(optionValueIds []int64) ([]domain.OptionValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptionValuesByIds", optionValueIds)
+	ret0, _ := ret[0].([]domain.OptionValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptionValuesByIds indicates an expected call of GetOptionValuesByIds.
+func (mr *MockIProductVariantRepositoryMockRecorder) GetOptionValuesByIds(optionValueIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionValuesByIds", reflect.TypeOf((*MockIProductVariantRepository)(nil).GetOptionValuesByIds), optionValueIds)
+}
+
+// GetVariantById mocks base method.
+func (m *MockIProductVariantRepository) GetVariantById(variantId int64) (domain.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantById", variantId)
+	ret0, _ := ret[0].(domain.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantById indicates an expected call of GetVariantById.
+func (mr *MockIProductVariantRepositoryMockRecorder) GetVariantById(variantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantById", reflect.TypeOf((*MockIProductVariantRepository)(nil).GetVariantById), variantId)
+}
+
+// GetVariantBySku mocks base method.
+func (m *MockIProductVariantRepository) GetVariantBySku(sku string) (domain.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantBySku", sku)
+	ret0, _ := ret[0].(domain.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantBySku indicates an expected call of GetVariantBySku.
+func (mr *MockIProductVariantRepositoryMockRecorder) GetVariantBySku(sku any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantBySku", reflect.TypeOf((*MockIProductVariantRepository)(nil).GetVariantBySku), sku)
+}
+
+// GetVariantsByProductId mocks base method.
+func (m *MockIProductVariantRepository) GetVariantsByProductId(productId int64) ([]domain.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantsByProductId", productId)
+	ret0, _ := ret[0].([]domain.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantsByProductId indicates an expected call of GetVariantsByProductId.
+func (mr *MockIProductVariantRepositoryMockRecorder) GetVariantsByProductId(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantsByProductId", reflect.TypeOf((*MockIProductVariantRepository)(nil).GetVariantsByProductId), productId)
+}
+
+// GetVariantsByProductIds mocks base method.
+func (m *MockIProductVariantRepository) GetVariantsByProductIds(productIds []int64) (map[int64][]domain.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantsByProductIds", productIds)
+	ret0, _ := ret[0].(map[int64][]domain.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantsByProductIds indicates an expected call of GetVariantsByProductIds.
+func (mr *MockIProductVariantRepositoryMockRecorder) GetVariantsByProductIds(productIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantsByProductIds", reflect.TypeOf((*MockIProductVariantRepository)(nil).GetVariantsByProductIds), productIds)
+}
+
+// UpdateVariant mocks base method.
+func (m *MockIProductVariantRepository) UpdateVariant(variantId int64, variant domain.ProductVariant, optionValueIds []int64) (domain.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", variantId, variant, optionValueIds)
+	ret0, _ := ret[0].(domain.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockIProductVariantRepositoryMockRecorder) UpdateVariant(variantId, variant, optionValueIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockIProductVariantRepository)(nil).UpdateVariant), variantId, variant, optionValueIds)
+}
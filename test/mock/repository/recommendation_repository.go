@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/recommendation_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/recommendation_repository.go -destination=test/mock/repository/recommendation_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRecommendationRepository is a mock of IRecommendationRepository interface.
+type MockIRecommendationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRecommendationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIRecommendationRepositoryMockRecorder is the mock recorder for MockIRecommendationRepository.
+type MockIRecommendationRepositoryMockRecorder struct {
+	mock *MockIRecommendationRepository
+}
+
+// NewMockIRecommendationRepository creates a new mock instance.
+func NewMockIRecommendationRepository(ctrl *gomock.Controller) *MockIRecommendationRepository {
+	mock := &MockIRecommendationRepository{ctrl: ctrl}
+	mock.recorder = &MockIRecommendationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRecommendationRepository) EXPECT() *MockIRecommendationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRecommendationsFor mocks base method.
+func (m *MockIRecommendationRepository) GetRecommendationsFor(productIds []int64, limit int) ([]domain.RelatedProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommendationsFor", productIds, limit)
+	ret0, _ := ret[0].([]domain.RelatedProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendationsFor indicates an expected call of GetRecommendationsFor.
+func (mr *MockIRecommendationRepositoryMockRecorder) GetRecommendationsFor(productIds, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendationsFor", reflect.TypeOf((*MockIRecommendationRepository)(nil).GetRecommendationsFor), productIds, limit)
+}
+
+// GetRelatedProducts mocks base method.
+func (m *MockIRecommendationRepository) GetRelatedProducts(productId int64, limit int) ([]domain.RelatedProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedProducts", productId, limit)
+	ret0, _ := ret[0].([]domain.RelatedProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelatedProducts indicates an expected call of GetRelatedProducts.
+func (mr *MockIRecommendationRepositoryMockRecorder) GetRelatedProducts(productId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedProducts", reflect.TypeOf((*MockIRecommendationRepository)(nil).GetRelatedProducts), productId, limit)
+}
+
+// RebuildRelations mocks base method.
+func (m *MockIRecommendationRepository) RebuildRelations(limit int) (domain.RelationRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildRelations", limit)
+	ret0, _ := ret[0].(domain.RelationRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildRelations indicates an expected call of RebuildRelations.
+func (mr *MockIRecommendationRepositoryMockRecorder) RebuildRelations(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildRelations", reflect.TypeOf((*MockIRecommendationRepository)(nil).RebuildRelations), limit)
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/sitemap_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/sitemap_repository.go -destination=test/mock/repository/sitemap_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISitemapRepository is a mock of ISitemapRepository interface.
+type MockISitemapRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISitemapRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockISitemapRepositoryMockRecorder is the mock recorder for MockISitemapRepository.
+type MockISitemapRepositoryMockRecorder struct {
+	mock *MockISitemapRepository
+}
+
+// NewMockISitemapRepository creates a new mock instance.
+func NewMockISitemapRepository(ctrl *gomock.Controller) *MockISitemapRepository {
+	mock := &MockISitemapRepository{ctrl: ctrl}
+	mock.recorder = &MockISitemapRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISitemapRepository) EXPECT() *MockISitemapRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeletePagesExcept mocks base method.
+func (m *MockISitemapRepository) DeletePagesExcept(entityType string, pages []int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePagesExcept", entityType, pages)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePagesExcept indicates an expected call of DeletePagesExcept.
+func (mr *MockISitemapRepositoryMockRecorder) DeletePagesExcept(entityType, pages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePagesExcept", reflect.TypeOf((*MockISitemapRepository)(nil).DeletePagesExcept), entityType, pages)
+}
+
+// GetEntries mocks base method.
+func (m *MockISitemapRepository) GetEntries(entityType string, page, pageSize int) ([]domain.SitemapEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntries", entityType, page, pageSize)
+	ret0, _ := ret[0].([]domain.SitemapEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntries indicates an expected call of GetEntries.
+func (mr *MockISitemapRepositoryMockRecorder) GetEntries(entityType, page, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntries", reflect.TypeOf((*MockISitemapRepository)(nil).GetEntries), entityType, page, pageSize)
+}
+
+// GetPageContent mocks base method.
+func (m *MockISitemapRepository) GetPageContent(entityType string, page int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPageContent", entityType, page)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPageContent indicates an expected call of GetPageContent.
+func (mr *MockISitemapRepositoryMockRecorder) GetPageContent(entityType, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPageContent", reflect.TypeOf((*MockISitemapRepository)(nil).GetPageContent), entityType, page)
+}
+
+// GetPageStates mocks base method.
+func (m *MockISitemapRepository) GetPageStates(entityType string, pageSize int) ([]domain.SitemapPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPageStates", entityType, pageSize)
+	ret0, _ := ret[0].([]domain.SitemapPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPageStates indicates an expected call of GetPageStates.
+func (mr *MockISitemapRepositoryMockRecorder) GetPageStates(entityType, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPageStates", reflect.TypeOf((*MockISitemapRepository)(nil).GetPageStates), entityType, pageSize)
+}
+
+// GetPages mocks base method.
+func (m *MockISitemapRepository) GetPages() ([]domain.SitemapPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPages")
+	ret0, _ := ret[0].([]domain.SitemapPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPages indicates an expected call of GetPages.
+func (mr *MockISitemapRepositoryMockRecorder) GetPages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPages", reflect.TypeOf((*MockISitemapRepository)(nil).GetPages))
+}
+
+// SavePage mocks base method.
+func (m *MockISitemapRepository) SavePage(page domain.SitemapPage, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePage", page, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePage indicates an expected call of SavePage.
+func (mr *MockISitemapRepositoryMockRecorder) SavePage(page, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePage", reflect.TypeOf((*MockISitemapRepository)(nil).SavePage), page, content)
+}
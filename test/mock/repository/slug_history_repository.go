@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/slug_history_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/slug_history_repository.go -destination=test/mock/repository/slug_history_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISlugHistoryRepository is a mock of ISlugHistoryRepository interface.
+type MockISlugHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISlugHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockISlugHistoryRepositoryMockRecorder is the mock recorder for MockISlugHistoryRepository.
+type MockISlugHistoryRepositoryMockRecorder struct {
+	mock *MockISlugHistoryRepository
+}
+
+// NewMockISlugHistoryRepository creates a new mock instance.
+func NewMockISlugHistoryRepository(ctrl *gomock.Controller) *MockISlugHistoryRepository {
+	mock := &MockISlugHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockISlugHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISlugHistoryRepository) EXPECT() *MockISlugHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBySlug mocks base method.
+func (m *MockISlugHistoryRepository) GetBySlug(entityType, slug string) (domain.SlugHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", entityType, slug)
+	ret0, _ := ret[0].(domain.SlugHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockISlugHistoryRepositoryMockRecorder) GetBySlug(entityType, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockISlugHistoryRepository)(nil).GetBySlug), entityType, slug)
+}
+
+// IsSlugTaken mocks base method.
+func (m *MockISlugHistoryRepository) IsSlugTaken(entityType, slug string, exceptEntityId int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSlugTaken", entityType, slug, exceptEntityId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSlugTaken indicates an expected call of IsSlugTaken.
+func (mr *MockISlugHistoryRepositoryMockRecorder) IsSlugTaken(entityType, slug, exceptEntityId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSlugTaken", reflect.TypeOf((*MockISlugHistoryRepository)(nil).IsSlugTaken), entityType, slug, exceptEntityId)
+}
+
+// RecordSlug mocks base method.
+func (m *MockISlugHistoryRepository) RecordSlug(entityType string, entityId int64, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSlug", entityType, entityId, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSlug indicates an expected call of RecordSlug.
+func (mr *MockISlugHistoryRepositoryMockRecorder) RecordSlug(entityType, entityId, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSlug", reflect.TypeOf((*MockISlugHistoryRepository)(nil).RecordSlug), entityType, entityId, slug)
+}
+
+// ReleaseSlug mocks base method.
+func (m *MockISlugHistoryRepository) ReleaseSlug(entityType string, entityId int64, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSlug", entityType, entityId, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSlug indicates an expected call of ReleaseSlug.
+func (mr *MockISlugHistoryRepositoryMockRecorder) ReleaseSlug(entityType, entityId, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSlug", reflect.TypeOf((*MockISlugHistoryRepository)(nil).ReleaseSlug), entityType, entityId, slug)
+}
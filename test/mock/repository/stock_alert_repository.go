@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/stock_alert_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/stock_alert_repository.go -destination=test/mock/repository/stock_alert_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIStockAlertRepository is a mock of IStockAlertRepository interface.
+type MockIStockAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStockAlertRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIStockAlertRepositoryMockRecorder is the mock recorder for MockIStockAlertRepository.
+type MockIStockAlertRepositoryMockRecorder struct {
+	mock *MockIStockAlertRepository
+}
+
+// NewMockIStockAlertRepository creates a new mock instance.
+func NewMockIStockAlertRepository(ctrl *gomock.Controller) *MockIStockAlertRepository {
+	mock := &MockIStockAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockIStockAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStockAlertRepository) EXPECT() *MockIStockAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteThreshold mocks base method.
+func (m *MockIStockAlertRepository) DeleteThreshold(productId int64, variantId *int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteThreshold", productId, variantId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteThreshold indicates an expected call of DeleteThreshold.
+func (mr *MockIStockAlertRepositoryMockRecorder) DeleteThreshold(productId, variantId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteThreshold", reflect.TypeOf((*MockIStockAlertRepository)(nil).DeleteThreshold), productId, variantId)
+}
+
+// GetLowStockItems mocks base method.
+func (m *MockIStockAlertRepository) GetLowStockItems(storeId *uint) ([]domain.LowStockItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLowStockItems", storeId)
+	ret0, _ := ret[0].([]domain.LowStockItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLowStockItems indicates an expected call of GetLowStockItems.
+func (mr *MockIStockAlertRepositoryMockRecorder) GetLowStockItems(storeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLowStockItems", reflect.TypeOf((*MockIStockAlertRepository)(nil).GetLowStockItems), storeId)
+}
+
+// GetStockVelocities mocks base method.
+func (m *MockIStockAlertRepository) GetStockVelocities(since time.Time, storeId *uint) ([]domain.StockVelocity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStockVelocities", since, storeId)
+	ret0, _ := ret[0].([]domain.StockVelocity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStockVelocities indicates an expected call of GetStockVelocities.
+func (mr *MockIStockAlertRepositoryMockRecorder) GetStockVelocities(since, storeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStockVelocities", reflect.TypeOf((*MockIStockAlertRepository)(nil).GetStockVelocities), since, storeId)
+}
+
+// GetThresholds mocks base method.
+func (m *MockIStockAlertRepository) GetThresholds(productId int64) ([]domain.StockThreshold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThresholds", productId)
+	ret0, _ := ret[0].([]domain.StockThreshold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThresholds indicates an expected call of GetThresholds.
+func (mr *MockIStockAlertRepositoryMockRecorder) GetThresholds(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThresholds", reflect.TypeOf((*MockIStockAlertRepository)(nil).GetThresholds), productId)
+}
+
+// GetUnalertedLowStockItems mocks base method.
+func (m *MockIStockAlertRepository) GetUnalertedLowStockItems() ([]domain.LowStockItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnalertedLowStockItems")
+	ret0, _ := ret[0].([]domain.LowStockItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnalertedLowStockItems indicates an expected call of GetUnalertedLowStockItems.
+func (mr *MockIStockAlertRepositoryMockRecorder) GetUnalertedLowStockItems() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnalertedLowStockItems", reflect.TypeOf((*MockIStockAlertRepository)(nil).GetUnalertedLowStockItems))
+}
+
+// MarkAlerted mocks base method.
+func (m *MockIStockAlertRepository) MarkAlerted(thresholdId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAlerted", thresholdId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAlerted indicates an expected call of MarkAlerted.
+func (mr *MockIStockAlertRepositoryMockRecorder) MarkAlerted(thresholdId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlerted", reflect.TypeOf((*MockIStockAlertRepository)(nil).MarkAlerted), thresholdId)
+}
+
+// ResetRecoveredAlerts mocks base method.
+func (m *MockIStockAlertRepository) ResetRecoveredAlerts() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetRecoveredAlerts")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetRecoveredAlerts indicates an expected call of ResetRecoveredAlerts.
+func (mr *MockIStockAlertRepositoryMockRecorder) ResetRecoveredAlerts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetRecoveredAlerts", reflect.TypeOf((*MockIStockAlertRepository)(nil).ResetRecoveredAlerts))
+}
+
+// SetThreshold mocks base method.
+func (m *MockIStockAlertRepository) SetThreshold(threshold domain.StockThreshold) (domain.StockThreshold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetThreshold", threshold)
+	ret0, _ := ret[0].(domain.StockThreshold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetThreshold indicates an expected call of SetThreshold.
+func (mr *MockIStockAlertRepositoryMockRecorder) SetThreshold(threshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetThreshold", reflect.TypeOf((*MockIStockAlertRepository)(nil).SetThreshold), threshold)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/stock_subscription_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/stock_subscription_repository.go -destination=test/mock/repository/stock_subscription_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIStockSubscriptionRepository is a mock of IStockSubscriptionRepository interface.
+type MockIStockSubscriptionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStockSubscriptionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIStockSubscriptionRepositoryMockRecorder is the mock recorder for MockIStockSubscriptionRepository.
+type MockIStockSubscriptionRepositoryMockRecorder struct {
+	mock *MockIStockSubscriptionRepository
+}
+
+// NewMockIStockSubscriptionRepository creates a new mock instance.
+func NewMockIStockSubscriptionRepository(ctrl *gomock.Controller) *MockIStockSubscriptionRepository {
+	mock := &MockIStockSubscriptionRepository{ctrl: ctrl}
+	mock.recorder = &MockIStockSubscriptionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStockSubscriptionRepository) EXPECT() *MockIStockSubscriptionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetPendingNotifications mocks base method.
+func (m *MockIStockSubscriptionRepository) GetPendingNotifications(notifiedSince time.Time, limit int) ([]domain.RestockNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingNotifications", notifiedSince, limit)
+	ret0, _ := ret[0].([]domain.RestockNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingNotifications indicates an expected call of GetPendingNotifications.
+func (mr *MockIStockSubscriptionRepositoryMockRecorder) GetPendingNotifications(notifiedSince, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingNotifications", reflect.TypeOf((*MockIStockSubscriptionRepository)(nil).GetPendingNotifications), notifiedSince, limit)
+}
+
+// GetSubscriptionById mocks base method.
+func (m *MockIStockSubscriptionRepository) GetSubscriptionById(id int64) (domain.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionById", id)
+	ret0, _ := ret[0].(domain.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionById indicates an expected call of GetSubscriptionById.
+func (mr *MockIStockSubscriptionRepositoryMockRecorder) GetSubscriptionById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionById", reflect.TypeOf((*MockIStockSubscriptionRepository)(nil).GetSubscriptionById), id)
+}
+
+// GetUserSubscriptions mocks base method.
+func (m *MockIStockSubscriptionRepository) GetUserSubscriptions(userId int64) ([]domain.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSubscriptions", userId)
+	ret0, _ := ret[0].([]domain.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSubscriptions indicates an expected call of GetUserSubscriptions.
+func (mr *MockIStockSubscriptionRepositoryMockRecorder) GetUserSubscriptions(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSubscriptions", reflect.TypeOf((*MockIStockSubscriptionRepository)(nil).GetUserSubscriptions), userId)
+}
+
+// MarkNotified mocks base method.
+func (m *MockIStockSubscriptionRepository) MarkNotified(id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotified", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotified indicates an expected call of MarkNotified.
+func (mr *MockIStockSubscriptionRepositoryMockRecorder) MarkNotified(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotified", reflect.TypeOf((*MockIStockSubscriptionRepository)(nil).MarkNotified), id)
+}
+
+// Subscribe mocks base method.
+func (m *MockIStockSubscriptionRepository) Subscribe(subscription domain.StockSubscription) (domain.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", subscription)
+	ret0, _ := ret[0].(domain.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockIStockSubscriptionRepositoryMockRecorder) Subscribe(subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockIStockSubscriptionRepository)(nil).Subscribe), subscription)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockIStockSubscriptionRepository) Unsubscribe(id int64) (domain.StockSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", id)
+	ret0, _ := ret[0].(domain.StockSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockIStockSubscriptionRepositoryMockRecorder) Unsubscribe(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockIStockSubscriptionRepository)(nil).Unsubscribe), id)
+}
@@ -0,0 +1,220 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/store_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/store_repository.go -destination=test/mock/repository/store_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIStoreRepository is a mock of IStoreRepository interface.
+type MockIStoreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStoreRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIStoreRepositoryMockRecorder is the mock recorder for MockIStoreRepository.
+type MockIStoreRepositoryMockRecorder struct {
+	mock *MockIStoreRepository
+}
+
+// NewMockIStoreRepository creates a new mock instance.
+func NewMockIStoreRepository(ctrl *gomock.Controller) *MockIStoreRepository {
+	mock := &MockIStoreRepository{ctrl: ctrl}
+	mock.recorder = &MockIStoreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStoreRepository) EXPECT() *MockIStoreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddStore mocks base method.
+func (m *MockIStoreRepository) AddStore(store domain.Store) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddStore", store)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddStore indicates an expected call of AddStore.
+func (mr *MockIStoreRepositoryMockRecorder) AddStore(store any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStore", reflect.TypeOf((*MockIStoreRepository)(nil).AddStore), store)
+}
+
+// AddStoreOwner mocks base method.
+func (m *MockIStoreRepository) AddStoreOwner(storeId uint, userId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddStoreOwner", storeId, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddStoreOwner indicates an expected call of AddStoreOwner.
+func (mr *MockIStoreRepositoryMockRecorder) AddStoreOwner(storeId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStoreOwner", reflect.TypeOf((*MockIStoreRepository)(nil).AddStoreOwner), storeId, userId)
+}
+
+// DeleteStoreById mocks base method.
+func (m *MockIStoreRepository) DeleteStoreById(storeId uint) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStoreById", storeId)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStoreById indicates an expected call of DeleteStoreById.
+func (mr *MockIStoreRepositoryMockRecorder) DeleteStoreById(storeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoreById", reflect.TypeOf((*MockIStoreRepository)(nil).DeleteStoreById), storeId)
+}
+
+// GetAllStores mocks base method.
+func (m *MockIStoreRepository) GetAllStores() []domain.Store {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStores")
+	ret0, _ := ret[0].([]domain.Store)
+	return ret0
+}
+
+// GetAllStores indicates an expected call of GetAllStores.
+func (mr *MockIStoreRepositoryMockRecorder) GetAllStores() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStores", reflect.TypeOf((*MockIStoreRepository)(nil).GetAllStores))
+}
+
+// GetDeletedStores mocks base method.
+func (m *MockIStoreRepository) GetDeletedStores() ([]domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedStores")
+	ret0, _ := ret[0].([]domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedStores indicates an expected call of GetDeletedStores.
+func (mr *MockIStoreRepositoryMockRecorder) GetDeletedStores() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedStores", reflect.TypeOf((*MockIStoreRepository)(nil).GetDeletedStores))
+}
+
+// GetStoreById mocks base method.
+func (m *MockIStoreRepository) GetStoreById(storeId uint) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreById", storeId)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoreById indicates an expected call of GetStoreById.
+func (mr *MockIStoreRepositoryMockRecorder) GetStoreById(storeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreById", reflect.TypeOf((*MockIStoreRepository)(nil).GetStoreById), storeId)
+}
+
+// GetStoreBySlug mocks base method.
+func (m *MockIStoreRepository) GetStoreBySlug(slug string) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreBySlug", slug)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoreBySlug indicates an expected call of GetStoreBySlug.
+func (mr *MockIStoreRepositoryMockRecorder) GetStoreBySlug(slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreBySlug", reflect.TypeOf((*MockIStoreRepository)(nil).GetStoreBySlug), slug)
+}
+
+// IsStoreOwner mocks base method.
+func (m *MockIStoreRepository) IsStoreOwner(storeId uint, userId int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsStoreOwner", storeId, userId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsStoreOwner indicates an expected call of IsStoreOwner.
+func (mr *MockIStoreRepositoryMockRecorder) IsStoreOwner(storeId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsStoreOwner", reflect.TypeOf((*MockIStoreRepository)(nil).IsStoreOwner), storeId, userId)
+}
+
+// PurgeDeletedStores mocks base method.
+func (m *MockIStoreRepository) PurgeDeletedStores(before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedStores", before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedStores indicates an expected call of PurgeDeletedStores.
+func (mr *MockIStoreRepositoryMockRecorder) PurgeDeletedStores(before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedStores", reflect.TypeOf((*MockIStoreRepository)(nil).PurgeDeletedStores), before)
+}
+
+// RemoveStoreOwner mocks base method.
+func (m *MockIStoreRepository) RemoveStoreOwner(storeId uint, userId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveStoreOwner", storeId, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveStoreOwner indicates an expected call of RemoveStoreOwner.
+func (mr *MockIStoreRepositoryMockRecorder) RemoveStoreOwner(storeId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveStoreOwner", reflect.TypeOf((*MockIStoreRepository)(nil).RemoveStoreOwner), storeId, userId)
+}
+
+// RestoreStoreById mocks base method.
+func (m *MockIStoreRepository) RestoreStoreById(storeId uint) (domain.Store, []int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreStoreById", storeId)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].([]int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RestoreStoreById indicates an expected call of RestoreStoreById.
+func (mr *MockIStoreRepositoryMockRecorder) RestoreStoreById(storeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreStoreById", reflect.TypeOf((*MockIStoreRepository)(nil).RestoreStoreById), storeId)
+}
+
+// UpdateStoreById mocks base method.
+func (m *MockIStoreRepository) UpdateStoreById(id uint, store domain.Store) (domain.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStoreById", id, store)
+	ret0, _ := ret[0].(domain.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStoreById indicates an expected call of UpdateStoreById.
+func (mr *MockIStoreRepositoryMockRecorder) UpdateStoreById(id, store any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStoreById", reflect.TypeOf((*MockIStoreRepository)(nil).UpdateStoreById), id, store)
+}
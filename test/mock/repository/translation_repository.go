@@ -0,0 +1,234 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/translation_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/translation_repository.go -destination=test/mock/repository/translation_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITranslationRepository is a mock of ITranslationRepository interface.
+type MockITranslationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITranslationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITranslationRepositoryMockRecorder is the mock recorder for MockITranslationRepository.
+type MockITranslationRepositoryMockRecorder struct {
+	mock *MockITranslationRepository
+}
+
+// NewMockITranslationRepository creates a new mock instance.
+func NewMockITranslationRepository(ctrl *gomock.Controller) *MockITranslationRepository {
+	mock := &MockITranslationRepository{ctrl: ctrl}
+	mock.recorder = &MockITranslationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITranslationRepository) EXPECT() *MockITranslationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteCategoryTranslation mocks base method.
+func (m *MockITranslationRepository) DeleteCategoryTranslation(categoryId int64, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryTranslation", categoryId, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategoryTranslation indicates an expected call of DeleteCategoryTranslation.
+func (mr *MockITranslationRepositoryMockRecorder) DeleteCategoryTranslation(categoryId, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryTranslation", reflect.TypeOf((*MockITranslationRepository)(nil).DeleteCategoryTranslation), categoryId, locale)
+}
+
+// DeleteProductTranslation mocks base method.
+func (m *MockITranslationRepository) DeleteProductTranslation(productId int64, locale string) (domain.ProductTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductTranslation", productId, locale)
+	ret0, _ := ret[0].(domain.ProductTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductTranslation indicates an expected call of DeleteProductTranslation.
+func (mr *MockITranslationRepositoryMockRecorder) DeleteProductTranslation(productId, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductTranslation", reflect.TypeOf((*MockITranslationRepository)(nil).DeleteProductTranslation), productId, locale)
+}
+
+// DeleteStoreTranslation mocks base method.
+func (m *MockITranslationRepository) DeleteStoreTranslation(storeId int64, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStoreTranslation", storeId, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStoreTranslation indicates an expected call of DeleteStoreTranslation.
+func (mr *MockITranslationRepositoryMockRecorder) DeleteStoreTranslation(storeId, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoreTranslation", reflect.TypeOf((*MockITranslationRepository)(nil).DeleteStoreTranslation), storeId, locale)
+}
+
+// GetCategoryTranslations mocks base method.
+func (m *MockITranslationRepository) GetCategoryTranslations(categoryId int64) ([]domain.CategoryTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTranslations", categoryId)
+	ret0, _ := ret[0].([]domain.CategoryTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTranslations indicates an expected call of GetCategoryTranslations.
+func (mr *MockITranslationRepositoryMockRecorder) GetCategoryTranslations(categoryId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTranslations", reflect.TypeOf((*MockITranslationRepository)(nil).GetCategoryTranslations), categoryId)
+}
+
+// GetCategoryTranslationsByLocale mocks base method.
+func (m *MockITranslationRepository) GetCategoryTranslationsByLocale(locale string, categoryIds []int64) (map[int64]domain.CategoryTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTranslationsByLocale", locale, categoryIds)
+	ret0, _ := ret[0].(map[int64]domain.CategoryTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTranslationsByLocale indicates an expected call of GetCategoryTranslationsByLocale.
+func (mr *MockITranslationRepositoryMockRecorder) GetCategoryTranslationsByLocale(locale, categoryIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTranslationsByLocale", reflect.TypeOf((*MockITranslationRepository)(nil).GetCategoryTranslationsByLocale), locale, categoryIds)
+}
+
+// GetProductTranslationBySlug mocks base method.
+func (m *MockITranslationRepository) GetProductTranslationBySlug(slug string) (domain.ProductTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductTranslationBySlug", slug)
+	ret0, _ := ret[0].(domain.ProductTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductTranslationBySlug indicates an expected call of GetProductTranslationBySlug.
+func (mr *MockITranslationRepositoryMockRecorder) GetProductTranslationBySlug(slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTranslationBySlug", reflect.TypeOf((*MockITranslationRepository)(nil).GetProductTranslationBySlug), slug)
+}
+
+// GetProductTranslations mocks base method.
+func (m *MockITranslationRepository) GetProductTranslations(productId int64) ([]domain.ProductTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductTranslations", productId)
+	ret0, _ := ret[0].([]domain.ProductTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductTranslations indicates an expected call of GetProductTranslations.
+func (mr *MockITranslationRepositoryMockRecorder) GetProductTranslations(productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTranslations", reflect.TypeOf((*MockITranslationRepository)(nil).GetProductTranslations), productId)
+}
+
+// GetProductTranslationsByLocale mocks base method.
+func (m *MockITranslationRepository) GetProductTranslationsByLocale(locale string, productIds []int64) (map[int64]domain.ProductTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductTranslationsByLocale", locale, productIds)
+	ret0, _ := ret[0].(map[int64]domain.ProductTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductTranslationsByLocale indicates an expected call of GetProductTranslationsByLocale.
+func (mr *MockITranslationRepositoryMockRecorder) GetProductTranslationsByLocale(locale, productIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTranslationsByLocale", reflect.TypeOf((*MockITranslationRepository)(nil).GetProductTranslationsByLocale), locale, productIds)
+}
+
+// GetStoreTranslations mocks base method.
+func (m *MockITranslationRepository) GetStoreTranslations(storeId int64) ([]domain.StoreTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreTranslations", storeId)
+	ret0, _ := ret[0].([]domain.StoreTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoreTranslations indicates an expected call of GetStoreTranslations.
+func (mr *MockITranslationRepositoryMockRecorder) GetStoreTranslations(storeId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreTranslations", reflect.TypeOf((*MockITranslationRepository)(nil).GetStoreTranslations), storeId)
+}
+
+// GetStoreTranslationsByLocale mocks base method.
+func (m *MockITranslationRepository) GetStoreTranslationsByLocale(locale string, storeIds []int64) (map[int64]domain.StoreTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreTranslationsByLocale", locale, storeIds)
+	ret0, _ := ret[0].(map[int64]domain.StoreTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoreTranslationsByLocale indicates an expected call of GetStoreTranslationsByLocale.
+func (mr *MockITranslationRepositoryMockRecorder) GetStoreTranslationsByLocale(locale, storeIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreTranslationsByLocale", reflect.TypeOf((*MockITranslationRepository)(nil).GetStoreTranslationsByLocale), locale, storeIds)
+}
+
+// SaveCategoryTranslation mocks base method.
+func (m *MockITranslationRepository) SaveCategoryTranslation(translation domain.CategoryTranslation) (domain.CategoryTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCategoryTranslation", translation)
+	ret0, _ := ret[0].(domain.CategoryTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCategoryTranslation indicates an expected call of SaveCategoryTranslation.
+func (mr *MockITranslationRepositoryMockRecorder) SaveCategoryTranslation(translation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCategoryTranslation", reflect.TypeOf((*MockITranslationRepository)(nil).SaveCategoryTranslation), translation)
+}
+
+// SaveProductTranslation mocks base method.
+func (m *MockITranslationRepository) SaveProductTranslation(translation domain.ProductTranslation) (domain.ProductTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductTranslation", translation)
+	ret0, _ := ret[0].(domain.ProductTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveProductTranslation indicates an expected call of SaveProductTranslation.
+func (mr *MockITranslationRepositoryMockRecorder) SaveProductTranslation(translation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductTranslation", reflect.TypeOf((*MockITranslationRepository)(nil).SaveProductTranslation), translation)
+}
+
+// SaveStoreTranslation mocks base method.
+func (m *MockITranslationRepository) SaveStoreTranslation(translation domain.StoreTranslation) (domain.StoreTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStoreTranslation", translation)
+	ret0, _ := ret[0].(domain.StoreTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveStoreTranslation indicates an expected call of SaveStoreTranslation.
+func (mr *MockITranslationRepositoryMockRecorder) SaveStoreTranslation(translation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStoreTranslation", reflect.TypeOf((*MockITranslationRepository)(nil).SaveStoreTranslation), translation)
+}
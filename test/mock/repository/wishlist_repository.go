@@ -0,0 +1,218 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: persistence/wishlist_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=persistence/wishlist_repository.go -destination=test/mock/repository/wishlist_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	domain "go-ecommerce-service/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWishlistRepository is a mock of IWishlistRepository interface.
+type MockIWishlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWishlistRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIWishlistRepositoryMockRecorder is the mock recorder for MockIWishlistRepository.
+type MockIWishlistRepositoryMockRecorder struct {
+	mock *MockIWishlistRepository
+}
+
+// NewMockIWishlistRepository creates a new mock instance.
+func NewMockIWishlistRepository(ctrl *gomock.Controller) *MockIWishlistRepository {
+	mock := &MockIWishlistRepository{ctrl: ctrl}
+	mock.recorder = &MockIWishlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWishlistRepository) EXPECT() *MockIWishlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockIWishlistRepository) AddItem(item domain.WishlistItem) (domain.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", item)
+	ret0, _ := ret[0].(domain.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockIWishlistRepositoryMockRecorder) AddItem(item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockIWishlistRepository)(nil).AddItem), item)
+}
+
+// CreateWishlist mocks base method.
+func (m *MockIWishlistRepository) CreateWishlist(wishlist domain.Wishlist) (domain.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWishlist", wishlist)
+	ret0, _ := ret[0].(domain.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWishlist indicates an expected call of CreateWishlist.
+func (mr *MockIWishlistRepositoryMockRecorder) CreateWishlist(wishlist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWishlist", reflect.TypeOf((*MockIWishlistRepository)(nil).CreateWishlist), wishlist)
+}
+
+// DeleteWishlistById mocks base method.
+func (m *MockIWishlistRepository) DeleteWishlistById(wishlistId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWishlistById", wishlistId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWishlistById indicates an expected call of DeleteWishlistById.
+func (mr *MockIWishlistRepositoryMockRecorder) DeleteWishlistById(wishlistId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWishlistById", reflect.TypeOf((*MockIWishlistRepository)(nil).DeleteWishlistById), wishlistId)
+}
+
+// GetItemById mocks base method.
+func (m *MockIWishlistRepository) GetItemById(itemId int64) (domain.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemById", itemId)
+	ret0, _ := ret[0].(domain.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemById indicates an expected call of GetItemById.
+func (mr *MockIWishlistRepositoryMockRecorder) GetItemById(itemId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemById", reflect.TypeOf((*MockIWishlistRepository)(nil).GetItemById), itemId)
+}
+
+// GetItemsByWishlistId mocks base method.
+func (m *MockIWishlistRepository) GetItemsByWishlistId(wishlistId int64) ([]domain.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemsByWishlistId", wishlistId)
+	ret0, _ := ret[0].([]domain.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemsByWishlistId indicates an expected call of GetItemsByWishlistId.
+func (mr *MockIWishlistRepositoryMockRecorder) GetItemsByWishlistId(wishlistId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsByWishlistId", reflect.TypeOf((*MockIWishlistRepository)(nil).GetItemsByWishlistId), wishlistId)
+}
+
+// GetPriceDrops mocks base method.
+func (m *MockIWishlistRepository) GetPriceDrops() ([]domain.WishlistPriceDrop, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceDrops")
+	ret0, _ := ret[0].([]domain.WishlistPriceDrop)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceDrops indicates an expected call of GetPriceDrops.
+func (mr *MockIWishlistRepositoryMockRecorder) GetPriceDrops() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceDrops", reflect.TypeOf((*MockIWishlistRepository)(nil).GetPriceDrops))
+}
+
+// GetWishlistById mocks base method.
+func (m *MockIWishlistRepository) GetWishlistById(wishlistId int64) (domain.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlistById", wishlistId)
+	ret0, _ := ret[0].(domain.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlistById indicates an expected call of GetWishlistById.
+func (mr *MockIWishlistRepositoryMockRecorder) GetWishlistById(wishlistId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlistById", reflect.TypeOf((*MockIWishlistRepository)(nil).GetWishlistById), wishlistId)
+}
+
+// GetWishlistByShareToken mocks base method.
+func (m *MockIWishlistRepository) GetWishlistByShareToken(shareToken string) (domain.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlistByShareToken", shareToken)
+	ret0, _ := ret[0].(domain.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlistByShareToken indicates an expected call of GetWishlistByShareToken.
+func (mr *MockIWishlistRepositoryMockRecorder) GetWishlistByShareToken(shareToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlistByShareToken", reflect.TypeOf((*MockIWishlistRepository)(nil).GetWishlistByShareToken), shareToken)
+}
+
+// GetWishlistsByUserId mocks base method.
+func (m *MockIWishlistRepository) GetWishlistsByUserId(userId int64) ([]domain.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlistsByUserId", userId)
+	ret0, _ := ret[0].([]domain.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlistsByUserId indicates an expected call of GetWishlistsByUserId.
+func (mr *MockIWishlistRepositoryMockRecorder) GetWishlistsByUserId(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlistsByUserId", reflect.TypeOf((*MockIWishlistRepository)(nil).GetWishlistsByUserId), userId)
+}
+
+// MarkPriceDropNotified mocks base method.
+func (m *MockIWishlistRepository) MarkPriceDropNotified(itemId int64, price float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPriceDropNotified", itemId, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPriceDropNotified indicates an expected call of MarkPriceDropNotified.
+func (mr *MockIWishlistRepositoryMockRecorder) MarkPriceDropNotified(itemId, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPriceDropNotified", reflect.TypeOf((*MockIWishlistRepository)(nil).MarkPriceDropNotified), itemId, price)
+}
+
+// RemoveItem mocks base method.
+func (m *MockIWishlistRepository) RemoveItem(itemId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", itemId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockIWishlistRepositoryMockRecorder) RemoveItem(itemId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockIWishlistRepository)(nil).RemoveItem), itemId)
+}
+
+// UpdateWishlist mocks base method.
+func (m *MockIWishlistRepository) UpdateWishlist(wishlistId int64, wishlist domain.Wishlist) (domain.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWishlist", wishlistId, wishlist)
+	ret0, _ := ret[0].(domain.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWishlist indicates an expected call of UpdateWishlist.
+func (mr *MockIWishlistRepositoryMockRecorder) UpdateWishlist(wishlistId, wishlist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWishlist", reflect.TypeOf((*MockIWishlistRepository)(nil).UpdateWishlist), wishlistId, wishlist)
+}
@@ -0,0 +1,37 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-ecommerce-service/pkg/locale"
+)
+
+func TestResolve(t *testing.T) {
+	locales := locale.New("tr", "tr,en,de")
+
+	// --- SENARYO 1: ?locale= parametresi Accept-Language başlığından önce gelir ---
+	t.Run("Resolve_RequestedLocaleWins", func(t *testing.T) {
+		assert.Equal(t, "de", locales.Resolve("de", "en-US,en;q=0.9"))
+	})
+
+	// --- SENARYO 2: Başlıktaki tercihler ağırlıklarına göre sıralanır, bölge dile düşer ---
+	t.Run("Resolve_AcceptLanguageByWeight", func(t *testing.T) {
+		assert.Equal(t, "en", locales.Resolve("", "fr;q=0.9, en-GB;q=0.8, de;q=0.5"))
+		assert.Equal(t, "de", locales.Resolve("", "en;q=0.2, de"))
+	})
+
+	// --- SENARYO 3: Desteklenmeyen diller varsayılan dile düşer ---
+	t.Run("Resolve_FallsBackToDefault", func(t *testing.T) {
+		assert.Equal(t, "tr", locales.Resolve("fr", "ja, *;q=0.1"))
+		assert.Equal(t, "tr", locales.Resolve("", ""))
+	})
+
+	// --- SENARYO 4: Varsayılan dil çeviri sayılmaz ---
+	t.Run("Translated_ExcludesDefault", func(t *testing.T) {
+		assert.Equal(t, []string{"en", "de"}, locales.Translated())
+		assert.False(t, locales.IsTranslated("tr"))
+		assert.True(t, locales.IsTranslated("en"))
+	})
+}
@@ -0,0 +1,66 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-ecommerce-service/internal/media"
+)
+
+func encodePng(t *testing.T, width int, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 120, A: 255})
+		}
+	}
+	var buffer bytes.Buffer
+	assert.NoError(t, png.Encode(&buffer, img))
+	return buffer.Bytes()
+}
+
+func TestProcess(t *testing.T) {
+	// --- SENARYO 1: Orijinalden küçük her genişlik için küçük resim ve WebP üretilir ---
+	t.Run("Process_RendersSmallerWidths", func(t *testing.T) {
+		processed, err := media.Process(encodePng(t, 400, 200), []int{100, 400, 800})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "image/png", processed.ContentType)
+		assert.Equal(t, 400, processed.Width)
+
+		names := []string{}
+		for _, rendition := range processed.Renditions {
+			names = append(names, rendition.Name)
+		}
+		assert.Equal(t, []string{"w100", "w100_webp", "webp"}, names)
+		assert.Equal(t, 50, processed.Renditions[0].Height)
+		assert.Equal(t, "image/png", processed.Renditions[0].ContentType)
+		assert.Equal(t, "image/webp", processed.Renditions[1].ContentType)
+
+		_, format, decodeErr := image.DecodeConfig(bytes.NewReader(processed.Renditions[1].Content))
+		assert.NoError(t, decodeErr)
+		assert.Equal(t, "webp", format)
+	})
+
+	// --- SENARYO 2: Resim olmayan dosyalar reddedilir ---
+	t.Run("Process_RejectsNonImages", func(t *testing.T) {
+		_, err := media.Process([]byte("not an image"), []int{100})
+
+		assert.ErrorIs(t, err, media.ErrUnsupportedImage)
+	})
+
+	// --- SENARYO 3: Genişlik listesi sıralanır, tekrarlar atılır ---
+	t.Run("ParseWidths_SortsAndDeduplicates", func(t *testing.T) {
+		widths, err := media.ParseWidths("480, 160,,480")
+		_, invalidErr := media.ParseWidths("160,abc")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{160, 480}, widths)
+		assert.Error(t, invalidErr)
+	})
+}
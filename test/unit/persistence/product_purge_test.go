@@ -0,0 +1,60 @@
+package persistence
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-ecommerce-service/persistence"
+)
+
+var (
+	createTablePattern  = regexp.MustCompile(`CREATE TABLE IF NOT EXISTS (\w+)`)
+	productForeignKey   = regexp.MustCompile(`REFERENCES products\s*\(id\)`)
+	cascadingForeignKey = regexp.MustCompile(`ON DELETE (CASCADE|SET NULL)`)
+)
+
+// nonCascadingProductReferences lists the tables of the schema whose foreign keys to products neither
+// cascade nor set null, so a product they reference cannot be deleted.
+func nonCascadingProductReferences(t *testing.T) []string {
+	file, err := os.Open("../../../init.sql")
+	if err != nil {
+		t.Fatalf("open schema: %v", err)
+	}
+	defer file.Close()
+
+	var tables []string
+	table := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := createTablePattern.FindStringSubmatch(line); match != nil {
+			table = match[1]
+			continue
+		}
+		if productForeignKey.MatchString(line) && !cascadingForeignKey.MatchString(line) {
+			tables = append(tables, table)
+		}
+	}
+	return tables
+}
+
+func TestPurgeDeletedProducts(t *testing.T) {
+	// --- SENARYO 1: Ürüne basamaklanmayan yabancı anahtarla bağlı her tablo çöp temizliğinde ele alınır ---
+	t.Run("Purge_CoversEveryNonCascadingReference", func(t *testing.T) {
+		handled := append(append([]string{}, persistence.ProductPurgeBlockers...), persistence.ProductPurgeCleared...)
+
+		for _, table := range nonCascadingProductReferences(t) {
+			assert.Contains(t, handled, table, "purge would fail on a trashed product referenced by %s", table)
+		}
+	})
+
+	// --- SENARYO 2: Çöpteki bir ürün paket bileşeni ya da sipariş bileşeniyse silinmez ---
+	t.Run("Purge_KeepsBundleComponents", func(t *testing.T) {
+		assert.Contains(t, persistence.ProductPurgeBlockers, "bundle_components")
+		assert.Contains(t, persistence.ProductPurgeBlockers, "order_item_components")
+	})
+}
@@ -0,0 +1,179 @@
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/jackc/pgconn"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence"
+	"go-ecommerce-service/persistence/common"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+const cartTTL = time.Hour
+
+// expectCartWrite expects the transaction that stores one cart line and marks the cart dirty.
+func expectCartWrite(mockRedis redismock.ClientMock, item domain.CartItem) {
+	data, _ := json.Marshal(item)
+	mockRedis.ExpectTxPipeline()
+	mockRedis.ExpectHSet("cart:1:items", fmt.Sprint(item.Id), data).SetVal(1)
+	mockRedis.ExpectExpire("cart:1:items", cartTTL).SetVal(true)
+	mockRedis.ExpectSet(fmt.Sprintf("cart_item:%d", item.Id), item.CartId, cartTTL).SetVal("OK")
+	mockRedis.ExpectSet("cart:1:loaded", 1, cartTTL).SetVal("OK")
+	mockRedis.ExpectSAdd("carts:dirty", item.CartId).SetVal(1)
+	mockRedis.ExpectTxPipelineExec()
+}
+
+// expectLoadedCart expects a cart that is already in Redis to be read and its TTL refreshed.
+func expectLoadedCart(mockRedis redismock.ClientMock, items ...domain.CartItem) []string {
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		data, _ := json.Marshal(item)
+		values = append(values, string(data))
+	}
+	mockRedis.ExpectExists("cart:1:loaded").SetVal(1)
+	mockRedis.ExpectHVals("cart:1:items").SetVal(values)
+	mockRedis.ExpectExpire("cart:1:items", cartTTL).SetVal(true)
+	mockRedis.ExpectExpire("cart:1:loaded", cartTTL).SetVal(true)
+	for _, item := range items {
+		mockRedis.ExpectExpire(fmt.Sprintf("cart_item:%d", item.Id), cartTTL).SetVal(true)
+	}
+	return values
+}
+
+func TestRedisCartItemRepository(t *testing.T) {
+	type mocks struct {
+		database *mock_repository.MockICartItemSnapshotRepository
+		redis    redismock.ClientMock
+	}
+
+	setup := func(t *testing.T) (persistence.IHotCartItemRepository, mocks) {
+		ctrl := gomock.NewController(t)
+		db, mockRedis := redismock.NewClientMock()
+		m := mocks{database: mock_repository.NewMockICartItemSnapshotRepository(ctrl), redis: mockRedis}
+		return persistence.NewRedisCartItemRepository(db, m.database, cartTTL), m
+	}
+
+	// --- SENARYO 1: Olmayan ürün sepete yazılmadan reddedilir ---
+	t.Run("AddItemToCart_MissingProductIsRejected", func(t *testing.T) {
+		repository, m := setup(t)
+		m.database.EXPECT().ValidateItemReferences(int64(1), int64(99)).Return(common.ErrProductNotFound)
+
+		_, err := repository.AddItemToCart(domain.CartItem{CartId: 1, ProductId: 99, Quantity: 1})
+
+		assert.ErrorIs(t, err, common.ErrProductNotFound)
+		assert.NoError(t, m.redis.ExpectationsWereMet())
+	})
+
+	// --- SENARYO 2: Aynı ürün tekrar eklenince satır birleştirilir ---
+	t.Run("AddItemToCart_MergesSameProduct", func(t *testing.T) {
+		repository, m := setup(t)
+		existing := domain.CartItem{Id: 5, CartId: 1, ProductId: 10, Quantity: 2}
+		m.database.EXPECT().ValidateItemReferences(int64(1), int64(10)).Return(nil)
+		values := expectLoadedCart(m.redis, existing)
+		m.redis.ExpectWatch("cart:1:items")
+		m.redis.ExpectHVals("cart:1:items").SetVal(values)
+		expectCartWrite(m.redis, domain.CartItem{Id: 5, CartId: 1, ProductId: 10, Quantity: 5})
+
+		item, err := repository.AddItemToCart(domain.CartItem{CartId: 1, ProductId: 10, Quantity: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), item.Id)
+		assert.Equal(t, 5, item.Quantity)
+		assert.NoError(t, m.redis.ExpectationsWereMet())
+	})
+
+	// --- SENARYO 3: Sepet araya giren bir yazmayla değişirse güncel sepet üzerinden yeniden denenir ---
+	t.Run("AddItemToCart_RetriesOnConcurrentWrite", func(t *testing.T) {
+		repository, m := setup(t)
+		before := domain.CartItem{Id: 5, CartId: 1, ProductId: 10, Quantity: 2}
+		concurrent := domain.CartItem{Id: 5, CartId: 1, ProductId: 10, Quantity: 4}
+		m.database.EXPECT().ValidateItemReferences(int64(1), int64(10)).Return(nil)
+		values := expectLoadedCart(m.redis, before)
+		m.redis.ExpectWatch("cart:1:items")
+		m.redis.ExpectHVals("cart:1:items").SetVal(values)
+		beforeData, _ := json.Marshal(domain.CartItem{Id: 5, CartId: 1, ProductId: 10, Quantity: 3})
+		m.redis.ExpectTxPipeline()
+		m.redis.ExpectHSet("cart:1:items", "5", beforeData).SetVal(1)
+		m.redis.ExpectExpire("cart:1:items", cartTTL).SetVal(true)
+		m.redis.ExpectSet("cart_item:5", int64(1), cartTTL).SetVal("OK")
+		m.redis.ExpectSet("cart:1:loaded", 1, cartTTL).SetVal("OK")
+		m.redis.ExpectSAdd("carts:dirty", int64(1)).SetVal(1)
+		m.redis.ExpectTxPipelineExec().SetErr(redis.TxFailedErr)
+		concurrentData, _ := json.Marshal(concurrent)
+		m.redis.ExpectWatch("cart:1:items")
+		m.redis.ExpectHVals("cart:1:items").SetVal([]string{string(concurrentData)})
+		expectCartWrite(m.redis, domain.CartItem{Id: 5, CartId: 1, ProductId: 10, Quantity: 5})
+
+		item, err := repository.AddItemToCart(domain.CartItem{CartId: 1, ProductId: 10, Quantity: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, item.Quantity)
+		assert.NoError(t, m.redis.ExpectationsWereMet())
+	})
+
+	// --- SENARYO 4: Silinmiş bir satıra başvuran sepet tekrar denenmez, dead-letter kümesine taşınır ---
+	t.Run("FlushDirtyCarts_ForeignKeyViolationIsDeadLettered", func(t *testing.T) {
+		repository, m := setup(t)
+		item := domain.CartItem{Id: 5, CartId: 1, ProductId: 10, Quantity: 2}
+		data, _ := json.Marshal(item)
+		m.redis.ExpectSPopN("carts:dirty", 100).SetVal([]string{"1"})
+		m.redis.ExpectExists("cart:1:loaded").SetVal(1)
+		m.redis.ExpectHVals("cart:1:items").SetVal([]string{string(data)})
+		m.database.EXPECT().SaveCartSnapshot(int64(1), []domain.CartItem{item}).
+			Return(common.WrapError("upsert cart snapshot item", &pgconn.PgError{Code: "23503"}))
+		m.redis.ExpectSAdd("carts:dead_letter", int64(1)).SetVal(1)
+
+		flushed, err := repository.FlushDirtyCarts()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, flushed)
+		assert.NoError(t, m.redis.ExpectationsWereMet())
+	})
+
+	// --- SENARYO 5: Geçici bir hatada sepet kirli kümeye geri konur ---
+	t.Run("FlushDirtyCarts_TransientErrorIsRetried", func(t *testing.T) {
+		repository, m := setup(t)
+		item := domain.CartItem{Id: 5, CartId: 1, ProductId: 10, Quantity: 2}
+		data, _ := json.Marshal(item)
+		m.redis.ExpectSPopN("carts:dirty", 100).SetVal([]string{"1"})
+		m.redis.ExpectExists("cart:1:loaded").SetVal(1)
+		m.redis.ExpectHVals("cart:1:items").SetVal([]string{string(data)})
+		m.database.EXPECT().SaveCartSnapshot(int64(1), []domain.CartItem{item}).
+			Return(common.WrapError("begin cart snapshot", assert.AnError))
+		m.redis.ExpectSAdd("carts:dirty", int64(1)).SetVal(1)
+
+		flushed, err := repository.FlushDirtyCarts()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, flushed)
+		assert.NoError(t, m.redis.ExpectationsWereMet())
+	})
+
+	// --- SENARYO 6: Silinen satır sepetten ve indeksten kaldırılır, sepet kirli olarak işaretlenir ---
+	t.Run("RemoveItemFromCart_DeletesLineAndMarksDirty", func(t *testing.T) {
+		repository, m := setup(t)
+		item := domain.CartItem{Id: 5, CartId: 1, ProductId: 10, Quantity: 2}
+		m.redis.ExpectGet("cart_item:5").SetVal("1")
+		values := expectLoadedCart(m.redis, item)
+		m.redis.ExpectHGet("cart:1:items", "5").SetVal(values[0])
+		m.redis.ExpectTxPipeline()
+		m.redis.ExpectHDel("cart:1:items", "5").SetVal(1)
+		m.redis.ExpectDel("cart_item:5").SetVal(1)
+		m.redis.ExpectSAdd("carts:dirty", int64(1)).SetVal(1)
+		m.redis.ExpectTxPipelineExec()
+
+		err := repository.RemoveItemFromCart(5)
+
+		assert.NoError(t, err)
+		assert.NoError(t, m.redis.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,95 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/pricing"
+)
+
+func TestResolve(t *testing.T) {
+	// --- SENARYO 1: Sadece fiyat gönderilirse taban fiyat olur ---
+	t.Run("Resolve_PriceOnly", func(t *testing.T) {
+		prices, err := pricing.Resolve(500, 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, pricing.Prices{Price: 500, BasePrice: 500, Discount: 0}, prices)
+	})
+
+	// --- SENARYO 2: Taban fiyat ve indirimden satış fiyatı türetilir ---
+	t.Run("Resolve_DerivesPrice", func(t *testing.T) {
+		prices, err := pricing.Resolve(0, 1000, 150)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 850.0, prices.Price)
+	})
+
+	// --- SENARYO 3: Tutarsız alanlar reddedilir ---
+	t.Run("Resolve_RejectsInconsistentFields", func(t *testing.T) {
+		_, discountErr := pricing.Resolve(0, 100, 150)
+		_, priceErr := pricing.Resolve(900, 1000, 50)
+		_, negativeErr := pricing.Resolve(-1, 0, 0)
+
+		assert.Error(t, discountErr)
+		assert.Error(t, priceErr)
+		assert.Error(t, negativeErr)
+	})
+}
+
+func TestCalculator(t *testing.T) {
+	categoryId := uint(3)
+	wholesale := "wholesale"
+	product := domain.Product{Id: 1, StoreId: 1, CategoryId: &categoryId, BasePrice: 200, Discount: 20, Price: 180}
+	priceRules := []domain.PriceRule{
+		{Id: 1, Kind: domain.PriceRuleKindSale, CategoryId: &categoryId, PercentOff: 10},
+		{Id: 2, Kind: domain.PriceRuleKindSale, PercentOff: 5},
+		{Id: 3, Kind: domain.PriceRuleKindCustomerGroup, CustomerGroup: &wholesale, PercentOff: 50},
+	}
+
+	// --- SENARYO 4: En iyi kampanya uygulanır, grup kuralı başka gruba uygulanmaz ---
+	t.Run("Quote_RetailGetsBestSale", func(t *testing.T) {
+		quote := pricing.NewCalculator(priceRules, domain.CustomerGroupRetail, nil).Quote(product, nil)
+
+		assert.Equal(t, 200.0, quote.BasePrice)
+		assert.Equal(t, 20.0, quote.ProductDiscount)
+		assert.Equal(t, 18.0, quote.SaleDiscount)
+		assert.Equal(t, 0.0, quote.GroupDiscount)
+		assert.Equal(t, 162.0, quote.Price)
+		assert.Equal(t, []int64{1}, quote.AppliedRuleIds)
+	})
+
+	// --- SENARYO 5: Grup indirimi kampanyadan sonra kalan fiyata uygulanır ---
+	t.Run("Quote_GroupRuleStacksOnSale", func(t *testing.T) {
+		quote := pricing.NewCalculator(priceRules, wholesale, nil).Quote(product, nil)
+
+		assert.Equal(t, 81.0, quote.GroupDiscount)
+		assert.Equal(t, 81.0, quote.Price)
+	})
+
+	// --- SENARYO 6: Varyant fiyatı ürün fiyatının yerine geçer ---
+	t.Run("Quote_VariantOverride", func(t *testing.T) {
+		variantPrice := 250.0
+		quote := pricing.NewCalculator(nil, "", nil).Quote(product, &domain.ProductVariant{Price: &variantPrice})
+
+		assert.Equal(t, 250.0, quote.BasePrice)
+		assert.Equal(t, 0.0, quote.ProductDiscount)
+		assert.Equal(t, 250.0, quote.Price)
+	})
+
+	// --- SENARYO 7: Kategori kampanyası alt kategorideki ürünlere de uygulanır, benzer yollu kategoriye uygulanmaz ---
+	t.Run("Quote_CategoryRuleCoversSubcategories", func(t *testing.T) {
+		childId, siblingId := uint(8), uint(30)
+		categoryPaths := map[uint]string{3: "1/3/", 8: "1/3/8/", 30: "1/30/"}
+		categoryRules := []domain.PriceRule{{Id: 1, Kind: domain.PriceRuleKindSale, CategoryId: &categoryId, PercentOff: 10}}
+		calculator := pricing.NewCalculator(categoryRules, "", categoryPaths)
+
+		childQuote := calculator.Quote(domain.Product{Id: 2, CategoryId: &childId, BasePrice: 100, Price: 100}, nil)
+		siblingQuote := calculator.Quote(domain.Product{Id: 3, CategoryId: &siblingId, BasePrice: 100, Price: 100}, nil)
+
+		assert.Equal(t, 90.0, childQuote.Price)
+		assert.Equal(t, []int64{1}, childQuote.AppliedRuleIds)
+		assert.Equal(t, 100.0, siblingQuote.Price)
+	})
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestBundleService(t *testing.T) {
+	type mocks struct {
+		bundleRepo  *mock_repository.MockIBundleRepository
+		productRepo *mock_repository.MockIProductRepository
+		variantRepo *mock_repository.MockIProductVariantRepository
+		storeRepo   *mock_repository.MockIStoreRepository
+		redis       redismock.ClientMock
+	}
+
+	setup := func(t *testing.T) (service.IBundleService, mocks) {
+		ctrl := gomock.NewController(t)
+		db, mockRedis := redismock.NewClientMock()
+		m := mocks{
+			bundleRepo:  mock_repository.NewMockIBundleRepository(ctrl),
+			productRepo: mock_repository.NewMockIProductRepository(ctrl),
+			variantRepo: mock_repository.NewMockIProductVariantRepository(ctrl),
+			storeRepo:   mock_repository.NewMockIStoreRepository(ctrl),
+			redis:       mockRedis,
+		}
+		return service.NewBundleService(m.bundleRepo, m.productRepo, m.variantRepo, m.storeRepo, db), m
+	}
+
+	discount := 10.0
+
+	// --- SENARYO 1: Paket başka bir paketi içeremez ---
+	t.Run("SaveBundle_RejectsNestedBundle", func(t *testing.T) {
+		bundleService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10}, nil)
+		m.bundleRepo.EXPECT().IsBundleComponent(int64(10)).Return(false, nil)
+		m.variantRepo.EXPECT().GetVariantsByProductIds([]int64{10, 1, 11}).Return(map[int64][]domain.ProductVariant{}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil)
+		m.bundleRepo.EXPECT().GetBundle(int64(1)).Return(domain.ProductBundle{}, common.ErrBundleNotFound)
+		m.productRepo.EXPECT().GetProductById(int64(11)).Return(domain.Product{Id: 11}, nil)
+		m.bundleRepo.EXPECT().GetBundle(int64(11)).Return(domain.ProductBundle{ProductId: 11}, nil)
+		m.bundleRepo.EXPECT().SaveBundle(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := bundleService.SaveBundle(7, domain.UserRoleAdmin, 10, dto.SaveBundleRequest{
+			PricingMode: domain.BundlePricingDiscount, DiscountPercent: &discount,
+			Components: []dto.BundleComponentRequest{{ProductId: 1, Quantity: 1}, {ProductId: 11, Quantity: 1}},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "A bundle cannot contain another bundle")
+	})
+
+	// --- SENARYO 2: Varyantlı bileşen için varyant seçilmelidir ---
+	t.Run("SaveBundle_RequiresVariantOfComponent", func(t *testing.T) {
+		bundleService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10}, nil)
+		m.bundleRepo.EXPECT().IsBundleComponent(int64(10)).Return(false, nil)
+		m.variantRepo.EXPECT().GetVariantsByProductIds([]int64{10, 2}).Return(map[int64][]domain.ProductVariant{
+			2: {{Id: 4, ProductId: 2, IsActive: true}},
+		}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(2)).Return(domain.Product{Id: 2, Name: "Mouse"}, nil)
+		m.bundleRepo.EXPECT().GetBundle(int64(2)).Return(domain.ProductBundle{}, common.ErrBundleNotFound)
+		m.bundleRepo.EXPECT().SaveBundle(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := bundleService.SaveBundle(7, domain.UserRoleAdmin, 10, dto.SaveBundleRequest{
+			PricingMode: domain.BundlePricingDiscount, DiscountPercent: &discount,
+			Components: []dto.BundleComponentRequest{{ProductId: 2, Quantity: 2}},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Choose a variant of Mouse")
+	})
+
+	// --- SENARYO 3: Kaydedilen paket, hesaplanan fiyat ve stokla birlikte önbellekte yenilenir ---
+	t.Run("SaveBundle_RefreshesBundleProduct", func(t *testing.T) {
+		bundleService, m := setup(t)
+
+		variantId := int64(4)
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10, StoreId: 1}, nil)
+		m.storeRepo.EXPECT().IsStoreOwner(uint(1), int64(7)).Return(true, nil)
+		m.bundleRepo.EXPECT().IsBundleComponent(int64(10)).Return(false, nil)
+		m.variantRepo.EXPECT().GetVariantsByProductIds([]int64{10, 1, 2}).Return(map[int64][]domain.ProductVariant{
+			2: {{Id: 4, ProductId: 2, IsActive: true}},
+		}, nil)
+		for _, productId := range []int64{1, 2} {
+			m.productRepo.EXPECT().GetProductById(productId).Return(domain.Product{Id: uint(productId)}, nil)
+			m.bundleRepo.EXPECT().GetBundle(productId).Return(domain.ProductBundle{}, common.ErrBundleNotFound)
+		}
+		m.bundleRepo.EXPECT().SaveBundle(gomock.Any(), int64(7)).DoAndReturn(func(bundle domain.ProductBundle, _ int64) (domain.ProductBundle, error) {
+			assert.Equal(t, domain.BundlePricingDiscount, bundle.PricingMode)
+			assert.Equal(t, &variantId, bundle.Components[1].VariantId)
+			return bundle, nil
+		})
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10, Price: 1080, BasePrice: 1200, Discount: 120,
+			StockQuantity: 3}, nil)
+		m.variantRepo.EXPECT().GetVariantsByProductIds([]int64{10}).Return(map[int64][]domain.ProductVariant{}, nil)
+		m.redis.ExpectDel("product:10").SetVal(1)
+		m.productRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+
+		result, err := bundleService.SaveBundle(7, domain.UserRoleCustomer, 10, dto.SaveBundleRequest{
+			PricingMode: domain.BundlePricingDiscount, DiscountPercent: &discount,
+			Components: []dto.BundleComponentRequest{{ProductId: 1, Quantity: 1}, {ProductId: 2, VariantId: &variantId, Quantity: 2}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1080.0, result.Price)
+		assert.Equal(t, 120.0, result.Savings)
+		assert.Equal(t, 3, result.StockQuantity)
+	})
+
+	// --- SENARYO 4: Yenileme yalnızca değişen paketleri önbellekte yeniler ---
+	t.Run("RefreshBundles_RefreshesChangedBundles", func(t *testing.T) {
+		bundleService, m := setup(t)
+
+		m.bundleRepo.EXPECT().RefreshBundles().Return([]int64{10}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10}, nil)
+		m.variantRepo.EXPECT().GetVariantsByProductIds([]int64{10}).Return(map[int64][]domain.ProductVariant{}, nil)
+		m.redis.ExpectDel("product:10").SetVal(1)
+		m.productRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+
+		changed, err := bundleService.RefreshBundles()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, changed)
+	})
+
+	// --- SENARYO 5: Mağaza sahibi olmayan kullanıcı paketi kaldıramaz ---
+	t.Run("DeleteBundle_ByNonOwnerIsForbidden", func(t *testing.T) {
+		bundleService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10, StoreId: 2}, nil)
+		m.storeRepo.EXPECT().IsStoreOwner(uint(2), int64(7)).Return(false, nil)
+		m.bundleRepo.EXPECT().DeleteBundle(gomock.Any()).Times(0)
+
+		err := bundleService.DeleteBundle(7, domain.UserRoleCustomer, 10)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only the owners")
+	})
+}
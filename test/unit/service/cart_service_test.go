@@ -0,0 +1,144 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/infrastructure/rabbitmq"
+	"go-ecommerce-service/pkg/util"
+	"go-ecommerce-service/service"
+	mock_infra "go-ecommerce-service/test/mock/infrastructure"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestCartService(t *testing.T) {
+	type mocks struct {
+		cartRepo     *mock_repository.MockICartRepository
+		cartItemRepo *mock_repository.MockICartItemRepository
+		rabbit       *mock_infra.MockIRabbitMQClient
+	}
+
+	cartConfig := config.CartConfig{
+		AbandonAfter:    "2h",
+		RecoverySecret:  "test-secret",
+		RecoveryTTL:     "168h",
+		RecoveryBaseUrl: "https://shop.example.com/cart/recover",
+	}
+
+	setup := func(t *testing.T) (service.ICartService, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{
+			cartRepo:     mock_repository.NewMockICartRepository(ctrl),
+			cartItemRepo: mock_repository.NewMockICartItemRepository(ctrl),
+			rabbit:       mock_infra.NewMockIRabbitMQClient(ctrl),
+		}
+		return service.NewCartService(m.cartRepo, m.cartItemRepo, mock_repository.NewMockIProductRepository(ctrl),
+			mock_repository.NewMockIProductVariantRepository(ctrl), mock_repository.NewMockIPriceRuleRepository(ctrl),
+			m.rabbit, cartConfig), m
+	}
+
+	// --- SENARYO 1: Hareketsiz sepetler terk edilmiş işaretlenir ve kurtarma bağlantısıyla olay yayınlanır ---
+	t.Run("DetectAbandonedCarts_MarksAndPublishes", func(t *testing.T) {
+		cartService, m := setup(t)
+
+		m.cartRepo.EXPECT().GetInactiveCarts(gomock.Any()).DoAndReturn(func(inactiveSince time.Time) ([]domain.Cart, error) {
+			assert.WithinDuration(t, time.Now().Add(-2*time.Hour), inactiveSince, time.Minute)
+			return []domain.Cart{{Id: 1, UserId: 7}, {Id: 2, UserId: 8}}, nil
+		})
+		abandonedAt := time.Unix(1700000000, 0)
+		m.cartRepo.EXPECT().MarkCartAbandoned(int64(1)).Return(domain.Cart{Id: 1, UserId: 7, Status: "abandoned", AbandonedAt: &abandonedAt}, nil)
+		m.cartRepo.EXPECT().MarkCartAbandoned(int64(2)).Return(domain.Cart{}, errors.New("db down"))
+		m.cartItemRepo.EXPECT().GetItemsByCartId(int64(1)).Return([]domain.CartItem{{Id: 3, CartId: 1, ProductId: 10, Quantity: 2}})
+		m.rabbit.EXPECT().Publish("", rabbitmq.CartAbandonedQueue, false, false, gomock.Any()).DoAndReturn(
+			func(exchange, routingKey string, mandatory, immediate bool, msg amqp.Publishing) error {
+				var payload map[string]interface{}
+				assert.NoError(t, json.Unmarshal(msg.Body, &payload))
+				assert.Equal(t, "cart.abandoned", payload["event"])
+				assert.Equal(t, float64(1), payload["cart_id"])
+
+				recoveryUrl, err := url.Parse(payload["recovery_url"].(string))
+				assert.NoError(t, err)
+				assert.True(t, strings.HasPrefix(payload["recovery_url"].(string), cartConfig.RecoveryBaseUrl))
+				subject, tokenErr := util.VerifyToken("test-secret", recoveryUrl.Query().Get("token"))
+				assert.NoError(t, tokenErr)
+				assert.Equal(t, "1:1700000000", subject)
+				return nil
+			})
+
+		count, err := cartService.DetectAbandonedCarts()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	// --- SENARYO 2: Geçerli kurtarma bağlantısı sepeti kalemleriyle geri getirir ---
+	t.Run("RecoverCart_RestoresCart", func(t *testing.T) {
+		cartService, m := setup(t)
+
+		m.cartRepo.EXPECT().RestoreCart(int64(5), int64(1700000000)).Return(domain.Cart{Id: 5, UserId: 7, Status: "active"}, nil)
+		m.cartItemRepo.EXPECT().GetItemsByCartId(int64(5)).Return([]domain.CartItem{{Id: 3, CartId: 5, ProductId: 10, Quantity: 2}})
+
+		recovered, err := cartService.RecoverCart(util.SignToken("test-secret", "5:1700000000", time.Now().Add(time.Hour)))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), recovered.Cart.Id)
+		assert.Len(t, recovered.Items, 1)
+	})
+
+	// --- SENARYO 3: Başka bir anahtarla imzalanmış ya da süresi geçmiş bağlantı reddedilir ---
+	t.Run("RecoverCart_RejectsInvalidToken", func(t *testing.T) {
+		cartService, m := setup(t)
+
+		m.cartRepo.EXPECT().RestoreCart(gomock.Any(), gomock.Any()).Times(0)
+
+		_, forgedErr := cartService.RecoverCart(util.SignToken("other-secret", "5:1700000000", time.Now().Add(time.Hour)))
+		_, expiredErr := cartService.RecoverCart(util.SignToken("test-secret", "5:1700000000", time.Now().Add(-time.Hour)))
+		_, legacyErr := cartService.RecoverCart(util.SignToken("test-secret", "5", time.Now().Add(time.Hour)))
+
+		assert.Error(t, forgedErr)
+		assert.Error(t, expiredErr)
+		assert.Error(t, legacyErr)
+	})
+
+	// --- SENARYO 4: Kullanılmış ya da sepet yeniden terk edildiği için eskimiş bağlantı sepeti geri getirmez ---
+	t.Run("RecoverCart_UsedLinkIsRejected", func(t *testing.T) {
+		cartService, m := setup(t)
+
+		m.cartRepo.EXPECT().RestoreCart(int64(5), int64(1700000000)).Return(domain.Cart{}, errors.New("no rows in result set"))
+		m.cartItemRepo.EXPECT().GetItemsByCartId(gomock.Any()).Times(0)
+
+		_, err := cartService.RecoverCart(util.SignToken("test-secret", "5:1700000000", time.Now().Add(time.Hour)))
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "already used")
+	})
+
+	// --- SENARYO 5: Terk ve kurtarma oranları hesaplanır, sıfıra bölme yapılmaz ---
+	t.Run("GetAbandonmentStats_Rates", func(t *testing.T) {
+		cartService, m := setup(t)
+
+		storeId := uint(3)
+		m.cartRepo.EXPECT().GetAbandonmentStats(gomock.Any(), &storeId).Return([]domain.CartAbandonmentStat{
+			{StoreId: 3, TotalCarts: 20, AbandonedCarts: 5, RecoveredCarts: 2},
+			{StoreId: 4, TotalCarts: 0},
+		}, nil)
+
+		stats, err := cartService.GetAbandonmentStats(time.Now().AddDate(0, 0, -30), &storeId)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0.25, stats[0].AbandonmentRate)
+		assert.Equal(t, 0.4, stats[0].RecoveryRate)
+		assert.Equal(t, 0.0, stats[1].AbandonmentRate)
+		assert.Equal(t, 0.0, stats[1].RecoveryRate)
+	})
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestCategoryService(t *testing.T) {
+	type mocks struct {
+		categoryRepo    *mock_repository.MockICategoryRepository
+		translationRepo *mock_repository.MockITranslationRepository
+	}
+
+	setup := func(t *testing.T) (service.ICategoryService, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{
+			categoryRepo:    mock_repository.NewMockICategoryRepository(ctrl),
+			translationRepo: mock_repository.NewMockITranslationRepository(ctrl),
+		}
+		return service.NewCategoryService(m.categoryRepo, m.translationRepo, testLocales), m
+	}
+
+	parentId := func(id uint) *uint { return &id }
+
+	// --- SENARYO 1: Ağaç kök kategorilerden başlayarak iç içe kurulur, pasif dallar istenirse çıkarılır ---
+	t.Run("GetCategoryTree_NestsAndPrunesInactive", func(t *testing.T) {
+		categoryService, m := setup(t)
+
+		categories := []domain.Category{
+			{Id: 1, Name: "Elektronik", IsActive: true, Path: "1/"},
+			{Id: 2, Name: "Giyim", IsActive: true, Path: "2/"},
+			{Id: 4, Name: "Bilgisayar", IsActive: true, ParentId: parentId(1), Path: "1/4/"},
+			{Id: 5, Name: "Eski Seri", IsActive: false, ParentId: parentId(1), Path: "1/5/"},
+			{Id: 9, Name: "Dizüstü", IsActive: true, ParentId: parentId(4), Path: "1/4/9/"},
+			{Id: 10, Name: "Yedek Parça", IsActive: true, ParentId: parentId(5), Path: "1/5/10/"},
+		}
+		m.categoryRepo.EXPECT().GetAllCategories().Return(categories).Times(2)
+
+		tree := categoryService.GetCategoryTree("tr", false)
+		assert.Len(t, tree, 2)
+		assert.Equal(t, uint(1), tree[0].Id)
+		assert.Len(t, tree[0].Children, 2)
+		assert.Equal(t, uint(9), tree[0].Children[0].Children[0].Id)
+		assert.Empty(t, tree[1].Children)
+
+		activeTree := categoryService.GetCategoryTree("tr", true)
+		assert.Len(t, activeTree[0].Children, 1)
+		assert.Equal(t, uint(4), activeTree[0].Children[0].Id)
+	})
+
+	// --- SENARYO 2: Alt ağaç istenen kategoriden başlar ---
+	t.Run("GetCategorySubtree_StartsAtCategory", func(t *testing.T) {
+		categoryService, m := setup(t)
+
+		m.categoryRepo.EXPECT().GetSubtree(uint(4)).Return([]domain.Category{
+			{Id: 4, Name: "Bilgisayar", IsActive: true, ParentId: parentId(1), Path: "1/4/"},
+			{Id: 9, Name: "Dizüstü", IsActive: true, ParentId: parentId(4), Path: "1/4/9/"},
+		}, nil)
+
+		subtree, err := categoryService.GetCategorySubtree(4, "tr", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(4), subtree.Id)
+		assert.Len(t, subtree.Children, 1)
+		assert.Equal(t, "Dizüstü", subtree.Children[0].Name)
+	})
+
+	// --- SENARYO 3: Kategori kendi alt ağacına taşınamaz ---
+	t.Run("MoveCategory_RejectsCycle", func(t *testing.T) {
+		categoryService, m := setup(t)
+
+		m.categoryRepo.EXPECT().GetCategoryById(9).Return(domain.Category{Id: 9, Path: "1/4/9/"}, nil)
+		m.categoryRepo.EXPECT().MoveCategory(uint(4), parentId(9), 0).Return(domain.Category{}, common.ErrCategoryCycle)
+
+		_, err := categoryService.MoveCategory(4, dto.MoveCategoryRequest{ParentId: parentId(9)})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), common.ErrCategoryCycle.Error())
+	})
+
+	// --- SENARYO 4: Alt kategorisi olan kategori silinemez ---
+	t.Run("DeleteCategory_WithSubcategories", func(t *testing.T) {
+		categoryService, m := setup(t)
+
+		m.categoryRepo.EXPECT().GetSubtree(uint(1)).Return([]domain.Category{
+			{Id: 1, Path: "1/"}, {Id: 4, ParentId: parentId(1), Path: "1/4/"},
+		}, nil)
+
+		err := categoryService.DeleteCategory(1)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/util"
+	"go-ecommerce-service/service"
+	mock_infra "go-ecommerce-service/test/mock/infrastructure"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestDigitalService(t *testing.T) {
+	type mocks struct {
+		digitalRepo *mock_repository.MockIDigitalRepository
+		productRepo *mock_repository.MockIProductRepository
+		storeRepo   *mock_repository.MockIStoreRepository
+		orderRepo   *mock_repository.MockIOrderRepository
+		storage     *mock_infra.MockIObjectStorage
+	}
+
+	digitalConfig := config.DigitalConfig{
+		DownloadBaseUrl: "https://api.example.com/api/v1/downloads/",
+		DownloadSecret:  "test-secret",
+		LinkTTL:         "15m",
+		DownloadExpiry:  "720h",
+		DownloadLimit:   3,
+	}
+
+	setup := func(t *testing.T) (service.IDigitalService, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{
+			digitalRepo: mock_repository.NewMockIDigitalRepository(ctrl),
+			productRepo: mock_repository.NewMockIProductRepository(ctrl),
+			storeRepo:   mock_repository.NewMockIStoreRepository(ctrl),
+			orderRepo:   mock_repository.NewMockIOrderRepository(ctrl),
+			storage:     mock_infra.NewMockIObjectStorage(ctrl),
+		}
+		return service.NewDigitalService(m.digitalRepo, m.productRepo, m.storeRepo, m.orderRepo, m.storage, digitalConfig), m
+	}
+
+	// --- SENARYO 1: Fiziksel ürüne dosya yüklenemez ---
+	t.Run("UploadFile_RejectsPhysicalProduct", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, ProductType: domain.ProductTypePhysical}, nil)
+		m.storage.EXPECT().PutStream(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := digitalService.UploadFile(9, domain.UserRoleAdmin, 1, dto.UploadDigitalFileRequest{
+			FileName: "kitap.pdf", Content: strings.NewReader("%PDF"), Size: 4,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "digital products")
+	})
+
+	// --- SENARYO 2: Dosya özel depoya ürün altında saklanır, yoldan arındırılmış adıyla kaydedilir ---
+	t.Run("UploadFile_StoresPrivately", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(2)).Return(domain.Product{Id: 2, StoreId: 3, ProductType: domain.ProductTypeDigital}, nil)
+		m.storeRepo.EXPECT().IsStoreOwner(uint(3), int64(9)).Return(true, nil)
+		m.storage.EXPECT().PutStream(gomock.Any(), gomock.Any(), int64(8), "application/pdf").DoAndReturn(
+			func(key string, content io.Reader, size int64, contentType string) error {
+				stored, _ := io.ReadAll(content)
+				assert.Equal(t, "%PDF-1.7", string(stored))
+				assert.True(t, strings.HasPrefix(key, "products/2/"))
+				assert.True(t, strings.HasSuffix(key, ".pdf"))
+				return nil
+			})
+		m.digitalRepo.EXPECT().AddFile(gomock.Any()).DoAndReturn(func(file domain.DigitalFile) (domain.DigitalFile, error) {
+			assert.Equal(t, "kitap.pdf", file.FileName)
+			assert.Equal(t, int64(8), file.SizeBytes)
+			file.Id = 5
+			return file, nil
+		})
+
+		result, err := digitalService.UploadFile(9, domain.UserRoleCustomer, 2, dto.UploadDigitalFileRequest{
+			FileName: `C:\indirilenler\kitap.pdf`, ContentType: "application/pdf", Content: strings.NewReader("%PDF-1.7"), Size: 8,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), result.Id)
+	})
+
+	// --- SENARYO 3: Lisans anahtarları kırpılır, tekrarlar ve boşlar atlanır ---
+	t.Run("AddLicenseKeys_TrimsAndSkipsRepeats", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(2)).Return(domain.Product{Id: 2, ProductType: domain.ProductTypeDigital}, nil)
+		m.digitalRepo.EXPECT().AddLicenseKeys(int64(2), []string{"AAAA-1111", "BBBB-2222"}).Return(1, nil)
+		m.digitalRepo.EXPECT().GetLicenseKeyPool(int64(2)).Return(domain.LicenseKeyPool{Available: 4, Assigned: 6}, nil)
+
+		pool, err := digitalService.AddLicenseKeys(9, domain.UserRoleAdmin, 2, dto.AddLicenseKeysRequest{Keys: []string{" AAAA-1111 ", "BBBB-2222", "", "AAAA-1111"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.LicenseKeyPoolResponse{ProductId: 2, Added: 1, Available: 4, Assigned: 6}, pool)
+	})
+
+	// --- SENARYO 4: Başkasının siparişinin indirmeleri görülemez ---
+	t.Run("GetOrderDownloads_ForeignOrder", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		m.orderRepo.EXPECT().GetOrderById(int64(7)).Return(domain.Order{Id: 7, UserId: 2})
+		m.digitalRepo.EXPECT().GetOrderDownloads(gomock.Any()).Times(0)
+
+		_, err := digitalService.GetOrderDownloads(9, 7)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Order not found")
+	})
+
+	// --- SENARYO 5: Yalnızca kullanılabilir indirmelere imzalı bağlantı verilir, anahtarlar listelenir ---
+	t.Run("GetOrderDownloads_SignsAvailableDownloads", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		orderItemId := int64(11)
+		m.orderRepo.EXPECT().GetOrderById(int64(7)).Return(domain.Order{Id: 7, UserId: 9})
+		m.digitalRepo.EXPECT().GetOrderDownloads(int64(7)).Return([]domain.DownloadGrant{
+			{Id: 21, OrderItemId: 11, FileId: 1, DownloadLimit: 3, DownloadCount: 1, ExpiresAt: time.Now().Add(time.Hour),
+				File: domain.DigitalFile{Id: 1, ProductId: 2, FileName: "kitap.pdf"}},
+			{Id: 22, OrderItemId: 11, FileId: 2, DownloadLimit: 3, DownloadCount: 3, ExpiresAt: time.Now().Add(time.Hour),
+				File: domain.DigitalFile{Id: 2, ProductId: 2, FileName: "kitap.epub"}},
+		}, nil)
+		m.digitalRepo.EXPECT().GetOrderLicenseKeys(int64(7)).Return([]domain.LicenseKey{
+			{Id: 1, ProductId: 2, Key: "AAAA-1111", OrderItemId: &orderItemId},
+		}, nil)
+
+		result, err := digitalService.GetOrderDownloads(9, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Downloads[0].DownloadsLeft)
+		assert.True(t, strings.HasPrefix(result.Downloads[0].Url, "https://api.example.com/api/v1/downloads/"))
+		token := strings.TrimPrefix(result.Downloads[0].Url, "https://api.example.com/api/v1/downloads/")
+		subject, tokenErr := util.VerifyToken("test-secret", token)
+		assert.NoError(t, tokenErr)
+		assert.Equal(t, "21", subject)
+		// Hakkı biten indirme bağlantısız listelenir
+		assert.Empty(t, result.Downloads[1].Url)
+		assert.Equal(t, "AAAA-1111", result.LicenseKeys[0].LicenseKey)
+	})
+
+	// --- SENARYO 6: İmzalı bağlantı dosyayı döndürür ve indirmeyi sayar ---
+	t.Run("Download_ServesFileAndCounts", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		grant := domain.DownloadGrant{Id: 21, DownloadLimit: 3, DownloadCount: 2, ExpiresAt: time.Now().Add(time.Hour),
+			File: domain.DigitalFile{FileName: "kitap.pdf", StorageKey: "products/2/a.pdf", ContentType: "application/pdf"}}
+		m.digitalRepo.EXPECT().GetDownloadGrant(int64(21)).Return(grant, nil)
+		m.storage.EXPECT().Open("products/2/a.pdf").Return(io.NopCloser(strings.NewReader("%PDF")), nil)
+		m.digitalRepo.EXPECT().RecordDownload(int64(21)).Return(grant, nil)
+
+		file, err := digitalService.Download(util.SignToken("test-secret", "21", time.Now().Add(time.Minute)))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "kitap.pdf", file.FileName)
+		content, _ := io.ReadAll(file.Content)
+		assert.Equal(t, "%PDF", string(content))
+	})
+
+	// --- SENARYO 7: Hakkı biten indirme dosyayı okumadan reddedilir ---
+	t.Run("Download_LimitReached", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		m.digitalRepo.EXPECT().GetDownloadGrant(int64(21)).Return(domain.DownloadGrant{
+			Id: 21, DownloadLimit: 3, DownloadCount: 3, ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+		m.storage.EXPECT().Open(gomock.Any()).Times(0)
+		m.digitalRepo.EXPECT().RecordDownload(gomock.Any()).Times(0)
+
+		_, err := digitalService.Download(util.SignToken("test-secret", "21", time.Now().Add(time.Minute)))
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Download limit reached")
+	})
+
+	// --- SENARYO 8: Teslimat, yapılandırılan indirme hakkı ve süresiyle izin verir, anahtarları atar ---
+	t.Run("FulfillOrders_GrantsAndAssigns", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		m.digitalRepo.EXPECT().GrantDownloads(3, gomock.Any()).DoAndReturn(func(limit int, expiresAt time.Time) (int, error) {
+			assert.WithinDuration(t, time.Now().Add(720*time.Hour), expiresAt, time.Minute)
+			return 2, nil
+		})
+		m.digitalRepo.EXPECT().AssignLicenseKeys().Return(4, 1, nil)
+
+		run, err := digitalService.FulfillOrders()
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.FulfillmentRun{Grants: 2, Keys: 4, WaitingLines: 1}, run)
+	})
+
+	// --- SENARYO 9: Mağazanın sahibi olmayan kullanıcı dijital ürünün dosyalarını ve anahtarlarını yönetemez ---
+	t.Run("AddLicenseKeys_RejectsUserNotOwningTheStore", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(2)).Return(domain.Product{Id: 2, StoreId: 3, ProductType: domain.ProductTypeDigital}, nil)
+		m.storeRepo.EXPECT().IsStoreOwner(uint(3), int64(9)).Return(false, nil)
+		m.digitalRepo.EXPECT().AddLicenseKeys(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := digitalService.AddLicenseKeys(9, domain.UserRoleCustomer, 2, dto.AddLicenseKeysRequest{Keys: []string{"AAAA-1111"}})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "owners of the product's store")
+	})
+
+	// --- SENARYO 10: Türü bilinmeyen dosyanın türü ilk baytlarından bulunur, içerik eksiksiz saklanır ---
+	t.Run("UploadFile_SniffsTypeWithoutLosingContent", func(t *testing.T) {
+		digitalService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(2)).Return(domain.Product{Id: 2, ProductType: domain.ProductTypeDigital}, nil)
+		m.storage.EXPECT().PutStream(gomock.Any(), gomock.Any(), int64(8), "application/pdf").DoAndReturn(
+			func(key string, content io.Reader, size int64, contentType string) error {
+				stored, _ := io.ReadAll(content)
+				assert.Equal(t, "%PDF-1.7", string(stored))
+				return nil
+			})
+		m.digitalRepo.EXPECT().AddFile(gomock.Any()).DoAndReturn(func(file domain.DigitalFile) (domain.DigitalFile, error) {
+			assert.Equal(t, "application/pdf", file.ContentType)
+			return file, nil
+		})
+
+		_, err := digitalService.UploadFile(9, domain.UserRoleAdmin, 2, dto.UploadDigitalFileRequest{
+			FileName: "kitap.pdf", ContentType: "application/octet-stream", Content: strings.NewReader("%PDF-1.7"), Size: 8,
+		})
+
+		assert.NoError(t, err)
+	})
+}
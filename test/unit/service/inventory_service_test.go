@@ -0,0 +1,164 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestInventoryService(t *testing.T) {
+	// --- SENARYO 1: Satış hareketi stoktan düşülür ve ürün yeniden indekslenir ---
+	t.Run("RecordMovement_SaleIsBookedNegative", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockInventoryRepo := mock_repository.NewMockIInventoryRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		inventoryService := service.NewInventoryService(mockInventoryRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, StockQuantity: 100}, nil)
+		mockInventoryRepo.EXPECT().GetWarehouseById(int64(2)).Return(domain.Warehouse{Id: 2, Code: "ANK", IsActive: true}, nil)
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any()).DoAndReturn(func(movements []domain.StockMovement) ([]domain.StockMovement, error) {
+			assert.Len(t, movements, 1)
+			assert.Equal(t, -3, movements[0].Quantity)
+			assert.Equal(t, int64(7), *movements[0].CreatedBy)
+			booked := movements[0]
+			booked.Id = 10
+			booked.BalanceAfter = 97
+			return []domain.StockMovement{booked}, nil
+		})
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, StockQuantity: 97}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).DoAndReturn(func(product domain.Product) error {
+			assert.Equal(t, 97, product.StockQuantity)
+			return nil
+		})
+
+		movement, err := inventoryService.RecordMovement(7, domain.UserRoleAdmin, 1, dto.CreateStockMovementRequest{
+			WarehouseId: 2, Type: domain.StockMovementSale, Quantity: 3,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 97, movement.BalanceAfter)
+	})
+
+	// --- SENARYO 2: Transfer iki bacak olarak aynı referansla kaydedilir ---
+	t.Run("TransferStock_BooksBothLegs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockInventoryRepo := mock_repository.NewMockIInventoryRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		inventoryService := service.NewInventoryService(mockInventoryRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil).Times(2)
+		mockInventoryRepo.EXPECT().GetWarehouseById(int64(1)).Return(domain.Warehouse{Id: 1, IsActive: true}, nil)
+		mockInventoryRepo.EXPECT().GetWarehouseById(int64(2)).Return(domain.Warehouse{Id: 2, IsActive: true}, nil)
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any()).DoAndReturn(func(movements []domain.StockMovement) ([]domain.StockMovement, error) {
+			assert.Len(t, movements, 2)
+			assert.Equal(t, -5, movements[0].Quantity)
+			assert.Equal(t, 5, movements[1].Quantity)
+			assert.Equal(t, *movements[0].Reference, *movements[1].Reference)
+			return movements, nil
+		})
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+
+		movements, err := inventoryService.TransferStock(7, domain.UserRoleAdmin, 1, dto.StockTransferRequest{FromWarehouseId: 1, ToWarehouseId: 2, Quantity: 5})
+
+		assert.NoError(t, err)
+		assert.Len(t, movements, 2)
+	})
+
+	// --- SENARYO 3: Depoda yeterli stok yoksa hareket reddedilir ---
+	t.Run("RecordMovement_InsufficientStock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockInventoryRepo := mock_repository.NewMockIInventoryRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		inventoryService := service.NewInventoryService(mockInventoryRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil)
+		mockInventoryRepo.EXPECT().GetWarehouseById(int64(1)).Return(domain.Warehouse{Id: 1, IsActive: true}, nil)
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any()).Return(nil, common.ErrInsufficientStock)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Times(0)
+
+		_, err := inventoryService.RecordMovement(7, domain.UserRoleAdmin, 1, dto.CreateStockMovementRequest{
+			WarehouseId: 1, Type: domain.StockMovementSale, Quantity: 500,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), common.ErrInsufficientStock.Error())
+	})
+
+	// --- SENARYO 4: Pasif depodaki stok satılabilir miktara sayılmaz ---
+	t.Run("GetProductStock_SkipsInactiveWarehouses", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockInventoryRepo := mock_repository.NewMockIInventoryRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		inventoryService := service.NewInventoryService(mockInventoryRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		variantId := int64(4)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil)
+		mockInventoryRepo.EXPECT().GetStockLevels(int64(1)).Return([]domain.StockLevel{
+			{WarehouseId: 1, ProductId: 1, Quantity: 10},
+			{WarehouseId: 2, ProductId: 1, Quantity: 5},
+			{WarehouseId: 1, ProductId: 1, VariantId: &variantId, Quantity: 3},
+		}, nil)
+		mockInventoryRepo.EXPECT().GetWarehouses().Return([]domain.Warehouse{
+			{Id: 1, Code: "IST", IsActive: true},
+			{Id: 2, Code: "ANK", IsActive: false},
+		}, nil)
+
+		stock, err := inventoryService.GetProductStock(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 10, stock.AvailableToSell)
+		assert.Len(t, stock.Locations, 3)
+		assert.False(t, stock.Locations[1].IsSellable)
+		assert.Equal(t, []dto.VariantStockResponse{{VariantId: 4, AvailableToSell: 3}}, stock.Variants)
+	})
+
+	// --- SENARYO 5: Mağaza sahibi olmayan müşteri stok hareketi kaydedemez ---
+	t.Run("RecordMovement_ByNonOwnerIsForbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockInventoryRepo := mock_repository.NewMockIInventoryRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		inventoryService := service.NewInventoryService(mockInventoryRepo, mockProductRepo,
+			mock_repository.NewMockIProductVariantRepository(ctrl), mockStoreRepo, db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, StoreId: 3}, nil)
+		mockStoreRepo.EXPECT().IsStoreOwner(uint(3), int64(7)).Return(false, nil)
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any()).Times(0)
+
+		_, err := inventoryService.RecordMovement(7, domain.UserRoleCustomer, 1, dto.CreateStockMovementRequest{
+			WarehouseId: 1, Type: domain.StockMovementReceipt, Quantity: 500,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "owners of the product's store")
+	})
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestOrderItemService(t *testing.T) {
+	// --- SENARYO 1: Sipariş kalemi müşterinin grubuna göre fiyatlanır ---
+	t.Run("AddOrderItem_PricesForCustomerGroup", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOrderItemRepo := mock_repository.NewMockIOrderItemRepository(ctrl)
+		mockOrderRepo := mock_repository.NewMockIOrderRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockRuleRepo := mock_repository.NewMockIPriceRuleRepository(ctrl)
+		mockBundleRepo := mock_repository.NewMockIBundleRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		orderItemService := service.NewOrderItemService(mockOrderItemRepo, mockOrderRepo, mockProductRepo, mockVariantRepo, mockRuleRepo,
+			mockBundleRepo, db)
+
+		wholesale := "wholesale"
+		mockOrderRepo.EXPECT().GetOrderById(int64(5)).Return(domain.Order{Id: 5, UserId: 9})
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, BasePrice: 100, Price: 100}, nil)
+		mockRuleRepo.EXPECT().GetActiveRules(gomock.Any()).Return([]domain.PriceRule{
+			{Id: 1, Kind: domain.PriceRuleKindCustomerGroup, CustomerGroup: &wholesale, PercentOff: 20},
+		}, nil)
+		mockRuleRepo.EXPECT().GetCustomerGroup(int64(9)).Return(wholesale, nil)
+		mockBundleRepo.EXPECT().GetBundle(int64(1)).Return(domain.ProductBundle{}, common.ErrBundleNotFound)
+		mockOrderItemRepo.EXPECT().AddOrderItem(gomock.Any()).DoAndReturn(func(orderItem domain.OrderItem) (domain.OrderItem, error) {
+			// İstemcinin gönderdiği değil, hesaplanan fiyat yazılmalı
+			assert.Equal(t, float32(80), orderItem.Price)
+			orderItem.Id = 1
+			return orderItem, nil
+		})
+		// Satılan stok düştüğü için ürün ve ürünü içeren paketler yenilenir
+		mockBundleRepo.EXPECT().RefreshBundles().Return([]int64{}, nil)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+		mockRedis.ExpectDel("product:1").SetVal(1)
+
+		result, err := orderItemService.AddOrderItem(dto.CreateOrderItemRequest{OrderId: 5, ProductId: 1, Quantity: 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, float32(80), result.Price)
+	})
+
+	// --- SENARYO 2: Başka ürünün varyantı kabul edilmez ---
+	t.Run("AddOrderItem_RejectsForeignVariant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOrderItemRepo := mock_repository.NewMockIOrderItemRepository(ctrl)
+		mockOrderRepo := mock_repository.NewMockIOrderRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockRuleRepo := mock_repository.NewMockIPriceRuleRepository(ctrl)
+		mockBundleRepo := mock_repository.NewMockIBundleRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		orderItemService := service.NewOrderItemService(mockOrderItemRepo, mockOrderRepo, mockProductRepo, mockVariantRepo, mockRuleRepo,
+			mockBundleRepo, db)
+
+		variantId := int64(12)
+		mockOrderRepo.EXPECT().GetOrderById(int64(5)).Return(domain.Order{Id: 5, UserId: 9})
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Price: 100}, nil)
+		mockVariantRepo.EXPECT().GetVariantById(variantId).Return(domain.ProductVariant{Id: variantId, ProductId: 2}, nil)
+		mockOrderItemRepo.EXPECT().AddOrderItem(gomock.Any()).Times(0)
+
+		_, err := orderItemService.AddOrderItem(dto.CreateOrderItemRequest{OrderId: 5, ProductId: 1, VariantId: &variantId, Quantity: 1})
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 3: Paket siparişi bileşenlerine ayrılır, paket fiyatı liste fiyatlarına göre paylaştırılır ---
+	t.Run("AddOrderItem_BundleBreakdown", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOrderItemRepo := mock_repository.NewMockIOrderItemRepository(ctrl)
+		mockOrderRepo := mock_repository.NewMockIOrderRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockRuleRepo := mock_repository.NewMockIPriceRuleRepository(ctrl)
+		mockBundleRepo := mock_repository.NewMockIBundleRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		orderItemService := service.NewOrderItemService(mockOrderItemRepo, mockOrderRepo, mockProductRepo, mockVariantRepo, mockRuleRepo,
+			mockBundleRepo, db)
+
+		mouseVariant := int64(4)
+		mockOrderRepo.EXPECT().GetOrderById(int64(5)).Return(domain.Order{Id: 5, UserId: 9})
+		mockProductRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10, BasePrice: 1200, Price: 1000}, nil)
+		mockRuleRepo.EXPECT().GetActiveRules(gomock.Any()).Return([]domain.PriceRule{}, nil)
+		mockRuleRepo.EXPECT().GetCustomerGroup(int64(9)).Return("", nil).AnyTimes()
+		mockBundleRepo.EXPECT().GetBundle(int64(10)).Return(domain.ProductBundle{ProductId: 10, Components: []domain.BundleComponent{
+			{ProductId: 1, Quantity: 1, ListPrice: 900},
+			{ProductId: 2, VariantId: &mouseVariant, Quantity: 2, ListPrice: 150},
+		}}, nil)
+		mockOrderItemRepo.EXPECT().AddOrderItem(gomock.Any()).Times(0)
+		mockBundleRepo.EXPECT().AddBundleOrderItem(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(orderItem domain.OrderItem, components []domain.OrderItemComponent) (domain.OrderItem, []domain.OrderItemComponent, error) {
+				assert.Equal(t, float32(1000), orderItem.Price)
+				// Bileşen adetleri sipariş adediyle çarpılır
+				assert.Equal(t, 3, components[0].Quantity)
+				assert.Equal(t, 6, components[1].Quantity)
+				assert.Equal(t, 750.0, components[0].AllocatedPrice)
+				assert.Equal(t, 250.0, components[1].AllocatedPrice)
+				orderItem.Id = 7
+				for i := range components {
+					components[i].OrderItemId = 7
+				}
+				return orderItem, components, nil
+			})
+		mockBundleRepo.EXPECT().RefreshBundles().Return([]int64{10}, nil)
+		for _, productId := range []int64{1, 2, 10} {
+			mockProductRepo.EXPECT().GetProductById(productId).Return(domain.Product{Id: uint(productId)}, nil)
+			mockRedis.ExpectDel(fmt.Sprintf("product:%d", productId)).SetVal(1)
+		}
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1, 2, 10}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil).Times(3)
+
+		result, err := orderItemService.AddOrderItem(dto.CreateOrderItemRequest{OrderId: 5, ProductId: 10, Quantity: 3})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Components, 2)
+		assert.Equal(t, &mouseVariant, result.Components[1].VariantId)
+	})
+
+	// --- SENARYO 4: Paket satırının adedi değiştirilemez ---
+	t.Run("UpdateOrderItemQuantity_RejectsBundleLine", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOrderItemRepo := mock_repository.NewMockIOrderItemRepository(ctrl)
+		mockBundleRepo := mock_repository.NewMockIBundleRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		orderItemService := service.NewOrderItemService(mockOrderItemRepo, mock_repository.NewMockIOrderRepository(ctrl),
+			mock_repository.NewMockIProductRepository(ctrl), mock_repository.NewMockIProductVariantRepository(ctrl),
+			mock_repository.NewMockIPriceRuleRepository(ctrl), mockBundleRepo, db)
+
+		mockBundleRepo.EXPECT().GetOrderItemComponents([]int64{7}).Return(map[int64][]domain.OrderItemComponent{
+			7: {{OrderItemId: 7, ProductId: 1, Quantity: 1}},
+		}, nil)
+		mockOrderItemRepo.EXPECT().UpdateOrderItemQuantity(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := orderItemService.UpdateOrderItemQuantity(7, 2)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Bundle order lines cannot be changed")
+	})
+
+	// --- SENARYO 5: Yeterli stok yoksa sipariş kalemi eklenmez ---
+	t.Run("AddOrderItem_InsufficientStock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOrderItemRepo := mock_repository.NewMockIOrderItemRepository(ctrl)
+		mockOrderRepo := mock_repository.NewMockIOrderRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockRuleRepo := mock_repository.NewMockIPriceRuleRepository(ctrl)
+		mockBundleRepo := mock_repository.NewMockIBundleRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		orderItemService := service.NewOrderItemService(mockOrderItemRepo, mockOrderRepo, mockProductRepo,
+			mock_repository.NewMockIProductVariantRepository(ctrl), mockRuleRepo, mockBundleRepo, db)
+
+		mockOrderRepo.EXPECT().GetOrderById(int64(5)).Return(domain.Order{Id: 5, UserId: 9})
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, BasePrice: 100, Price: 100}, nil)
+		mockRuleRepo.EXPECT().GetActiveRules(gomock.Any()).Return([]domain.PriceRule{}, nil)
+		mockRuleRepo.EXPECT().GetCustomerGroup(int64(9)).Return("", nil).AnyTimes()
+		mockBundleRepo.EXPECT().GetBundle(int64(1)).Return(domain.ProductBundle{}, common.ErrBundleNotFound)
+		mockOrderItemRepo.EXPECT().AddOrderItem(gomock.Any()).Return(domain.OrderItem{}, common.ErrInsufficientStock)
+		mockBundleRepo.EXPECT().RefreshBundles().Times(0)
+
+		_, err := orderItemService.AddOrderItem(dto.CreateOrderItemRequest{OrderId: 5, ProductId: 1, Quantity: 50})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), common.ErrInsufficientStock.Error())
+	})
+
+	// --- SENARYO 6: Silinen satırın stoğu geri döner, ürün yenilenir ---
+	t.Run("DeleteOrderItemById_RefreshesReturnedStock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOrderItemRepo := mock_repository.NewMockIOrderItemRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockBundleRepo := mock_repository.NewMockIBundleRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		orderItemService := service.NewOrderItemService(mockOrderItemRepo, mock_repository.NewMockIOrderRepository(ctrl),
+			mockProductRepo, mockVariantRepo, mock_repository.NewMockIPriceRuleRepository(ctrl), mockBundleRepo, db)
+
+		mockBundleRepo.EXPECT().GetOrderItemComponents([]int64{7}).Return(map[int64][]domain.OrderItemComponent{}, nil)
+		mockOrderItemRepo.EXPECT().GetOrderItemById(int64(7)).Return(domain.OrderItem{Id: 7, OrderId: 5, ProductId: 1, Quantity: 2}, nil)
+		mockOrderItemRepo.EXPECT().DeleteOrderItemById(int64(7)).Return(nil)
+		mockBundleRepo.EXPECT().RefreshBundles().Return([]int64{10}, nil)
+		for _, productId := range []int64{1, 10} {
+			mockProductRepo.EXPECT().GetProductById(productId).Return(domain.Product{Id: uint(productId)}, nil)
+			mockRedis.ExpectDel(fmt.Sprintf("product:%d", productId)).SetVal(1)
+		}
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1, 10}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil).Times(2)
+
+		err := orderItemService.DeleteOrderItemById(7)
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestProductAttributeService(t *testing.T) {
+	// --- SENARYO 1: Aynı kategoride aynı kodla ikinci özellik eklenemez ---
+	t.Run("AddAttribute_DuplicateCode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		attributeService := service.NewProductAttributeService(mockAttributeRepo, mockCategoryRepo, mockProductRepo, mockVariantRepo, db)
+
+		mockCategoryRepo.EXPECT().GetCategoryById(1).Return(domain.Category{Id: 1}, nil)
+		mockAttributeRepo.EXPECT().GetCategoryAttributes(int64(1)).Return([]domain.CategoryAttribute{{Id: 1, CategoryId: 1, Code: "color"}}, nil)
+		mockAttributeRepo.EXPECT().AddAttribute(gomock.Any()).Times(0)
+
+		_, err := attributeService.AddAttribute(1, dto.CreateCategoryAttributeRequest{
+			Code: "color", Name: "Renk", Type: domain.AttributeTypeEnum, Options: []string{"Siyah"},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "already exists")
+	})
+
+	// --- SENARYO 2: Ürünlerin kullandığı enum seçeneği kaldırılamaz ---
+	t.Run("UpdateAttribute_KeepsUsedOptions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		attributeService := service.NewProductAttributeService(mockAttributeRepo, mockCategoryRepo, mockProductRepo, mockVariantRepo, db)
+
+		mockAttributeRepo.EXPECT().GetAttributeById(int64(2)).Return(domain.CategoryAttribute{
+			Id: 2, CategoryId: 1, Code: "color", Type: domain.AttributeTypeEnum, Options: []string{"Siyah", "Gri"},
+		}, nil)
+		mockAttributeRepo.EXPECT().GetUsedValues(int64(2)).Return([]string{"Gri"}, nil)
+		mockAttributeRepo.EXPECT().UpdateAttribute(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := attributeService.UpdateAttribute(1, 2, dto.UpdateCategoryAttributeRequest{Name: "Renk", Options: []string{"Siyah"}})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Option Gri is still used")
+	})
+
+	// --- SENARYO 3: Filtrelenebilir özellikler değer sayıları ve sayı aralığı ile listelenir ---
+	t.Run("GetFacets_GroupsValuesAndRanges", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		attributeService := service.NewProductAttributeService(mockAttributeRepo, mockCategoryRepo, mockProductRepo, mockVariantRepo, db)
+
+		minSize, maxSize := 13.3, 17.3
+		mockCategoryRepo.EXPECT().GetCategoryById(1).Return(domain.Category{Id: 1}, nil)
+		mockAttributeRepo.EXPECT().GetCategoryAttributes(int64(1)).Return([]domain.CategoryAttribute{
+			{Id: 1, CategoryId: 1, Code: "screen_size", Type: domain.AttributeTypeNumber, Unit: "inch", IsFilterable: true},
+			{Id: 2, CategoryId: 1, Code: "color", Type: domain.AttributeTypeEnum, IsFilterable: true},
+			{Id: 3, CategoryId: 1, Code: "warranty", Type: domain.AttributeTypeText},
+		}, nil)
+		mockAttributeRepo.EXPECT().GetFacets(int64(1)).Return([]domain.AttributeFacet{
+			{AttributeId: 1, MinNumber: &minSize, MaxNumber: &maxSize, Count: 4},
+			{AttributeId: 2, Value: "Gri", Count: 3},
+			{AttributeId: 2, Value: "Siyah", Count: 1},
+		}, nil)
+
+		facets, err := attributeService.GetFacets(1)
+
+		assert.NoError(t, err)
+		assert.Len(t, facets, 2)
+		assert.Equal(t, 13.3, *facets[0].Min)
+		assert.Equal(t, 17.3, *facets[0].Max)
+		assert.Equal(t, 4, facets[1].Count)
+		assert.Equal(t, "Gri", facets[1].Values[0].Value)
+	})
+}
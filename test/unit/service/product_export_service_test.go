@@ -0,0 +1,105 @@
+package service
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestProductExportService(t *testing.T) {
+	setup := func(t *testing.T) (service.IProductExportService, *mock_repository.MockIProductRepository, *mock_repository.MockIProductVariantRepository) {
+		ctrl := gomock.NewController(t)
+		productRepo := mock_repository.NewMockIProductRepository(ctrl)
+		variantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		exportConfig := config.ExportConfig{BatchSize: 2, SiteBaseUrl: "https://shop.example.com/", Currency: "TRY"}
+		return service.NewProductExportService(productRepo, variantRepo, exportConfig), productRepo, variantRepo
+	}
+
+	// --- SENARYO 1: CSV sayfa sayfa okunur ---
+	t.Run("WriteExport_CsvReadsInBatches", func(t *testing.T) {
+		exportService, productRepo, variantRepo := setup(t)
+
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		storeId := uint(1)
+		firstPage := []domain.Product{
+			{Id: 3, Name: "Laptop", Slug: "laptop", Price: 100, StoreId: 1, CreatedAt: createdAt},
+			{Id: 2, Name: "Mouse", Slug: "mouse", Price: 10, StoreId: 1, CreatedAt: createdAt},
+		}
+		gomock.InOrder(
+			productRepo.EXPECT().GetProducts(gomock.Any()).DoAndReturn(func(filter domain.ProductFilter) ([]domain.Product, error) {
+				assert.Equal(t, 2, filter.Limit)
+				assert.Equal(t, &storeId, filter.StoreId)
+				assert.Nil(t, filter.After)
+				return firstPage, nil
+			}),
+			productRepo.EXPECT().GetProducts(gomock.Any()).DoAndReturn(func(filter domain.ProductFilter) ([]domain.Product, error) {
+				// İkinci sayfa son kaydın imlecinden devam etmeli
+				assert.Equal(t, uint(2), filter.After.Id)
+				return []domain.Product{{Id: 1, Name: "Cable", Slug: "cable", Price: 5, StoreId: 1, CreatedAt: createdAt}}, nil
+			}),
+		)
+		variantRepo.EXPECT().GetVariantsByProductIds(gomock.Any()).Return(map[int64][]domain.ProductVariant{}, nil).Times(2)
+
+		var out bytes.Buffer
+		err := exportService.WriteExport(dto.ProductExportRequest{Format: "csv", StoreId: &storeId}, &out)
+
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		assert.Len(t, lines, 4)
+		assert.True(t, strings.HasPrefix(lines[0], "id,sku,name,slug"))
+		assert.True(t, strings.HasPrefix(lines[1], "3,,Laptop,laptop,,100.00"))
+	})
+
+	// --- SENARYO 2: Google feed varyantları ayrı ürün olarak yazar ---
+	t.Run("WriteExport_GoogleFeedListsVariants", func(t *testing.T) {
+		exportService, productRepo, variantRepo := setup(t)
+
+		sku := "TSHIRT"
+		salePrice := 80.0
+		productRepo.EXPECT().GetProducts(gomock.Any()).DoAndReturn(func(filter domain.ProductFilter) ([]domain.Product, error) {
+			// Feed varsayılan olarak sadece aktif ürünleri listeler
+			assert.True(t, *filter.IsActive)
+			return []domain.Product{{Id: 7, Name: "T-Shirt", Slug: "t-shirt", Sku: &sku, Price: 100, BasePrice: 120, IsActive: true}}, nil
+		})
+		variantRepo.EXPECT().GetVariantsByProductIds([]int64{7}).Return(map[int64][]domain.ProductVariant{
+			7: {
+				{Id: 1, ProductId: 7, Sku: "TSHIRT-M", StockQuantity: 3, IsActive: true, Options: []domain.VariantOption{{Value: "M"}}},
+				{Id: 2, ProductId: 7, Sku: "TSHIRT-L", Price: &salePrice, IsActive: true, Options: []domain.VariantOption{{Value: "L"}}},
+				{Id: 3, ProductId: 7, Sku: "TSHIRT-XL", IsActive: false},
+			},
+		}, nil)
+
+		var out bytes.Buffer
+		err := exportService.WriteExport(dto.ProductExportRequest{Format: "google"}, &out)
+
+		assert.NoError(t, err)
+		feed := out.String()
+		assert.Contains(t, feed, `xmlns:g="http://base.google.com/ns/1.0"`)
+		assert.Contains(t, feed, "<g:id>TSHIRT-M</g:id>")
+		assert.Contains(t, feed, "<g:item_group_id>TSHIRT</g:item_group_id>")
+		assert.Contains(t, feed, "<title>T-Shirt - M</title>")
+		assert.Contains(t, feed, "<g:price>120.00 TRY</g:price><g:sale_price>80.00 TRY</g:sale_price><g:availability>out_of_stock</g:availability>")
+		assert.Contains(t, feed, "<link>https://shop.example.com/products/t-shirt?variant=1</link>")
+		assert.NotContains(t, feed, "TSHIRT-XL")
+		assert.True(t, strings.HasSuffix(feed, "</channel></rss>\n"))
+	})
+
+	// --- SENARYO 3: Bilinmeyen format ---
+	t.Run("ExportFile_UnknownFormat", func(t *testing.T) {
+		exportService, _, _ := setup(t)
+
+		_, err := exportService.ExportFile(dto.ProductExportRequest{Format: "xlsx"})
+
+		assert.Error(t, err)
+	})
+}
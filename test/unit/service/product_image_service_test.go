@@ -0,0 +1,168 @@
+package service
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/infrastructure/storage"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_infra "go-ecommerce-service/test/mock/infrastructure"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestProductImageService(t *testing.T) {
+	mediaConfig := config.MediaConfig{MaxImagesPerProduct: 2, ThumbnailWidths: "100", OrphanGracePeriod: "1h"}
+
+	// --- SENARYO 1: Yüklenen resim, küçük resimleri ile saklanır ve galeriye eklenir ---
+	t.Run("UploadImage_StoresOriginalAndRenditions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockImageRepo := mock_repository.NewMockIProductImageRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockStorage := mock_infra.NewMockIObjectStorage(ctrl)
+		db, _ := redismock.NewClientMock()
+		imageService := service.NewProductImageService(mockImageRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db, mockStorage, mediaConfig)
+
+		var content bytes.Buffer
+		assert.NoError(t, jpeg.Encode(&content, image.NewRGBA(image.Rect(0, 0, 300, 150)), nil))
+
+		storedKeys := []string{}
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil).Times(2)
+		mockImageRepo.EXPECT().GetImages(int64(1)).Return([]domain.ProductImage{}, nil)
+		mockStorage.EXPECT().URL(gomock.Any()).DoAndReturn(func(key string) string { return "http://media.test/" + key }).AnyTimes()
+		mockStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content []byte, contentType string) error {
+			assert.True(t, strings.HasPrefix(key, "products/1/"))
+			storedKeys = append(storedKeys, key)
+			return nil
+		}).Times(4)
+		mockImageRepo.EXPECT().AddImage(gomock.Any()).DoAndReturn(func(image domain.ProductImage) (domain.ProductImage, error) {
+			assert.Equal(t, "Front view", image.AltText)
+			assert.Equal(t, 300, image.Width)
+			assert.Len(t, image.Renditions, 3)
+			image.Id = 9
+			return image, nil
+		})
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+
+		uploaded, err := imageService.UploadImage(1, domain.UserRoleAdmin, 1, dto.UploadProductImageRequest{Content: content.Bytes(), AltText: " Front view "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), uploaded.Id)
+		assert.True(t, strings.HasSuffix(storedKeys[0], "/original.jpg"))
+		assert.Equal(t, "http://media.test/"+storedKeys[0], uploaded.Url)
+		assert.Equal(t, []string{"w100", "w100_webp", "webp"},
+			[]string{uploaded.Renditions[0].Name, uploaded.Renditions[1].Name, uploaded.Renditions[2].Name})
+	})
+
+	// --- SENARYO 2: Galeri doluysa yükleme reddedilir ---
+	t.Run("UploadImage_GalleryFull", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockImageRepo := mock_repository.NewMockIProductImageRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockStorage := mock_infra.NewMockIObjectStorage(ctrl)
+		db, _ := redismock.NewClientMock()
+		imageService := service.NewProductImageService(mockImageRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db, mockStorage, mediaConfig)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil)
+		mockImageRepo.EXPECT().GetImages(int64(1)).Return([]domain.ProductImage{{Id: 1}, {Id: 2}}, nil)
+		mockStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := imageService.UploadImage(1, domain.UserRoleAdmin, 1, dto.UploadProductImageRequest{Content: []byte("image")})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Product gallery is full")
+	})
+
+	// --- SENARYO 3: Sıralama galerideki her resmi bir kez içermelidir ---
+	t.Run("ReorderImages_RequiresEveryImage", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockImageRepo := mock_repository.NewMockIProductImageRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockStorage := mock_infra.NewMockIObjectStorage(ctrl)
+		db, _ := redismock.NewClientMock()
+		imageService := service.NewProductImageService(mockImageRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db, mockStorage, mediaConfig)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil)
+		mockImageRepo.EXPECT().GetImages(int64(1)).Return([]domain.ProductImage{{Id: 1}, {Id: 2}}, nil)
+		mockImageRepo.EXPECT().ReorderImages(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := imageService.ReorderImages(1, domain.UserRoleAdmin, 1, dto.ReorderProductImagesRequest{ImageIds: []int64{2, 2}})
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 4: Sadece referansı olmayan ve bekleme süresini geçen dosyalar silinir ---
+	t.Run("CleanupOrphanedFiles_DeletesUnreferencedOldFiles", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockImageRepo := mock_repository.NewMockIProductImageRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockStorage := mock_infra.NewMockIObjectStorage(ctrl)
+		db, _ := redismock.NewClientMock()
+		imageService := service.NewProductImageService(mockImageRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db, mockStorage, mediaConfig)
+
+		old := time.Now().Add(-2 * time.Hour)
+		mockStorage.EXPECT().List("products/").Return([]storage.StoredObject{
+			{Key: "products/1/a/original.jpg", ModifiedAt: old},
+			{Key: "products/1/b/original.jpg", ModifiedAt: old},
+			{Key: "products/1/c/original.jpg", ModifiedAt: time.Now()},
+		}, nil)
+		mockImageRepo.EXPECT().GetStorageKeys().Return(map[string]bool{"products/1/a/original.jpg": true}, nil)
+		mockStorage.EXPECT().Delete("products/1/b/original.jpg").Return(nil)
+
+		count, err := imageService.CleanupOrphanedFiles()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	// --- SENARYO 5: Mağaza sahibi olmayan kullanıcı resim silemez ---
+	t.Run("DeleteImage_ByNonOwnerIsForbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockImageRepo := mock_repository.NewMockIProductImageRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockStorage := mock_infra.NewMockIObjectStorage(ctrl)
+		db, _ := redismock.NewClientMock()
+		imageService := service.NewProductImageService(mockImageRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db, mockStorage, mediaConfig)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, StoreId: 2}, nil)
+		mockStoreRepo.EXPECT().IsStoreOwner(uint(2), int64(5)).Return(false, nil)
+		mockImageRepo.EXPECT().DeleteImage(gomock.Any()).Times(0)
+		mockStorage.EXPECT().Delete(gomock.Any()).Times(0)
+
+		err := imageService.DeleteImage(5, domain.UserRoleCustomer, 1, 3)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only the owners")
+	})
+}
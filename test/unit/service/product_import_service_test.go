@@ -0,0 +1,208 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestProductImportService(t *testing.T) {
+	type mocks struct {
+		importRepo  *mock_repository.MockIProductImportRepository
+		productRepo *mock_repository.MockIProductRepository
+		variantRepo *mock_repository.MockIProductVariantRepository
+		storeRepo   *mock_repository.MockIStoreRepository
+	}
+
+	setup := func(t *testing.T) (service.IProductImportService, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{
+			importRepo:  mock_repository.NewMockIProductImportRepository(ctrl),
+			productRepo: mock_repository.NewMockIProductRepository(ctrl),
+			variantRepo: mock_repository.NewMockIProductVariantRepository(ctrl),
+			storeRepo:   mock_repository.NewMockIStoreRepository(ctrl),
+		}
+		db, _ := redismock.NewClientMock()
+		return service.NewProductImportService(m.importRepo, m.productRepo, m.variantRepo, m.storeRepo, db, config.ImportConfig{BatchSize: 2}), m
+	}
+
+	// --- SENARYO 1: Geçerli satırlar yazılır, hatalı satır rapora düşer ---
+	t.Run("StartImport_ReportsEachRow", func(t *testing.T) {
+		importService, m := setup(t)
+
+		csvFile := "sku,name,description,price\n" +
+			"LAP-1,Laptop,Yeni laptop,15000\n" +
+			",Mouse,Kablosuz mouse,500\n" +
+			"KB-1,Klavye,Mekanik klavye,abc\n"
+		storeId := uint(1)
+
+		// Varsayılan mağaza iş başlarken, geçerli satırın mağazası işlenirken kontrol edilir
+		m.storeRepo.EXPECT().IsStoreOwner(storeId, int64(7)).Return(true, nil).Times(2)
+		m.importRepo.EXPECT().CreateJob(gomock.Any()).DoAndReturn(func(job domain.ProductImportJob) (domain.ProductImportJob, error) {
+			assert.Equal(t, int64(7), *job.CreatedBy)
+			assert.Equal(t, 3, job.TotalRows)
+			assert.Equal(t, domain.ImportMatchBySku, job.MatchBy)
+			job.Id = 5
+			return job, nil
+		})
+		m.importRepo.EXPECT().UpsertProducts(domain.ImportMatchBySku, gomock.Any(), int64(7)).DoAndReturn(func(_ string, products []domain.Product, _ int64) ([]domain.ProductUpsert, error) {
+			// Sadece geçerli satır veritabanına gitmeli
+			assert.Len(t, products, 1)
+			assert.Equal(t, "LAP-1", *products[0].Sku)
+			products[0].Id = 42
+			return []domain.ProductUpsert{{Product: products[0], Created: true}}, nil
+		})
+		m.variantRepo.EXPECT().GetVariantsByProductIds([]int64{42}).Return(map[int64][]domain.ProductVariant{}, nil)
+		m.productRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+
+		var results []domain.ProductImportResult
+		m.importRepo.EXPECT().AddResults(gomock.Any()).DoAndReturn(func(batch []domain.ProductImportResult) error {
+			results = append(results, batch...)
+			return nil
+		}).Times(2)
+
+		finished := make(chan domain.ProductImportJob, 1)
+		m.importRepo.EXPECT().UpdateJob(gomock.Any()).DoAndReturn(func(job domain.ProductImportJob) error {
+			if job.CompletedAt != nil {
+				finished <- job
+			}
+			return nil
+		}).AnyTimes()
+
+		job, err := importService.StartImport(7, domain.UserRoleCustomer, "products.csv", []byte(csvFile),
+			dto.ProductImportOptions{DefaultStoreId: &storeId})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), job.Id)
+
+		select {
+		case completed := <-finished:
+			assert.Equal(t, domain.ImportStatusCompleted, completed.Status)
+			assert.Equal(t, 3, completed.ProcessedRows)
+			assert.Equal(t, 1, completed.CreatedCount)
+			assert.Equal(t, 2, completed.FailedCount)
+		case <-time.After(2 * time.Second):
+			t.Fatal("Import işi tamamlanmadı")
+		}
+
+		assert.Len(t, results, 3)
+		assert.Equal(t, domain.ImportRowCreated, results[0].Status)
+		assert.Equal(t, domain.ImportRowFailed, results[1].Status)
+		assert.Contains(t, *results[2].Message, "price")
+	})
+
+	// --- SENARYO 2: Desteklenmeyen dosya formatı ---
+	t.Run("StartImport_UnsupportedFormat", func(t *testing.T) {
+		importService, _ := setup(t)
+
+		_, err := importService.StartImport(7, domain.UserRoleAdmin, "products.xlsx", []byte("data"), dto.ProductImportOptions{})
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 3: Rapor CSV olarak yazılır ---
+	t.Run("WriteImportReport_WritesCsv", func(t *testing.T) {
+		importService, m := setup(t)
+
+		key, productId, message := "LAP-1", int64(42), "name: required"
+		userId := int64(7)
+		m.importRepo.EXPECT().GetJobById(int64(5)).Return(domain.ProductImportJob{Id: 5, CreatedBy: &userId}, nil)
+		m.importRepo.EXPECT().GetResultsByJobId(int64(5)).Return([]domain.ProductImportResult{
+			{RowNumber: 1, Status: domain.ImportRowUpdated, MatchKey: &key, ProductId: &productId},
+			{RowNumber: 2, Status: domain.ImportRowFailed, Message: &message},
+		}, nil)
+
+		var report bytes.Buffer
+		err := importService.WriteImportReport(userId, domain.UserRoleCustomer, 5, &report)
+
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(report.String()), "\n")
+		assert.Equal(t, "row,status,match_key,product_id,message", lines[0])
+		assert.Equal(t, "1,updated,LAP-1,42,", lines[1])
+		assert.Equal(t, "2,failed,,,name: required", lines[2])
+	})
+
+	// --- SENARYO 4: Olmayan iş ---
+	t.Run("GetImportJob_NotFound", func(t *testing.T) {
+		importService, m := setup(t)
+
+		m.importRepo.EXPECT().GetJobById(int64(9)).Return(domain.ProductImportJob{}, errors.New("Import job not found"))
+
+		_, err := importService.GetImportJob(7, domain.UserRoleAdmin, 9)
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 5: Kullanıcının sahibi olmadığı mağazaya ait satırlar yazılmaz ---
+	t.Run("StartImport_RowsOfOtherStoresFail", func(t *testing.T) {
+		importService, m := setup(t)
+
+		csvFile := "sku,name,description,price,store_id\n" +
+			"LAP-1,Laptop,Yeni laptop,15000,1\n" +
+			"LAP-2,Laptop Pro,Yeni laptop,25000,2\n"
+
+		m.storeRepo.EXPECT().IsStoreOwner(uint(1), int64(7)).Return(true, nil)
+		m.storeRepo.EXPECT().IsStoreOwner(uint(2), int64(7)).Return(false, nil)
+		m.importRepo.EXPECT().CreateJob(gomock.Any()).DoAndReturn(func(job domain.ProductImportJob) (domain.ProductImportJob, error) {
+			job.Id = 6
+			return job, nil
+		})
+		m.importRepo.EXPECT().UpsertProducts(domain.ImportMatchBySku, gomock.Any(), int64(7)).DoAndReturn(func(_ string, products []domain.Product, _ int64) ([]domain.ProductUpsert, error) {
+			assert.Len(t, products, 1)
+			assert.Equal(t, uint(1), products[0].StoreId)
+			products[0].Id = 42
+			return []domain.ProductUpsert{{Product: products[0], Created: true}}, nil
+		})
+		m.variantRepo.EXPECT().GetVariantsByProductIds([]int64{42}).Return(map[int64][]domain.ProductVariant{}, nil)
+		m.productRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+
+		var results []domain.ProductImportResult
+		m.importRepo.EXPECT().AddResults(gomock.Any()).DoAndReturn(func(batch []domain.ProductImportResult) error {
+			results = append(results, batch...)
+			return nil
+		})
+		finished := make(chan domain.ProductImportJob, 1)
+		m.importRepo.EXPECT().UpdateJob(gomock.Any()).DoAndReturn(func(job domain.ProductImportJob) error {
+			if job.CompletedAt != nil {
+				finished <- job
+			}
+			return nil
+		}).AnyTimes()
+
+		_, err := importService.StartImport(7, domain.UserRoleCustomer, "products.csv", []byte(csvFile), dto.ProductImportOptions{})
+		assert.NoError(t, err)
+
+		select {
+		case completed := <-finished:
+			assert.Equal(t, 1, completed.CreatedCount)
+			assert.Equal(t, 1, completed.FailedCount)
+		case <-time.After(2 * time.Second):
+			t.Fatal("Import işi tamamlanmadı")
+		}
+		assert.Contains(t, *results[1].Message, "not one of your stores")
+	})
+
+	// --- SENARYO 6: Başka kullanıcının başlattığı iş görünmez ---
+	t.Run("GetImportJob_OtherUsersJobIsHidden", func(t *testing.T) {
+		importService, m := setup(t)
+
+		ownerId := int64(8)
+		m.importRepo.EXPECT().GetJobById(int64(5)).Return(domain.ProductImportJob{Id: 5, CreatedBy: &ownerId}, nil)
+
+		_, err := importService.GetImportJob(7, domain.UserRoleCustomer, 5)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Import job not found")
+	})
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestProductPriceService(t *testing.T) {
+	// --- SENARYO 1: Son 30 günün en düşük fiyatı geçmişten gelir ---
+	t.Run("GetLowestPrice_UsesHistory", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPriceRepo := mock_repository.NewMockIProductPriceRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		priceService := service.NewProductPriceService(mockPriceRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db)
+
+		lowest := 12000.0
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Price: 13500}, nil)
+		mockPriceRepo.EXPECT().GetLowestPrice(int64(1), gomock.Any()).Return(&lowest, nil)
+
+		result, err := priceService.GetLowestPrice(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 13500.0, result.CurrentPrice)
+		assert.Equal(t, 12000.0, result.LowestPrice)
+		assert.Equal(t, 30, result.Days)
+	})
+
+	// --- SENARYO 2: Çakışan fiyat planı reddedilir ---
+	t.Run("AddSchedule_RejectsOverlap", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPriceRepo := mock_repository.NewMockIProductPriceRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		priceService := service.NewProductPriceService(mockPriceRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db)
+
+		startsAt := time.Now().Add(time.Hour)
+		endsAt := startsAt.Add(48 * time.Hour)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Price: 15000, BasePrice: 15000}, nil)
+		mockPriceRepo.EXPECT().HasOverlappingSchedule(int64(1), startsAt, &endsAt).Return(true, nil)
+		mockPriceRepo.EXPECT().AddSchedule(gomock.Any()).Times(0)
+
+		_, err := priceService.AddSchedule(1, domain.UserRoleAdmin, 1, dto.CreatePriceScheduleRequest{Price: 12000, StartsAt: startsAt, EndsAt: &endsAt})
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 3: Zamanı gelen planlar uygulanır, biten planlar geri alınır ---
+	t.Run("ApplySchedules_FinishesAndStarts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPriceRepo := mock_repository.NewMockIProductPriceRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		priceService := service.NewProductPriceService(mockPriceRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db)
+
+		ending := domain.PriceSchedule{Id: 1, ProductId: 1, Status: domain.PriceScheduleStatusActive}
+		starting := domain.PriceSchedule{Id: 2, ProductId: 2, Price: 90, Status: domain.PriceScheduleStatusScheduled}
+
+		mockPriceRepo.EXPECT().GetSchedulesToFinish(gomock.Any()).Return([]domain.PriceSchedule{ending}, nil)
+		mockPriceRepo.EXPECT().FinishSchedule(ending, domain.PriceScheduleStatusCompleted).Return(domain.Product{Id: 1, Price: 100}, true, nil)
+		mockPriceRepo.EXPECT().GetSchedulesToStart(gomock.Any()).Return([]domain.PriceSchedule{starting}, nil)
+		mockPriceRepo.EXPECT().StartSchedule(starting).Return(domain.Product{Id: 2, Price: 90}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1, 2}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil).Times(2)
+		mockRedis.ExpectDel("product:1").SetVal(1)
+		mockRedis.ExpectDel("product:2").SetVal(1)
+
+		run, err := priceService.ApplySchedules()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, run.Started)
+		assert.Equal(t, 1, run.Finished)
+		if err := mockRedis.ExpectationsWereMet(); err != nil {
+			t.Error("Redis işlemleri eksik kaldı:", err)
+		}
+	})
+
+	// --- SENARYO 4: Mağaza sahibi olmayan kullanıcı fiyat planını iptal edemez ---
+	t.Run("CancelSchedule_ByNonOwnerIsForbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPriceRepo := mock_repository.NewMockIProductPriceRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		priceService := service.NewProductPriceService(mockPriceRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db)
+
+		mockPriceRepo.EXPECT().GetScheduleById(int64(3)).Return(domain.PriceSchedule{Id: 3, ProductId: 1, Status: domain.PriceScheduleStatusActive}, nil)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, StoreId: 2}, nil)
+		mockStoreRepo.EXPECT().IsStoreOwner(uint(2), int64(5)).Return(false, nil)
+		mockPriceRepo.EXPECT().FinishSchedule(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := priceService.CancelSchedule(5, domain.UserRoleCustomer, 3)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only the owners")
+	})
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestProductReviewService(t *testing.T) {
+	// --- SENARYO 1: Ürünü satın almayan kullanıcı yorum yapamaz ---
+	t.Run("AddReview_RejectsCustomerWithoutPurchase", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockReviewRepo := mock_repository.NewMockIProductReviewRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		reviewService := service.NewProductReviewService(mockReviewRepo, mockProductRepo, mockVariantRepo, db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil)
+		mockReviewRepo.EXPECT().HasPurchased(int64(7), int64(1)).Return(false, nil)
+		mockReviewRepo.EXPECT().AddReview(gomock.Any()).Times(0)
+
+		_, err := reviewService.AddReview(7, 1, dto.CreateReviewRequest{Rating: 5, Title: "Harika", Body: "Çok memnun kaldım"})
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 2: Onaylanan yorum ürün puanını yeniler ---
+	t.Run("ModerateReview_ApproveRefreshesRating", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockReviewRepo := mock_repository.NewMockIProductReviewRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		reviewService := service.NewProductReviewService(mockReviewRepo, mockProductRepo, mockVariantRepo, db)
+
+		pending := domain.ProductReview{Id: 3, ProductId: 1, UserId: 7, Rating: 4, Status: domain.ReviewStatusPending}
+		approved := pending
+		approved.Status = domain.ReviewStatusApproved
+
+		mockReviewRepo.EXPECT().GetReviewById(int64(3)).Return(pending, nil)
+		mockReviewRepo.EXPECT().ModerateReview(int64(3), domain.ReviewStatusApproved, gomock.Nil()).Return(approved, nil)
+		mockReviewRepo.EXPECT().RefreshProductRating(int64(1)).Return(nil)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, AverageRating: 4, ReviewCount: 1}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).DoAndReturn(func(product domain.Product) error {
+			assert.Equal(t, 4.0, product.AverageRating)
+			return nil
+		})
+		mockRedis.ExpectDel("product:1").SetVal(1)
+
+		review, err := reviewService.ModerateReview(3, dto.ModerateReviewRequest{Status: domain.ReviewStatusApproved})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReviewStatusApproved, review.Status)
+		if err := mockRedis.ExpectationsWereMet(); err != nil {
+			t.Error("Redis işlemleri eksik kaldı:", err)
+		}
+	})
+
+	// --- SENARYO 3: Kullanıcı kendi yorumuna oy veremez ---
+	t.Run("VoteReview_RejectsOwnReview", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockReviewRepo := mock_repository.NewMockIProductReviewRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		reviewService := service.NewProductReviewService(mockReviewRepo, mockProductRepo, mockVariantRepo, db)
+
+		mockReviewRepo.EXPECT().GetReviewById(int64(3)).Return(domain.ProductReview{Id: 3, UserId: 7, Status: domain.ReviewStatusApproved}, nil)
+		mockReviewRepo.EXPECT().VoteReview(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := reviewService.VoteReview(7, 3, dto.ReviewVoteRequest{Helpful: true})
+
+		assert.Error(t, err)
+	})
+}
@@ -12,10 +12,13 @@ import (
 
 	"go-ecommerce-service/domain"
 	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/locale"
 	"go-ecommerce-service/service"
 	mock_repository "go-ecommerce-service/test/mock/repository"
 )
 
+var testLocales = locale.New("tr", "tr,en")
+
 func TestProductService(t *testing.T) {
 	// --- SENARYO 1: Başarılı Getirme (Redis Boş -> DB Dolu -> Redis Yaz) ---
 	t.Run("GetProductById_Success_FromDB", func(t *testing.T) {
@@ -24,21 +27,27 @@ func TestProductService(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
 		db, mockRedis := redismock.NewClientMock()
-		productService := service.NewProductService(mockRepo, db)
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
 
 		// 2. Veri Hazırlığı
 		productId := int64(1)
 		// Domain'den gelen veri
 		domainProduct := domain.Product{
 			Id: 1, Name: "Laptop", Price: 15000,
-			Description: "Test Desc", StockQuantity: 5,
+			Description: "Test Desc", StockQuantity: 5, Status: domain.ProductStatusPublished,
 		}
 
 		// Redis'e yazılması beklenen veri (Service içindeki dönüşümü taklit ediyoruz)
 		expectedResponse := dto.ProductResponse{
 			Id: domainProduct.Id, Name: domainProduct.Name, Price: domainProduct.Price,
-			Description: domainProduct.Description, StockQuantity: domainProduct.StockQuantity,
+			Description: domainProduct.Description, StockQuantity: domainProduct.StockQuantity, Status: domainProduct.Status,
 		}
 		expectedJson, _ := json.Marshal(expectedResponse)
 
@@ -48,12 +57,14 @@ func TestProductService(t *testing.T) {
 
 		// B. DB'ye sor -> VAR
 		mockRepo.EXPECT().GetProductById(productId).Return(domainProduct, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{productId}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockAttributeRepo.EXPECT().GetAttributeValues([]int64{productId}).Return(map[int64][]domain.ProductAttributeValue{}, nil)
 
 		// C. Redis'e YAZ (Düzeltme: gomock.Any() yerine gerçek JSON verisini bekliyoruz)
 		mockRedis.ExpectSet("product:1", expectedJson, 10*time.Minute).SetVal("OK")
 
 		// 4. Çalıştır
-		result, err := productService.GetProductById(productId)
+		result, err := productService.GetProductById(productId, "tr")
 
 		// 5. Kontrol
 		assert.NoError(t, err)
@@ -70,8 +81,14 @@ func TestProductService(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
 		db, mockRedis := redismock.NewClientMock()
-		productService := service.NewProductService(mockRepo, db)
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
 
 		productId := int64(99)
 
@@ -80,7 +97,7 @@ func TestProductService(t *testing.T) {
 		mockRepo.EXPECT().GetProductById(productId).Return(domain.Product{}, errors.New("product not found"))
 
 		// Çalıştır
-		_, err := productService.GetProductById(productId)
+		_, err := productService.GetProductById(productId, "tr")
 
 		// Kontrol
 		assert.Error(t, err)
@@ -97,8 +114,14 @@ func TestProductService(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
 		db, _ := redismock.NewClientMock() // Redis kullanmıyoruz ama servise lazım
-		productService := service.NewProductService(mockRepo, db)
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
 
 		// Düzeltme: Validasyonun geçmesi için tüm zorunlu alanları doldurduk
 		req := dto.CreateProductRequest{
@@ -110,14 +133,383 @@ func TestProductService(t *testing.T) {
 		}
 
 		// Mock Beklentisi
-		mockRepo.EXPECT().AddProduct(gomock.Any()).Return(domain.Product{
-			Id: 1, Name: req.Name, Price: req.Price,
-		}, nil)
+		// Yeni ürünler taslak olarak kaydedilir
+		mockRepo.EXPECT().AddProduct(gomock.Any(), int64(7)).DoAndReturn(func(product domain.Product, _ int64) (domain.Product, error) {
+			assert.Equal(t, domain.ProductStatusDraft, product.Status)
+			product.Id = 1
+			return product, nil
+		})
 
 		// Çalıştır
-		_, err := productService.AddProduct(req)
+		_, err := productService.AddProduct(7, domain.UserRoleAdmin, req)
 
 		// Kontrol
 		assert.NoError(t, err)
 	})
+	// --- SENARYO 4: Listeleme (limit+1 kayıt -> sonraki sayfa var) ---
+	t.Run("ListProducts_ReturnsPageWithCursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		storeId := uint(3)
+		mockRepo.EXPECT().CountProducts(gomock.Any()).Return(5, nil)
+		mockRepo.EXPECT().GetProducts(gomock.Any()).DoAndReturn(func(filter domain.ProductFilter) ([]domain.Product, error) {
+			// Servis bir fazla kayıt istemeli ve varsayılan sıralamayı uygulamalı
+			assert.Equal(t, 3, filter.Limit)
+			assert.Equal(t, domain.ProductSortPriceAsc, filter.Sort)
+			assert.Equal(t, &storeId, filter.StoreId)
+			assert.True(t, *filter.IsActive)
+			return []domain.Product{{Id: 1, Price: 10}, {Id: 2, Price: 20}, {Id: 3, Price: 30}}, nil
+		})
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1, 2}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockAttributeRepo.EXPECT().GetAttributeValues([]int64{1, 2}).Return(map[int64][]domain.ProductAttributeValue{}, nil)
+
+		page, err := productService.ListProducts(dto.ProductListRequest{StoreId: &storeId, Sort: domain.ProductSortPriceAsc, Limit: 2})
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.True(t, page.Page.HasMore)
+		assert.Equal(t, 5, page.Page.Total)
+		assert.NotEmpty(t, page.Page.NextCursor)
+	})
+
+	// --- SENARYO 5: Geçersiz sıralama ---
+	t.Run("ListProducts_InvalidSort", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		_, err := productService.ListProducts(dto.ProductListRequest{Sort: "popularity"})
+
+		assert.Error(t, err)
+	})
+	// --- SENARYO 6: Güncelleme slug'ı değiştirmez ---
+	t.Run("UpdateProduct_KeepsSlugWhenNotRequested", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		mockRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Slug: "laptop-001"}, nil)
+		mockRepo.EXPECT().UpdateProduct(uint(1), gomock.Any(), int64(7)).DoAndReturn(func(_ uint, product domain.Product, _ int64) (domain.Product, error) {
+			assert.Equal(t, "laptop-001", product.Slug)
+			product.Id = 1
+			return product, nil
+		})
+		mockSlugRepo.EXPECT().RecordSlug(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockRedis.ExpectDel("product:1").SetVal(1)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+
+		_, err := productService.UpdateProduct(7, domain.UserRoleAdmin, 1, dto.CreateProductRequest{Name: "Laptop Pro", Description: "Yeni isim"})
+
+		assert.NoError(t, err)
+	})
+
+	// --- SENARYO 7: Eski slug güncel slug'a yönlendirilir ---
+	t.Run("GetProductBySlug_HistoricalSlugRedirects", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		mockRepo.EXPECT().GetProductBySlug("old-laptop").Return(domain.Product{}, errors.New("Product not found"))
+		mockTranslationRepo.EXPECT().GetProductTranslationBySlug("old-laptop").Return(domain.ProductTranslation{}, errors.New("Translation not found"))
+		mockSlugRepo.EXPECT().GetBySlug(domain.SlugEntityProduct, "old-laptop").Return(domain.SlugHistory{EntityId: 1, Slug: "old-laptop"}, nil)
+		mockRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Slug: "laptop", Status: domain.ProductStatusPublished}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockAttributeRepo.EXPECT().GetAttributeValues([]int64{1}).Return(map[int64][]domain.ProductAttributeValue{}, nil)
+
+		product, currentSlug, err := productService.GetProductBySlug("old-laptop", "tr")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "laptop", currentSlug)
+		assert.Equal(t, uint(1), product.Id)
+	})
+	// --- SENARYO 8: Özellikler kategori şemasına göre doğrulanır ve tipli olarak kaydedilir ---
+	t.Run("AddProduct_ValidatesAttributesAgainstCategorySchema", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		categoryId := uint(1)
+		schema := []domain.CategoryAttribute{
+			{Id: 1, CategoryId: 1, Code: "screen_size", Type: domain.AttributeTypeNumber, Unit: "inch", IsRequired: true},
+			{Id: 2, CategoryId: 1, Code: "color", Type: domain.AttributeTypeEnum, Options: []string{"Siyah", "Gri"}},
+		}
+		mockAttributeRepo.EXPECT().GetCategoryAttributes(int64(1)).Return(schema, nil).Times(2)
+		mockRepo.EXPECT().AddProduct(gomock.Any(), int64(7)).DoAndReturn(func(product domain.Product, _ int64) (domain.Product, error) {
+			assert.Len(t, product.Attributes, 2)
+			assert.Equal(t, 15.6, *product.Attributes[0].NumberValue)
+			assert.Equal(t, "Gri", *product.Attributes[1].TextValue)
+			product.Id = 1
+			return product, nil
+		})
+
+		req := dto.CreateProductRequest{Name: "Laptop", Description: "15.6 inç", Price: 100, StoreId: 1, CategoryId: &categoryId,
+			Attributes: map[string]interface{}{"screen_size": 15.6, "color": "Gri"}}
+		product, err := productService.AddProduct(7, domain.UserRoleAdmin, req)
+		assert.NoError(t, err)
+		assert.Equal(t, 15.6, product.Attributes[0].Value)
+
+		// Listede olmayan enum değeri reddedilir
+		req.Attributes = map[string]interface{}{"screen_size": 15.6, "color": "Mor"}
+		_, err = productService.AddProduct(7, domain.UserRoleAdmin, req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "color must be one of")
+	})
+
+	// --- SENARYO 9: attr.* parametreleri listeleme filtresine çevrilir ---
+	t.Run("ListProducts_ParsesAttributeFilters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		mockRepo.EXPECT().CountProducts(gomock.Any()).Return(0, nil)
+		mockRepo.EXPECT().GetProducts(gomock.Any()).DoAndReturn(func(filter domain.ProductFilter) ([]domain.Product, error) {
+			assert.Len(t, filter.Attributes, 2)
+			assert.Equal(t, "color", filter.Attributes[0].Code)
+			assert.Equal(t, []string{"Siyah", "Gri"}, filter.Attributes[0].Values)
+			assert.Equal(t, "screen_size", filter.Attributes[1].Code)
+			assert.Equal(t, 13.0, *filter.Attributes[1].Min)
+			return []domain.Product{}, nil
+		})
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockAttributeRepo.EXPECT().GetAttributeValues([]int64{}).Return(map[int64][]domain.ProductAttributeValue{}, nil)
+
+		_, err := productService.ListProducts(dto.ProductListRequest{
+			Attributes: map[string]string{"color": "Siyah,Gri", "screen_size.min": "13"},
+		})
+		assert.NoError(t, err)
+
+		_, err = productService.ListProducts(dto.ProductListRequest{Attributes: map[string]string{"screen_size.min": "büyük"}})
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 10: Varsayılan slug başka bir dilde istenirse o dilin slug'ına yönlendirilir ---
+	t.Run("GetProductBySlug_RedirectsToLocaleSlug", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		mockRepo.EXPECT().GetProductBySlug("dizustu-bilgisayar").Return(domain.Product{
+			Id: 1, Name: "Dizüstü Bilgisayar", Slug: "dizustu-bilgisayar", Status: domain.ProductStatusPublished,
+		}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockAttributeRepo.EXPECT().GetAttributeValues([]int64{1}).Return(map[int64][]domain.ProductAttributeValue{}, nil)
+		mockTranslationRepo.EXPECT().GetProductTranslationsByLocale("en", []int64{1}).Return(map[int64]domain.ProductTranslation{
+			1: {ProductId: 1, Locale: "en", Name: "Laptop", Slug: "laptop"},
+		}, nil)
+
+		product, currentSlug, err := productService.GetProductBySlug("dizustu-bilgisayar", "en")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "laptop", currentSlug)
+		assert.Equal(t, "Laptop", product.Name)
+	})
+
+	// --- SENARYO 11: Önbellekteki varsayılan içerik istenen dile çevrilir, çevirisi olmayan alanlar korunur ---
+	t.Run("GetProductById_TranslatesCachedProduct", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		cached, _ := json.Marshal(dto.ProductResponse{
+			Id: 1, Name: "Dizüstü Bilgisayar", Slug: "dizustu-bilgisayar", Price: 15000, Status: domain.ProductStatusPublished,
+		})
+		mockRedis.ExpectGet("product:1").SetVal(string(cached))
+		mockTranslationRepo.EXPECT().GetProductTranslationsByLocale("en", []int64{1}).Return(map[int64]domain.ProductTranslation{
+			1: {ProductId: 1, Locale: "en", Name: "Laptop", Slug: "laptop", Description: "Light laptop"},
+		}, nil)
+
+		product, err := productService.GetProductById(1, "en")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Laptop", product.Name)
+		assert.Equal(t, "laptop", product.Slug)
+		assert.Equal(t, "Light laptop", product.Description)
+		assert.Equal(t, 15000.0, product.Price)
+	})
+
+	// --- SENARYO 12: Kategori filtresi alt kategorilerdeki ürünleri de kapsar ---
+	t.Run("ListProducts_IncludesSubcategories", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		categoryId := uint(1)
+		mockCategoryRepo.EXPECT().GetSubtree(categoryId).Return([]domain.Category{
+			{Id: 1, Path: "1/"}, {Id: 4, Path: "1/4/"}, {Id: 9, Path: "1/4/9/"},
+		}, nil)
+		mockRepo.EXPECT().CountProducts(gomock.Any()).DoAndReturn(func(filter domain.ProductFilter) (int, error) {
+			assert.Equal(t, []uint{1, 4, 9}, filter.CategoryIds)
+			return 0, nil
+		})
+		mockRepo.EXPECT().GetProducts(gomock.Any()).Return([]domain.Product{}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockAttributeRepo.EXPECT().GetAttributeValues([]int64{}).Return(map[int64][]domain.ProductAttributeValue{}, nil)
+
+		_, err := productService.ListProducts(dto.ProductListRequest{CategoryId: &categoryId})
+
+		assert.NoError(t, err)
+	})
+
+	// --- SENARYO 13: Ürün yanıtı kökten ürünün kategorisine kadar breadcrumb taşır ---
+	t.Run("GetProductById_AddsBreadcrumbs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		categoryId := uint(9)
+		cached, _ := json.Marshal(dto.ProductResponse{Id: 1, Name: "Laptop", CategoryId: &categoryId, Status: domain.ProductStatusPublished})
+		mockRedis.ExpectGet("product:1").SetVal(string(cached))
+		mockCategoryRepo.EXPECT().GetCategoriesWithAncestors([]uint{9}).Return([]domain.Category{
+			{Id: 9, Name: "Dizüstü", Path: "1/4/9/"}, {Id: 1, Name: "Elektronik", Path: "1/"}, {Id: 4, Name: "Bilgisayar", Path: "1/4/"},
+		}, nil)
+
+		product, err := productService.GetProductById(1, "tr")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.CategoryBreadcrumb{
+			{Id: 1, Name: "Elektronik"}, {Id: 4, Name: "Bilgisayar"}, {Id: 9, Name: "Dizüstü"},
+		}, product.Breadcrumbs)
+	})
+
+	// --- SENARYO 14: Yayında olmayan ürün herkese açık okumada bulunamaz ---
+	t.Run("GetProductById_DraftIsNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		cached, _ := json.Marshal(dto.ProductResponse{Id: 1, Name: "Laptop", Status: domain.ProductStatusDraft})
+		mockRedis.ExpectGet("product:1").SetVal(string(cached))
+
+		_, err := productService.GetProductById(1, "tr")
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 15: Mağaza sahibi olmayan kullanıcı başka mağazanın ürününü güncelleyemez ---
+	t.Run("UpdateProduct_ByNonOwnerIsForbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockAttributeRepo := mock_repository.NewMockIProductAttributeRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		productService := service.NewProductService(mockRepo, mockVariantRepo, mockAttributeRepo, mockCategoryRepo, mockSlugRepo, mockTranslationRepo, testLocales, mockStoreRepo, db)
+
+		mockRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Slug: "laptop-001", StoreId: 2}, nil)
+		mockStoreRepo.EXPECT().IsStoreOwner(uint(2), int64(7)).Return(false, nil)
+		mockRepo.EXPECT().UpdateProduct(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := productService.UpdateProduct(7, domain.UserRoleCustomer, 1,
+			dto.CreateProductRequest{Name: "Laptop Pro", Description: "Yeni isim", Price: 100, StoreId: 2})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only the owners")
+	})
 }
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestProductStatusService(t *testing.T) {
+	// --- SENARYO 1: Yayın zamanı gelmemiş ürün onaylanınca yayın dışı bekler ---
+	t.Run("Approve_WaitsForPublishTime", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStatusRepo := mock_repository.NewMockIProductStatusRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		statusService := service.NewProductStatusService(mockStatusRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		userId := int64(5)
+		publishAt := time.Now().Add(24 * time.Hour)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{
+			Id: 1, Status: domain.ProductStatusPendingReview, PublishAt: &publishAt,
+		}, nil)
+		mockStatusRepo.EXPECT().ChangeStatus(domain.ProductStatusChange{
+			ProductId: 1, FromStatus: domain.ProductStatusPendingReview, ToStatus: domain.ProductStatusUnpublished,
+			Note: "Uygun", ChangedBy: &userId,
+		}, domain.PublishSchedule{PublishAt: &publishAt}).Return(domain.Product{
+			Id: 1, Status: domain.ProductStatusUnpublished, PublishAt: &publishAt,
+		}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+		mockRedis.ExpectDel("product:1").SetVal(1)
+
+		product, err := statusService.Approve(userId, 1, dto.ReviewProductRequest{Note: " Uygun "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ProductStatusUnpublished, product.Status)
+		assert.False(t, product.IsActive)
+	})
+
+	// --- SENARYO 2: Not olmadan ret yapılamaz ---
+	t.Run("Reject_RequiresNote", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStatusRepo := mock_repository.NewMockIProductStatusRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		statusService := service.NewProductStatusService(mockStatusRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		mockStatusRepo.EXPECT().ChangeStatus(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := statusService.Reject(5, 1, dto.ReviewProductRequest{Note: "  "})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "needs a note")
+	})
+
+	// --- SENARYO 3: Taslak ürün incelemeden yayınlanamaz ---
+	t.Run("Publish_DraftIsRejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStatusRepo := mock_repository.NewMockIProductStatusRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		statusService := service.NewProductStatusService(mockStatusRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Status: domain.ProductStatusDraft}, nil)
+		mockStatusRepo.EXPECT().ChangeStatus(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := statusService.Publish(5, 1)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot move from draft to published")
+	})
+
+	// --- SENARYO 4: Zamanı gelen ürünler yayınlanır, süresi dolanlar yayından kaldırılır ---
+	t.Run("ApplySchedules_PublishesAndUnpublishes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStatusRepo := mock_repository.NewMockIProductStatusRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		statusService := service.NewProductStatusService(mockStatusRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		unpublishAt := time.Now().Add(7 * 24 * time.Hour)
+		mockStatusRepo.EXPECT().GetProductsToPublish(gomock.Any()).Return([]domain.Product{
+			{Id: 1, Status: domain.ProductStatusUnpublished, UnpublishAt: &unpublishAt},
+		}, nil)
+		mockStatusRepo.EXPECT().ChangeStatus(domain.ProductStatusChange{
+			ProductId: 1, FromStatus: domain.ProductStatusUnpublished, ToStatus: domain.ProductStatusPublished, Note: "Scheduled publish",
+		}, domain.PublishSchedule{UnpublishAt: &unpublishAt}).Return(domain.Product{Id: 1, Status: domain.ProductStatusPublished}, nil)
+		mockStatusRepo.EXPECT().GetProductsToUnpublish(gomock.Any()).Return([]domain.Product{
+			{Id: 2, Status: domain.ProductStatusPublished},
+		}, nil)
+		mockStatusRepo.EXPECT().ChangeStatus(domain.ProductStatusChange{
+			ProductId: 2, FromStatus: domain.ProductStatusPublished, ToStatus: domain.ProductStatusUnpublished, Note: "Scheduled unpublish",
+		}, domain.PublishSchedule{}).Return(domain.Product{Id: 2, Status: domain.ProductStatusUnpublished}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1, 2}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil).Times(2)
+		mockRedis.ExpectDel("product:1").SetVal(1)
+		mockRedis.ExpectDel("product:2").SetVal(1)
+
+		run, err := statusService.ApplySchedules()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, run.Published)
+		assert.Equal(t, 1, run.Unpublished)
+		if err := mockRedis.ExpectationsWereMet(); err != nil {
+			t.Error("Redis işlemleri eksik kaldı:", err)
+		}
+	})
+
+	// --- SENARYO 5: İncelemedeki ürün onaysız yayınlanamaz ---
+	t.Run("Publish_PendingReviewIsRejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStatusRepo := mock_repository.NewMockIProductStatusRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		statusService := service.NewProductStatusService(mockStatusRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Status: domain.ProductStatusPendingReview}, nil)
+
+		_, err := statusService.Publish(5, 1)
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 6: Ürünü incelemeye gönderen kullanıcı onaylayamaz ---
+	t.Run("Approve_BySubmitterIsForbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStatusRepo := mock_repository.NewMockIProductStatusRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		statusService := service.NewProductStatusService(mockStatusRepo, mockProductRepo, mockVariantRepo, mock_repository.NewMockIStoreRepository(ctrl), db)
+
+		submitterId := int64(5)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{
+			Id: 1, Status: domain.ProductStatusPendingReview, SubmittedBy: &submitterId,
+		}, nil)
+
+		_, err := statusService.Approve(submitterId, 1, dto.ReviewProductRequest{})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "submitted")
+	})
+
+	// --- SENARYO 7: Mağaza sahibi olmayan müşteri ürünü yayından kaldıramaz ---
+	t.Run("Unpublish_ByNonOwnerIsForbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStatusRepo := mock_repository.NewMockIProductStatusRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		statusService := service.NewProductStatusService(mockStatusRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, StoreId: 3, Status: domain.ProductStatusPublished}, nil)
+		mockStoreRepo.EXPECT().IsStoreOwner(uint(3), int64(9)).Return(false, nil)
+		mockStatusRepo.EXPECT().ChangeStatus(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := statusService.Unpublish(9, domain.UserRoleCustomer, 1)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "owners of the product's store")
+	})
+
+	// --- SENARYO 8: Mağaza sahibinin yaptığı zamanlama değişikliği geçmişe onun adıyla yazılır ---
+	t.Run("SetSchedule_RecordsOwner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStatusRepo := mock_repository.NewMockIProductStatusRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		statusService := service.NewProductStatusService(mockStatusRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, db)
+
+		ownerId := int64(9)
+		unpublishAt := time.Now().Add(24 * time.Hour)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, StoreId: 3, Status: domain.ProductStatusPublished}, nil)
+		mockStoreRepo.EXPECT().IsStoreOwner(uint(3), ownerId).Return(true, nil)
+		mockStatusRepo.EXPECT().SetSchedule(domain.ProductStatusChange{
+			ProductId: 1, Note: "Publish schedule changed", ChangedBy: &ownerId,
+		}, domain.PublishSchedule{UnpublishAt: &unpublishAt}).Return(domain.Product{
+			Id: 1, StoreId: 3, Status: domain.ProductStatusPublished, UnpublishAt: &unpublishAt,
+		}, nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+		mockRedis.ExpectDel("product:1").SetVal(1)
+
+		product, err := statusService.SetSchedule(ownerId, domain.UserRoleCustomer, 1, dto.ProductScheduleRequest{UnpublishAt: &unpublishAt})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, product.UnpublishAt)
+	})
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestProductVariantService(t *testing.T) {
+	type mocks struct {
+		variantRepo *mock_repository.MockIProductVariantRepository
+		productRepo *mock_repository.MockIProductRepository
+		storeRepo   *mock_repository.MockIStoreRepository
+	}
+
+	setup := func(t *testing.T) (service.IProductVariantService, mocks) {
+		ctrl := gomock.NewController(t)
+		db, _ := redismock.NewClientMock()
+		m := mocks{
+			variantRepo: mock_repository.NewMockIProductVariantRepository(ctrl),
+			productRepo: mock_repository.NewMockIProductRepository(ctrl),
+			storeRepo:   mock_repository.NewMockIStoreRepository(ctrl),
+		}
+		return service.NewProductVariantService(m.variantRepo, m.productRepo, m.storeRepo, db), m
+	}
+
+	// --- SENARYO 1: Yayında olan ürünün varyantları fiyatlarıyla listelenir ---
+	t.Run("GetVariantsByProductId_PublishedProduct", func(t *testing.T) {
+		variantService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Price: 100, Status: domain.ProductStatusPublished}, nil)
+		m.variantRepo.EXPECT().GetVariantsByProductId(int64(1)).Return([]domain.ProductVariant{{Id: 3, ProductId: 1, IsActive: true}}, nil)
+
+		variants, err := variantService.GetVariantsByProductId(1)
+
+		assert.NoError(t, err)
+		assert.Len(t, variants, 1)
+		assert.Equal(t, 100.0, variants[0].EffectivePrice)
+	})
+
+	// --- SENARYO 2: Yayında olmayan ürünün varyantları herkese açık okumada bulunamaz ---
+	t.Run("GetVariants_UnpublishedProductNotFound", func(t *testing.T) {
+		variantService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Status: domain.ProductStatusDraft}, nil).Times(2)
+		m.variantRepo.EXPECT().GetVariantBySku("LAP-1-RED").Return(domain.ProductVariant{Id: 3, ProductId: 1}, nil)
+		m.variantRepo.EXPECT().GetVariantsByProductId(gomock.Any()).Times(0)
+
+		_, err := variantService.GetVariantsByProductId(1)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Product not found")
+
+		_, err = variantService.GetVariantBySku("LAP-1-RED")
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 3: Mağaza sahibi olmayan kullanıcı varyant ekleyemez ---
+	t.Run("AddVariant_ByNonOwnerIsForbidden", func(t *testing.T) {
+		variantService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, StoreId: 2}, nil)
+		m.storeRepo.EXPECT().IsStoreOwner(uint(2), int64(7)).Return(false, nil)
+		m.variantRepo.EXPECT().AddVariant(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := variantService.AddVariant(7, domain.UserRoleCustomer, 1, dto.CreateProductVariantRequest{
+			Sku: "LAP-1-RED", OptionValueIds: []int64{1},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only the owners")
+	})
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestRecommendationService(t *testing.T) {
+	recommendationConfig := config.RecommendationConfig{TopN: 10, CacheTTL: "1h"}
+
+	// --- SENARYO 1: Önbellek boşsa ilişkiler DB'den okunur, önbelleğe yazılır ve limit uygulanır ---
+	t.Run("GetRelatedProducts_LoadsAndCaches", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRecommendationRepo := mock_repository.NewMockIRecommendationRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockCartRepo := mock_repository.NewMockICartRepository(ctrl)
+		mockCartItemRepo := mock_repository.NewMockICartItemRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		recommendationService := service.NewRecommendationService(mockRecommendationRepo, mockProductRepo, mockCartRepo, mockCartItemRepo,
+			db, recommendationConfig)
+
+		related := []domain.RelatedProduct{
+			{Product: domain.Product{Id: 2, Name: "Mouse"}, Source: domain.RelationSourceBoughtTogether, Score: 5},
+			{Product: domain.Product{Id: 3, Name: "Çanta"}, Source: domain.RelationSourceCategory},
+		}
+		relatedJson, _ := json.Marshal(related)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil)
+		mockRedis.ExpectGet("related:1").RedisNil()
+		mockRecommendationRepo.EXPECT().GetRelatedProducts(int64(1), 10).Return(related, nil)
+		mockRedis.ExpectSet("related:1", relatedJson, time.Hour).SetVal("OK")
+
+		result, err := recommendationService.GetRelatedProducts(1, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, uint(2), result[0].Product.Id)
+		assert.Equal(t, domain.RelationSourceBoughtTogether, result[0].Source)
+		assert.NoError(t, mockRedis.ExpectationsWereMet())
+	})
+
+	// --- SENARYO 2: Sepet önerileri sepetteki ürünlerden, tekrarsız ve sıralı kimliklerle hesaplanır ---
+	t.Run("GetCartRecommendations_UsesDistinctCartProducts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRecommendationRepo := mock_repository.NewMockIRecommendationRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockCartRepo := mock_repository.NewMockICartRepository(ctrl)
+		mockCartItemRepo := mock_repository.NewMockICartItemRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		recommendationService := service.NewRecommendationService(mockRecommendationRepo, mockProductRepo, mockCartRepo, mockCartItemRepo,
+			db, recommendationConfig)
+
+		recommendations := []domain.RelatedProduct{{Product: domain.Product{Id: 9}, Source: domain.RelationSourceBoughtTogether, Score: 3}}
+		recommendationsJson, _ := json.Marshal(recommendations)
+
+		mockCartRepo.EXPECT().GetCartById(int64(4)).Return(domain.Cart{Id: 4})
+		mockCartItemRepo.EXPECT().GetItemsByCartId(int64(4)).Return([]domain.CartItem{
+			{Id: 1, ProductId: 7}, {Id: 2, ProductId: 2}, {Id: 3, ProductId: 7},
+		})
+		mockRedis.ExpectGet("recommendations:products:2,7").RedisNil()
+		mockRecommendationRepo.EXPECT().GetRecommendationsFor([]int64{2, 7}, 10).Return(recommendations, nil)
+		mockRedis.ExpectSet("recommendations:products:2,7", recommendationsJson, time.Hour).SetVal("OK")
+
+		result, err := recommendationService.GetCartRecommendations(4, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, uint(9), result[0].Product.Id)
+		assert.NoError(t, mockRedis.ExpectationsWereMet())
+	})
+
+	// --- SENARYO 3: Saklanan ilişki sayısından büyük limit reddedilir ---
+	t.Run("GetRelatedProducts_RejectsLimitAboveTopN", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRecommendationRepo := mock_repository.NewMockIRecommendationRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockCartRepo := mock_repository.NewMockICartRepository(ctrl)
+		mockCartItemRepo := mock_repository.NewMockICartItemRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		recommendationService := service.NewRecommendationService(mockRecommendationRepo, mockProductRepo, mockCartRepo, mockCartItemRepo,
+			db, recommendationConfig)
+
+		mockRecommendationRepo.EXPECT().GetRelatedProducts(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := recommendationService.GetRelatedProducts(1, 11)
+
+		assert.Error(t, err)
+	})
+}
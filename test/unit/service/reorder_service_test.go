@@ -0,0 +1,155 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestReorderService(t *testing.T) {
+	type mocks struct {
+		orderRepo     *mock_repository.MockIOrderRepository
+		orderItemRepo *mock_repository.MockIOrderItemRepository
+		productRepo   *mock_repository.MockIProductRepository
+		cartRepo      *mock_repository.MockICartRepository
+		cartItemRepo  *mock_repository.MockICartItemRepository
+		variantRepo   *mock_repository.MockIProductVariantRepository
+		ruleRepo      *mock_repository.MockIPriceRuleRepository
+	}
+
+	setup := func(t *testing.T) (service.IReorderService, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{
+			orderRepo:     mock_repository.NewMockIOrderRepository(ctrl),
+			orderItemRepo: mock_repository.NewMockIOrderItemRepository(ctrl),
+			productRepo:   mock_repository.NewMockIProductRepository(ctrl),
+			cartRepo:      mock_repository.NewMockICartRepository(ctrl),
+			cartItemRepo:  mock_repository.NewMockICartItemRepository(ctrl),
+			variantRepo:   mock_repository.NewMockIProductVariantRepository(ctrl),
+			ruleRepo:      mock_repository.NewMockIPriceRuleRepository(ctrl),
+		}
+		// Fiyat kuralı yok: güncel fiyat ürünün kendi fiyatıdır
+		m.ruleRepo.EXPECT().GetActiveRules(gomock.Any()).Return([]domain.PriceRule{}, nil).AnyTimes()
+		m.ruleRepo.EXPECT().GetCustomerGroup(gomock.Any()).Return(domain.CustomerGroupRetail, nil).AnyTimes()
+		return service.NewReorderService(m.orderRepo, m.orderItemRepo, m.productRepo, m.cartRepo, m.cartItemRepo, m.variantRepo, m.ruleRepo), m
+	}
+
+	t.Run("Reorder_AddsAdjustsAndDropsLines", func(t *testing.T) {
+		reorderService, m := setup(t)
+
+		m.orderRepo.EXPECT().GetOrderById(int64(10)).Return(domain.Order{Id: 10, UserId: 1})
+		m.orderItemRepo.EXPECT().GetOrderItemsByOrderId(int64(10)).Return([]domain.OrderItem{
+			{ProductId: 100, Quantity: 2, Price: 50},
+			{ProductId: 200, Quantity: 5, Price: 20},
+			{ProductId: 300, Quantity: 1, Price: 10},
+			{ProductId: 400, Quantity: 1, Price: 10},
+		}, nil)
+		m.cartRepo.EXPECT().GetCartsByUserId(int64(1)).Return([]domain.Cart{
+			{Id: 7, UserId: 1, Status: domain.CartStatusActive, UpdatedAt: time.Now()},
+		})
+		m.cartItemRepo.EXPECT().GetItemsByCartId(int64(7)).Return([]domain.CartItem{
+			{Id: 1, CartId: 7, ProductId: 200, Quantity: 1},
+		})
+
+		m.productRepo.EXPECT().GetProductById(int64(100)).Return(domain.Product{Id: 100, Name: "Mouse", Price: 55, StockQuantity: 10, IsActive: true}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(200)).Return(domain.Product{Id: 200, Name: "Cable", Price: 20, StockQuantity: 4, IsActive: true}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(300)).Return(domain.Product{Id: 300, Name: "Old", IsActive: false}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(400)).Return(domain.Product{Id: 400, Name: "Sold out", StockQuantity: 0, IsActive: true}, nil)
+
+		m.cartItemRepo.EXPECT().AddItemToCart(domain.CartItem{CartId: 7, ProductId: 100, Quantity: 2}).Return(domain.CartItem{}, nil)
+		m.cartItemRepo.EXPECT().AddItemToCart(domain.CartItem{CartId: 7, ProductId: 200, Quantity: 3}).Return(domain.CartItem{}, nil)
+
+		report, err := reorderService.Reorder(1, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), report.CartId)
+		assert.Len(t, report.Added, 1)
+		assert.Equal(t, []string{dto.ReorderReasonPriceChanged}, report.Added[0].Reasons)
+		assert.Len(t, report.Adjusted, 1)
+		assert.Equal(t, 3, report.Adjusted[0].AddedQuantity)
+		assert.Equal(t, []string{dto.ReorderReasonCappedToStock}, report.Adjusted[0].Reasons)
+		assert.Len(t, report.Dropped, 2)
+		assert.Equal(t, []string{dto.ReorderReasonUnavailable}, report.Dropped[0].Reasons)
+		assert.Equal(t, []string{dto.ReorderReasonOutOfStock}, report.Dropped[1].Reasons)
+	})
+
+	t.Run("Reorder_UsesVariantPriceAndStock", func(t *testing.T) {
+		reorderService, m := setup(t)
+
+		small, large := int64(11), int64(12)
+		variantPrice := 60.0
+		m.orderRepo.EXPECT().GetOrderById(int64(10)).Return(domain.Order{Id: 10, UserId: 1})
+		m.orderItemRepo.EXPECT().GetOrderItemsByOrderId(int64(10)).Return([]domain.OrderItem{
+			{ProductId: 100, VariantId: &small, Quantity: 2, Price: 50},
+			{ProductId: 100, VariantId: &large, Quantity: 1, Price: 50},
+		}, nil)
+		m.cartRepo.EXPECT().GetCartsByUserId(int64(1)).Return([]domain.Cart{
+			{Id: 7, UserId: 1, Status: domain.CartStatusActive, UpdatedAt: time.Now()},
+		})
+		m.cartItemRepo.EXPECT().GetItemsByCartId(int64(7)).Return([]domain.CartItem{})
+
+		m.productRepo.EXPECT().GetProductById(int64(100)).Return(domain.Product{Id: 100, Name: "T-Shirt", Price: 50, StockQuantity: 0, IsActive: true}, nil).Times(2)
+		m.variantRepo.EXPECT().GetVariantById(small).Return(domain.ProductVariant{Id: small, ProductId: 100, Sku: "TS-S", Price: &variantPrice, StockQuantity: 1, IsActive: true}, nil)
+		m.variantRepo.EXPECT().GetVariantById(large).Return(domain.ProductVariant{Id: large, ProductId: 100, Sku: "TS-L", IsActive: false}, nil)
+
+		m.cartItemRepo.EXPECT().AddItemToCart(domain.CartItem{CartId: 7, ProductId: 100, VariantId: &small, Quantity: 1}).Return(domain.CartItem{}, nil)
+
+		report, err := reorderService.Reorder(1, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, report.Adjusted, 1)
+		assert.Equal(t, "TS-S", report.Adjusted[0].Sku)
+		assert.Equal(t, 60.0, report.Adjusted[0].CurrentPrice)
+		assert.Equal(t, []string{dto.ReorderReasonCappedToStock, dto.ReorderReasonPriceChanged}, report.Adjusted[0].Reasons)
+		assert.Len(t, report.Dropped, 1)
+		assert.Equal(t, []string{dto.ReorderReasonVariantGone}, report.Dropped[0].Reasons)
+	})
+
+	t.Run("Reorder_OtherUsersOrder_NotFound", func(t *testing.T) {
+		reorderService, m := setup(t)
+
+		m.orderRepo.EXPECT().GetOrderById(int64(10)).Return(domain.Order{Id: 10, UserId: 2})
+		m.orderItemRepo.EXPECT().GetOrderItemsByOrderId(gomock.Any()).Times(0)
+
+		_, err := reorderService.Reorder(1, 10)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Reorder_CreatesCartWhenNoneActive", func(t *testing.T) {
+		reorderService, m := setup(t)
+
+		m.orderRepo.EXPECT().GetOrderById(int64(10)).Return(domain.Order{Id: 10, UserId: 1})
+		m.orderItemRepo.EXPECT().GetOrderItemsByOrderId(int64(10)).Return([]domain.OrderItem{}, nil)
+		m.cartRepo.EXPECT().GetCartsByUserId(int64(1)).Return([]domain.Cart{
+			{Id: 3, UserId: 1, Status: domain.CartStatusAbandoned},
+		})
+		m.cartRepo.EXPECT().CreateCart(gomock.Any()).Return(domain.Cart{Id: 8, UserId: 1}, nil)
+		m.cartItemRepo.EXPECT().GetItemsByCartId(int64(8)).Return([]domain.CartItem{})
+
+		report, err := reorderService.Reorder(1, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(8), report.CartId)
+		assert.Empty(t, report.Added)
+	})
+
+	t.Run("Reorder_OrderItemsError", func(t *testing.T) {
+		reorderService, m := setup(t)
+
+		m.orderRepo.EXPECT().GetOrderById(int64(10)).Return(domain.Order{Id: 10, UserId: 1})
+		m.orderItemRepo.EXPECT().GetOrderItemsByOrderId(int64(10)).Return(nil, errors.New("db down"))
+
+		_, err := reorderService.Reorder(1, 10)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestSeoService(t *testing.T) {
+	type mocks struct {
+		sitemapRepo     *mock_repository.MockISitemapRepository
+		productRepo     *mock_repository.MockIProductRepository
+		variantRepo     *mock_repository.MockIProductVariantRepository
+		storeRepo       *mock_repository.MockIStoreRepository
+		translationRepo *mock_repository.MockITranslationRepository
+		ruleRepo        *mock_repository.MockIPriceRuleRepository
+	}
+
+	setup := func(t *testing.T) (service.ISeoService, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{
+			sitemapRepo:     mock_repository.NewMockISitemapRepository(ctrl),
+			productRepo:     mock_repository.NewMockIProductRepository(ctrl),
+			variantRepo:     mock_repository.NewMockIProductVariantRepository(ctrl),
+			storeRepo:       mock_repository.NewMockIStoreRepository(ctrl),
+			translationRepo: mock_repository.NewMockITranslationRepository(ctrl),
+			ruleRepo:        mock_repository.NewMockIPriceRuleRepository(ctrl),
+		}
+		m.ruleRepo.EXPECT().GetActiveRules(gomock.Any()).Return([]domain.PriceRule{}, nil).AnyTimes()
+		seoService := service.NewSeoService(m.sitemapRepo, m.productRepo, m.variantRepo, m.storeRepo, m.translationRepo, m.ruleRepo,
+			testLocales, config.SeoConfig{SitemapBaseUrl: "https://api.example.com", SitemapPageSize: 100},
+			config.ExportConfig{SiteBaseUrl: "https://shop.example.com/", Currency: "TRY"})
+		return seoService, m
+	}
+
+	// --- SENARYO 1: Yalnızca içeriği değişen sayfa yeniden üretilir, boşalan sayfa silinir ---
+	t.Run("RefreshSitemaps_RegeneratesOnlyChangedPages", func(t *testing.T) {
+		seoService, m := setup(t)
+
+		m.sitemapRepo.EXPECT().GetPages().Return([]domain.SitemapPage{
+			{EntityType: domain.SitemapProducts, Page: 1, Fingerprint: "unchanged"},
+			{EntityType: domain.SitemapProducts, Page: 2, Fingerprint: "stale"},
+			{EntityType: domain.SitemapStores, Page: 3, Fingerprint: "emptied"},
+		}, nil)
+		changed := domain.SitemapPage{EntityType: domain.SitemapProducts, Page: 2, Fingerprint: "fresh", EntryCount: 1}
+		m.sitemapRepo.EXPECT().GetPageStates(domain.SitemapProducts, 100).Return([]domain.SitemapPage{
+			{EntityType: domain.SitemapProducts, Page: 1, Fingerprint: "unchanged"}, changed,
+		}, nil)
+		m.sitemapRepo.EXPECT().GetEntries(domain.SitemapProducts, 2, 100).Return([]domain.SitemapEntry{
+			{EntityId: 101, Slug: "dizustu-bilgisayar", Alternates: map[string]string{"en": "laptop"}},
+		}, nil)
+		m.sitemapRepo.EXPECT().SavePage(changed, gomock.Any()).DoAndReturn(func(page domain.SitemapPage, content string) error {
+			assert.Contains(t, content, "<loc>https://shop.example.com/products/dizustu-bilgisayar</loc>")
+			assert.Contains(t, content, "<loc>https://shop.example.com/en/products/laptop</loc>")
+			assert.Contains(t, content, `hreflang="en" href="https://shop.example.com/en/products/laptop"`)
+			return nil
+		})
+		m.sitemapRepo.EXPECT().DeletePagesExcept(domain.SitemapProducts, []int{1, 2}).Return(int64(0), nil)
+		m.sitemapRepo.EXPECT().GetPageStates(domain.SitemapCategories, 100).Return([]domain.SitemapPage{}, nil)
+		m.sitemapRepo.EXPECT().DeletePagesExcept(domain.SitemapCategories, []int{}).Return(int64(0), nil)
+		m.sitemapRepo.EXPECT().GetPageStates(domain.SitemapStores, 100).Return([]domain.SitemapPage{}, nil)
+		m.sitemapRepo.EXPECT().DeletePagesExcept(domain.SitemapStores, []int{}).Return(int64(1), nil)
+
+		run, err := seoService.RefreshSitemaps()
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SitemapRun{Generated: 1, Removed: 1}, run)
+	})
+
+	// --- SENARYO 2: Sitemap indeksi üretilmiş sayfaları API adresinden listeler ---
+	t.Run("GetSitemapIndex_ListsGeneratedPages", func(t *testing.T) {
+		seoService, m := setup(t)
+
+		m.sitemapRepo.EXPECT().GetPages().Return([]domain.SitemapPage{
+			{EntityType: domain.SitemapCategories, Page: 1},
+			{EntityType: domain.SitemapProducts, Page: 1},
+		}, nil)
+
+		content, err := seoService.GetSitemapIndex()
+
+		assert.NoError(t, err)
+		assert.True(t, strings.Contains(string(content), "<loc>https://api.example.com/sitemaps/categories-1.xml</loc>"))
+		assert.True(t, strings.Contains(string(content), "<loc>https://api.example.com/sitemaps/products-1.xml</loc>"))
+	})
+
+	// --- SENARYO 3: Olmayan sitemap sayfası bulunamadı döner ---
+	t.Run("GetSitemap_NotFound", func(t *testing.T) {
+		seoService, m := setup(t)
+
+		m.sitemapRepo.EXPECT().GetPageContent(domain.SitemapProducts, 9).Return("", common.ErrSitemapNotFound)
+
+		_, err := seoService.GetSitemap(domain.SitemapProducts, 9)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Sitemap not found")
+	})
+
+	// --- SENARYO 4: Varyantlı ürünün JSON-LD'si fiyat aralığı, stok durumu ve puanla birlikte çevrilmiş içerikle döner ---
+	t.Run("GetProductSeo_AggregateOfferInLocale", func(t *testing.T) {
+		seoService, m := setup(t)
+
+		medium, large := 1100.0, 1300.0
+		m.productRepo.EXPECT().GetProductById(int64(7)).Return(domain.Product{
+			Id: 7, Name: "Mont", Slug: "mont", Price: 1000, BasePrice: 1000, StoreId: 1, IsActive: true,
+			AverageRating: 4.5, ReviewCount: 12,
+		}, nil)
+		m.variantRepo.EXPECT().GetVariantsByProductIds([]int64{7}).Return(map[int64][]domain.ProductVariant{7: {
+			{Id: 1, Sku: "MONT-M", Price: &medium, StockQuantity: 3, IsActive: true},
+			{Id: 2, Sku: "MONT-L", Price: &large, StockQuantity: 0, IsActive: true},
+			{Id: 3, Sku: "MONT-XL", StockQuantity: 5, IsActive: false},
+		}}, nil)
+		m.translationRepo.EXPECT().GetProductTranslations(int64(7)).Return([]domain.ProductTranslation{
+			{ProductId: 7, Locale: "en", Name: "Coat", Slug: "coat"},
+		}, nil)
+		m.storeRepo.EXPECT().GetStoreById(uint(1)).Return(domain.Store{Id: 1, Name: "TeknoStore"}, nil)
+
+		seo, err := seoService.GetProductSeo(7, "en")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "https://shop.example.com/en/products/coat", seo.CanonicalUrl)
+		assert.Equal(t, []dto.AlternateUrl{
+			{Locale: "tr", Url: "https://shop.example.com/products/mont"},
+			{Locale: "en", Url: "https://shop.example.com/en/products/coat"},
+		}, seo.Alternates)
+		assert.Equal(t, "Coat", seo.JsonLd.Name)
+		assert.Equal(t, &dto.AggregateRating{Type: "AggregateRating", RatingValue: 4.5, ReviewCount: 12}, seo.JsonLd.AggregateRating)
+
+		offers, ok := seo.JsonLd.Offers.(dto.AggregateOffer)
+		assert.True(t, ok)
+		assert.Equal(t, "1100.00", offers.LowPrice)
+		assert.Equal(t, "1300.00", offers.HighPrice)
+		assert.Equal(t, 2, offers.OfferCount)
+		assert.Equal(t, "https://schema.org/InStock", offers.Offers[0].Availability)
+		assert.Equal(t, "https://schema.org/OutOfStock", offers.Offers[1].Availability)
+		assert.Equal(t, "TeknoStore", offers.Seller.Name)
+	})
+
+	// --- SENARYO 5: Pasif ürün için SEO verisi dönmez ---
+	t.Run("GetProductSeo_InactiveProduct", func(t *testing.T) {
+		seoService, m := setup(t)
+
+		m.productRepo.EXPECT().GetProductById(int64(7)).Return(domain.Product{Id: 7, IsActive: false}, nil)
+
+		_, err := seoService.GetProductSeo(7, "tr")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), common.ErrProductNotFound.Error())
+	})
+}
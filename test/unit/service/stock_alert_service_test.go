@@ -0,0 +1,137 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_infra "go-ecommerce-service/test/mock/infrastructure"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestStockAlertService(t *testing.T) {
+	inventoryConfig := config.InventoryConfig{DefaultLeadTimeDays: 7, ReorderCoverDays: 30}
+
+	// --- SENARYO 1: Yeniden sipariş önerisi satış hızından hesaplanır ve aciller öne alınır ---
+	t.Run("GetReorderReport_SuggestsFromSalesVelocity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAlertRepo := mock_repository.NewMockIStockAlertRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		rabbitMQClient := mock_infra.NewMockIRabbitMQClient(ctrl)
+		alertService := service.NewStockAlertService(mockAlertRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, rabbitMQClient, inventoryConfig)
+
+		threshold := 5
+		idleThreshold := 10
+		mockAlertRepo.EXPECT().GetStockVelocities(gomock.Any(), nil).Return([]domain.StockVelocity{
+			{ProductId: 1, StockQuantity: 100, UnitsSold: 30},
+			{ProductId: 2, StockQuantity: 50, Threshold: &idleThreshold},
+			{ProductId: 3, StockQuantity: 10, Threshold: &threshold, UnitsSold: 60},
+		}, nil)
+
+		report, err := alertService.GetReorderReport(1, domain.UserRoleAdmin, dto.ReorderReportRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 30, report.Days)
+		assert.Equal(t, 30, report.CoverDays)
+		assert.Len(t, report.Items, 3)
+
+		urgent := report.Items[0]
+		assert.Equal(t, int64(3), urgent.ProductId)
+		assert.Equal(t, 2.0, urgent.DailySales)
+		assert.Equal(t, 5.0, *urgent.DaysOfCover)
+		assert.Equal(t, 19, urgent.ReorderPoint)
+		assert.Equal(t, 69, urgent.SuggestedQuantity)
+
+		assert.Equal(t, int64(1), report.Items[1].ProductId)
+		assert.Equal(t, 0, report.Items[1].SuggestedQuantity)
+		assert.Equal(t, int64(2), report.Items[2].ProductId)
+		assert.Nil(t, report.Items[2].DaysOfCover)
+	})
+
+	// --- SENARYO 2: Eşiği geçen ürünler için olay yayınlanır, yayınlanamayan tekrar denenir ---
+	t.Run("DetectLowStock_PublishesAndMarksAlerted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAlertRepo := mock_repository.NewMockIStockAlertRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		rabbitMQClient := mock_infra.NewMockIRabbitMQClient(ctrl)
+		alertService := service.NewStockAlertService(mockAlertRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, rabbitMQClient, inventoryConfig)
+
+		mockAlertRepo.EXPECT().ResetRecoveredAlerts().Return(int64(1), nil)
+		mockAlertRepo.EXPECT().GetUnalertedLowStockItems().Return([]domain.LowStockItem{
+			{ThresholdId: 1, ProductId: 1, StockQuantity: 2, Threshold: 5},
+			{ThresholdId: 2, ProductId: 2, StockQuantity: 0, Threshold: 3},
+		}, nil)
+		gomock.InOrder(
+			rabbitMQClient.EXPECT().Publish("", "stock_low_queue", false, false, gomock.Any()).Return(nil),
+			rabbitMQClient.EXPECT().Publish("", "stock_low_queue", false, false, gomock.Any()).Return(errors.New("channel closed")),
+		)
+		mockAlertRepo.EXPECT().MarkAlerted(int64(1)).Return(nil)
+		mockAlertRepo.EXPECT().MarkAlerted(int64(2)).Times(0)
+
+		count, err := alertService.DetectLowStock()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	// --- SENARYO 3: Başka ürüne ait varyant için eşik tanımlanamaz ---
+	t.Run("SetThreshold_VariantOfAnotherProduct", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAlertRepo := mock_repository.NewMockIStockAlertRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		rabbitMQClient := mock_infra.NewMockIRabbitMQClient(ctrl)
+		alertService := service.NewStockAlertService(mockAlertRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, rabbitMQClient, inventoryConfig)
+
+		variantId := int64(9)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1}, nil)
+		mockVariantRepo.EXPECT().GetVariantById(variantId).Return(domain.ProductVariant{Id: 9, ProductId: 2}, nil)
+		mockAlertRepo.EXPECT().SetThreshold(gomock.Any()).Times(0)
+
+		_, err := alertService.SetThreshold(1, domain.UserRoleAdmin, 1, dto.SetStockThresholdRequest{VariantId: &variantId, Threshold: 5})
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 4: Mağaza sahibi yalnızca kendi mağazasının düşük stok listesini görür ---
+	t.Run("GetLowStockItems_RequiresOwnStore", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAlertRepo := mock_repository.NewMockIStockAlertRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		rabbitMQClient := mock_infra.NewMockIRabbitMQClient(ctrl)
+		alertService := service.NewStockAlertService(mockAlertRepo, mockProductRepo, mockVariantRepo, mockStoreRepo, rabbitMQClient, inventoryConfig)
+
+		otherStoreId := uint(2)
+		mockStoreRepo.EXPECT().IsStoreOwner(otherStoreId, int64(5)).Return(false, nil)
+		mockAlertRepo.EXPECT().GetLowStockItems(gomock.Any()).Times(0)
+
+		// Tüm mağazaları yalnızca admin listeleyebilir
+		_, err := alertService.GetLowStockItems(5, domain.UserRoleCustomer, nil)
+		assert.Error(t, err)
+
+		_, err = alertService.GetLowStockItems(5, domain.UserRoleCustomer, &otherStoreId)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only the owners")
+	})
+}
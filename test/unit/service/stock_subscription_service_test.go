@@ -0,0 +1,146 @@
+package service
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/pkg/util"
+	"go-ecommerce-service/service"
+	mock_infra "go-ecommerce-service/test/mock/infrastructure"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestStockSubscriptionService(t *testing.T) {
+	backInStockConfig := config.BackInStockConfig{
+		BatchSize:          100,
+		EmailCooldown:      "1h",
+		UnsubscribeSecret:  "test-secret",
+		UnsubscribeTTL:     "720h",
+		UnsubscribeBaseUrl: "http://shop.test/unsubscribe",
+	}
+
+	// --- SENARYO 1: Stokta olan ürüne abone olunamaz ---
+	t.Run("Subscribe_ProductInStock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSubscriptionRepo := mock_repository.NewMockIStockSubscriptionRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+		rabbitMQClient := mock_infra.NewMockIRabbitMQClient(ctrl)
+		subscriptionService := service.NewStockSubscriptionService(mockSubscriptionRepo, mockProductRepo, mockVariantRepo,
+			mockUserRepo, rabbitMQClient, backInStockConfig)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, IsActive: true, StockQuantity: 4}, nil)
+		mockSubscriptionRepo.EXPECT().Subscribe(gomock.Any()).Times(0)
+
+		_, err := subscriptionService.Subscribe(nil, dto.CreateStockSubscriptionRequest{ProductId: 1, Email: "guest@example.com"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Product is in stock")
+	})
+
+	// --- SENARYO 2: Giriş yapmış kullanıcı hesap e-postası ile abone olur ---
+	t.Run("Subscribe_UserUsesAccountEmail", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSubscriptionRepo := mock_repository.NewMockIStockSubscriptionRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+		rabbitMQClient := mock_infra.NewMockIRabbitMQClient(ctrl)
+		subscriptionService := service.NewStockSubscriptionService(mockSubscriptionRepo, mockProductRepo, mockVariantRepo,
+			mockUserRepo, rabbitMQClient, backInStockConfig)
+
+		userId := int64(7)
+		variantId := int64(3)
+		mockUserRepo.EXPECT().GetUserById(userId).Return(domain.User{Id: userId, Email: "user@example.com"}, nil)
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, IsActive: true, StockQuantity: 10}, nil)
+		mockVariantRepo.EXPECT().GetVariantById(variantId).Return(domain.ProductVariant{Id: 3, ProductId: 1, IsActive: true}, nil)
+		mockSubscriptionRepo.EXPECT().Subscribe(gomock.Any()).DoAndReturn(func(subscription domain.StockSubscription) (domain.StockSubscription, error) {
+			assert.Equal(t, "user@example.com", subscription.Email)
+			assert.Equal(t, userId, *subscription.UserId)
+			subscription.Id = 11
+			subscription.Status = domain.StockSubscriptionActive
+			return subscription, nil
+		})
+
+		subscription, err := subscriptionService.Subscribe(&userId, dto.CreateStockSubscriptionRequest{ProductId: 1, VariantId: &variantId})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), subscription.Id)
+		assert.Equal(t, domain.StockSubscriptionActive, subscription.Status)
+	})
+
+	// --- SENARYO 3: Stoğa giren ürün için bildirim kuyruğa atılır ve abonelik işaretlenir ---
+	t.Run("NotifyRestocked_PublishesWithUnsubscribeLink", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSubscriptionRepo := mock_repository.NewMockIStockSubscriptionRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+		rabbitMQClient := mock_infra.NewMockIRabbitMQClient(ctrl)
+		subscriptionService := service.NewStockSubscriptionService(mockSubscriptionRepo, mockProductRepo, mockVariantRepo,
+			mockUserRepo, rabbitMQClient, backInStockConfig)
+
+		mockSubscriptionRepo.EXPECT().GetPendingNotifications(gomock.Any(), 100).DoAndReturn(func(notifiedSince time.Time, limit int) ([]domain.RestockNotification, error) {
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), notifiedSince, time.Minute)
+			return []domain.RestockNotification{{SubscriptionId: 5, ProductId: 1, Email: "guest@example.com", StockQuantity: 3}}, nil
+		})
+		rabbitMQClient.EXPECT().Publish("", "back_in_stock_queue", false, false, gomock.Any()).DoAndReturn(
+			func(exchange, routingKey string, mandatory, immediate bool, msg amqp.Publishing) error {
+				var payload map[string]interface{}
+				assert.NoError(t, json.Unmarshal(msg.Body, &payload))
+				assert.Equal(t, "product.back_in_stock", payload["event"])
+				assert.Equal(t, "guest@example.com", payload["email"])
+
+				unsubscribeUrl, parseErr := url.Parse(payload["unsubscribe_url"].(string))
+				assert.NoError(t, parseErr)
+				subject, tokenErr := util.VerifyToken("test-secret", unsubscribeUrl.Query().Get("token"))
+				assert.NoError(t, tokenErr)
+				assert.Equal(t, "5", subject)
+				return nil
+			})
+		mockSubscriptionRepo.EXPECT().MarkNotified(int64(5)).Return(nil)
+
+		count, err := subscriptionService.NotifyRestocked()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	// --- SENARYO 4: Başkasına ait abonelik iptal edilemez ---
+	t.Run("CancelSubscription_NotOwner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSubscriptionRepo := mock_repository.NewMockIStockSubscriptionRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+		rabbitMQClient := mock_infra.NewMockIRabbitMQClient(ctrl)
+		subscriptionService := service.NewStockSubscriptionService(mockSubscriptionRepo, mockProductRepo, mockVariantRepo,
+			mockUserRepo, rabbitMQClient, backInStockConfig)
+
+		ownerId := int64(8)
+		mockSubscriptionRepo.EXPECT().GetSubscriptionById(int64(5)).Return(domain.StockSubscription{Id: 5, UserId: &ownerId}, nil)
+		mockSubscriptionRepo.EXPECT().Unsubscribe(gomock.Any()).Times(0)
+
+		err := subscriptionService.CancelSubscription(7, 5)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestTranslationService(t *testing.T) {
+	// --- SENARYO 1: Slug verilmeyen yeni çeviriye dilin kurallarıyla slug üretilir ve ürün yeniden indekslenir ---
+	t.Run("SaveProductTranslation_GeneratesLocaleSlug", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		translationService := service.NewTranslationService(mockTranslationRepo, mockProductRepo, mockVariantRepo, mockCategoryRepo,
+			mockStoreRepo, mockSlugRepo, testLocales, db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Name: "Dizüstü Bilgisayar", Slug: "dizustu-bilgisayar"}, nil)
+		mockTranslationRepo.EXPECT().GetProductTranslations(int64(1)).Return([]domain.ProductTranslation{}, nil)
+		mockTranslationRepo.EXPECT().SaveProductTranslation(gomock.Any()).DoAndReturn(func(translation domain.ProductTranslation) (domain.ProductTranslation, error) {
+			assert.Equal(t, "en", translation.Locale)
+			assert.Equal(t, "Laptop", translation.Name)
+			assert.True(t, strings.HasPrefix(translation.Slug, "laptop-"))
+			return translation, nil
+		})
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+		mockRedis.ExpectDel("product:1").SetVal(1)
+
+		translation, err := translationService.SaveProductTranslation(1, domain.UserRoleAdmin, 1, "en", dto.SaveProductTranslationRequest{Name: " Laptop "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Laptop", translation.Name)
+	})
+
+	// --- SENARYO 2: Çevirinin slug'ı değişirse eski slug yönlendirme için geçmişe yazılır ---
+	t.Run("SaveProductTranslation_RecordsReplacedSlug", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		db, mockRedis := redismock.NewClientMock()
+		translationService := service.NewTranslationService(mockTranslationRepo, mockProductRepo, mockVariantRepo, mockCategoryRepo,
+			mockStoreRepo, mockSlugRepo, testLocales, db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, Slug: "dizustu-bilgisayar"}, nil)
+		mockTranslationRepo.EXPECT().GetProductTranslations(int64(1)).Return([]domain.ProductTranslation{
+			{ProductId: 1, Locale: "en", Name: "Laptop", Slug: "laptop"},
+		}, nil)
+		mockSlugRepo.EXPECT().IsSlugTaken(domain.SlugEntityProduct, "light-notebook", int64(1)).Return(false, nil)
+		mockTranslationRepo.EXPECT().SaveProductTranslation(domain.ProductTranslation{
+			ProductId: 1, Locale: "en", Name: "Notebook", Slug: "light-notebook",
+		}).Return(domain.ProductTranslation{ProductId: 1, Locale: "en", Name: "Notebook", Slug: "light-notebook"}, nil)
+		mockSlugRepo.EXPECT().RecordSlug(domain.SlugEntityProduct, int64(1), "laptop").Return(nil)
+		mockSlugRepo.EXPECT().ReleaseSlug(domain.SlugEntityProduct, int64(1), "light-notebook").Return(nil)
+		mockVariantRepo.EXPECT().GetVariantsByProductIds([]int64{1}).Return(map[int64][]domain.ProductVariant{}, nil)
+		mockProductRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil)
+		mockRedis.ExpectDel("product:1").SetVal(1)
+
+		translation, err := translationService.SaveProductTranslation(1, domain.UserRoleAdmin, 1, "en", dto.SaveProductTranslationRequest{
+			Name: "Notebook", Slug: "Light Notebook",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "light-notebook", translation.Slug)
+	})
+
+	// --- SENARYO 3: Varsayılan dil ve desteklenmeyen diller için çeviri kaydedilemez ---
+	t.Run("SaveCategoryTranslation_RejectsDefaultAndUnsupportedLocales", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		translationService := service.NewTranslationService(mockTranslationRepo, mockProductRepo, mockVariantRepo, mockCategoryRepo,
+			mockStoreRepo, mockSlugRepo, testLocales, db)
+
+		request := dto.SaveCategoryTranslationRequest{Name: "Electronics"}
+		_, err := translationService.SaveCategoryTranslation(1, "tr", request)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "edited on the entity itself")
+
+		_, err = translationService.SaveCategoryTranslation(1, "fr", request)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not supported")
+	})
+
+	// --- SENARYO 4: Olmayan çeviri silinmek istenirse bulunamadı döner ---
+	t.Run("DeleteStoreTranslation_NotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		translationService := service.NewTranslationService(mockTranslationRepo, mockProductRepo, mockVariantRepo, mockCategoryRepo,
+			mockStoreRepo, mockSlugRepo, testLocales, db)
+
+		// Mağaza sahibi kendi mağazasının çevirilerini yönetebilir
+		mockStoreRepo.EXPECT().GetStoreById(uint(1)).Return(domain.Store{Id: 1}, nil)
+		mockStoreRepo.EXPECT().IsStoreOwner(uint(1), int64(5)).Return(true, nil)
+		mockTranslationRepo.EXPECT().DeleteStoreTranslation(int64(1), "en").Return(common.ErrTranslationNotFound)
+
+		err := translationService.DeleteStoreTranslation(5, domain.UserRoleCustomer, 1, "en")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Translation not found")
+	})
+
+	// --- SENARYO 5: Mağaza sahibi olmayan kullanıcı ürün çevirisi kaydedemez ---
+	t.Run("SaveProductTranslation_ByNonOwnerIsForbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTranslationRepo := mock_repository.NewMockITranslationRepository(ctrl)
+		mockProductRepo := mock_repository.NewMockIProductRepository(ctrl)
+		mockVariantRepo := mock_repository.NewMockIProductVariantRepository(ctrl)
+		mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+		mockStoreRepo := mock_repository.NewMockIStoreRepository(ctrl)
+		mockSlugRepo := mock_repository.NewMockISlugHistoryRepository(ctrl)
+		db, _ := redismock.NewClientMock()
+		translationService := service.NewTranslationService(mockTranslationRepo, mockProductRepo, mockVariantRepo, mockCategoryRepo,
+			mockStoreRepo, mockSlugRepo, testLocales, db)
+
+		mockProductRepo.EXPECT().GetProductById(int64(1)).Return(domain.Product{Id: 1, StoreId: 2}, nil)
+		mockStoreRepo.EXPECT().IsStoreOwner(uint(2), int64(5)).Return(false, nil)
+		mockTranslationRepo.EXPECT().SaveProductTranslation(gomock.Any()).Times(0)
+
+		_, err := translationService.SaveProductTranslation(5, domain.UserRoleCustomer, 1, "en", dto.SaveProductTranslationRequest{Name: "Laptop"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only the owners")
+	})
+}
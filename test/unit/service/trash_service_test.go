@@ -0,0 +1,87 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/persistence/common"
+	"go-ecommerce-service/service"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestTrashService(t *testing.T) {
+	type mocks struct {
+		productRepo  *mock_repository.MockIProductRepository
+		variantRepo  *mock_repository.MockIProductVariantRepository
+		storeRepo    *mock_repository.MockIStoreRepository
+		categoryRepo *mock_repository.MockICategoryRepository
+		redis        redismock.ClientMock
+	}
+
+	setup := func(t *testing.T) (service.ITrashService, mocks) {
+		ctrl := gomock.NewController(t)
+		db, mockRedis := redismock.NewClientMock()
+		m := mocks{
+			productRepo:  mock_repository.NewMockIProductRepository(ctrl),
+			variantRepo:  mock_repository.NewMockIProductVariantRepository(ctrl),
+			storeRepo:    mock_repository.NewMockIStoreRepository(ctrl),
+			categoryRepo: mock_repository.NewMockICategoryRepository(ctrl),
+			redis:        mockRedis,
+		}
+		return service.NewTrashService(m.productRepo, m.variantRepo, m.storeRepo, m.categoryRepo, db, 30*24*time.Hour), m
+	}
+
+	// --- SENARYO 1: Mağaza, birlikte silinen ürünleriyle geri gelir ---
+	t.Run("RestoreStore_ReindexesProducts", func(t *testing.T) {
+		trashService, m := setup(t)
+
+		m.storeRepo.EXPECT().RestoreStoreById(uint(3)).Return(domain.Store{Id: 3, Name: "TeknoStore"}, []int64{10, 11}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10, StoreId: 3}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(11)).Return(domain.Product{Id: 11, StoreId: 3}, nil)
+		m.variantRepo.EXPECT().GetVariantsByProductIds([]int64{10, 11}).Return(map[int64][]domain.ProductVariant{}, nil)
+		m.redis.ExpectDel("product:10").SetVal(1)
+		m.redis.ExpectDel("product:11").SetVal(1)
+		m.productRepo.EXPECT().IndexProduct(gomock.Any()).Return(nil).Times(2)
+
+		store, err := trashService.RestoreStore(3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), store.Id)
+		assert.NoError(t, m.redis.ExpectationsWereMet())
+	})
+
+	// --- SENARYO 2: Çöpte olmayan ürün ---
+	t.Run("RestoreProduct_NotInTrash", func(t *testing.T) {
+		trashService, m := setup(t)
+
+		m.productRepo.EXPECT().RestoreProductById(int64(5)).Return(domain.Product{}, common.ErrProductNotFound)
+
+		_, err := trashService.RestoreProduct(5)
+
+		assert.Error(t, err)
+	})
+
+	// --- SENARYO 3: Saklama süresini aşanlar silinir ---
+	t.Run("PurgeExpired_UsesRetention", func(t *testing.T) {
+		trashService, m := setup(t)
+
+		var cutoff time.Time
+		m.productRepo.EXPECT().PurgeDeletedProducts(gomock.Any()).DoAndReturn(func(before time.Time) (int64, error) {
+			cutoff = before
+			return 4, nil
+		})
+		m.storeRepo.EXPECT().PurgeDeletedStores(gomock.Any()).Return(int64(1), nil)
+		m.categoryRepo.EXPECT().PurgeDeletedCategories(gomock.Any()).Return(int64(0), nil)
+
+		purge, err := trashService.PurgeExpired()
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TrashPurge{Products: 4, Stores: 1}, purge)
+		assert.WithinDuration(t, time.Now().Add(-30*24*time.Hour), cutoff, time.Minute)
+	})
+}
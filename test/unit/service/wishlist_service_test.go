@@ -0,0 +1,217 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/infrastructure/rabbitmq"
+	"go-ecommerce-service/internal/dto"
+	"go-ecommerce-service/service"
+	mock_infra "go-ecommerce-service/test/mock/infrastructure"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestWishlistService(t *testing.T) {
+	type mocks struct {
+		wishlistRepo *mock_repository.MockIWishlistRepository
+		productRepo  *mock_repository.MockIProductRepository
+		variantRepo  *mock_repository.MockIProductVariantRepository
+		cartRepo     *mock_repository.MockICartRepository
+		cartItemRepo *mock_repository.MockICartItemRepository
+		rabbit       *mock_infra.MockIRabbitMQClient
+	}
+
+	setup := func(t *testing.T) (service.IWishlistService, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{
+			wishlistRepo: mock_repository.NewMockIWishlistRepository(ctrl),
+			productRepo:  mock_repository.NewMockIProductRepository(ctrl),
+			variantRepo:  mock_repository.NewMockIProductVariantRepository(ctrl),
+			cartRepo:     mock_repository.NewMockICartRepository(ctrl),
+			cartItemRepo: mock_repository.NewMockICartItemRepository(ctrl),
+			rabbit:       mock_infra.NewMockIRabbitMQClient(ctrl),
+		}
+		return service.NewWishlistService(m.wishlistRepo, m.productRepo, m.variantRepo, m.cartRepo, m.cartItemRepo, m.rabbit), m
+	}
+
+	shareToken := "paylasim"
+
+	// --- SENARYO 1: Herkese açık liste paylaşım anahtarıyla, adı kırpılarak oluşturulur ---
+	t.Run("CreateWishlist_PublicGetsShareToken", func(t *testing.T) {
+		wishlistService, m := setup(t)
+
+		m.wishlistRepo.EXPECT().CreateWishlist(gomock.Any()).DoAndReturn(func(wishlist domain.Wishlist) (domain.Wishlist, error) {
+			assert.Equal(t, int64(7), wishlist.UserId)
+			assert.Equal(t, "Doğum günü", wishlist.Name)
+			assert.NotNil(t, wishlist.ShareToken)
+			assert.Len(t, *wishlist.ShareToken, 32)
+			wishlist.Id = 1
+			return wishlist, nil
+		})
+
+		created, err := wishlistService.CreateWishlist(7, dto.CreateWishlistRequest{Name: "  Doğum günü ", IsPublic: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), created.Id)
+		assert.NotNil(t, created.ShareToken)
+	})
+
+	// --- SENARYO 2: Özel listeye paylaşım anahtarı verilmez, adsız liste reddedilir ---
+	t.Run("CreateWishlist_PrivateHasNoTokenAndNameIsRequired", func(t *testing.T) {
+		wishlistService, m := setup(t)
+
+		m.wishlistRepo.EXPECT().CreateWishlist(gomock.Any()).DoAndReturn(func(wishlist domain.Wishlist) (domain.Wishlist, error) {
+			assert.Nil(t, wishlist.ShareToken)
+			return wishlist, nil
+		})
+
+		_, err := wishlistService.CreateWishlist(7, dto.CreateWishlistRequest{Name: "Özel"})
+		_, blankErr := wishlistService.CreateWishlist(7, dto.CreateWishlistRequest{Name: ""})
+
+		assert.NoError(t, err)
+		assert.Error(t, blankErr)
+	})
+
+	// --- SENARYO 3: Liste özele alınınca paylaşım bağlantısı iptal edilir ---
+	t.Run("UpdateWishlist_PrivateRevokesShareToken", func(t *testing.T) {
+		wishlistService, m := setup(t)
+
+		m.wishlistRepo.EXPECT().GetWishlistById(int64(1)).Return(domain.Wishlist{Id: 1, UserId: 7, IsPublic: true, ShareToken: &shareToken}, nil)
+		m.wishlistRepo.EXPECT().UpdateWishlist(int64(1), gomock.Any()).DoAndReturn(func(wishlistId int64, wishlist domain.Wishlist) (domain.Wishlist, error) {
+			assert.False(t, wishlist.IsPublic)
+			assert.Nil(t, wishlist.ShareToken)
+			return wishlist, nil
+		})
+
+		_, err := wishlistService.UpdateWishlist(7, 1, dto.CreateWishlistRequest{Name: "Liste", IsPublic: false})
+
+		assert.NoError(t, err)
+	})
+
+	// --- SENARYO 4: Paylaşılan liste yayındaki ürünleriyle döner, paylaşım anahtarı gizlenir ---
+	t.Run("GetSharedWishlist_HidesShareTokenAndUnpublishedProducts", func(t *testing.T) {
+		wishlistService, m := setup(t)
+
+		m.wishlistRepo.EXPECT().GetWishlistByShareToken(shareToken).Return(domain.Wishlist{Id: 1, UserId: 7, IsPublic: true, ShareToken: &shareToken}, nil)
+		m.wishlistRepo.EXPECT().GetItemsByWishlistId(int64(1)).Return([]domain.WishlistItem{
+			{Id: 4, WishlistId: 1, ProductId: 10, AddedPrice: 120},
+			{Id: 5, WishlistId: 1, ProductId: 11, AddedPrice: 50},
+			{Id: 6, WishlistId: 1, ProductId: 12, AddedPrice: 80},
+		}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10, Name: "Laptop", Price: 100, Status: domain.ProductStatusPublished}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(11)).Return(domain.Product{}, errors.New("not found"))
+		m.productRepo.EXPECT().GetProductById(int64(12)).Return(domain.Product{Id: 12, Name: "Taslak", Price: 80, Status: domain.ProductStatusDraft}, nil)
+
+		wishlist, err := wishlistService.GetSharedWishlist(shareToken)
+
+		assert.NoError(t, err)
+		assert.Nil(t, wishlist.ShareToken)
+		assert.Len(t, wishlist.Items, 1)
+		assert.Equal(t, int64(10), wishlist.Items[0].ProductId)
+		assert.True(t, wishlist.Items[0].PriceDropped)
+	})
+
+	// --- SENARYO 5: Liste ürünü varyantıyla birlikte kullanıcının sepetine eklenir ve listeden çıkarılır ---
+	t.Run("MoveItemToCart_AddsToCartAndRemovesFromWishlist", func(t *testing.T) {
+		wishlistService, m := setup(t)
+
+		variantId := int64(6)
+		m.wishlistRepo.EXPECT().GetWishlistById(int64(1)).Return(domain.Wishlist{Id: 1, UserId: 7}, nil)
+		m.wishlistRepo.EXPECT().GetItemById(int64(4)).Return(domain.WishlistItem{Id: 4, WishlistId: 1, ProductId: 10, VariantId: &variantId}, nil)
+		m.cartRepo.EXPECT().GetCartById(int64(3)).Return(domain.Cart{Id: 3, UserId: 7})
+		m.cartItemRepo.EXPECT().AddItemToCart(domain.CartItem{CartId: 3, ProductId: 10, VariantId: &variantId, Quantity: 1}).
+			Return(domain.CartItem{Id: 9, CartId: 3, ProductId: 10, VariantId: &variantId, Quantity: 1}, nil)
+		m.wishlistRepo.EXPECT().RemoveItem(int64(4)).Return(nil)
+
+		cartItem, err := wishlistService.MoveItemToCart(7, 1, 4, dto.MoveWishlistItemToCartRequest{CartId: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), cartItem.Id)
+		assert.Equal(t, &variantId, cartItem.VariantId)
+	})
+
+	// --- SENARYO 6: Başkasının sepetine taşıma yapılamaz, liste değişmez ---
+	t.Run("MoveItemToCart_ForeignCart", func(t *testing.T) {
+		wishlistService, m := setup(t)
+
+		m.wishlistRepo.EXPECT().GetWishlistById(int64(1)).Return(domain.Wishlist{Id: 1, UserId: 7}, nil)
+		m.wishlistRepo.EXPECT().GetItemById(int64(4)).Return(domain.WishlistItem{Id: 4, WishlistId: 1, ProductId: 10}, nil)
+		m.cartRepo.EXPECT().GetCartById(int64(3)).Return(domain.Cart{Id: 3, UserId: 8})
+		m.cartItemRepo.EXPECT().AddItemToCart(gomock.Any()).Times(0)
+		m.wishlistRepo.EXPECT().RemoveItem(gomock.Any()).Times(0)
+
+		_, err := wishlistService.MoveItemToCart(7, 1, 4, dto.MoveWishlistItemToCartRequest{CartId: 3, Quantity: 2})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Cart not found")
+	})
+
+	// --- SENARYO 7: Sepet kalemi listeye güncel fiyatıyla eklenir ve sepetten silinir ---
+	t.Run("MoveCartItemToWishlist_RemovesCartLine", func(t *testing.T) {
+		wishlistService, m := setup(t)
+
+		m.wishlistRepo.EXPECT().GetWishlistById(int64(1)).Return(domain.Wishlist{Id: 1, UserId: 7}, nil)
+		m.cartItemRepo.EXPECT().GetItemById(int64(9)).Return(domain.CartItem{Id: 9, CartId: 3, ProductId: 10, Quantity: 2}, nil)
+		m.cartRepo.EXPECT().GetCartById(int64(3)).Return(domain.Cart{Id: 3, UserId: 7})
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10, Name: "Laptop", Price: 100}, nil)
+		m.wishlistRepo.EXPECT().AddItem(domain.WishlistItem{WishlistId: 1, ProductId: 10, AddedPrice: 100}).
+			Return(domain.WishlistItem{Id: 4, WishlistId: 1, ProductId: 10, AddedPrice: 100}, nil)
+		m.cartItemRepo.EXPECT().RemoveItemFromCart(int64(9)).Return(nil)
+
+		item, err := wishlistService.MoveCartItemToWishlist(7, 1, dto.MoveCartItemToWishlistRequest{CartItemId: 9})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), item.Id)
+		assert.Equal(t, 100.0, item.AddedPrice)
+	})
+
+	// --- SENARYO 8: Fiyatı düşen ürün için olay yayınlanır, yayınlanamayan bildirim tekrar denenir ---
+	t.Run("DetectPriceDrops_PublishesAndMarksNotified", func(t *testing.T) {
+		wishlistService, m := setup(t)
+
+		m.wishlistRepo.EXPECT().GetPriceDrops().Return([]domain.WishlistPriceDrop{
+			{WishlistItemId: 4, WishlistId: 1, UserId: 7, ProductId: 10, PreviousPrice: 120, CurrentPrice: 100},
+			{WishlistItemId: 5, WishlistId: 1, UserId: 7, ProductId: 11, PreviousPrice: 60, CurrentPrice: 50},
+		}, nil)
+		gomock.InOrder(
+			m.rabbit.EXPECT().Publish("", rabbitmq.WishlistPriceDroppedQueue, false, false, gomock.Any()).DoAndReturn(
+				func(exchange, routingKey string, mandatory, immediate bool, msg amqp.Publishing) error {
+					var payload map[string]interface{}
+					assert.NoError(t, json.Unmarshal(msg.Body, &payload))
+					assert.Equal(t, "wishlist.price_dropped", payload["event"])
+					assert.Equal(t, 100.0, payload["current_price"])
+					return nil
+				}),
+			m.rabbit.EXPECT().Publish("", rabbitmq.WishlistPriceDroppedQueue, false, false, gomock.Any()).Return(errors.New("broker down")),
+		)
+		m.wishlistRepo.EXPECT().MarkPriceDropNotified(int64(4), 100.0).Return(nil)
+		m.wishlistRepo.EXPECT().MarkPriceDropNotified(int64(5), gomock.Any()).Times(0)
+
+		count, err := wishlistService.DetectPriceDrops()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	// --- SENARYO 9: Başka ürüne ait varyant listeye eklenemez ---
+	t.Run("AddItem_VariantOfOtherProductIsRejected", func(t *testing.T) {
+		wishlistService, m := setup(t)
+
+		variantId := int64(6)
+		m.wishlistRepo.EXPECT().GetWishlistById(int64(1)).Return(domain.Wishlist{Id: 1, UserId: 7}, nil)
+		m.productRepo.EXPECT().GetProductById(int64(10)).Return(domain.Product{Id: 10, Price: 100}, nil)
+		m.variantRepo.EXPECT().GetVariantById(variantId).Return(domain.ProductVariant{Id: 6, ProductId: 11}, nil)
+		m.wishlistRepo.EXPECT().AddItem(gomock.Any()).Times(0)
+
+		_, err := wishlistService.AddItem(7, 1, dto.AddWishlistItemRequest{ProductId: 10, VariantId: &variantId})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Variant does not belong to the product")
+	})
+}
@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"go-ecommerce-service/config"
+	"go-ecommerce-service/domain"
+	"go-ecommerce-service/service"
+	"go-ecommerce-service/service/worker"
+	mock_infra "go-ecommerce-service/test/mock/infrastructure"
+	mock_repository "go-ecommerce-service/test/mock/repository"
+)
+
+func TestCartAbandonmentWorker(t *testing.T) {
+	setup := func(t *testing.T) (*worker.CartAbandonmentWorker, *mock_repository.MockICartRepository, *mock_infra.MockIRabbitMQClient) {
+		ctrl := gomock.NewController(t)
+		cartRepo := mock_repository.NewMockICartRepository(ctrl)
+		cartItemRepo := mock_repository.NewMockICartItemRepository(ctrl)
+		cartItemRepo.EXPECT().GetItemsByCartId(gomock.Any()).Return([]domain.CartItem{}).AnyTimes()
+		rabbit := mock_infra.NewMockIRabbitMQClient(ctrl)
+		cartService := service.NewCartService(cartRepo, cartItemRepo, mock_repository.NewMockIProductRepository(ctrl),
+			mock_repository.NewMockIProductVariantRepository(ctrl), mock_repository.NewMockIPriceRuleRepository(ctrl),
+			rabbit, config.CartConfig{AbandonAfter: "24h", RecoverySecret: "test-secret"})
+		return worker.NewCartAbandonmentWorker(cartService, time.Minute), cartRepo, rabbit
+	}
+
+	// --- SENARYO 1: Bir çalıştırma hareketsiz sepetleri bir kez terk edilmiş işaretler ---
+	t.Run("Run_MarksIdleCarts", func(t *testing.T) {
+		abandonmentWorker, cartRepo, rabbit := setup(t)
+
+		cartRepo.EXPECT().GetInactiveCarts(gomock.Any()).Return([]domain.Cart{{Id: 1}, {Id: 2}}, nil).Times(1)
+		cartRepo.EXPECT().MarkCartAbandoned(int64(1)).Return(domain.Cart{Id: 1}, nil)
+		cartRepo.EXPECT().MarkCartAbandoned(int64(2)).Return(domain.Cart{Id: 2}, nil)
+		rabbit.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		abandonmentWorker.Run()
+	})
+
+	// --- SENARYO 2: Sepetler okunamazsa hiçbir sepet işaretlenmez ---
+	t.Run("Run_SkipsWhenCartsCannotBeRead", func(t *testing.T) {
+		abandonmentWorker, cartRepo, rabbit := setup(t)
+
+		cartRepo.EXPECT().GetInactiveCarts(gomock.Any()).Return(nil, errors.New("db down"))
+		cartRepo.EXPECT().MarkCartAbandoned(gomock.Any()).Times(0)
+		rabbit.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		abandonmentWorker.Run()
+	})
+}